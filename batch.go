@@ -0,0 +1,260 @@
+package pango
+
+import (
+	"sync"
+	"time"
+)
+
+// BatchWriter collects set, edit, and delete requests and sends them to
+// PAN-OS in multi-config requests.
+//
+// A BatchWriter satisfies util.XapiClient, so namespaces can be initialized
+// with it instead of the client:
+//
+//	bw := fw.NewBatchWriter(500, 2*time.Second, false)
+//	ns := &addr.FwAddr{}
+//	ns.Initialize(bw)
+//
+// Pending requests are flushed once MaxOps requests have been collected, or
+// once MaxDelay has passed since the first pending request was collected,
+// whichever comes first.  Flushes triggered by MaxOps happen in the calling
+// goroutine, so callers writing faster than PAN-OS can accept are slowed
+// down accordingly.  Errors from flushes triggered by MaxDelay are returned
+// from the next write, Flush(), or Close().
+//
+// Any other config changing request (move, rename, multi-config, import,
+// vsys import or unimport, partial config load, or commit) flushes pending
+// requests first so that ordering is preserved.  Reads and op commands are
+// not flushed, so pending writes are not visible to reads until flushed.
+//
+// A BatchWriter is safe for concurrent use, including alongside other API
+// calls made with the same client, as long as the client is not also in
+// PrepareMultiConfigure() mode.
+type BatchWriter struct {
+	*Client
+
+	MaxOps   int
+	MaxDelay time.Duration
+	Strict   bool
+
+	q     mcQueue
+	mu    sync.Mutex
+	send  sync.Mutex
+	timer *time.Timer
+	err   error
+}
+
+// NewBatchWriter returns a BatchWriter that sends its requests using this
+// client.
+//
+// If maxOps is 0 or less, then flushing is based on time alone.  If maxDelay
+// is 0 or less, then flushing is based on the number of requests alone.
+// Param strict should be true if each multi-config request should be
+// strictly transactional.
+func (c *Client) NewBatchWriter(maxOps int, maxDelay time.Duration, strict bool) *BatchWriter {
+	return &BatchWriter{
+		Client:   c,
+		MaxOps:   maxOps,
+		MaxDelay: maxDelay,
+		Strict:   strict,
+	}
+}
+
+// Set queues a "set" type command.
+func (b *BatchWriter) Set(path, element, extras, ans interface{}) ([]byte, error) {
+	return nil, b.add("set", path, element)
+}
+
+// Edit queues an "edit" type command.
+func (b *BatchWriter) Edit(path, element, extras, ans interface{}) ([]byte, error) {
+	return nil, b.add("edit", path, element)
+}
+
+// Delete queues a "delete" type command.
+func (b *BatchWriter) Delete(path, extras, ans interface{}) ([]byte, error) {
+	return nil, b.add("delete", path, nil)
+}
+
+// Move flushes any pending requests, then performs a "move" type command.
+func (b *BatchWriter) Move(path interface{}, where, dst string, extras, ans interface{}) ([]byte, error) {
+	if err := b.Flush(); err != nil {
+		return nil, err
+	}
+
+	return b.Client.Move(path, where, dst, extras, ans)
+}
+
+// Rename flushes any pending requests, then performs a "rename" type command.
+func (b *BatchWriter) Rename(path interface{}, newname string, extras, ans interface{}) ([]byte, error) {
+	if err := b.Flush(); err != nil {
+		return nil, err
+	}
+
+	return b.Client.Rename(path, newname, extras, ans)
+}
+
+// MultiConfig flushes any pending requests, then performs a "multi-config"
+// type command.
+func (b *BatchWriter) MultiConfig(element MultiConfigure, strict bool, extras interface{}) ([]byte, MultiConfigureResponse, error) {
+	if err := b.Flush(); err != nil {
+		return nil, MultiConfigureResponse{}, err
+	}
+
+	return b.Client.MultiConfig(element, strict, extras)
+}
+
+// Import flushes any pending requests, then performs an "import" type
+// command.
+func (b *BatchWriter) Import(cat, content, filename, fp string, extras map[string]string, ans interface{}) ([]byte, error) {
+	if err := b.Flush(); err != nil {
+		return nil, err
+	}
+
+	return b.Client.Import(cat, content, filename, fp, extras, ans)
+}
+
+// LoadConfigPartial flushes any pending requests, then performs a partial
+// config load.
+func (b *BatchWriter) LoadConfigPartial(name, fromXpath, toXpath, mode string) error {
+	if err := b.Flush(); err != nil {
+		return err
+	}
+
+	return b.Client.LoadConfigPartial(name, fromXpath, toXpath, mode)
+}
+
+// VsysImport flushes any pending requests, then performs a vsys import.
+func (b *BatchWriter) VsysImport(loc, tmpl, ts, vsys string, names []string) error {
+	if err := b.Flush(); err != nil {
+		return err
+	}
+
+	return b.Client.VsysImport(loc, tmpl, ts, vsys, names)
+}
+
+// VsysUnimport flushes any pending requests, then performs a vsys unimport.
+func (b *BatchWriter) VsysUnimport(loc, tmpl, ts string, names []string) error {
+	if err := b.Flush(); err != nil {
+		return err
+	}
+
+	return b.Client.VsysUnimport(loc, tmpl, ts, names)
+}
+
+// PositionFirstEntity flushes any pending requests, then positions the
+// given entity.
+func (b *BatchWriter) PositionFirstEntity(mvt int, rel, ent string, path, elms []string) error {
+	if err := b.Flush(); err != nil {
+		return err
+	}
+
+	return b.Client.PositionFirstEntity(mvt, rel, ent, path, elms)
+}
+
+// Commit flushes any pending requests, then performs a commit.
+func (b *BatchWriter) Commit(cmd interface{}, action string, extras interface{}) (uint, []byte, error) {
+	if err := b.Flush(); err != nil {
+		return 0, nil, err
+	}
+
+	return b.Client.Commit(cmd, action, extras)
+}
+
+// Pending returns the number of requests waiting to be sent.
+func (b *BatchWriter) Pending() int {
+	return b.q.pending()
+}
+
+// Flush sends all pending requests now.
+//
+// If a previous background flush failed, then that error is returned.
+func (b *BatchWriter) Flush() error {
+	b.send.Lock()
+	defer b.send.Unlock()
+
+	b.mu.Lock()
+	reqs := b.take()
+	err := b.err
+	b.err = nil
+	b.mu.Unlock()
+
+	if e2 := b.sendReqs(reqs); err == nil {
+		err = e2
+	}
+
+	return err
+}
+
+// Close flushes all pending requests and stops the background flush timer.
+func (b *BatchWriter) Close() error {
+	return b.Flush()
+}
+
+/** Internal functions for the BatchWriter struct **/
+
+func (b *BatchWriter) add(action string, path, element interface{}) error {
+	if b.ReadOnly {
+		return ReadOnlyError{action}
+	}
+
+	r := newMultiConfigureRequest(action, path, element)
+	b.logXpath(r.Xpath)
+
+	b.mu.Lock()
+	if err := b.err; err != nil {
+		b.err = nil
+		b.mu.Unlock()
+		return err
+	}
+	num := b.q.add(r)
+	full := b.MaxOps > 0 && num >= b.MaxOps
+	if !full && b.timer == nil && b.MaxDelay > 0 {
+		b.timer = time.AfterFunc(b.MaxDelay, b.background)
+	}
+	b.mu.Unlock()
+
+	if full {
+		return b.Flush()
+	}
+
+	return nil
+}
+
+// background is invoked when MaxDelay has passed.
+func (b *BatchWriter) background() {
+	b.send.Lock()
+	defer b.send.Unlock()
+
+	b.mu.Lock()
+	reqs := b.take()
+	b.mu.Unlock()
+
+	if err := b.sendReqs(reqs); err != nil {
+		b.mu.Lock()
+		if b.err == nil {
+			b.err = err
+		}
+		b.mu.Unlock()
+	}
+}
+
+// take stops the flush timer, then removes and returns the pending requests.
+// The caller must hold mu.
+func (b *BatchWriter) take() []MultiConfigureRequest {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+
+	return b.q.take()
+}
+
+func (b *BatchWriter) sendReqs(reqs []MultiConfigureRequest) error {
+	if len(reqs) == 0 {
+		return nil
+	}
+
+	b.LogAction("(batch) sending %d requests", len(reqs))
+	_, err := b.Client.sendBatch(reqs, b.Strict)
+	return err
+}
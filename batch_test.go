@@ -0,0 +1,189 @@
+package pango
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/objs/addr"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+var _ util.XapiClient = &BatchWriter{}
+
+func TestBatchWriterMaxOps(t *testing.T) {
+	c := &Client{rb: [][]byte{[]byte(okMultiConfigResp), []byte(okMultiConfigResp)}}
+	c.Initialize()
+	bw := c.NewBatchWriter(2, 0, false)
+
+	ns := &addr.FwAddr{}
+	ns.Initialize(bw)
+
+	for _, name := range []string{"a1", "a2", "a3"} {
+		if err := ns.Set("", addr.Entry{Name: name, Value: "10.1.1.1", Type: addr.IpNetmask}); err != nil {
+			t.Fatalf("Error in set: %s", err)
+		}
+	}
+
+	if len(c.rp) != 1 {
+		t.Fatalf("Sent %d requests, not 1", len(c.rp))
+	} else if c.rp[0].Get("action") != "multi-config" {
+		t.Errorf("Action is %q", c.rp[0].Get("action"))
+	} else if n := strings.Count(c.rp[0].Get("element"), "<set "); n != 2 {
+		t.Errorf("Multi-config has %d sets", n)
+	}
+	if bw.Pending() != 1 {
+		t.Errorf("Pending is %d, not 1", bw.Pending())
+	}
+
+	if err := bw.Close(); err != nil {
+		t.Fatalf("Error in close: %s", err)
+	}
+	if len(c.rp) != 2 {
+		t.Errorf("Sent %d requests, not 2", len(c.rp))
+	}
+	if bw.Pending() != 0 {
+		t.Errorf("Pending is %d after close", bw.Pending())
+	}
+}
+
+func TestBatchWriterMaxDelay(t *testing.T) {
+	c := &Client{rb: [][]byte{[]byte(okMultiConfigResp)}}
+	c.Initialize()
+	bw := c.NewBatchWriter(0, 10*time.Millisecond, false)
+
+	if _, err := bw.Delete("/config/shared/address/entry[@name='a1']", nil, nil); err != nil {
+		t.Fatalf("Error in delete: %s", err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for bw.Pending() != 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if err := bw.Close(); err != nil {
+		t.Fatalf("Error in close: %s", err)
+	}
+	if len(c.rp) != 1 {
+		t.Errorf("Sent %d requests, not 1", len(c.rp))
+	}
+}
+
+func TestBatchWriterError(t *testing.T) {
+	c := &Client{rb: [][]byte{[]byte(invalidMultiConfigResp)}}
+	c.Initialize()
+	bw := c.NewBatchWriter(0, 0, true)
+
+	if _, err := bw.Set("/config/shared/address", "<entry name='a1'/>", nil, nil); err != nil {
+		t.Fatalf("Error in set: %s", err)
+	}
+	if err := bw.Flush(); err == nil {
+		t.Errorf("Expected an error from a failed batch")
+	}
+	if c.rp[0].Get("strict-transactional") != "yes" {
+		t.Errorf("Batch was not strict")
+	}
+}
+
+func TestBatchWriterFlushesBeforeImport(t *testing.T) {
+	c := &Client{rb: [][]byte{
+		[]byte(okMultiConfigResp),
+		[]byte(`<response status="success" code="20"><msg>command succeeded</msg></response>`),
+	}}
+	c.Initialize()
+	bw := c.NewBatchWriter(10, 0, false)
+
+	bw.Set("/config/devices/entry/network/interface/ethernet", "<entry name='ethernet1/1'/>", nil, nil)
+	if err := bw.VsysImport(util.InterfaceImport, "", "", "vsys1", []string{"ethernet1/1"}); err != nil {
+		t.Fatalf("Error in import: %s", err)
+	}
+
+	if len(c.rp) != 2 {
+		t.Fatalf("Sent %d requests, not 2", len(c.rp))
+	} else if c.rp[0].Get("action") != "multi-config" || c.rp[1].Get("action") != "set" {
+		t.Errorf("Requests sent out of order: %q, %q", c.rp[0].Get("action"), c.rp[1].Get("action"))
+	}
+}
+
+func TestBatchWriterFlushesBeforeRenameAndLoad(t *testing.T) {
+	ok := []byte(`<response status="success" code="20"><msg>command succeeded</msg></response>`)
+	c := &Client{rb: [][]byte{[]byte(okMultiConfigResp), ok, []byte(okMultiConfigResp), ok}}
+	c.Initialize()
+	bw := c.NewBatchWriter(10, 0, false)
+
+	bw.Set("/config/shared/address", "<entry name='a1'/>", nil, nil)
+	if _, err := bw.Rename("/config/shared/address/entry[@name='a1']", "a2", nil, nil); err != nil {
+		t.Fatalf("Error in rename: %s", err)
+	}
+	bw.Set("/config/shared/address", "<entry name='a3'/>", nil, nil)
+	if err := bw.LoadConfigPartial("snap.xml", "/config/shared/tag", "", LoadPartialMerge); err != nil {
+		t.Fatalf("Error in load config partial: %s", err)
+	}
+
+	if len(c.rp) != 4 {
+		t.Fatalf("Sent %d requests, not 4", len(c.rp))
+	}
+	for i, action := range []string{"multi-config", "rename", "multi-config", ""} {
+		if c.rp[i].Get("action") != action {
+			t.Errorf("Request %d is %q, not %q", i, c.rp[i].Get("action"), action)
+		}
+	}
+	if bw.Pending() != 0 {
+		t.Errorf("%d requests still pending", bw.Pending())
+	}
+}
+
+func TestBatchWriterReadOnly(t *testing.T) {
+	c := &Client{ReadOnly: true, rb: [][]byte{[]byte(okMultiConfigResp)}}
+	c.Initialize()
+	bw := c.NewBatchWriter(10, 0, false)
+
+	if _, err := bw.Set("/config/shared/address", "<entry name='a1'/>", nil, nil); err == nil {
+		t.Errorf("Expected a ReadOnlyError")
+	}
+}
+
+func TestBatchWriterConcurrentReads(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<response status="success"><msg><line>Warning: x is not a valid reference</line></msg></response>`))
+	}))
+	defer srv.Close()
+
+	c := &Client{Logging: LogQuiet}
+	c.con = srv.Client()
+	c.api_url = srv.URL
+
+	bw := c.NewBatchWriter(0, time.Millisecond, false)
+	b := c.NewBatch()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				if _, err := bw.Set("/config/shared", "<address/>", nil, nil); err != nil {
+					t.Errorf("Error in set: %s", err)
+				}
+				if _, err := bw.Get("/config/shared", nil, nil); err != nil {
+					t.Errorf("Error in get: %s", err)
+				}
+				if _, err := b.Edit("/config/shared", "<address/>", nil, nil); err != nil {
+					t.Errorf("Error in edit: %s", err)
+				}
+				_ = c.Warnings()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := bw.Close(); err != nil {
+		t.Errorf("Error in close: %s", err)
+	}
+	if b.Pending() != 80 {
+		t.Errorf("Pending is %d, not 80", b.Pending())
+	}
+}
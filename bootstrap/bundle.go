@@ -0,0 +1,145 @@
+package bootstrap
+
+import (
+	"archive/tar"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Bundle is a VM-Series bootstrap package.
+//
+// BootstrapXml is the content of config/bootstrap.xml, and is omitted if
+// empty.  AuthCodes are written to license/authcodes, one per line.
+//
+// Content, Software, and Plugins are maps of filename to file content for
+// the content, software, and plugins directories respectively.
+type Bundle struct {
+	InitCfg      InitCfg
+	BootstrapXml []byte
+	AuthCodes    []string
+	Content      map[string][]byte
+	Software     map[string][]byte
+	Plugins      map[string][]byte
+}
+
+// Files returns a map of relative file path to file content for every file
+// in this bundle.
+//
+// Each of the top level bootstrap directories is always present, even if
+// it is empty.
+func (o Bundle) Files() (map[string][]byte, error) {
+	ans := map[string][]byte{
+		"config/init-cfg.txt": o.InitCfg.Bytes(),
+	}
+
+	if len(o.BootstrapXml) > 0 {
+		ans["config/bootstrap.xml"] = o.BootstrapXml
+	}
+
+	if len(o.AuthCodes) > 0 {
+		ans["license/authcodes"] = []byte(strings.Join(o.AuthCodes, "\n") + "\n")
+	}
+
+	dirs := []struct {
+		name  string
+		files map[string][]byte
+	}{
+		{"content", o.Content},
+		{"software", o.Software},
+		{"plugins", o.Plugins},
+	}
+	for _, d := range dirs {
+		for name, data := range d.files {
+			if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+				return nil, fmt.Errorf("Invalid %s filename: %q", d.name, name)
+			}
+			ans[d.name+"/"+name] = data
+		}
+	}
+
+	return ans, nil
+}
+
+// WriteDir writes this bundle into the given directory, creating it if it
+// does not already exist.
+func (o Bundle) WriteDir(dir string) error {
+	files, err := o.Files()
+	if err != nil {
+		return err
+	}
+
+	for _, d := range bundleDirs {
+		if err = os.MkdirAll(filepath.Join(dir, d), 0755); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range sortedNames(files) {
+		fp := filepath.Join(dir, filepath.FromSlash(name))
+		if err = os.WriteFile(fp, files[name], 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteTar writes this bundle as an uncompressed tar stream to w.
+func (o Bundle) WriteTar(w io.Writer) error {
+	files, err := o.Files()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	tw := tar.NewWriter(w)
+
+	for _, d := range bundleDirs {
+		hdr := &tar.Header{
+			Typeflag: tar.TypeDir,
+			Name:     d + "/",
+			Mode:     0755,
+			ModTime:  now,
+		}
+		if err = tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range sortedNames(files) {
+		hdr := &tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     name,
+			Mode:     0644,
+			Size:     int64(len(files[name])),
+			ModTime:  now,
+		}
+		if err = tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if _, err = tw.Write(files[name]); err != nil {
+			return err
+		}
+	}
+
+	return tw.Close()
+}
+
+/** Internal variables / functions **/
+
+var bundleDirs = []string{"config", "content", "license", "plugins", "software"}
+
+func sortedNames(m map[string][]byte) []string {
+	ans := make([]string, 0, len(m))
+	for k := range m {
+		ans = append(ans, k)
+	}
+	sort.Strings(ans)
+
+	return ans
+}
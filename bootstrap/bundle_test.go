@@ -0,0 +1,102 @@
+package bootstrap
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func testBundle() Bundle {
+	return Bundle{
+		InitCfg: InitCfg{
+			Type:           TypeDhcpClient,
+			Hostname:       "fw1",
+			PanoramaServer: "10.1.1.1",
+		},
+		BootstrapXml: []byte("<config/>"),
+		AuthCodes:    []string{"I1234567"},
+		Content: map[string][]byte{
+			"panupv2-all-contents-8000-1234": []byte("content"),
+		},
+	}
+}
+
+func TestInitCfg(t *testing.T) {
+	o := InitCfg{
+		Type:           TypeStatic,
+		IpAddress:      "10.1.1.5",
+		Netmask:        "255.255.255.0",
+		DefaultGateway: "10.1.1.1",
+		TemplateStack:  "ts1",
+	}
+	expected := "type=static\nip-address=10.1.1.5\ndefault-gateway=10.1.1.1\nnetmask=255.255.255.0\ntplname=ts1\n"
+
+	if s := string(o.Bytes()); s != expected {
+		t.Errorf("Got:\n%s\nExpected:\n%s", s, expected)
+	}
+}
+
+func TestWriteDir(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := testBundle().WriteDir(dir); err != nil {
+		t.Fatalf("Failed to write dir: %s", err)
+	}
+
+	b, err := os.ReadFile(filepath.Join(dir, "license", "authcodes"))
+	if err != nil {
+		t.Fatalf("Failed to read authcodes: %s", err)
+	} else if string(b) != "I1234567\n" {
+		t.Errorf("authcodes is %q", b)
+	}
+
+	if fi, err := os.Stat(filepath.Join(dir, "software")); err != nil || !fi.IsDir() {
+		t.Errorf("software dir missing: %v", err)
+	}
+}
+
+func TestWriteTar(t *testing.T) {
+	var buf bytes.Buffer
+
+	if err := testBundle().WriteTar(&buf); err != nil {
+		t.Fatalf("Failed to write tar: %s", err)
+	}
+
+	found := make(map[string]string)
+	tr := tar.NewReader(&buf)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("Failed to read tar: %s", err)
+		}
+		b, _ := io.ReadAll(tr)
+		found[hdr.Name] = string(b)
+	}
+
+	if found["config/bootstrap.xml"] != "<config/>" {
+		t.Errorf("bootstrap.xml is %q", found["config/bootstrap.xml"])
+	}
+	if found["content/panupv2-all-contents-8000-1234"] != "content" {
+		t.Errorf("content file is %q", found["content/panupv2-all-contents-8000-1234"])
+	}
+	if _, ok := found["plugins/"]; !ok {
+		t.Errorf("plugins dir missing")
+	}
+}
+
+func TestInvalidFilename(t *testing.T) {
+	for _, name := range []string{"../evil", `..\evil`, "", ".", ".."} {
+		t.Run(name, func(t *testing.T) {
+			b := Bundle{Software: map[string][]byte{name: nil}}
+
+			if _, err := b.Files(); err == nil {
+				t.Errorf("Expected an error on invalid filename")
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+/*
+Package bootstrap builds VM-Series bootstrap packages.
+
+A bootstrap package is a directory structure that a VM-Series firewall reads
+on first boot.  It contains the following:
+
+	config/init-cfg.txt     - basic management and Panorama settings
+	config/bootstrap.xml    - the full firewall config (optional)
+	license/authcodes       - license auth codes (optional)
+	content/                - content updates (optional)
+	software/               - PAN-OS software images (optional)
+	plugins/                - VM-Series plugin images (optional)
+
+The bootstrap.xml can be generated from namespace Entry objects by using an
+offline pango client and its RenderConfig() function.
+
+A Bundle can be written out to a local directory with WriteDir() or as a
+tar stream with WriteTar().
+*/
+package bootstrap
@@ -0,0 +1,84 @@
+package bootstrap
+
+import (
+	"bytes"
+)
+
+// Valid values for InitCfg.Type.
+const (
+	TypeDhcpClient = "dhcp-client"
+	TypeStatic     = "static"
+)
+
+// InitCfg is the content of the init-cfg.txt file.
+//
+// Fields left empty are omitted from the resulting file.
+type InitCfg struct {
+	Type                     string
+	IpAddress                string
+	DefaultGateway           string
+	Netmask                  string
+	Ipv6Address              string
+	Ipv6DefaultGateway       string
+	Hostname                 string
+	VmAuthKey                string
+	PanoramaServer           string
+	PanoramaServer2          string
+	TemplateStack            string
+	DeviceGroup              string
+	DnsPrimary               string
+	DnsSecondary             string
+	OpCommandModes           string
+	PluginOpCommands         string
+	DhcpSendHostname         string
+	DhcpSendClientId         string
+	DhcpAcceptServerHostname string
+	DhcpAcceptServerDomain   string
+	AutoRegistrationPinId    string
+	AutoRegistrationPinValue string
+}
+
+// Bytes returns the init-cfg.txt file content.
+func (o InitCfg) Bytes() []byte {
+	var buf bytes.Buffer
+
+	params := []struct {
+		key   string
+		value string
+	}{
+		{"type", o.Type},
+		{"ip-address", o.IpAddress},
+		{"default-gateway", o.DefaultGateway},
+		{"netmask", o.Netmask},
+		{"ipv6-address", o.Ipv6Address},
+		{"ipv6-default-gateway", o.Ipv6DefaultGateway},
+		{"hostname", o.Hostname},
+		{"vm-auth-key", o.VmAuthKey},
+		{"panorama-server", o.PanoramaServer},
+		{"panorama-server-2", o.PanoramaServer2},
+		{"tplname", o.TemplateStack},
+		{"dgname", o.DeviceGroup},
+		{"dns-primary", o.DnsPrimary},
+		{"dns-secondary", o.DnsSecondary},
+		{"op-command-modes", o.OpCommandModes},
+		{"plugin-op-commands", o.PluginOpCommands},
+		{"dhcp-send-hostname", o.DhcpSendHostname},
+		{"dhcp-send-client-id", o.DhcpSendClientId},
+		{"dhcp-accept-server-hostname", o.DhcpAcceptServerHostname},
+		{"dhcp-accept-server-domain", o.DhcpAcceptServerDomain},
+		{"vm-series-auto-registration-pin-id", o.AutoRegistrationPinId},
+		{"vm-series-auto-registration-pin-value", o.AutoRegistrationPinValue},
+	}
+
+	for _, p := range params {
+		if p.value == "" {
+			continue
+		}
+		buf.WriteString(p.key)
+		buf.WriteString("=")
+		buf.WriteString(p.value)
+		buf.WriteString("\n")
+	}
+
+	return buf.Bytes()
+}
@@ -0,0 +1,163 @@
+package pango
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// RunningConfig retrieves the full running config of the PAN-OS device.
+func (c *Client) RunningConfig() ([]byte, error) {
+	type req struct {
+		XMLName xml.Name `xml:"show"`
+		Cmd     string   `xml:"config>running"`
+	}
+
+	c.LogOp("(op) retrieving the running config")
+	b, err := c.Op(req{}, "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return util.StripPanosPackaging(b, ""), nil
+}
+
+// ConfigVersion returns a string that identifies the current running config
+// of the PAN-OS device.
+//
+// PAN-OS does not expose a config revision number, so the version is derived
+// from the device's serial number along with the job ID and finish time of
+// the most recent successful commit.
+func (c *Client) ConfigVersion() (string, error) {
+	type req struct {
+		XMLName xml.Name `xml:"show"`
+		Cmd     string   `xml:"jobs>all"`
+	}
+
+	type job struct {
+		Id     uint   `xml:"id"`
+		Type   string `xml:"type"`
+		Status string `xml:"status"`
+		Result string `xml:"result"`
+		End    string `xml:"tfin"`
+	}
+
+	type resp struct {
+		Jobs []job `xml:"result>job"`
+	}
+
+	c.LogOp("(op) determining the config version")
+	var ans resp
+	if _, err := c.Op(req{}, "", nil, &ans); err != nil {
+		return "", err
+	}
+
+	var last *job
+	for i := range ans.Jobs {
+		j := &ans.Jobs[i]
+		if j.Status != "FIN" || j.Result != "OK" {
+			continue
+		} else if !strings.Contains(j.Type, "Commit") && j.Type != "AutoCom" {
+			continue
+		}
+		if last == nil || j.Id > last.Id {
+			last = j
+		}
+	}
+
+	if last == nil {
+		return "", fmt.Errorf("no completed commits found")
+	}
+
+	device := c.SystemInfo["serial"]
+	if device == "" {
+		device = c.Hostname
+	}
+
+	return fmt.Sprintf("%s/%d/%s", device, last.Id, last.End), nil
+}
+
+// CachedRunningConfig returns the running config of the PAN-OS device, using
+// a copy cached in the given directory if the running config is unchanged
+// since it was cached (as determined by ConfigVersion()).
+//
+// This makes repeated runs against the same device, such as periodic audits,
+// much faster when nothing has been committed in between.
+//
+// The bool returned is true if the cached copy was used.
+func (c *Client) CachedRunningConfig(dir string) ([]byte, bool, error) {
+	ver, err := c.ConfigVersion()
+	if err != nil {
+		return nil, false, err
+	}
+
+	name := c.cacheName()
+	verFile := filepath.Join(dir, name+".version")
+	cfgFile := filepath.Join(dir, name+".xml")
+
+	if prev, err := ioutil.ReadFile(verFile); err == nil && string(bytes.TrimSpace(prev)) == ver {
+		if b, err := ioutil.ReadFile(cfgFile); err == nil {
+			c.LogQuery("(cache) using cached running config for %q", ver)
+			return b, true, nil
+		}
+	}
+
+	b, err := c.RunningConfig()
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return b, false, err
+	}
+	if err = writeFileAtomic(cfgFile, b); err != nil {
+		return b, false, err
+	}
+	if err = writeFileAtomic(verFile, []byte(ver)); err != nil {
+		return b, false, err
+	}
+
+	return b, false, nil
+}
+
+/** Internal functions **/
+
+var cacheNameRe = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// cacheName returns the base filename used to cache this device's config.
+func (c *Client) cacheName() string {
+	name := c.SystemInfo["serial"]
+	if name == "" {
+		name = c.Hostname
+	}
+
+	return "running-" + cacheNameRe.ReplaceAllString(name, "_")
+}
+
+// writeFileAtomic writes the given file such that readers never see a
+// partially written file.
+func writeFileAtomic(fn string, b []byte) error {
+	f, err := ioutil.TempFile(filepath.Dir(fn), filepath.Base(fn)+".tmp")
+	if err != nil {
+		return err
+	}
+
+	if _, err = f.Write(b); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err = f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	return os.Rename(f.Name(), fn)
+}
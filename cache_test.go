@@ -0,0 +1,69 @@
+package pango
+
+import (
+	"testing"
+)
+
+const cacheJobs = `<response status="success"><result>
+<job><id>3</id><type>Commit</type><status>FIN</status><result>OK</result><tfin>2021/01/01 10:00:00</tfin></job>
+<job><id>5</id><type>Commit</type><status>FIN</status><result>FAIL</result><tfin>2021/01/02 10:00:00</tfin></job>
+<job><id>4</id><type>Download</type><status>FIN</status><result>OK</result><tfin>2021/01/03 10:00:00</tfin></job>
+</result></response>`
+
+func TestConfigVersion(t *testing.T) {
+	c := &Client{rb: [][]byte{[]byte(cacheJobs)}}
+	c.Initialize()
+	c.SystemInfo = map[string]string{"serial": "0123456789"}
+
+	v, err := c.ConfigVersion()
+	if err != nil {
+		t.Fatalf("Error getting config version: %s", err)
+	}
+	if v != "0123456789/3/2021/01/01 10:00:00" {
+		t.Errorf("Version is %q", v)
+	}
+}
+
+func TestCachedRunningConfig(t *testing.T) {
+	dir := t.TempDir()
+	running := []byte(`<response status="success"><result><config version="10.1.0"><shared/></config></result></response>`)
+
+	c := &Client{rb: [][]byte{[]byte(cacheJobs), running}}
+	c.Initialize()
+
+	b, cached, err := c.CachedRunningConfig(dir)
+	if err != nil {
+		t.Fatalf("Error in first retrieval: %s", err)
+	} else if cached {
+		t.Errorf("First retrieval used the cache")
+	} else if string(b) != `<config version="10.1.0"><shared/></config>` {
+		t.Errorf("Config is %q", b)
+	}
+
+	c.rb = [][]byte{[]byte(cacheJobs)}
+	c.ri = 0
+	c.rp = nil
+	b2, cached, err := c.CachedRunningConfig(dir)
+	if err != nil {
+		t.Fatalf("Error in second retrieval: %s", err)
+	} else if !cached {
+		t.Errorf("Second retrieval did not use the cache")
+	} else if string(b2) != string(b) {
+		t.Errorf("Cached config is %q", b2)
+	}
+	if len(c.rp) != 1 {
+		t.Errorf("Second retrieval sent %d requests", len(c.rp))
+	}
+
+	// A new commit invalidates the cache.
+	c.rb = [][]byte{
+		[]byte(`<response status="success"><result><job><id>9</id><type>Commit</type><status>FIN</status><result>OK</result><tfin>2021/02/01 10:00:00</tfin></job></result></response>`),
+		running,
+	}
+	c.ri = 0
+	if _, cached, err = c.CachedRunningConfig(dir); err != nil {
+		t.Fatalf("Error in third retrieval: %s", err)
+	} else if cached {
+		t.Errorf("Third retrieval used a stale cache")
+	}
+}
@@ -0,0 +1,149 @@
+package pango
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/PaloAltoNetworks/pango/util"
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+// poeModels are the firewall models with Power over Ethernet ports.
+var poeModels = map[string]bool{
+	"PA-415":    true,
+	"PA-415-5G": true,
+	"PA-445":    true,
+	"PA-1410":   true,
+	"PA-1420":   true,
+}
+
+// logicalInterfaceTypes are the interface types available on every firewall.
+var logicalInterfaceTypes = []string{
+	util.LoopbackInterface,
+	util.TunnelInterface,
+	util.VlanInterface,
+}
+
+// Capabilities are the hardware capabilities of a firewall.
+//
+// Slots maps each slot number to the number of physical ethernet ports in
+// that slot, and Interfaces lists those physical ports.  InterfaceTypes are
+// the interface types that can be configured (such as "ethernet" or
+// "aggregate-ethernet").
+type Capabilities struct {
+	Model          string
+	Virtual        bool
+	PoE            bool
+	SdWan          bool
+	Slots          map[int]int
+	Interfaces     []string
+	InterfaceTypes []string
+}
+
+// HasInterfaceType returns if the given interface type is supported.
+func (o Capabilities) HasInterfaceType(t string) bool {
+	for _, x := range o.InterfaceTypes {
+		if x == t {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateInterface returns an error if the given interface name is not valid
+// on this firewall, such as "ethernet1/25" on a firewall that only has 24
+// ports in slot 1.
+//
+// The name is first checked with util.ValidateInterfaceName.  For
+// subinterfaces (such as "ethernet1/3.5"), the parent interface is checked,
+// while other interfaces only have their type checked.
+func (o Capabilities) ValidateInterface(name string) error {
+	v, err := util.ParseInterfaceName(name)
+	if err != nil {
+		return err
+	}
+
+	if !o.HasInterfaceType(v.Type) {
+		return fmt.Errorf("%s: %s does not support %s interfaces", name, o.Model, v.Type)
+	}
+
+	if v.Type == util.EthernetInterface {
+		for _, x := range o.Interfaces {
+			if x == v.Parent {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s: %s does not have interface %s", name, o.Model, v.Parent)
+	}
+
+	return nil
+}
+
+// Capabilities probes the firewall for its hardware capabilities.
+//
+// The physical ports are retrieved using "show interface all", while other
+// capabilities are determined from the model and PAN-OS version.
+func (c *Firewall) Capabilities() (Capabilities, error) {
+	type req struct {
+		XMLName xml.Name `xml:"show"`
+		Cmd     string   `xml:"interface"`
+	}
+
+	type resp struct {
+		Names []string `xml:"result>hw>entry>name"`
+	}
+
+	c.LogOp("(op) show interface all")
+	var ans resp
+	if _, err := c.Op(req{Cmd: "all"}, "", nil, &ans); err != nil {
+		return Capabilities{}, err
+	}
+
+	return newCapabilities(c.SystemInfo["model"], c.Versioning(), ans.Names), nil
+}
+
+func newCapabilities(model string, v version.Number, names []string) Capabilities {
+	ans := Capabilities{
+		Model:   model,
+		Virtual: strings.HasPrefix(model, "PA-VM") || strings.HasPrefix(model, "PA-CN"),
+		PoE:     poeModels[model],
+		SdWan:   v.Gte(version.Number{9, 1, 0, ""}),
+		Slots:   make(map[int]int),
+	}
+
+	types := make(map[string]bool)
+	for _, name := range names {
+		switch {
+		case strings.HasPrefix(name, "ethernet"):
+			parts := strings.SplitN(strings.TrimPrefix(name, "ethernet"), "/", 2)
+			slot, err := strconv.Atoi(parts[0])
+			if err != nil || len(parts) != 2 {
+				continue
+			}
+			ans.Slots[slot]++
+			ans.Interfaces = append(ans.Interfaces, name)
+			types[util.EthernetInterface] = true
+		case strings.HasPrefix(name, "ae"):
+			types[util.AggregateEthernetInterface] = true
+		}
+	}
+
+	// Aggregate ethernet is supported on all hardware firewalls.
+	if len(ans.Interfaces) > 0 && !ans.Virtual {
+		types[util.AggregateEthernetInterface] = true
+	}
+
+	for _, t := range logicalInterfaceTypes {
+		types[t] = true
+	}
+	for t := range types {
+		ans.InterfaceTypes = append(ans.InterfaceTypes, t)
+	}
+	sort.Strings(ans.InterfaceTypes)
+
+	return ans
+}
@@ -0,0 +1,66 @@
+package pango
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+const capabilityInterfaces = `<response status="success"><result><hw>
+<entry><name>ethernet1/1</name></entry>
+<entry><name>ethernet1/2</name></entry>
+<entry><name>ethernet1/3</name></entry>
+<entry><name>ethernet2/1</name></entry>
+</hw></result></response>`
+
+func TestCapabilities(t *testing.T) {
+	c := &Firewall{Client: Client{rb: [][]byte{[]byte(capabilityInterfaces)}}}
+	c.Client.Initialize()
+	c.SystemInfo = map[string]string{"model": "PA-445"}
+
+	caps, err := c.Capabilities()
+	if err != nil {
+		t.Fatalf("Error probing capabilities: %s", err)
+	}
+
+	if !caps.PoE || caps.Virtual {
+		t.Errorf("PoE is %t, virtual is %t", caps.PoE, caps.Virtual)
+	}
+	if !reflect.DeepEqual(caps.Slots, map[int]int{1: 3, 2: 1}) {
+		t.Errorf("Slots are %v", caps.Slots)
+	}
+	expected := []string{"aggregate-ethernet", "ethernet", "loopback", "tunnel", "vlan"}
+	if !reflect.DeepEqual(caps.InterfaceTypes, expected) {
+		t.Errorf("Interface types are %v", caps.InterfaceTypes)
+	}
+	if s := c.rp[len(c.rp)-1].Get("cmd"); s != "<show><interface>all</interface></show>" {
+		t.Errorf("Cmd is %q", s)
+	}
+}
+
+func TestValidateInterface(t *testing.T) {
+	caps := newCapabilities("PA-VM-100", version.Number{10, 1, 0, ""}, []string{"ethernet1/1", "ethernet1/2"})
+
+	testCases := []struct {
+		name string
+		ok   bool
+	}{
+		{"ethernet1/2", true},
+		{"ethernet1/2.10", true},
+		{"ethernet1/25", false},
+		{"ethernet1/25.3", false},
+		{"tunnel.4", true},
+		{"loopback.1", true},
+		{"ae1", false},
+		{"bogus1", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := caps.ValidateInterface(tc.name); (err == nil) != tc.ok {
+				t.Errorf("Expected ok %t, got error %v", tc.ok, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package pango
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Valid values for the format of certificate imports and exports.
+const (
+	CertificateFormatPem    = "pem"
+	CertificateFormatPkcs12 = "pkcs12"
+	CertificateFormatDer    = "der"
+)
+
+// CertificateLocation is where a certificate is imported to or exported from.
+//
+// Leave everything empty for shared certificates (or Panorama's own
+// certificates).  Vsys is for a vsys certificate on a firewall, while
+// Template and TemplateVsys are for a certificate inside of a Panorama
+// template.
+type CertificateLocation struct {
+	Vsys         string
+	Template     string
+	TemplateVsys string
+}
+
+func (o CertificateLocation) params() map[string]string {
+	ans := make(map[string]string)
+
+	if o.Vsys != "" && o.Vsys != "shared" {
+		ans["vsys"] = o.Vsys
+	}
+	if o.Template != "" {
+		ans["target-tpl"] = o.Template
+		if o.TemplateVsys != "" && o.TemplateVsys != "shared" {
+			ans["target-tpl-vsys"] = o.TemplateVsys
+		}
+	}
+
+	return ans
+}
+
+// ImportCertificate imports a certificate without a private key, such as a
+// CA certificate or the signed certificate for a previously generated CSR.
+//
+// The format is CertificateFormatPem or CertificateFormatDer.
+func (c *Client) ImportCertificate(name, format, content string, loc CertificateLocation) error {
+	extras := loc.params()
+	extras["certificate-name"] = name
+	extras["format"] = format
+
+	c.LogAction("(import) certificate %q", name)
+	_, err := c.Import("certificate", content, certificateFilename(name, format), "file", extras, nil)
+	return err
+}
+
+// ImportKeyPair imports a certificate along with its private key.
+//
+// The format is CertificateFormatPem (the certificate and encrypted private
+// key concatenated together) or CertificateFormatPkcs12.  The passphrase is
+// what the private key is encrypted with.
+func (c *Client) ImportKeyPair(name, format, content, passphrase string, loc CertificateLocation) error {
+	extras := loc.params()
+	extras["certificate-name"] = name
+	extras["format"] = format
+	extras["passphrase"] = passphrase
+
+	c.LogAction("(import) keypair %q", name)
+	_, err := c.Import("keypair", content, certificateFilename(name, format), "file", extras, nil)
+	return err
+}
+
+// ExportCertificate exports the given certificate and returns the file
+// contents.
+//
+// If includeKey is true, then the private key is also exported, encrypted
+// with the given passphrase.  Private keys can only be exported in
+// CertificateFormatPem or CertificateFormatPkcs12 format.
+func (c *Client) ExportCertificate(name, format string, includeKey bool, passphrase string, loc CertificateLocation) ([]byte, error) {
+	if includeKey && format == CertificateFormatDer {
+		return nil, fmt.Errorf("private keys cannot be exported in %s format", format)
+	}
+
+	data := url.Values{}
+	data.Set("type", "export")
+	data.Set("category", "certificate")
+	data.Set("certificate-name", name)
+	data.Set("format", format)
+	data.Set("include-key", util.YesNo(includeKey))
+	if includeKey {
+		data.Set("passphrase", passphrase)
+	}
+	for k, v := range loc.params() {
+		data.Set(k, v)
+	}
+
+	c.LogOp("(export) certificate %q", name)
+	return c.Communicate(data, nil)
+}
+
+func certificateFilename(name, format string) string {
+	switch format {
+	case CertificateFormatPkcs12:
+		return name + ".p12"
+	case CertificateFormatDer:
+		return name + ".der"
+	}
+
+	return name + ".pem"
+}
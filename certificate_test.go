@@ -0,0 +1,94 @@
+package pango
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestImportKeyPair(t *testing.T) {
+	var form map[string]string
+	var file, filename string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("Error parsing form: %s", err)
+			return
+		}
+		form = make(map[string]string)
+		for k := range r.MultipartForm.Value {
+			form[k] = r.FormValue(k)
+		}
+		f, hdr, err := r.FormFile("file")
+		if err != nil {
+			t.Errorf("Error getting file: %s", err)
+			return
+		}
+		b, _ := io.ReadAll(f)
+		file, filename = string(b), hdr.Filename
+		w.Write([]byte(`<response status="success"><msg>Successfully imported c1 into candidate configuration</msg></response>`))
+	}))
+	defer srv.Close()
+
+	c := &Client{con: srv.Client(), api_url: srv.URL}
+	loc := CertificateLocation{Template: "t1", TemplateVsys: "vsys2"}
+	if err := c.ImportKeyPair("c1", CertificateFormatPkcs12, "p12 data", "secret", loc); err != nil {
+		t.Fatalf("Error in import: %s", err)
+	}
+
+	expected := map[string]string{
+		"type":             "import",
+		"category":         "keypair",
+		"certificate-name": "c1",
+		"format":           "pkcs12",
+		"passphrase":       "secret",
+		"target-tpl":       "t1",
+		"target-tpl-vsys":  "vsys2",
+	}
+	for k, v := range expected {
+		if form[k] != v {
+			t.Errorf("%s is %q, not %q", k, form[k], v)
+		}
+	}
+	if file != "p12 data" || filename != "c1.p12" {
+		t.Errorf("File is %q / %q", filename, file)
+	}
+}
+
+func TestImportCertificateReadOnly(t *testing.T) {
+	c := &Client{ReadOnly: true}
+	if err := c.ImportCertificate("c1", CertificateFormatPem, "pem", CertificateLocation{}); err == nil {
+		t.Errorf("Expected a ReadOnlyError")
+	}
+}
+
+func TestExportCertificate(t *testing.T) {
+	pem := "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+
+	c := &Client{rb: [][]byte{[]byte(pem)}}
+	c.Initialize()
+
+	body, err := c.ExportCertificate("c1", CertificateFormatPem, false, "", CertificateLocation{Vsys: "vsys2"})
+	if err != nil {
+		t.Fatalf("Error in export: %s", err)
+	}
+	if string(body) != pem {
+		t.Errorf("Got %q", body)
+	}
+
+	req := c.rp[0]
+	if req.Get("type") != "export" || req.Get("category") != "certificate" {
+		t.Errorf("Request is %v", req)
+	}
+	if req.Get("certificate-name") != "c1" || req.Get("include-key") != "no" || req.Get("vsys") != "vsys2" {
+		t.Errorf("Request is %v", req)
+	}
+	if req.Get("passphrase") != "" {
+		t.Errorf("Passphrase sent without the key")
+	}
+
+	if _, err = c.ExportCertificate("c1", CertificateFormatDer, true, "secret", CertificateLocation{}); err == nil {
+		t.Errorf("Exporting a private key in der format did not error")
+	}
+}
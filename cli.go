@@ -0,0 +1,197 @@
+package pango
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// CliResult is the result of running a CLI command using RunCli.
+//
+// Cmd is the XML form of the CLI command that was sent, and Raw is the full
+// response from PAN-OS.
+//
+// Commands that return text output have it in Text, normalized as if "set cli
+// scripting-mode on" were in effect:  line endings are "\n", trailing
+// whitespace is removed from each line, and leading and trailing blank lines
+// are removed.
+//
+// Commands that return XML output instead have Fields populated with the leaf
+// values of the result, keyed by xpath relative to the result element (such
+// as "/system/hostname").  Member lists are a single leaf with the sorted
+// members joined with ", ".
+type CliResult struct {
+	Cmd    string
+	Raw    []byte
+	Text   string
+	Fields map[string]string
+}
+
+// RunCli runs the given CLI command as an op command, for the commands that
+// do not have their own function in this package.
+//
+// See CliToXml for how the CLI command is converted.
+func (c *Client) RunCli(cmd, vsys string) (CliResult, error) {
+	ans := CliResult{}
+
+	x, err := CliToXml(cmd)
+	if err != nil {
+		return ans, err
+	}
+	ans.Cmd = x
+
+	c.LogOp("(op) cli: %s", cmd)
+	ans.Raw, err = c.Op(x, vsys, nil, nil)
+	if err != nil {
+		return ans, err
+	}
+
+	var resp struct {
+		Result *configNode `xml:"result"`
+	}
+	if err = xml.Unmarshal(ans.Raw, &resp); err != nil {
+		return ans, err
+	}
+
+	if resp.Result == nil {
+		return ans, nil
+	}
+
+	if len(resp.Result.Nodes) == 0 {
+		ans.Text = scriptingText(resp.Result.Text)
+		return ans, nil
+	}
+
+	ans.Fields = make(map[string]string)
+	for _, x := range resp.Result.Nodes {
+		x.leaves("", ans.Fields, nil)
+	}
+
+	return ans, nil
+}
+
+// CliToXml converts the given CLI command into the XML form used by the op
+// API.
+//
+// Each word becomes an XML element nested inside the element of the previous
+// word.  A double quoted value becomes the text of the element of the word
+// preceding it, and any words after it are nested alongside that element.
+// For example:
+//
+//	show interface "ethernet1/1"
+//	test security-policy-match from "trust" to "untrust" destination-port "443"
+//
+// Words that are not valid XML element names must be quoted.
+func CliToXml(cmd string) (string, error) {
+	tokens, err := cliTokens(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	if len(tokens) == 0 {
+		return "", fmt.Errorf("no CLI command given")
+	}
+
+	var b bytes.Buffer
+	stack := make([]string, 0, len(tokens))
+	canQuote := false
+
+	for _, t := range tokens {
+		if t.quoted {
+			if !canQuote {
+				return "", fmt.Errorf("quoted value %q must follow a command word", t.val)
+			}
+			xml.EscapeText(&b, []byte(t.val))
+			b.WriteString("</" + stack[len(stack)-1] + ">")
+			stack = stack[:len(stack)-1]
+			canQuote = false
+			continue
+		}
+
+		if !cliWord(t.val) {
+			return "", fmt.Errorf("%q is not a valid command word, quote it if it is a value", t.val)
+		}
+		b.WriteString("<" + t.val + ">")
+		stack = append(stack, t.val)
+		canQuote = true
+	}
+
+	for i := len(stack) - 1; i >= 0; i-- {
+		b.WriteString("</" + stack[i] + ">")
+	}
+
+	return b.String(), nil
+}
+
+/** Internal functions for the CLI passthrough **/
+
+type cliToken struct {
+	val    string
+	quoted bool
+}
+
+func cliTokens(cmd string) ([]cliToken, error) {
+	var ans []cliToken
+	var cur strings.Builder
+	inQuote, inWord := false, false
+
+	for _, r := range cmd {
+		switch {
+		case inQuote:
+			if r == '"' {
+				ans = append(ans, cliToken{val: cur.String(), quoted: true})
+				cur.Reset()
+				inQuote = false
+			} else {
+				cur.WriteRune(r)
+			}
+		case r == '"':
+			if inWord {
+				return nil, fmt.Errorf("unexpected quote after %q", cur.String())
+			}
+			inQuote = true
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			if inWord {
+				ans = append(ans, cliToken{val: cur.String()})
+				cur.Reset()
+				inWord = false
+			}
+		default:
+			cur.WriteRune(r)
+			inWord = true
+		}
+	}
+
+	if inQuote {
+		return nil, fmt.Errorf("unterminated quote in CLI command")
+	}
+	if inWord {
+		ans = append(ans, cliToken{val: cur.String()})
+	}
+
+	return ans, nil
+}
+
+func cliWord(s string) bool {
+	for i, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '_':
+		case i > 0 && (r >= '0' && r <= '9' || r == '-' || r == '.'):
+		default:
+			return false
+		}
+	}
+
+	return s != ""
+}
+
+func scriptingText(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	lines := strings.Split(s, "\n")
+	for i := range lines {
+		lines[i] = strings.TrimRight(lines[i], " \t\r")
+	}
+
+	return strings.Trim(strings.Join(lines, "\n"), "\n")
+}
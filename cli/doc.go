@@ -0,0 +1,33 @@
+/*
+Package cli drives the PAN-OS command line over an interactive session.
+
+This is a fallback for the few actions that cannot be performed using the
+XML API, such as setting the initial admin password or configuring the
+management interface on a factory default device.  Once those are done, the
+normal pango clients should be used instead.
+
+The session can be anything that can read and write, such as the stdin and
+stdout of an SSH session or a serial console connection, so this package does
+not depend on any particular SSH library.  For example, using
+golang.org/x/crypto/ssh:
+
+	session, _ := conn.NewSession()
+	session.RequestPty("vt100", 80, 200, ssh.TerminalModes{})
+	stdin, _ := session.StdinPipe()
+	stdout, _ := session.StdoutPipe()
+	session.Shell()
+
+	e := cli.NewExecutor(stdout, stdin)
+	if err := e.ChangeInitialPassword("admin", "newpass"); err != nil {
+	    return err
+	}
+	if err := e.Initialize(); err != nil {
+	    return err
+	}
+	err := e.SetManagementIp(cli.MgmtConfig{
+	    IpAddress:      "10.1.1.5",
+	    Netmask:        "255.255.255.0",
+	    DefaultGateway: "10.1.1.1",
+	})
+*/
+package cli
@@ -0,0 +1,282 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// DefaultTimeout is the default time to wait for expected output.
+const DefaultTimeout = 30 * time.Second
+
+// CommitTimeout is the time to wait for a CLI commit to finish.
+const CommitTimeout = 10 * time.Minute
+
+var promptRe = regexp.MustCompile(`(?m)[\w.-]+@[\w.()-]+[>#]\s*$`)
+
+// Executor runs commands over an interactive PAN-OS CLI session.
+//
+// Timeout is the time to wait for expected output, which defaults to
+// DefaultTimeout if left unset.
+type Executor struct {
+	Timeout time.Duration
+
+	w    io.Writer
+	ch   chan string
+	errc chan error
+	buf  string
+}
+
+// NewExecutor returns an executor that reads CLI output from r and writes
+// commands to w.
+func NewExecutor(r io.Reader, w io.Writer) *Executor {
+	e := &Executor{
+		w:    w,
+		ch:   make(chan string, 100),
+		errc: make(chan error, 1),
+	}
+
+	go func() {
+		b := make([]byte, 4096)
+		for {
+			n, err := r.Read(b)
+			if n > 0 {
+				e.ch <- string(b[:n])
+			}
+			if err != nil {
+				e.errc <- err
+				close(e.ch)
+				return
+			}
+		}
+	}()
+
+	return e
+}
+
+// Send writes the given line to the session.
+func (e *Executor) Send(line string) error {
+	_, err := io.WriteString(e.w, line+"\n")
+	return err
+}
+
+// Expect reads output until one of the given regular expressions matches.
+//
+// The index of the matching regex is returned along with all output up to
+// and including the match.  Output after the match is kept for the next call.
+func (e *Executor) Expect(timeout time.Duration, patterns ...*regexp.Regexp) (int, string, error) {
+	if timeout == 0 {
+		timeout = e.timeout()
+	}
+	deadline := time.After(timeout)
+
+	for {
+		idx, end := -1, -1
+		for i, re := range patterns {
+			if loc := re.FindStringIndex(e.buf); loc != nil && (end == -1 || loc[1] < end) {
+				idx, end = i, loc[1]
+			}
+		}
+		if idx != -1 {
+			out := e.buf[:end]
+			e.buf = e.buf[end:]
+			return idx, out, nil
+		}
+
+		select {
+		case s, ok := <-e.ch:
+			if !ok {
+				err := <-e.errc
+				e.errc <- err
+				return -1, e.buf, fmt.Errorf("session closed: %s", err)
+			}
+			e.buf += s
+		case <-deadline:
+			return -1, e.buf, fmt.Errorf("timed out waiting for output")
+		}
+	}
+}
+
+// Run sends the given command and waits for the CLI prompt.
+//
+// The command output is returned, with the echoed command and the trailing
+// prompt removed.
+func (e *Executor) Run(cmd string) (string, error) {
+	return e.run(cmd, e.timeout())
+}
+
+// Initialize waits for the CLI prompt, then disables the pager and enables
+// scripting mode so that command output can be parsed.
+func (e *Executor) Initialize() error {
+	if err := e.Send(""); err != nil {
+		return err
+	}
+	if _, _, err := e.Expect(0, promptRe); err != nil {
+		return err
+	}
+
+	for _, cmd := range []string{"set cli pager off", "set cli scripting-mode on"} {
+		if _, err := e.Run(cmd); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ChangeInitialPassword answers the forced password change that PAN-OS
+// presents on the first login of a factory default device.
+func (e *Executor) ChangeInitialPassword(oldPassword, newPassword string) error {
+	steps := []struct {
+		re    *regexp.Regexp
+		value string
+	}{
+		{oldPasswordRe, oldPassword},
+		{newPasswordRe, newPassword},
+		{confirmPasswordRe, newPassword},
+	}
+
+	for _, s := range steps {
+		if _, _, err := e.Expect(0, s.re); err != nil {
+			return err
+		}
+		if err := e.Send(s.value); err != nil {
+			return err
+		}
+	}
+
+	idx, out, err := e.Expect(0, promptRe, passwordErrorRe)
+	if err != nil {
+		return err
+	} else if idx != 0 {
+		return fmt.Errorf("password change failed: %s", strings.TrimSpace(out))
+	}
+
+	return nil
+}
+
+// Configure enters configuration mode, runs the given commands, commits,
+// then exits configuration mode.
+//
+// If any command fails, the remaining commands are not run, the candidate
+// config is reverted, and an error is returned.
+func (e *Executor) Configure(cmds ...string) error {
+	if _, err := e.Run("configure"); err != nil {
+		return err
+	}
+
+	for _, cmd := range cmds {
+		out, err := e.Run(cmd)
+		if err == nil {
+			err = cliError(out)
+		}
+		if err != nil {
+			e.Run("revert config")
+			e.Run("exit")
+			return fmt.Errorf("%q: %s", cmd, err)
+		}
+	}
+
+	out, err := e.run("commit", CommitTimeout)
+	if err == nil && !strings.Contains(out, "committed successfully") {
+		err = fmt.Errorf("commit failed: %s", strings.TrimSpace(out))
+	}
+	if err != nil {
+		e.Run("exit")
+		return err
+	}
+
+	_, err = e.Run("exit")
+	return err
+}
+
+// MgmtConfig is the management interface config.
+type MgmtConfig struct {
+	IpAddress      string
+	Netmask        string
+	DefaultGateway string
+	DnsPrimary     string
+	DnsSecondary   string
+	Hostname       string
+}
+
+// SetManagementIp configures the management interface as a static IP and
+// commits.
+func (e *Executor) SetManagementIp(o MgmtConfig) error {
+	if o.IpAddress == "" || o.Netmask == "" {
+		return fmt.Errorf("IP address and netmask are required")
+	}
+
+	base := "set deviceconfig system"
+	cmds := []string{
+		fmt.Sprintf("%s type static", base),
+		fmt.Sprintf("%s ip-address %s netmask %s", base, o.IpAddress, o.Netmask),
+	}
+	if o.DefaultGateway != "" {
+		cmds = append(cmds, fmt.Sprintf("%s default-gateway %s", base, o.DefaultGateway))
+	}
+	if o.DnsPrimary != "" {
+		cmds = append(cmds, fmt.Sprintf("%s dns-setting servers primary %s", base, o.DnsPrimary))
+	}
+	if o.DnsSecondary != "" {
+		cmds = append(cmds, fmt.Sprintf("%s dns-setting servers secondary %s", base, o.DnsSecondary))
+	}
+	if o.Hostname != "" {
+		cmds = append(cmds, fmt.Sprintf("%s hostname %s", base, o.Hostname))
+	}
+
+	return e.Configure(cmds...)
+}
+
+/** Internal variables / functions **/
+
+var (
+	oldPasswordRe     = regexp.MustCompile(`(?i)old password\s*:\s*$`)
+	newPasswordRe     = regexp.MustCompile(`(?i)new password\s*:\s*$`)
+	confirmPasswordRe = regexp.MustCompile(`(?i)confirm password\s*:\s*$`)
+	passwordErrorRe   = regexp.MustCompile(`(?i)(passwords do not match|incorrect|failed|error)[^\n]*\n`)
+)
+
+func (e *Executor) timeout() time.Duration {
+	if e.Timeout == 0 {
+		return DefaultTimeout
+	}
+
+	return e.Timeout
+}
+
+func (e *Executor) run(cmd string, timeout time.Duration) (string, error) {
+	if err := e.Send(cmd); err != nil {
+		return "", err
+	}
+
+	_, out, err := e.Expect(timeout, promptRe)
+	if err != nil {
+		return out, err
+	}
+
+	out = promptRe.ReplaceAllString(out, "")
+	out = strings.TrimLeft(out, "\r\n")
+	if strings.HasPrefix(out, cmd) {
+		out = out[len(cmd):]
+	}
+
+	return strings.TrimSpace(out), nil
+}
+
+func cliError(out string) error {
+	for _, line := range strings.Split(out, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "Invalid syntax"),
+			strings.HasPrefix(line, "Unknown command"),
+			strings.HasPrefix(line, "Server error"),
+			strings.HasPrefix(line, "Validation Error"):
+			return fmt.Errorf("%s", out)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+const prompt = "admin@PA-VM> "
+
+// fakeDevice answers each line received with the given responses in order.
+func fakeDevice(t *testing.T, greeting string, responses ...string) (*Executor, *[]string) {
+	devR, cliW := io.Pipe()
+	cliR, devW := io.Pipe()
+	var got []string
+
+	go func() {
+		io.WriteString(devW, greeting)
+		sc := bufio.NewScanner(devR)
+		for _, resp := range responses {
+			if !sc.Scan() {
+				return
+			}
+			got = append(got, sc.Text())
+			io.WriteString(devW, resp)
+		}
+		devW.Close()
+	}()
+
+	e := NewExecutor(cliR, cliW)
+	e.Timeout = time.Second
+	return e, &got
+}
+
+func TestRun(t *testing.T) {
+	e, _ := fakeDevice(t, "", "show clock\r\nThu Oct 15 10:00:00 UTC 2026\r\n\r\n"+prompt)
+
+	out, err := e.Run("show clock")
+	if err != nil {
+		t.Fatalf("Error in run: %s", err)
+	} else if out != "Thu Oct 15 10:00:00 UTC 2026" {
+		t.Errorf("Output is %q", out)
+	}
+}
+
+func TestChangeInitialPassword(t *testing.T) {
+	e, got := fakeDevice(t,
+		"Enter old password : ",
+		"Enter new password : ",
+		"Confirm password : ",
+		"Password changed\n"+prompt,
+	)
+
+	if err := e.ChangeInitialPassword("admin", "secret"); err != nil {
+		t.Fatalf("Error in password change: %s", err)
+	}
+	if strings.Join(*got, ",") != "admin,secret,secret" {
+		t.Errorf("Sent %v", *got)
+	}
+}
+
+func TestChangeInitialPasswordMismatch(t *testing.T) {
+	e, _ := fakeDevice(t,
+		"Enter old password : ",
+		"Enter new password : ",
+		"Confirm password : ",
+		"Passwords do not match\nEnter new password : ",
+	)
+
+	if err := e.ChangeInitialPassword("admin", "secret"); err == nil {
+		t.Errorf("Expected an error on a password mismatch")
+	}
+}
+
+func TestSetManagementIp(t *testing.T) {
+	cfgPrompt := "\r\nadmin@PA-VM# "
+	e, got := fakeDevice(t, "",
+		"Entering configuration mode"+cfgPrompt,
+		cfgPrompt,
+		cfgPrompt,
+		cfgPrompt,
+		"Configuration committed successfully"+cfgPrompt,
+		"\r\n"+prompt,
+	)
+
+	err := e.SetManagementIp(MgmtConfig{
+		IpAddress:      "10.1.1.5",
+		Netmask:        "255.255.255.0",
+		DefaultGateway: "10.1.1.1",
+	})
+	if err != nil {
+		t.Fatalf("Error in set management ip: %s", err)
+	}
+
+	expected := []string{
+		"configure",
+		"set deviceconfig system type static",
+		"set deviceconfig system ip-address 10.1.1.5 netmask 255.255.255.0",
+		"set deviceconfig system default-gateway 10.1.1.1",
+		"commit",
+		"exit",
+	}
+	if strings.Join(*got, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Sent %#v", *got)
+	}
+}
+
+func TestConfigureInvalidSyntax(t *testing.T) {
+	cfgPrompt := "\r\nadmin@PA-VM# "
+	e, got := fakeDevice(t, "",
+		"Entering configuration mode"+cfgPrompt,
+		"Invalid syntax."+cfgPrompt,
+		cfgPrompt,
+		"\r\n"+prompt,
+	)
+
+	if err := e.Configure("set foo bar"); err == nil {
+		t.Fatalf("Expected an error on invalid syntax")
+	}
+	if (*got)[2] != "revert config" {
+		t.Errorf("Sent %#v", *got)
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	e, _ := fakeDevice(t, "", "no prompt here")
+	e.Timeout = 50 * time.Millisecond
+
+	if _, err := e.Run("show clock"); err == nil {
+		t.Errorf("Expected a timeout error")
+	}
+}
@@ -0,0 +1,89 @@
+package pango
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCliToXml(t *testing.T) {
+	testCases := []struct {
+		cmd      string
+		expected string
+		err      bool
+	}{
+		{"show system info", "<show><system><info></info></system></show>", false},
+		{`show interface "ethernet1/1"`, "<show><interface>ethernet1/1</interface></show>", false},
+		{
+			`test security-policy-match from "trust" to "untrust" destination-port "443"`,
+			"<test><security-policy-match><from>trust</from><to>untrust</to><destination-port>443</destination-port></security-policy-match></test>",
+			false,
+		},
+		{`show object "a<b"`, "<show><object>a&lt;b</object></show>", false},
+		{"", "", true},
+		{`"show"`, "", true},
+		{`show interface "a" "b"`, "", true},
+		{`show interface "ethernet1/1`, "", true},
+		{"show interface ethernet1/1", "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.cmd, func(t *testing.T) {
+			ans, err := CliToXml(tc.cmd)
+			if tc.err {
+				if err == nil {
+					t.Errorf("Expected error, got %s", ans)
+				}
+			} else if err != nil {
+				t.Errorf("Error: %s", err)
+			} else if ans != tc.expected {
+				t.Errorf("Got %s", ans)
+			}
+		})
+	}
+}
+
+func TestRunCliText(t *testing.T) {
+	c := &Client{rb: [][]byte{
+		[]byte("<response status=\"success\"><result><![CDATA[\r\n\r\nname    id  \r\nethernet1/1  16   \r\n\r\n]]></result></response>"),
+	}}
+	c.Initialize()
+
+	ans, err := c.RunCli(`show interface "all"`, "")
+	if err != nil {
+		t.Fatalf("Error in run: %s", err)
+	}
+
+	if ans.Text != "name    id\nethernet1/1  16" {
+		t.Errorf("Text is %q", ans.Text)
+	}
+	if ans.Fields != nil {
+		t.Errorf("Fields is %#v", ans.Fields)
+	}
+	if cmd := c.rp[0].Get("cmd"); cmd != "<show><interface>all</interface></show>" {
+		t.Errorf("cmd is %s", cmd)
+	}
+}
+
+func TestRunCliFields(t *testing.T) {
+	c := &Client{rb: [][]byte{
+		[]byte(`<response status="success"><result><system><hostname>fw1</hostname><serial>0123</serial></system><dns><member>a</member><member>b</member></dns></result></response>`),
+	}}
+	c.Initialize()
+
+	ans, err := c.RunCli("show system info", "vsys2")
+	if err != nil {
+		t.Fatalf("Error in run: %s", err)
+	}
+
+	expected := map[string]string{
+		"/system/hostname": "fw1",
+		"/system/serial":   "0123",
+		"/dns":             "a, b",
+	}
+	if !reflect.DeepEqual(ans.Fields, expected) {
+		t.Errorf("Fields is %#v", ans.Fields)
+	}
+	if v := c.rp[0].Get("vsys"); v != "vsys2" {
+		t.Errorf("vsys is %q", v)
+	}
+}
@@ -2,6 +2,7 @@ package pango
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"encoding/json"
 	"encoding/xml"
@@ -15,6 +16,8 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/PaloAltoNetworks/pango/util"
@@ -67,10 +70,67 @@ type Client struct {
 	// for auth and connection properties.
 	CheckEnvironment bool `json:"-"`
 
+	// Optional source of fallback auth and connection properties, such as
+	// a secrets manager.  Settings from the provider take precedence over
+	// the JSON config file, but not over environment variables.
+	CredentialProvider CredentialProvider `json:"-"`
+
+	// Set to true to run the client without a PAN-OS connection.  In
+	// offline mode, config changes are recorded instead of sent, so that
+	// they can be rendered using RenderConfig().  The Version field should
+	// be set prior to Initialize() so that namespaces generate the config
+	// for the desired PAN-OS version.
+	Offline bool `json:"-"`
+
+	// Set to true to prevent this client from making config changes.  All
+	// set, edit, delete, move, rename, multi-config, import, partial config
+	// load, and commit requests are rejected with a ReadOnlyError before
+	// anything is sent, as are op commands that make changes (such as load,
+	// request, clear, and commit-all).  Note that User-ID commands are not
+	// restricted.
+	ReadOnly bool `json:"-"`
+
+	// Set to true to have namespaces that support it compare the desired
+	// config against the current config before writing, skipping the write
+	// (and any vsys import) for objects that already match.  This avoids
+	// needless candidate config changes, at the cost of an extra GET.
+	SkipUnchanged bool `json:"-"`
+
+	// Set to true to decode responses directly from the connection into the
+	// answer struct given to Communicate() instead of buffering the whole
+	// response first.  Namespaces retrieve config this way as well, which
+	// bounds memory usage when listing very large configs.  When a response
+	// is streamed, the raw response is not returned and warnings are not
+	// checked.  Error responses, job polling, and calls without an answer
+	// struct (such as retrieving the full running config) are still
+	// buffered as usual.
+	StreamResponses bool `json:"-"`
+
+	// Set to true to return a WarningError when PAN-OS reports success, but
+	// with warnings (such as an invalid reference).  Regardless of this
+	// setting, the warnings from the most recent API call are available from
+	// Warnings().
+	WarningsAsErrors bool `json:"-"`
+
+	// Set to DrainWait or DrainError to keep config changes from being
+	// included in a commit that is in progress.  A commit waits for any
+	// in flight set, edit, delete, move, rename, multi-config, import, and
+	// partial config load requests to finish, and these requests are then
+	// held back until the commit job has finished.  With DrainWait, they
+	// wait for the job to finish; with DrainError, they return a
+	// CommitInProgressError.  This only covers requests made through this
+	// client, and op commands are not restricted.
+	DrainMode string `json:"-"`
+
 	// HTTP transport options.  Note that the VerifyCertificate setting is
 	// only used if you do not specify a HTTP transport yourself.
-	VerifyCertificate bool            `json:"verify_certificate"`
-	Transport         *http.Transport `json:"-"`
+	//
+	// Responses are requested using gzip or deflate compression, and are
+	// decompressed transparently.  Set DisableCompression to true to request
+	// uncompressed responses instead.
+	VerifyCertificate  bool            `json:"verify_certificate"`
+	DisableCompression bool            `json:"disable_compression"`
+	Transport          *http.Transport `json:"-"`
 
 	// Variables determined at runtime.
 	Version        version.Number      `json:"-"`
@@ -86,6 +146,9 @@ type Client struct {
 	credsFile string
 	con       *http.Client
 	api_url   string
+	drain     *drainGate
+	warnings  []string
+	locks     atomic.Value
 
 	// Variables for testing, response bytes and response index.
 	rp              []url.Values
@@ -123,6 +186,18 @@ func (c *Client) Versioning() version.Number {
 	return c.Version
 }
 
+// SkipUnchangedWrites returns if namespaces should skip writing objects whose
+// config is unchanged.  This is always false in offline mode.
+func (c *Client) SkipUnchangedWrites() bool {
+	return c.SkipUnchanged && !c.Offline
+}
+
+// StreamsResponses returns if responses are decoded directly into the given
+// answer struct instead of being returned.
+func (c *Client) StreamsResponses() bool {
+	return c.StreamResponses
+}
+
 // Plugins returns the plugin information.
 func (c *Client) Plugins() []map[string]string {
 	return c.Plugin
@@ -133,13 +208,20 @@ func (c *Client) Plugins() []map[string]string {
 // info" to get the PAN-OS version.  The full results are saved into the
 // client's SystemInfo map.
 //
+// If ApiKey is set, then it is used as-is and no keygen is performed.  This
+// allows for using a long-lived key of a restricted admin, such as a vsys
+// admin.  If that admin is not permitted to run "show system info", then set
+// Version prior to invoking Initialize().
+//
 // If not specified, the following is assumed:
 //  * Protocol: https
 //  * Port: (unspecified)
 //  * Timeout: 10
 //  * Logging: LogAction | LogUid
 func (c *Client) Initialize() error {
-	if len(c.rb) == 0 {
+	if c.Offline {
+		c.initOffline()
+	} else if len(c.rb) == 0 {
 		var e error
 
 		if e = c.initCon(); e != nil {
@@ -164,6 +246,7 @@ func (c *Client) Initialize() error {
 //
 // * explicitly set
 // * environment variable (set chkenv to true to enable this)
+// * credential provider (if CredentialProvider is set)
 // * json file
 func (c *Client) InitializeUsing(filename string, chkenv bool) error {
 	c.CheckEnvironment = chkenv
@@ -291,7 +374,8 @@ func (c *Client) RequestPasswordHash(val string) (string, error) {
 // The sleep param is an optional sleep duration to wait between polling for
 // job completion.  This param is only used if sync is set to true.
 //
-// This function returns the job ID and if any errors were encountered.
+// This function returns the job ID and if any errors were encountered.  Use
+// ValidateAndWait to get the validation errors and warnings.
 func (c *Client) ValidateConfig(sync bool, sleep time.Duration) (uint, error) {
 	var err error
 
@@ -448,7 +532,13 @@ func (c *Client) UnlockCommits(vsys, admin string) error {
 // In the case that there are multiple errors returned from the job, the first
 // error is returned as the error string, and no unmarshaling is attempted.
 func (c *Client) WaitForJob(id uint, sleep time.Duration, resp interface{}) error {
-	var err error
+	return c.waitForJob(id, sleep, resp, nil)
+}
+
+// waitForJob is WaitForJob, with an optional callback that is invoked with the
+// response of each poll.
+func (c *Client) waitForJob(id uint, sleep time.Duration, resp interface{}, fn func([]byte)) error {
+	var err, werr error
 	var prev uint
 	var data []byte
 	dp := false
@@ -467,12 +557,20 @@ func (c *Client) WaitForJob(id uint, sleep time.Duration, resp interface{}) erro
 		// of strings append to each other instead of zeroing out.
 		ans = util.BasicJob{}
 
-		// Get current percent complete.
-		data, err = c.Op(req, "", nil, &ans)
-		if err != nil {
+		// Get current percent complete.  Warnings are saved until the job's
+		// result has been checked.  The response is always buffered, as it
+		// is unmarshaled into resp once the job completes.
+		data, err = c.op(req, "", nil, &ans, false)
+		if _, ok := err.(WarningError); ok {
+			werr = err
+		} else if err != nil {
 			return err
 		}
 
+		if fn != nil {
+			fn(data)
+		}
+
 		// Output percent complete if it's new.
 		if ans.Progress != prev {
 			prev = ans.Progress
@@ -505,6 +603,7 @@ func (c *Client) WaitForJob(id uint, sleep time.Duration, resp interface{}) erro
 			time.Sleep(sleep)
 		}
 	}
+	c.drainJobDone(id)
 
 	// Check the results for a failed commit.
 	if ans.Result == "FAIL" {
@@ -517,11 +616,13 @@ func (c *Client) WaitForJob(id uint, sleep time.Duration, resp interface{}) erro
 		return fmt.Errorf("Commit failed on one or more devices")
 	}
 
-	if resp == nil {
-		return nil
+	if resp != nil {
+		if err = xml.Unmarshal(data, resp); err != nil {
+			return err
+		}
 	}
 
-	return xml.Unmarshal(data, resp)
+	return werr
 }
 
 // LogAction writes a log message for SET/DELETE operations if LogAction is set.
@@ -566,6 +667,16 @@ func (c *Client) LogUid(msg string, i ...interface{}) {
 //
 // If the API key is set, but not present in the given data, then it is added in.
 func (c *Client) Communicate(data url.Values, ans interface{}) ([]byte, error) {
+	return c.communicate(data, ans, c.StreamResponses)
+}
+
+// communicate is Communicate(), but with control over whether or not the
+// response is streamed.
+func (c *Client) communicate(data url.Values, ans interface{}, stream bool) ([]byte, error) {
+	if c.Offline {
+		return nil, errOffline
+	}
+
 	if c.ApiKey != "" && data.Get("key") == "" {
 		data.Set("key", c.ApiKey)
 	}
@@ -581,12 +692,27 @@ func (c *Client) Communicate(data url.Values, ans interface{}) ([]byte, error) {
 		}
 	}
 
+	if stream && ans != nil {
+		c.saveWarnings(nil)
+		body, err := c.communicateStream(data, ans)
+		if err != nil {
+			return body, permissionError(data, err)
+		}
+		return nil, nil
+	}
+
 	body, err := c.post(data)
 	if err != nil {
 		return nil, err
 	}
 
-	return c.endCommunication(body, ans)
+	body, err = c.endCommunication(body, ans)
+	if err != nil {
+		c.saveWarnings(nil)
+		return body, permissionError(data, err)
+	}
+
+	return body, c.checkWarnings(body)
 }
 
 // CommunicateFile does a file upload to PAN-OS.
@@ -612,6 +738,10 @@ func (c *Client) Communicate(data url.Values, ans interface{}) ([]byte, error) {
 func (c *Client) CommunicateFile(content, filename, fp string, data url.Values, ans interface{}) ([]byte, error) {
 	var err error
 
+	if c.Offline {
+		return nil, errOffline
+	}
+
 	if c.ApiKey != "" && data.Get("key") == "" {
 		data.Set("key", c.ApiKey)
 	}
@@ -651,18 +781,18 @@ func (c *Client) CommunicateFile(content, filename, fp string, data url.Values,
 	}
 	req.Header.Set("Content-Type", w.FormDataContentType())
 
-	res, err := c.con.Do(req)
+	body, err := c.do(req)
 	if err != nil {
 		return nil, err
 	}
 
-	defer res.Body.Close()
-	body, err := ioutil.ReadAll(res.Body)
+	body, err = c.endCommunication(body, ans)
 	if err != nil {
-		return nil, err
+		c.saveWarnings(nil)
+		return body, permissionError(data, err)
 	}
 
-	return c.endCommunication(body, ans)
+	return body, c.checkWarnings(body)
 }
 
 // Op runs an operational or "op" type command.
@@ -681,6 +811,11 @@ func (c *Client) CommunicateFile(content, filename, fp string, data url.Values,
 // Any response received from the server is returned, along with any errors
 // encountered.
 func (c *Client) Op(req interface{}, vsys string, extras, ans interface{}) ([]byte, error) {
+	return c.op(req, vsys, extras, ans, c.StreamResponses)
+}
+
+// op is Op(), but with control over whether or not the response is streamed.
+func (c *Client) op(req interface{}, vsys string, extras, ans interface{}, stream bool) ([]byte, error) {
 	var err error
 	data := url.Values{}
 	data.Set("type", "op")
@@ -689,6 +824,12 @@ func (c *Client) Op(req interface{}, vsys string, extras, ans interface{}) ([]by
 		return nil, err
 	}
 
+	if c.ReadOnly {
+		if action := mutatingOp(data.Get("cmd")); action != "" {
+			return nil, ReadOnlyError{action}
+		}
+	}
+
 	if vsys != "" {
 		data.Set("vsys", vsys)
 	}
@@ -701,10 +842,10 @@ func (c *Client) Op(req interface{}, vsys string, extras, ans interface{}) ([]by
 		return nil, err
 	}
 
-	return c.Communicate(data, ans)
+	return c.communicate(data, ans, stream)
 }
 
-// Show runs a "show" type command.
+// Show runs a "show" type command, which retrieves from the running config.
 //
 // The path param should be either a string or a slice of strings.
 //
@@ -725,7 +866,7 @@ func (c *Client) Show(path, extras, ans interface{}) ([]byte, error) {
 	return c.typeConfig("show", data, nil, extras, ans)
 }
 
-// Get runs a "get" type command.
+// Get runs a "get" type command, which retrieves from the candidate config.
 //
 // The path param should be either a string or a slice of strings.
 //
@@ -859,6 +1000,10 @@ func (c *Client) MultiConfig(element MultiConfigure, strict bool, extras interfa
 		data.Set("strict-transactional", "yes")
 	}
 
+	if c.ReadOnly {
+		return nil, MultiConfigureResponse{}, ReadOnlyError{"multi-config"}
+	}
+
 	text, _ := c.typeConfig("multi-config", data, element, extras, nil)
 
 	resp := MultiConfigureResponse{}
@@ -910,6 +1055,16 @@ func (c *Client) Uid(cmd interface{}, vsys string, extras, ans interface{}) ([]b
 // Any response received from the server is returned, along with any errors
 // encountered.
 func (c *Client) Import(cat, content, filename, fp string, extras map[string]string, ans interface{}) ([]byte, error) {
+	if c.ReadOnly {
+		return nil, ReadOnlyError{"import"}
+	}
+
+	done, err := c.drainBegin("import")
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
 	data := url.Values{}
 	data.Set("type", "import")
 	data.Set("category", cat)
@@ -929,6 +1084,8 @@ func (c *Client) Import(cat, content, filename, fp string, extras map[string]str
 // The action param is the commit action to be taken (e.g. - "all").  If the
 // cmd param is one of the commit types, and the action passed in to this function
 // is an empty string, then the action will be determined by the commit type.
+// Commit types that have a Validate() function are validated before being
+// sent.
 //
 // The extras param should be either nil or a url.Values{} to be mixed in with
 // the constructed request.
@@ -938,6 +1095,17 @@ func (c *Client) Import(cat, content, filename, fp string, extras map[string]str
 // and if an error was encountered or not are all returned from this function.
 func (c *Client) Commit(cmd interface{}, action string, extras interface{}) (uint, []byte, error) {
 	var err error
+
+	if c.ReadOnly {
+		return 0, nil, ReadOnlyError{"commit"}
+	}
+
+	if v, ok := cmd.(util.Validator); ok {
+		if err = v.Validate(); err != nil {
+			return 0, nil, err
+		}
+	}
+
 	data := url.Values{}
 	data.Set("type", "commit")
 
@@ -959,8 +1127,10 @@ func (c *Client) Commit(cmd interface{}, action string, extras interface{}) (uin
 		return 0, nil, err
 	}
 
+	submitted := c.drainCommit()
 	ans := util.JobResponse{}
 	b, err := c.Communicate(data, &ans)
+	submitted(ans.Id)
 	return ans.Id, b, err
 }
 
@@ -991,6 +1161,19 @@ func (c *Client) initCon() error {
 		}
 	}
 
+	// Overlay the credential provider's settings.
+	if c.CredentialProvider != nil {
+		hostname := c.Hostname
+		if val := os.Getenv("PANOS_HOSTNAME"); hostname == "" && c.CheckEnvironment {
+			hostname = val
+		}
+		creds, err := c.CredentialProvider.Credentials(hostname)
+		if err != nil {
+			return err
+		}
+		applyCredentials(json_client, creds)
+	}
+
 	// Hostname.
 	if c.Hostname == "" {
 		if val := os.Getenv("PANOS_HOSTNAME"); c.CheckEnvironment && val != "" {
@@ -1205,6 +1388,12 @@ func (c *Client) initSystemInfo() error {
 
 	_, err = c.Op(req, "", nil, &ans)
 	if err != nil {
+		// Restricted admins may not be allowed to run this command, so if
+		// the version is already known, then continue without it.
+		if _, ok := err.(PermissionError); ok && c.Version != (version.Number{}) {
+			c.LogOp("(op) show system info denied, using version %s", c.Version)
+			return nil
+		}
 		return err
 	}
 
@@ -1225,18 +1414,22 @@ func (c *Client) initSystemInfo() error {
 func (c *Client) typeConfig(action string, data url.Values, element, extras, ans interface{}) ([]byte, error) {
 	var err error
 
-	if c.MultiConfigure != nil && (action == "set" ||
-		action == "edit" ||
-		action == "delete") {
-		r := MultiConfigureRequest{
-			XMLName: xml.Name{Local: action},
-			Xpath:   data.Get("xpath"),
+	if c.ReadOnly && action != "get" && action != "show" {
+		return nil, ReadOnlyError{action}
+	}
+
+	if action == "set" || action == "edit" || action == "delete" {
+		if c.queueMultiConfigure(newMultiConfigureRequest(action, data.Get("xpath"), element)) {
+			return nil, nil
 		}
-		if element != nil {
-			r.Data = element
+	}
+
+	if action != "get" && action != "show" {
+		done, err := c.drainBegin(action)
+		if err != nil {
+			return nil, err
 		}
-		c.MultiConfigure.Reqs = append(c.MultiConfigure.Reqs, r)
-		return nil, nil
+		defer done()
 	}
 
 	data.Set("type", "config")
@@ -1331,6 +1524,37 @@ func (c *Client) IsImported(loc, tmpl, ts, vsys, name string) (bool, error) {
 	return false, err
 }
 
+// ImportedVsys returns the vsys that each of the given importable objects is
+// imported into.  Objects that are not imported into any vsys are omitted.
+func (c *Client) ImportedVsys(loc, tmpl, ts string, names []string) (map[string]string, error) {
+	path := c.xpathImport(tmpl, ts, "")
+	list, err := c.EntryListUsing(c.Get, path[:len(path)-3])
+	if err != nil {
+		return nil, err
+	}
+
+	want := make(map[string]bool, len(names))
+	for _, name := range names {
+		want[name] = true
+	}
+
+	ans := make(map[string]string)
+	for _, vsys := range list {
+		path = append(c.xpathImport(tmpl, ts, vsys), loc)
+		members, err := c.MemberListUsing(c.Get, path)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range members {
+			if want[name] {
+				ans[name] = vsys
+			}
+		}
+	}
+
+	return ans, nil
+}
+
 func (c *Client) xpathImport(tmpl, ts, vsys string) []string {
 	ans := make([]string, 0, 12)
 	if tmpl != "" || ts != "" {
@@ -1350,14 +1574,18 @@ func (c *Client) xpathImport(tmpl, ts, vsys string) []string {
 }
 
 func (c *Client) post(data url.Values) ([]byte, error) {
+	return c.postContext(context.Background(), data)
+}
+
+func (c *Client) postContext(ctx context.Context, data url.Values) ([]byte, error) {
 	if len(c.rb) == 0 {
-		r, err := c.con.PostForm(c.api_url, data)
+		req, err := http.NewRequestWithContext(ctx, "POST", c.api_url, strings.NewReader(data.Encode()))
 		if err != nil {
 			return nil, err
 		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
-		defer r.Body.Close()
-		return ioutil.ReadAll(r.Body)
+		return c.do(req)
 	} else {
 		if c.ri < len(c.rb) {
 			c.rp = append(c.rp, data)
@@ -1384,12 +1612,12 @@ func (c *Client) endCommunication(body []byte, ans interface{}) ([]byte, error)
 	// a result.
 	if errType1.Failed() {
 		if err == nil && errType1.Error() != "" {
-			return body, PanosError{errType1.Error(), errType1.ResponseCode}
+			return body, PanosError{Msg: errType1.Error(), Code: errType1.ResponseCode}
 		}
 		errType2 := panosErrorResponseWithLine{}
 		err = xml.Unmarshal(body, &errType2)
 		if err == nil && errType2.Error() != "" {
-			return body, PanosError{errType2.Error(), errType2.ResponseCode}
+			return body, PanosError{Msg: errType2.Error(), Code: errType2.ResponseCode, Lines: errType2.Lines()}
 		}
 		// Still an error, but some unknown format.
 		return body, fmt.Errorf("Unknown error format: %s", body)
@@ -1501,13 +1729,16 @@ func (c *Client) Clock() (time.Time, error) {
 		return time.Time{}, err
 	}
 
-	return time.Parse(time.UnixDate+"\n", ans.Result)
+	return parseClock(ans.Result)
 }
 
 // PrepareMultiConfigure will start a multi config command.
 //
 // Capacity is the initial capacity of the requests to be sent.
 func (c *Client) PrepareMultiConfigure(capacity int) {
+	c.mu().multiConfigure.Lock()
+	defer c.mu().multiConfigure.Unlock()
+
 	c.MultiConfigure = &MultiConfigure{
 		Reqs: make([]MultiConfigureRequest, 0, capacity),
 	}
@@ -1521,13 +1752,11 @@ func (c *Client) PrepareMultiConfigure(capacity int) {
 // unmarshaling the response into the the multi config response struct.  If the
 // multi config itself failed, then the reason can be found in its results.
 func (c *Client) SendMultiConfigure(strict bool) (MultiConfigureResponse, error) {
-	if c.MultiConfigure == nil {
+	mc := c.takeMultiConfigure()
+	if mc == nil {
 		return MultiConfigureResponse{}, nil
 	}
 
-	mc := c.MultiConfigure
-	c.MultiConfigure = nil
-
 	_, ans, err := c.MultiConfig(*mc, strict, nil)
 	return ans, err
 }
@@ -1593,9 +1822,13 @@ func asString(i interface{}, attemptMarshal bool) (string, error) {
 }
 
 // PanosError is the error struct returned from the Communicate method.
+//
+// If PAN-OS returned multiple message lines, then Msg is all of them joined
+// together, and Lines has each line individually.
 type PanosError struct {
-	Msg  string
-	Code int
+	Msg   string
+	Code  int
+	Lines []string
 }
 
 // Error returns the error message.
@@ -1603,6 +1836,15 @@ func (e PanosError) Error() string {
 	return e.Msg
 }
 
+// Messages returns each message line of the error.
+func (e PanosError) Messages() []string {
+	if len(e.Lines) > 0 {
+		return e.Lines
+	}
+
+	return []string{e.Msg}
+}
+
 // ObjectNotFound returns true on missing object error.
 func (e PanosError) ObjectNotFound() bool {
 	return e.Code == 7
@@ -1673,18 +1915,37 @@ func (e panosStatus) codeError() string {
 type panosErrorResponseWithLine struct {
 	XMLName xml.Name `xml:"response"`
 	panosStatus
-	ResponseMsg string `xml:"msg>line"`
+	ResponseMsg []string `xml:"msg>line"`
 }
 
 // Error retrieves the parsed error message.
 func (e panosErrorResponseWithLine) Error() string {
-	if e.ResponseMsg != "" {
-		return e.ResponseMsg
-	} else {
+	switch len(e.ResponseMsg) {
+	case 0:
 		return e.codeError()
+	case 1:
+		return e.ResponseMsg[0]
+	default:
+		return strings.Join(e.Lines(), " | ")
 	}
 }
 
+// Lines returns the non-empty message lines, if there are more than one.
+func (e panosErrorResponseWithLine) Lines() []string {
+	if len(e.ResponseMsg) < 2 {
+		return nil
+	}
+
+	ans := make([]string, 0, len(e.ResponseMsg))
+	for _, line := range e.ResponseMsg {
+		if line = strings.TrimSpace(line); line != "" {
+			ans = append(ans, line)
+		}
+	}
+
+	return ans
+}
+
 // panosErrorResponseWithoutLine is one of a few known error formats that PAN-OS
 // outputs.  It checks two locations that the error could be, and returns the
 // one that was discovered in its Error().
@@ -1704,6 +1965,93 @@ func (e panosErrorResponseWithoutLine) Error() string {
 	}
 }
 
+// ReadOnlyError is returned when a read-only client is asked to perform an
+// action that would change the config.
+type ReadOnlyError struct {
+	Action string
+}
+
+// Error returns the error message.
+func (e ReadOnlyError) Error() string {
+	return fmt.Sprintf("%s not permitted: client is read-only", e.Action)
+}
+
+// mutatingOps are the root elements of op commands that make changes.
+var mutatingOps = map[string]bool{
+	"clear":      true,
+	"commit":     true,
+	"commit-all": true,
+	"delete":     true,
+	"load":       true,
+	"request":    true,
+	"reset":      true,
+	"revert":     true,
+	"save":       true,
+	"set":        true,
+}
+
+// readOnlyOps are op commands under one of the mutatingOps roots that only
+// retrieve information.
+var readOnlyOps = []string{
+	"request password-hash",
+	"request license info",
+	"request license api-key show",
+	"request bootstrap vm-auth-key show",
+}
+
+// mutatingOp returns the root element of the given op command if the command
+// makes changes, or an empty string if it does not.  If the command cannot
+// be parsed, then it is assumed to make changes.
+func mutatingOp(cmd string) string {
+	var path []string
+	d := xml.NewDecoder(strings.NewReader(cmd))
+	for len(path) < 4 {
+		t, err := d.Token()
+		if err != nil {
+			break
+		}
+		if e, ok := t.(xml.StartElement); ok {
+			path = append(path, e.Name.Local)
+		} else if _, ok := t.(xml.EndElement); ok {
+			break
+		}
+	}
+
+	if len(path) == 0 {
+		return "op"
+	} else if !mutatingOps[path[0]] {
+		return ""
+	}
+
+	cmd = strings.Join(path, " ") + " "
+	for _, ro := range readOnlyOps {
+		if strings.HasPrefix(cmd, ro+" ") {
+			return ""
+		}
+	}
+
+	return path[0]
+}
+
+// clientLocks guard the internal state of a client.
+type clientLocks struct {
+	drainGate      sync.Mutex
+	warnings       sync.Mutex
+	multiConfigure sync.Mutex
+}
+
+// mu returns this client's locks, creating them if needed.  The locks are
+// kept behind a pointer so that a client can still be passed by value (such
+// as to Connect()) before it is used.
+func (c *Client) mu() *clientLocks {
+	if v := c.locks.Load(); v != nil {
+		return v.(*clientLocks)
+	}
+	c.locks.CompareAndSwap(nil, &clientLocks{})
+
+	return c.locks.Load().(*clientLocks)
+}
+
 // vis is a vsys import struct.
 type vis struct {
 	XMLName xml.Name
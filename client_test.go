@@ -5,11 +5,13 @@ import (
 	"encoding/xml"
 	"log"
 	"os"
+	"reflect"
 	"strings"
 	"testing"
 
 	"github.com/PaloAltoNetworks/pango/commit"
 	"github.com/PaloAltoNetworks/pango/testdata"
+	"github.com/PaloAltoNetworks/pango/util"
 )
 
 func init() {
@@ -229,3 +231,136 @@ func TestAsStringWithNil(t *testing.T) {
 		t.Errorf("asString() returned no error on nil input")
 	}
 }
+
+func TestReadOnlyRejectsChanges(t *testing.T) {
+	fw := &Firewall{Client: Client{
+		ReadOnly: true,
+		rb:       [][]byte{[]byte(`<response status="success"><result><entry name="a1"><ip-netmask>10.1.1.1</ip-netmask></entry></result></response>`)},
+	}}
+	fw.Initialize()
+
+	checks := []struct {
+		action string
+		fn     func() error
+	}{
+		{"set", func() error { _, err := fw.Set("/config/shared", "<address/>", nil, nil); return err }},
+		{"edit", func() error { _, err := fw.Edit("/config/shared/address", "<address/>", nil, nil); return err }},
+		{"delete", func() error { _, err := fw.Delete("/config/shared/address", nil, nil); return err }},
+		{"commit", func() error { _, _, err := fw.Commit(commit.FirewallCommit{}, "", nil); return err }},
+		{"multi-config", func() error { _, _, err := fw.MultiConfig(MultiConfigure{}, false, nil); return err }},
+		{"import", func() error { _, err := fw.Import("certificate", "", "f", "file", nil, nil); return err }},
+	}
+
+	for _, chk := range checks {
+		err := chk.fn()
+		if e, ok := err.(ReadOnlyError); !ok {
+			t.Errorf("%s: error is %#v", chk.action, err)
+		} else if e.Action != chk.action {
+			t.Errorf("%s: action is %q", chk.action, e.Action)
+		}
+	}
+
+	if len(fw.rp) != 0 {
+		t.Errorf("Sent %d requests", len(fw.rp))
+	}
+
+	if _, err := fw.Objects.Address.Get("vsys1", "a1"); err != nil {
+		t.Errorf("Get failed on read-only client: %s", err)
+	}
+}
+
+func TestReadOnlyRejectsChangingOps(t *testing.T) {
+	fw := &Firewall{Client: Client{
+		ReadOnly: true,
+		rb:       [][]byte{[]byte(`<response status="success"><result>ok</result></response>`)},
+	}}
+	fw.Initialize()
+
+	checks := []struct {
+		action string
+		fn     func() error
+	}{
+		{"load", fw.RevertToRunningConfig},
+		{"clear", func() error { return fw.Policies.HitCount.Reset("vsys1", "security") }},
+		{"request", func() error {
+			_, err := fw.Op("<request><certificate><generate><certificate-name>c</certificate-name></generate></certificate></request>", "", nil, nil)
+			return err
+		}},
+		{"commit-all", func() error { _, err := fw.Op("<commit-all><shared-policy/></commit-all>", "", nil, nil); return err }},
+		{"op", func() error { _, err := fw.Op("not xml", "", nil, nil); return err }},
+	}
+
+	for _, chk := range checks {
+		err := chk.fn()
+		if e, ok := err.(ReadOnlyError); !ok {
+			t.Errorf("%s: error is %#v", chk.action, err)
+		} else if e.Action != chk.action {
+			t.Errorf("%s: action is %q", chk.action, e.Action)
+		}
+	}
+
+	if len(fw.rp) != 0 {
+		t.Errorf("Sent %d requests", len(fw.rp))
+	}
+
+	for _, cmd := range []string{
+		"<show><system><info/></system></show>",
+		"<request><license><info/></license></request>",
+		"<request><password-hash><password>x</password></password-hash></request>",
+	} {
+		if _, err := fw.Op(cmd, "", nil, nil); err != nil {
+			t.Errorf("%s failed on read-only client: %s", cmd, err)
+		}
+	}
+}
+
+func TestCommitValidates(t *testing.T) {
+	c := &Client{}
+	c.Initialize()
+
+	cmd := commit.FirewallCommit{Vsys: []string{"vsys1"}, NoVsys: true}
+	if _, _, err := c.Commit(cmd, "", nil); err == nil {
+		t.Errorf("Commit with vsys and no-vsys did not fail")
+	}
+	if len(c.rp) != 0 {
+		t.Errorf("Sent %d requests", len(c.rp))
+	}
+}
+
+func TestPanosErrorMultipleLines(t *testing.T) {
+	c := &Client{rb: [][]byte{
+		[]byte(`<response status="error" code="12"><msg><line><![CDATA[ address -> a1 -> ip-netmask 'x' is not a valid IP]]></line><line><![CDATA[ address is invalid]]></line></msg></response>`),
+	}}
+	c.Initialize()
+
+	_, err := c.Set("/config/shared", "<address/>", nil, nil)
+	e, ok := err.(PanosError)
+	if !ok {
+		t.Fatalf("Error is %T, not PanosError", err)
+	}
+	if len(e.Messages()) != 2 || e.Messages()[1] != "address is invalid" {
+		t.Errorf("Messages are %#v", e.Messages())
+	}
+	if e.Msg != "address -> a1 -> ip-netmask 'x' is not a valid IP | address is invalid" {
+		t.Errorf("Msg is %q", e.Msg)
+	}
+}
+
+func TestImportedVsys(t *testing.T) {
+	c := &Client{rb: [][]byte{
+		[]byte(`<response status="success"><result><entry name="vsys1"/><entry name="vsys2"/></result></response>`),
+		[]byte(`<response status="success"><result><member>ethernet1/1</member></result></response>`),
+		[]byte(`<response status="success"><result><member>ethernet1/2</member><member>ethernet1/3</member></result></response>`),
+	}}
+	c.Initialize()
+
+	ans, err := c.ImportedVsys(util.InterfaceImport, "", "", []string{"ethernet1/2", "ethernet1/4"})
+	if err != nil {
+		t.Fatalf("Error looking up imports: %s", err)
+	} else if !reflect.DeepEqual(ans, map[string]string{"ethernet1/2": "vsys2"}) {
+		t.Errorf("Got %#v", ans)
+	}
+	if xp := c.rp[2].Get("xpath"); xp != "/config/devices/entry[@name='localhost.localdomain']/vsys/entry[@name='vsys2']/import/network/interface/member" {
+		t.Errorf("Xpath is %q", xp)
+	}
+}
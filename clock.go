@@ -0,0 +1,81 @@
+package pango
+
+import (
+	"strings"
+	"time"
+)
+
+// clockZones maps the timezone abbreviations PAN-OS commonly reports to their
+// UTC offset in seconds.  Go's time.Parse does not know the offset of
+// abbreviations other than UTC and the local zone's, so without this the
+// device time would be off by the zone's offset.
+var clockZones = map[string]int{
+	"UTC":  0,
+	"GMT":  0,
+	"WET":  0,
+	"WEST": 1 * 3600,
+	"BST":  1 * 3600,
+	"CET":  1 * 3600,
+	"CEST": 2 * 3600,
+	"EET":  2 * 3600,
+	"EEST": 3 * 3600,
+	"MSK":  3 * 3600,
+	"IST":  5*3600 + 1800,
+	"SGT":  8 * 3600,
+	"HKT":  8 * 3600,
+	"JST":  9 * 3600,
+	"KST":  9 * 3600,
+	"AEST": 10 * 3600,
+	"AEDT": 11 * 3600,
+	"NZST": 12 * 3600,
+	"NZDT": 13 * 3600,
+	"AST":  -4 * 3600,
+	"ADT":  -3 * 3600,
+	"EST":  -5 * 3600,
+	"EDT":  -4 * 3600,
+	"CST":  -6 * 3600,
+	"CDT":  -5 * 3600,
+	"MST":  -7 * 3600,
+	"MDT":  -6 * 3600,
+	"PST":  -8 * 3600,
+	"PDT":  -7 * 3600,
+	"AKST": -9 * 3600,
+	"AKDT": -8 * 3600,
+	"HST":  -10 * 3600,
+}
+
+// parseClock parses the output of "show clock".
+func parseClock(s string) (time.Time, error) {
+	t, err := time.Parse(time.UnixDate, strings.TrimSpace(s))
+	if err != nil {
+		return t, err
+	}
+
+	name, offset := t.Zone()
+	if known, ok := clockZones[name]; ok && known != offset {
+		// Reinterpret the wall clock time in the correct zone.
+		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.FixedZone(name, known))
+	}
+
+	return t, nil
+}
+
+// ClockSkew returns how far the PAN-OS appliance's clock is ahead of the local
+// clock.  A negative value means the appliance is behind.
+//
+// The appliance only reports time to the second and the local time used is
+// the midpoint of the request, so the skew is rounded to the second.
+//
+// This is useful for adjusting log query windows or sanity checking
+// certificate validity periods against the appliance's notion of "now".
+func (c *Client) ClockSkew() (time.Duration, error) {
+	start := time.Now()
+	t, err := c.Clock()
+	if err != nil {
+		return 0, err
+	}
+	end := time.Now()
+
+	local := start.Add(end.Sub(start) / 2)
+	return t.Sub(local).Round(time.Second), nil
+}
@@ -0,0 +1,49 @@
+package pango
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseClock(t *testing.T) {
+	testCases := []struct {
+		v string
+		r time.Time
+	}{
+		{"Mon Jan  4 10:00:00 UTC 2021\n", time.Date(2021, 1, 4, 10, 0, 0, 0, time.UTC)},
+		{"Mon Jan  4 10:00:00 PST 2021\n", time.Date(2021, 1, 4, 18, 0, 0, 0, time.UTC)},
+		{"Tue Jun 15 23:30:00 CEST 2021", time.Date(2021, 6, 15, 21, 30, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.v, func(t *testing.T) {
+			v, err := parseClock(tc.v)
+			if err != nil {
+				t.Fatalf("Error parsing: %s", err)
+			}
+			if !v.Equal(tc.r) {
+				t.Errorf("Got %s, not %s", v, tc.r)
+			}
+		})
+	}
+
+	if _, err := parseClock("bogus"); err == nil {
+		t.Errorf("Expected an error for a bad time")
+	}
+}
+
+func TestClockSkew(t *testing.T) {
+	now := time.Now().UTC().Add(-90 * time.Second)
+	resp := `<response status="success"><result>` + now.Format(time.UnixDate) + "\n</result></response>"
+
+	c := &Client{rb: [][]byte{[]byte(resp)}}
+	c.Initialize()
+
+	skew, err := c.ClockSkew()
+	if err != nil {
+		t.Fatalf("Error getting skew: %s", err)
+	}
+	if skew < -91*time.Second || skew > -89*time.Second {
+		t.Errorf("Skew is %s", skew)
+	}
+}
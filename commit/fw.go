@@ -2,36 +2,66 @@ package commit
 
 import (
 	"encoding/xml"
+	"fmt"
 
 	"github.com/PaloAltoNetworks/pango/util"
 )
 
 // FirewallCommit is a normalized object for defining firewall commits.
 //
-// Admins is the list of admins whose changes should be committed.
+// Specifying any of Admins, Vsys, NoVsys, or the Exclude* params makes this a
+// partial commit:
+//
+//      * Admins is the list of admins whose changes should be committed.
+//      * Vsys is the list of vsys whose changes should be committed.
+//      * NoVsys excludes the changes of all vsys, so it may not be used with Vsys.
+//      * ExcludeDeviceAndNetwork commits only policies and objects.
+//      * ExcludePolicyAndObjects commits only device and network config.
+//      * ExcludeSharedObjects excludes shared object changes.
+//
+// Force forces the commit, even if another admin holds a commit lock.
 type FirewallCommit struct {
 	Description             string
 	Admins                  []string
+	Vsys                    []string
+	NoVsys                  bool
 	ExcludeDeviceAndNetwork bool
 	ExcludeSharedObjects    bool
 	ExcludePolicyAndObjects bool
 	Force                   bool
 }
 
+// Partial returns if this is a partial commit.
+func (o FirewallCommit) Partial() bool {
+	return len(o.Admins) > 0 || len(o.Vsys) > 0 || o.NoVsys ||
+		o.ExcludeDeviceAndNetwork || o.ExcludeSharedObjects || o.ExcludePolicyAndObjects
+}
+
 // Action returns a commit action of an empty string.
 func (o FirewallCommit) Action() string { return "" }
 
+// Validate returns an error if both Vsys and NoVsys are specified.
+func (o FirewallCommit) Validate() error {
+	if o.NoVsys && len(o.Vsys) > 0 {
+		return fmt.Errorf("vsys and no-vsys are mutually exclusive")
+	}
+
+	return nil
+}
+
 // Element returns an interface to be marshalled to perform the specified commit.
+//
+// Use Validate to check the commit first; Client.Commit does this for you.
 func (o FirewallCommit) Element() interface{} {
 	ans := fwCommit{
 		Description: o.Description,
 	}
 
 	var p *fwPartialCommit
-	if len(o.Admins) > 0 ||
-		o.ExcludeDeviceAndNetwork || o.ExcludeSharedObjects || o.ExcludePolicyAndObjects {
+	if o.Partial() {
 		p = &fwPartialCommit{
 			Admins: util.StrToMem(o.Admins),
+			Vsys:   util.StrToMem(o.Vsys),
 		}
 
 		if o.ExcludeDeviceAndNetwork {
@@ -45,6 +75,11 @@ func (o FirewallCommit) Element() interface{} {
 		if o.ExcludePolicyAndObjects {
 			p.ExcludePolicyAndObjects = "excluded"
 		}
+
+		if o.NoVsys {
+			s := ""
+			p.NoVsys = &s
+		}
 	}
 
 	if o.Force {
@@ -74,4 +109,6 @@ type fwPartialCommit struct {
 	ExcludeDeviceAndNetwork string           `xml:"device-and-network,omitempty"`
 	ExcludeSharedObjects    string           `xml:"shared-object,omitempty"`
 	ExcludePolicyAndObjects string           `xml:"policy-and-objects,omitempty"`
+	NoVsys                  *string          `xml:"no-vsys"`
+	Vsys                    *util.MemberType `xml:"vsys"`
 }
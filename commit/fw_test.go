@@ -151,3 +151,66 @@ func TestFirewallAction(t *testing.T) {
 		t.Errorf("Action is %q and not empty string", c.Action())
 	}
 }
+
+func TestPartialVsys(t *testing.T) {
+	s := []string{
+		"<commit>",
+		"<force>",
+		"<partial>",
+		"<admin><member>user1</member></admin>",
+		"<policy-and-objects>excluded</policy-and-objects>",
+		"<vsys><member>vsys2</member></vsys>",
+		"</partial>",
+		"</force>",
+		"</commit>",
+	}
+
+	expected := strings.Join(s, "")
+
+	c := FirewallCommit{
+		Admins:                  []string{"user1"},
+		Vsys:                    []string{"vsys2"},
+		ExcludePolicyAndObjects: true,
+		Force:                   true,
+	}
+
+	b, _ := xml.Marshal(c.Element())
+	if expected != string(b) {
+		t.Errorf("Expected(%s) got(%s)", expected, b)
+	}
+}
+
+func TestPartialNoVsys(t *testing.T) {
+	expected := "<commit><partial><no-vsys></no-vsys></partial></commit>"
+
+	c := FirewallCommit{NoVsys: true}
+	if !c.Partial() {
+		t.Errorf("Commit is not partial")
+	}
+
+	b, _ := xml.Marshal(c.Element())
+	if expected != string(b) {
+		t.Errorf("Expected(%s) got(%s)", expected, b)
+	}
+}
+
+func TestFirewallCommitValidate(t *testing.T) {
+	testCases := []struct {
+		desc string
+		c    FirewallCommit
+		ok   bool
+	}{
+		{"full", FirewallCommit{}, true},
+		{"vsys", FirewallCommit{Vsys: []string{"vsys1"}}, true},
+		{"no vsys", FirewallCommit{NoVsys: true}, true},
+		{"vsys and no vsys", FirewallCommit{Vsys: []string{"vsys1"}, NoVsys: true}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if err := tc.c.Validate(); (err == nil) != tc.ok {
+				t.Errorf("Validate: %v", err)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package commit
 
 import (
 	"encoding/xml"
+	"fmt"
 
 	"github.com/PaloAltoNetworks/pango/util"
 )
@@ -102,9 +103,17 @@ object are used.
 
 Regardless of the type given, all commits will use the Description  and Name params.
 
-TypeDeviceGroup types uses Devices, IncludeTemplate, and ForceTemplateValues.
+TypeDeviceGroup types uses Devices, IncludeTemplate, ForceTemplateValues, and
+MergeWithCandidate.
 
-TypeTemplate and TypeTemplateStack uses Devices and ForceTemplateValues.
+TypeTemplate and TypeTemplateStack uses Devices, ForceTemplateValues, and
+MergeWithCandidate.
+
+Devices are the serial numbers of the firewalls to push to.  If no Devices are
+given, then the push goes to all firewalls of the device group / template.
+
+MergeWithCandidate merges the pushed config with each firewall's candidate
+config, instead of the firewall's running config.
 */
 type PanoramaCommitAll struct {
 	Type                string
@@ -112,12 +121,30 @@ type PanoramaCommitAll struct {
 	Description         string
 	IncludeTemplate     bool
 	ForceTemplateValues bool
+	MergeWithCandidate  bool
 	Devices             []string
 }
 
 // Action returns a commit action of "all".
 func (o PanoramaCommitAll) Action() string { return "all" }
 
+// Validate returns an error if the commit-all is missing its type or name.
+func (o PanoramaCommitAll) Validate() error {
+	switch o.Type {
+	case TypeDeviceGroup, TypeTemplate, TypeTemplateStack, TypeLogCollectorGroup, TypeWildfireAppliance, TypeWildfireCluster:
+	case "":
+		return fmt.Errorf("commit-all type must be specified")
+	default:
+		return fmt.Errorf("invalid commit-all type: %q", o.Type)
+	}
+
+	if o.Name == "" {
+		return fmt.Errorf("%s name must be specified", o.Type)
+	}
+
+	return nil
+}
+
 // Element returns an interface to be marshalled to perform the specified commit.
 func (o PanoramaCommitAll) Element() interface{} {
 	var ans panoCommitAll
@@ -135,6 +162,7 @@ func (o PanoramaCommitAll) Element() interface{} {
 				Description:         o.Description,
 				IncludeTemplate:     util.YesNo(o.IncludeTemplate),
 				ForceTemplateValues: util.YesNo(o.ForceTemplateValues),
+				MergeWithCandidate:  yesOrEmpty(o.MergeWithCandidate),
 			},
 		}
 	case TypeTemplate:
@@ -143,6 +171,7 @@ func (o PanoramaCommitAll) Element() interface{} {
 				Name:                o.Name,
 				Description:         o.Description,
 				ForceTemplateValues: util.YesNo(o.ForceTemplateValues),
+				MergeWithCandidate:  yesOrEmpty(o.MergeWithCandidate),
 				Devices:             util.StrToMem(o.Devices),
 			},
 		}
@@ -152,6 +181,7 @@ func (o PanoramaCommitAll) Element() interface{} {
 				Name:                o.Name,
 				Description:         o.Description,
 				ForceTemplateValues: util.YesNo(o.ForceTemplateValues),
+				MergeWithCandidate:  yesOrEmpty(o.MergeWithCandidate),
 				Devices:             util.StrToMem(o.Devices),
 			},
 		}
@@ -195,6 +225,7 @@ type pcaDeviceGroup struct {
 	Description         string    `xml:"description,omitempty"`
 	IncludeTemplate     string    `xml:"include-template"`
 	ForceTemplateValues string    `xml:"force-template-values"`
+	MergeWithCandidate  string    `xml:"merge-with-candidate-cfg,omitempty"`
 }
 
 type pcaDgInfo struct {
@@ -211,6 +242,7 @@ type pcaTemplate struct {
 	Description         string           `xml:"description,omitempty"`
 	Devices             *util.MemberType `xml:"device"`
 	ForceTemplateValues string           `xml:"force-template-values"`
+	MergeWithCandidate  string           `xml:"merge-with-candidate-cfg,omitempty"`
 }
 
 type pcaLogCollectorGroup struct {
@@ -223,3 +255,13 @@ type pcaWildfire struct {
 	Appliance   string `xml:"wildfire-appliance,omitempty"`
 	Cluster     string `xml:"wildfire-appliance-cluster,omitempty"`
 }
+
+// yesOrEmpty returns "yes" if v is true, so that options that are not
+// supported by all PAN-OS versions are only sent when used.
+func yesOrEmpty(v bool) string {
+	if v {
+		return "yes"
+	}
+
+	return ""
+}
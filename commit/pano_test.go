@@ -269,3 +269,44 @@ func TestPanoCommitAllAction(t *testing.T) {
 		t.Errorf("Commit all action should be %q, not %q", expected, c.Action())
 	}
 }
+
+func TestPanoCommitAllMergeWithCandidate(t *testing.T) {
+	s := []string{
+		"<commit-all>",
+		"<template-stack>",
+		"<name>ts1</name>",
+		"<device><member>0001</member><member>0002</member></device>",
+		"<force-template-values>no</force-template-values>",
+		"<merge-with-candidate-cfg>yes</merge-with-candidate-cfg>",
+		"</template-stack>",
+		"</commit-all>",
+	}
+	expected := strings.Join(s, "")
+
+	c := PanoramaCommitAll{
+		Type:               TypeTemplateStack,
+		Name:               "ts1",
+		Devices:            []string{"0001", "0002"},
+		MergeWithCandidate: true,
+	}
+
+	b, _ := xml.Marshal(c.Element())
+	if expected != string(b) {
+		t.Errorf("Expected(%s) got(%s)", expected, b)
+	}
+}
+
+func TestPanoCommitAllValidate(t *testing.T) {
+	if err := (PanoramaCommitAll{Type: TypeDeviceGroup, Name: "dg1"}).Validate(); err != nil {
+		t.Errorf("Error for valid commit-all: %s", err)
+	}
+	if err := (PanoramaCommitAll{Name: "dg1"}).Validate(); err == nil {
+		t.Errorf("No error for missing type")
+	}
+	if err := (PanoramaCommitAll{Type: "bogus", Name: "dg1"}).Validate(); err == nil {
+		t.Errorf("No error for invalid type")
+	}
+	if err := (PanoramaCommitAll{Type: TypeTemplate}).Validate(); err == nil {
+		t.Errorf("No error for missing name")
+	}
+}
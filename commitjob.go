@@ -0,0 +1,177 @@
+package pango
+
+import (
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// CommitResult is the result of a commit job.
+//
+// For Panorama commit-all / push jobs, Devices are the per-device results.
+type CommitResult struct {
+	Id       uint
+	Status   string
+	Result   string
+	Progress uint
+	Details  []string
+	Warnings []string
+	Devices  []DeviceCommitResult
+}
+
+// Ok returns if the commit succeeded on PAN-OS and on all devices.
+func (o CommitResult) Ok() bool {
+	if o.Result != "OK" {
+		return false
+	}
+	for _, d := range o.Devices {
+		if d.Result != "OK" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Done returns if the commit job and all device commits have finished.
+func (o CommitResult) Done() bool {
+	if o.Status != "FIN" && o.Progress != 100 {
+		return false
+	}
+	for _, d := range o.Devices {
+		if d.Result == "PEND" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DeviceCommitResult is the result of a commit on one device, as part of a
+// Panorama commit-all / push job.
+type DeviceCommitResult struct {
+	Serial   string
+	Name     string
+	Vsys     string
+	Status   string
+	Result   string
+	Progress uint
+	Errors   []string
+	Warnings []string
+}
+
+// CommitProgressFunc is invoked with the current state of a commit job while
+// waiting for it to finish.
+type CommitProgressFunc func(CommitResult)
+
+// CommitAndWait performs a commit, then waits for the commit job to finish
+// using WaitForCommit.
+//
+// If there was nothing to commit, then the CommitResult is empty.
+func (c *Client) CommitAndWait(cmd interface{}, action string, extras interface{}, sleep time.Duration, fn CommitProgressFunc) (CommitResult, error) {
+	id, _, err := c.Commit(cmd, action, extras)
+	if err != nil || id == 0 {
+		return CommitResult{}, err
+	}
+
+	return c.WaitForCommit(id, sleep, fn)
+}
+
+// WaitForCommit polls the device with "show jobs id", waiting for the given
+// commit job to finish.
+//
+// The sleep param is an optional sleep duration to wait between polls.  The
+// fn param is an optional callback that is invoked with the state of the job
+// whenever the percent complete, warnings, or device results change.
+//
+// The final state of the job is always returned.  An error is returned if the
+// commit failed on PAN-OS or on any device.
+func (c *Client) WaitForCommit(id uint, sleep time.Duration, fn CommitProgressFunc) (CommitResult, error) {
+	var (
+		prev   CommitResult
+		polled bool
+	)
+
+	err := c.waitForJob(id, sleep, nil, func(data []byte) {
+		var ans commitJobAns
+		if xml.Unmarshal(data, &ans) != nil {
+			return
+		}
+
+		cur := ans.normalize(id)
+		if fn != nil && (!polled || !reflect.DeepEqual(cur, prev)) {
+			fn(cur)
+		}
+		prev = cur
+		polled = true
+	})
+
+	return prev, err
+}
+
+/** Structs / functions for the commit job op command. **/
+
+type commitJobCmd struct {
+	XMLName xml.Name `xml:"show"`
+	Id      uint     `xml:"jobs>id"`
+}
+
+type commitJobAns struct {
+	XMLName  xml.Name             `xml:"response"`
+	Status   string               `xml:"result>job>status"`
+	Result   string               `xml:"result>job>result"`
+	Progress uint                 `xml:"result>job>progress"`
+	Details  util.BasicJobDetails `xml:"result>job>details"`
+	Warnings []string             `xml:"result>job>warnings>line"`
+	Devices  []commitJobDevice    `xml:"result>job>devices>entry"`
+}
+
+type commitJobDevice struct {
+	Serial   string   `xml:"serial-no"`
+	Name     string   `xml:"devicename"`
+	Vsys     string   `xml:"vsys"`
+	Status   string   `xml:"status"`
+	Result   string   `xml:"result"`
+	Progress uint     `xml:"progress"`
+	Errors   []string `xml:"details>msg>errors>line"`
+	Warnings []string `xml:"details>msg>warnings>line"`
+}
+
+func (o commitJobAns) normalize(id uint) CommitResult {
+	ans := CommitResult{
+		Id:       id,
+		Status:   o.Status,
+		Result:   o.Result,
+		Progress: o.Progress,
+		Warnings: o.Warnings,
+	}
+
+	for _, line := range o.Details.Lines {
+		if line.Cdata != nil {
+			ans.Details = append(ans.Details, strings.TrimSpace(*line.Cdata))
+		} else if line.Text != nil {
+			ans.Details = append(ans.Details, *line.Text)
+		}
+	}
+
+	if len(o.Devices) > 0 {
+		ans.Devices = make([]DeviceCommitResult, 0, len(o.Devices))
+		for _, d := range o.Devices {
+			ans.Devices = append(ans.Devices, DeviceCommitResult{
+				Serial:   d.Serial,
+				Name:     d.Name,
+				Vsys:     d.Vsys,
+				Status:   d.Status,
+				Result:   d.Result,
+				Progress: d.Progress,
+				Errors:   d.Errors,
+				Warnings: d.Warnings,
+			})
+		}
+	}
+
+	return ans
+}
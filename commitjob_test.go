@@ -0,0 +1,79 @@
+package pango
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCommitAndWait(t *testing.T) {
+	c := &Client{rb: [][]byte{
+		[]byte(`<response status="success"><result><job>7</job></result></response>`),
+		[]byte(`<response status="success"><result><job><status>ACT</status><result>PEND</result><progress>40</progress></job></result></response>`),
+		[]byte(`<response status="success"><result><job><status>ACT</status><result>PEND</result><progress>40</progress></job></result></response>`),
+		[]byte(`<response status="success"><result><job><status>FIN</status><result>OK</result><progress>100</progress><warnings><line>app dependency warning</line></warnings><details><line>Configuration committed successfully</line></details></job></result></response>`),
+	}}
+	c.Initialize()
+
+	var seen []uint
+	ans, err := c.CommitAndWait("<commit/>", "", nil, 0, func(r CommitResult) {
+		seen = append(seen, r.Progress)
+	})
+	if err != nil {
+		t.Fatalf("Error in commit: %s", err)
+	}
+
+	if !reflect.DeepEqual(seen, []uint{40, 100}) {
+		t.Errorf("Callback progress: %v", seen)
+	}
+	expected := CommitResult{
+		Id:       7,
+		Status:   "FIN",
+		Result:   "OK",
+		Progress: 100,
+		Details:  []string{"Configuration committed successfully"},
+		Warnings: []string{"app dependency warning"},
+	}
+	if !reflect.DeepEqual(ans, expected) {
+		t.Errorf("%#v != %#v", ans, expected)
+	}
+}
+
+func TestCommitAndWaitNothingToCommit(t *testing.T) {
+	c := &Client{rb: [][]byte{
+		[]byte(`<response status="success" code="19"><msg>There are no changes to commit.</msg></response>`),
+	}}
+	c.Initialize()
+
+	ans, err := c.CommitAndWait("<commit/>", "", nil, 0, nil)
+	if err != nil {
+		t.Fatalf("Error in commit: %s", err)
+	} else if ans.Id != 0 {
+		t.Errorf("Job id is %d", ans.Id)
+	} else if len(c.rp) != 1 {
+		t.Errorf("Sent %d requests, not 1", len(c.rp))
+	}
+}
+
+func TestWaitForCommitDevices(t *testing.T) {
+	c := &Client{rb: [][]byte{
+		[]byte(`<response status="success"><result><job><status>FIN</status><result>OK</result><progress>100</progress><devices><entry><serial-no>0001</serial-no><devicename>fw1</devicename><result>PEND</result><progress>50</progress></entry></devices></job></result></response>`),
+		[]byte(`<response status="success"><result><job><status>FIN</status><result>OK</result><progress>100</progress><devices><entry><serial-no>0001</serial-no><devicename>fw1</devicename><status>commit failed</status><result>FAIL</result><progress>100</progress><details><msg><errors><line>bad zone</line></errors></msg></details></entry></devices></job></result></response>`),
+	}}
+	c.Initialize()
+
+	var calls int
+	ans, err := c.WaitForCommit(9, 0, func(r CommitResult) { calls++ })
+	if err == nil {
+		t.Errorf("No error for failed device commit")
+	}
+	if calls != 2 {
+		t.Errorf("Callback invoked %d times, not 2", calls)
+	}
+	if len(ans.Devices) != 1 {
+		t.Fatalf("Got %d devices", len(ans.Devices))
+	}
+	d := ans.Devices[0]
+	if d.Serial != "0001" || d.Name != "fw1" || d.Result != "FAIL" || !reflect.DeepEqual(d.Errors, []string{"bad zone"}) {
+		t.Errorf("Device result: %#v", d)
+	}
+}
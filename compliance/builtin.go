@@ -0,0 +1,134 @@
+package compliance
+
+import (
+	"strings"
+)
+
+// Thresholds used by the built-in password complexity check.
+const (
+	PasswordMinimumLength    = 12
+	PasswordMaximumAgeInDays = 90
+)
+
+// BuiltinChecks returns the built-in audit checks, which are loosely based
+// on the CIS benchmark for PAN-OS:
+//
+//      * PasswordComplexityCheck
+//      * InsecureManagementServicesCheck
+//      * RuleLoggingCheck
+func BuiltinChecks() []Check {
+	return []Check{
+		PasswordComplexityCheck,
+		InsecureManagementServicesCheck,
+		RuleLoggingCheck,
+	}
+}
+
+// PasswordComplexityCheck verifies that a password complexity policy is
+// enabled, requires long passwords made up of mixed character classes, and
+// expires passwords.
+//
+// No findings are returned if the password complexity policy was not
+// retrieved (such as for Panorama device groups).
+var PasswordComplexityCheck = Check{
+	Name:        "password-complexity",
+	Description: "A strong administrator password complexity policy is enforced",
+	Run: func(conf *Config) []Finding {
+		pc := conf.PasswordComplexity
+		if pc == nil {
+			return nil
+		}
+		xpath := conf.PasswordComplexityXpath()
+
+		if !pc.Enabled {
+			return []Finding{Fail("password-complexity", xpath, "password complexity is not enabled")}
+		}
+
+		var problems []string
+		if pc.MinimumLength < PasswordMinimumLength {
+			problems = append(problems, "minimum length is less than 12")
+		}
+		if pc.MinimumUppercase < 1 {
+			problems = append(problems, "uppercase letters are not required")
+		}
+		if pc.MinimumLowercase < 1 {
+			problems = append(problems, "lowercase letters are not required")
+		}
+		if pc.MinimumNumeric < 1 {
+			problems = append(problems, "numbers are not required")
+		}
+		if pc.MinimumSpecial < 1 {
+			problems = append(problems, "special characters are not required")
+		}
+		if pc.ExpirationPeriod == 0 || pc.ExpirationPeriod > PasswordMaximumAgeInDays {
+			problems = append(problems, "passwords do not expire within 90 days")
+		}
+
+		if len(problems) > 0 {
+			return []Finding{Fail("password-complexity", xpath, "%s", strings.Join(problems, "; "))}
+		}
+		return []Finding{Pass("password-complexity", xpath)}
+	},
+}
+
+// InsecureManagementServicesCheck verifies that interface management profiles
+// do not allow cleartext management protocols (telnet and HTTP).
+var InsecureManagementServicesCheck = Check{
+	Name:        "insecure-management-services",
+	Description: "Interface management profiles do not allow telnet or HTTP",
+	Run: func(conf *Config) []Finding {
+		ans := make([]Finding, 0, len(conf.ManagementProfiles))
+		for _, e := range conf.ManagementProfiles {
+			var svcs []string
+			if e.Telnet {
+				svcs = append(svcs, "telnet")
+			}
+			if e.Http {
+				svcs = append(svcs, "http")
+			}
+
+			xpath := conf.ManagementProfileXpath(e.Name)
+			if len(svcs) > 0 {
+				ans = append(ans, Fail(e.Name, xpath, "insecure services enabled: %s", strings.Join(svcs, ", ")))
+			} else {
+				ans = append(ans, Pass(e.Name, xpath))
+			}
+		}
+
+		return ans
+	},
+}
+
+// RuleLoggingCheck verifies that all enabled allow security rules log at
+// session end and forward their logs using a log forwarding profile.
+var RuleLoggingCheck = Check{
+	Name:        "rule-logging",
+	Description: "Allow security rules log at session end to a log forwarding profile",
+	Run: func(conf *Config) []Finding {
+		var ans []Finding
+		for _, base := range sortedRulebases(conf) {
+			for _, r := range conf.SecurityRules[base] {
+				if r.Disabled || (r.Action != "" && r.Action != "allow") {
+					continue
+				}
+
+				var problems []string
+				if !r.LogEnd {
+					problems = append(problems, "log at session end is disabled")
+				}
+				if r.LogSetting == "" {
+					problems = append(problems, "no log forwarding profile")
+				}
+
+				xpath := conf.SecurityRuleXpath(base, r.Name)
+				if len(problems) > 0 {
+					ans = append(ans, Fail(r.Name, xpath, "%s", strings.Join(problems, "; ")))
+				} else {
+					ans = append(ans, Pass(r.Name, xpath))
+				}
+			}
+		}
+
+		return ans
+	},
+}
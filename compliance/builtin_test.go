@@ -0,0 +1,109 @@
+package compliance
+
+import (
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/dev/password/complexity"
+	"github.com/PaloAltoNetworks/pango/netw/profile/mngtprof"
+	"github.com/PaloAltoNetworks/pango/poli/security"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+func TestPasswordComplexityCheck(t *testing.T) {
+	strong := complexity.Settings{
+		Enabled:          true,
+		MinimumLength:    14,
+		MinimumUppercase: 1,
+		MinimumLowercase: 1,
+		MinimumNumeric:   1,
+		MinimumSpecial:   1,
+		ExpirationPeriod: 90,
+	}
+	weak := strong
+	weak.MinimumLength = 8
+	weak.ExpirationPeriod = 0
+
+	testCases := []struct {
+		desc     string
+		pc       *complexity.Settings
+		findings int
+		passed   bool
+	}{
+		{"not retrieved", nil, 0, true},
+		{"disabled", &complexity.Settings{}, 1, false},
+		{"weak", &weak, 1, false},
+		{"strong", &strong, 1, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			f := PasswordComplexityCheck.Run(&Config{PasswordComplexity: tc.pc})
+			if len(f) != tc.findings {
+				t.Fatalf("Got %d findings", len(f))
+			}
+			if len(f) > 0 {
+				if f[0].Passed != tc.passed {
+					t.Errorf("Passed is %t: %s", f[0].Passed, f[0].Message)
+				}
+				if f[0].Xpath != "/config/mgt-config/password-complexity" {
+					t.Errorf("Xpath is %q", f[0].Xpath)
+				}
+			}
+		})
+	}
+}
+
+func TestInsecureManagementServicesCheck(t *testing.T) {
+	conf := &Config{ManagementProfiles: []mngtprof.Entry{
+		{Name: "good", Ping: true, Https: true, Ssh: true},
+		{Name: "bad", Telnet: true, Http: true},
+	}}
+
+	f := InsecureManagementServicesCheck.Run(conf)
+	if len(f) != 2 {
+		t.Fatalf("Got %d findings", len(f))
+	}
+	if !f[0].Passed {
+		t.Errorf("good failed: %s", f[0].Message)
+	}
+	if f[1].Passed || f[1].Message != "insecure services enabled: telnet, http" {
+		t.Errorf("bad: %#v", f[1])
+	}
+	if f[1].Xpath != "/config/devices/entry[@name='localhost.localdomain']/network/profiles/interface-management-profile/entry[@name='bad']" {
+		t.Errorf("Xpath is %q", f[1].Xpath)
+	}
+}
+
+func TestRuleLoggingCheck(t *testing.T) {
+	conf := &Config{
+		DeviceGroup: "dg1",
+		SecurityRules: map[string][]security.Entry{
+			util.PostRulebase: {
+				{Name: "post", Action: "allow", LogEnd: true},
+			},
+			util.PreRulebase: {
+				{Name: "logged", Action: "allow", LogEnd: true, LogSetting: "fwd"},
+				{Name: "deny", Action: "deny"},
+				{Name: "disabled", Action: "allow", Disabled: true},
+			},
+		},
+	}
+
+	f := RuleLoggingCheck.Run(conf)
+	if len(f) != 2 {
+		t.Fatalf("Got %d findings: %#v", len(f), f)
+	}
+	if f[0].Object != "logged" || !f[0].Passed {
+		t.Errorf("First finding: %#v", f[0])
+	}
+	if f[1].Object != "post" || f[1].Passed || f[1].Message != "no log forwarding profile" {
+		t.Errorf("Second finding: %#v", f[1])
+	}
+}
+
+func TestBuiltinChecksRegister(t *testing.T) {
+	eng := &Engine{}
+	if err := eng.Register(BuiltinChecks()...); err != nil {
+		t.Fatalf("Error registering built-in checks: %s", err)
+	}
+}
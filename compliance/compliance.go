@@ -0,0 +1,146 @@
+package compliance
+
+import (
+	"fmt"
+)
+
+// Finding is the result of a check for a single object.
+type Finding struct {
+	// Check is the name of the check that produced this finding.  This is
+	// filled in by the engine if the check leaves it empty.
+	Check string
+
+	// Passed is true if the object complies with the check.
+	Passed bool
+
+	// Object is the name of the object that was checked.
+	Object string
+
+	// Xpath is the xpath of the object that was checked.
+	Xpath string
+
+	// Message is a human readable description of the finding.
+	Message string
+}
+
+// Pass returns a passing finding for the given object.
+func Pass(obj, xpath string) Finding {
+	return Finding{Passed: true, Object: obj, Xpath: xpath}
+}
+
+// Fail returns a failing finding for the given object.
+func Fail(obj, xpath, format string, a ...interface{}) Finding {
+	return Finding{
+		Object:  obj,
+		Xpath:   xpath,
+		Message: fmt.Sprintf(format, a...),
+	}
+}
+
+// Check is a single compliance check.
+type Check struct {
+	Name        string
+	Description string
+	Run         func(*Config) []Finding
+}
+
+// Engine runs registered checks against a Config.
+//
+// The zero value is an engine with no checks registered.
+type Engine struct {
+	checks []Check
+}
+
+// Register adds the given checks to the engine.
+//
+// Check names must be unique.
+func (e *Engine) Register(checks ...Check) error {
+	for _, c := range checks {
+		if c.Name == "" {
+			return fmt.Errorf("check name is empty")
+		} else if c.Run == nil {
+			return fmt.Errorf("check %q has no Run function", c.Name)
+		}
+		for _, x := range e.checks {
+			if x.Name == c.Name {
+				return fmt.Errorf("check %q is already registered", c.Name)
+			}
+		}
+		e.checks = append(e.checks, c)
+	}
+
+	return nil
+}
+
+// Checks returns the names of the registered checks, in registration order.
+func (e *Engine) Checks() []string {
+	ans := make([]string, 0, len(e.checks))
+	for _, c := range e.checks {
+		ans = append(ans, c.Name)
+	}
+
+	return ans
+}
+
+// Run runs all registered checks against the given config.
+func (e *Engine) Run(conf *Config) Report {
+	ans := Report{
+		Checks: e.Checks(),
+	}
+
+	for _, c := range e.checks {
+		for _, f := range c.Run(conf) {
+			if f.Check == "" {
+				f.Check = c.Name
+			}
+			ans.Findings = append(ans.Findings, f)
+		}
+	}
+
+	return ans
+}
+
+// Report is the result of running an engine's checks.
+type Report struct {
+	// Checks are the names of the checks that were run.
+	Checks []string
+
+	// Findings are all findings from all checks, in the order the checks
+	// were run.
+	Findings []Finding
+}
+
+// Passed returns true if there are no failing findings.
+func (o Report) Passed() bool {
+	for _, f := range o.Findings {
+		if !f.Passed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Failures returns only the failing findings.
+func (o Report) Failures() []Finding {
+	var ans []Finding
+	for _, f := range o.Findings {
+		if !f.Passed {
+			ans = append(ans, f)
+		}
+	}
+
+	return ans
+}
+
+// ForCheck returns the findings for the given check.
+func (o Report) ForCheck(name string) []Finding {
+	var ans []Finding
+	for _, f := range o.Findings {
+		if f.Check == name {
+			ans = append(ans, f)
+		}
+	}
+
+	return ans
+}
@@ -0,0 +1,153 @@
+package compliance
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/netw/interface/eth"
+	"github.com/PaloAltoNetworks/pango/poli/security"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+func testConfig() *Config {
+	return &Config{
+		Vsys: "vsys1",
+		SecurityRules: map[string][]security.Entry{
+			util.Rulebase: {
+				{
+					Name:                 "web",
+					SourceAddresses:      []string{"any"},
+					DestinationAddresses: []string{"10.1.1.1"},
+					Action:               "allow",
+				},
+				{
+					Name:                 "bad",
+					SourceAddresses:      []string{"any"},
+					DestinationAddresses: []string{"any"},
+					Action:               "allow",
+				},
+			},
+		},
+		EthernetInterfaces: []eth.Entry{
+			{Name: "ethernet1/1", Mode: "layer3", ManagementProfile: "ping"},
+			{Name: "ethernet1/2", Mode: "layer3"},
+		},
+	}
+}
+
+var noAnyAny = Check{
+	Name: "no-any-any-allow",
+	Run: func(conf *Config) []Finding {
+		var ans []Finding
+		for base, rules := range conf.SecurityRules {
+			for _, r := range rules {
+				xpath := conf.SecurityRuleXpath(base, r.Name)
+				if r.Action == "allow" && reflect.DeepEqual(r.SourceAddresses, []string{"any"}) && reflect.DeepEqual(r.DestinationAddresses, []string{"any"}) {
+					ans = append(ans, Fail(r.Name, xpath, "rule allows any to any"))
+				} else {
+					ans = append(ans, Pass(r.Name, xpath))
+				}
+			}
+		}
+		return ans
+	},
+}
+
+var hasMgmtProfile = Check{
+	Name: "interface-mgmt-profile",
+	Run: func(conf *Config) []Finding {
+		var ans []Finding
+		for _, e := range conf.EthernetInterfaces {
+			xpath := conf.EthernetInterfaceXpath(e.Name)
+			if e.ManagementProfile == "" {
+				ans = append(ans, Fail(e.Name, xpath, "no management profile"))
+			} else {
+				ans = append(ans, Pass(e.Name, xpath))
+			}
+		}
+		return ans
+	},
+}
+
+func TestEngineRun(t *testing.T) {
+	eng := &Engine{}
+	if err := eng.Register(noAnyAny, hasMgmtProfile); err != nil {
+		t.Fatalf("Error registering checks: %s", err)
+	}
+
+	report := eng.Run(testConfig())
+	if report.Passed() {
+		t.Fatalf("Report passed")
+	}
+	if !reflect.DeepEqual(report.Checks, []string{"no-any-any-allow", "interface-mgmt-profile"}) {
+		t.Errorf("Checks is %#v", report.Checks)
+	}
+	if len(report.Findings) != 4 {
+		t.Errorf("Got %d findings", len(report.Findings))
+	}
+
+	expected := []Finding{
+		{
+			Check:   "no-any-any-allow",
+			Object:  "bad",
+			Xpath:   "/config/devices/entry[@name='localhost.localdomain']/vsys/entry[@name='vsys1']/rulebase/security/rules/entry[@name='bad']",
+			Message: "rule allows any to any",
+		},
+		{
+			Check:   "interface-mgmt-profile",
+			Object:  "ethernet1/2",
+			Xpath:   "/config/devices/entry[@name='localhost.localdomain']/network/interface/ethernet/entry[@name='ethernet1/2']",
+			Message: "no management profile",
+		},
+	}
+	if v := report.Failures(); !reflect.DeepEqual(v, expected) {
+		t.Errorf("Failures:\n%#v\n!=\n%#v", v, expected)
+	}
+	if v := report.ForCheck("interface-mgmt-profile"); len(v) != 2 {
+		t.Errorf("Got %d findings for interface-mgmt-profile", len(v))
+	}
+}
+
+func TestEngineRegisterErrors(t *testing.T) {
+	eng := &Engine{}
+	if err := eng.Register(noAnyAny); err != nil {
+		t.Fatalf("Error registering check: %s", err)
+	}
+
+	testCases := []struct {
+		desc  string
+		check Check
+	}{
+		{"duplicate", noAnyAny},
+		{"no name", Check{Run: noAnyAny.Run}},
+		{"no run", Check{Name: "nothing"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if err := eng.Register(tc.check); err == nil {
+				t.Errorf("Expected an error")
+			}
+		})
+	}
+}
+
+func TestSecurityRuleXpath(t *testing.T) {
+	testCases := []struct {
+		conf Config
+		base string
+		r    string
+	}{
+		{Config{Vsys: "shared"}, util.Rulebase, "/config/shared/rulebase/security/rules/entry[@name='r']"},
+		{Config{DeviceGroup: "shared"}, util.PreRulebase, "/config/shared/pre-rulebase/security/rules/entry[@name='r']"},
+		{Config{DeviceGroup: "dg1"}, util.PostRulebase, "/config/devices/entry[@name='localhost.localdomain']/device-group/entry[@name='dg1']/post-rulebase/security/rules/entry[@name='r']"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.r, func(t *testing.T) {
+			if v := tc.conf.SecurityRuleXpath(tc.base, "r"); v != tc.r {
+				t.Errorf("Got %s", v)
+			}
+		})
+	}
+}
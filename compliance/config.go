@@ -0,0 +1,173 @@
+package compliance
+
+import (
+	"github.com/PaloAltoNetworks/pango"
+	"github.com/PaloAltoNetworks/pango/dev/password/complexity"
+	"github.com/PaloAltoNetworks/pango/netw/interface/eth"
+	"github.com/PaloAltoNetworks/pango/netw/profile/mngtprof"
+	"github.com/PaloAltoNetworks/pango/poli/security"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Config is the configuration that checks are run against.
+//
+// Exactly one of Vsys or DeviceGroup should be set, depending on if the
+// config came from a firewall or from Panorama.
+type Config struct {
+	Vsys        string
+	DeviceGroup string
+
+	// SecurityRules are keyed by rulebase:  util.Rulebase for firewalls,
+	// util.PreRulebase and util.PostRulebase for Panorama.
+	SecurityRules map[string][]security.Entry
+
+	// Network config.  This is only retrieved from firewalls.
+	EthernetInterfaces []eth.Entry
+	ManagementProfiles []mngtprof.Entry
+
+	// PasswordComplexity is only retrieved from firewalls.  If the firewall
+	// does not have a password complexity policy configured, then this is
+	// the zero value (disabled).
+	PasswordComplexity *complexity.Settings
+}
+
+// FromFirewall retrieves the config for the given vsys of a firewall.
+//
+// The vsys will default to "vsys1" if left as an empty string.
+func FromFirewall(fw *pango.Firewall, vsys string) (*Config, error) {
+	var err error
+
+	if vsys == "" {
+		vsys = "vsys1"
+	}
+	ans := &Config{
+		Vsys:          vsys,
+		SecurityRules: make(map[string][]security.Entry),
+	}
+
+	rules, err := fw.Policies.Security.GetAll(vsys)
+	if err != nil {
+		return nil, err
+	}
+	ans.SecurityRules[util.Rulebase] = rules
+
+	if ans.EthernetInterfaces, err = fw.Network.EthernetInterface.GetAll(); err != nil {
+		return nil, err
+	}
+
+	names, err := fw.Network.ManagementProfile.GetList()
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		e, err := fw.Network.ManagementProfile.Get(name)
+		if err != nil {
+			return nil, err
+		}
+		ans.ManagementProfiles = append(ans.ManagementProfiles, e)
+	}
+
+	pc, err := fw.Device.PasswordComplexity.Get()
+	if err != nil {
+		if e2, ok := err.(pango.PanosError); !ok || !e2.ObjectNotFound() {
+			return nil, err
+		}
+	}
+	ans.PasswordComplexity = &pc
+
+	return ans, nil
+}
+
+// FromPanorama retrieves the config for the given device group.
+//
+// The device group will default to "shared" if left as an empty string.
+func FromPanorama(pano *pango.Panorama, dg string) (*Config, error) {
+	if dg == "" {
+		dg = "shared"
+	}
+	ans := &Config{
+		DeviceGroup:   dg,
+		SecurityRules: make(map[string][]security.Entry),
+	}
+
+	for _, base := range []string{util.PreRulebase, util.PostRulebase} {
+		rules, err := pano.Policies.Security.GetAll(dg, base)
+		if err != nil {
+			return nil, err
+		}
+		ans.SecurityRules[base] = rules
+	}
+
+	return ans, nil
+}
+
+// SecurityRuleXpath returns the xpath of the given security rule.
+func (o *Config) SecurityRuleXpath(base, name string) string {
+	var path []string
+	switch {
+	case o.DeviceGroup == "shared":
+		path = []string{"config", "shared", base}
+	case o.DeviceGroup != "":
+		path = []string{
+			"config",
+			"devices",
+			util.AsEntryXpath([]string{"localhost.localdomain"}),
+			"device-group",
+			util.AsEntryXpath([]string{o.DeviceGroup}),
+			base,
+		}
+	case o.Vsys == "shared":
+		path = []string{"config", "shared", base}
+	default:
+		path = []string{
+			"config",
+			"devices",
+			util.AsEntryXpath([]string{"localhost.localdomain"}),
+			"vsys",
+			util.AsEntryXpath([]string{o.Vsys}),
+			base,
+		}
+	}
+
+	return util.AsXpath(append(path, "security", "rules", util.AsEntryXpath([]string{name})))
+}
+
+// EthernetInterfaceXpath returns the xpath of the given ethernet interface.
+func (o *Config) EthernetInterfaceXpath(name string) string {
+	return o.networkXpath("interface", "ethernet", util.AsEntryXpath([]string{name}))
+}
+
+// ManagementProfileXpath returns the xpath of the given interface management
+// profile.
+func (o *Config) ManagementProfileXpath(name string) string {
+	return o.networkXpath("profiles", "interface-management-profile", util.AsEntryXpath([]string{name}))
+}
+
+// PasswordComplexityXpath returns the xpath of the password complexity policy.
+func (o *Config) PasswordComplexityXpath() string {
+	return util.AsXpath([]string{"config", "mgt-config", "password-complexity"})
+}
+
+func (o *Config) networkXpath(p ...string) string {
+	path := []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"network",
+	}
+
+	return util.AsXpath(append(path, p...))
+}
+
+// sortedRulebases returns the rulebases present in the config in evaluation
+// order.
+func sortedRulebases(conf *Config) []string {
+	ans := make([]string, 0, len(conf.SecurityRules))
+	for _, base := range []string{util.PreRulebase, util.Rulebase, util.PostRulebase} {
+		if _, ok := conf.SecurityRules[base]; ok {
+			ans = append(ans, base)
+		}
+	}
+
+	return ans
+}
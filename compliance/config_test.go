@@ -0,0 +1,45 @@
+package compliance
+
+import (
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango"
+	"github.com/PaloAltoNetworks/pango/dev"
+	"github.com/PaloAltoNetworks/pango/netw"
+	"github.com/PaloAltoNetworks/pango/poli"
+	"github.com/PaloAltoNetworks/pango/testdata"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+func TestFromFirewall(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp(`<rules><entry name="r1"><action>allow</action></entry><entry name="r2"><action>deny</action></entry></rules>`)
+	mc.AddResp(`<ethernet><entry name="ethernet1/1"><layer3><interface-management-profile>ping</interface-management-profile></layer3></entry></ethernet>`)
+	mc.AddResp(`<password-complexity><enabled>yes</enabled><minimum-length>12</minimum-length></password-complexity>`)
+
+	fw := &pango.Firewall{}
+	fw.Policies = &poli.FwPoli{}
+	fw.Policies.Initialize(mc)
+	fw.Network = &netw.FwNetw{}
+	fw.Network.Initialize(mc)
+	fw.Device = &dev.FwDev{}
+	fw.Device.Initialize(mc)
+
+	conf, err := FromFirewall(fw, "")
+	if err != nil {
+		t.Fatalf("Error retrieving config: %s", err)
+	}
+
+	if conf.Vsys != "vsys1" {
+		t.Errorf("Vsys is %q", conf.Vsys)
+	}
+	if rules := conf.SecurityRules[util.Rulebase]; len(rules) != 2 || rules[1].Action != "deny" {
+		t.Errorf("Security rules: %#v", rules)
+	}
+	if len(conf.EthernetInterfaces) != 1 || conf.EthernetInterfaces[0].ManagementProfile != "ping" {
+		t.Errorf("Ethernet interfaces: %#v", conf.EthernetInterfaces)
+	}
+	if pc := conf.PasswordComplexity; pc == nil || !pc.Enabled || pc.MinimumLength != 12 {
+		t.Errorf("Password complexity: %#v", pc)
+	}
+}
@@ -0,0 +1,33 @@
+/*
+Package compliance is a configuration compliance engine.
+
+Compliance checks are plain functions that are run against a Config, which is
+a snapshot of the parts of a firewall vsys or Panorama device group that
+checks typically care about.  Each check returns findings for the objects it
+looked at, and running all registered checks produces a Report.
+
+A set of built-in audit checks is available from BuiltinChecks.
+
+A Config is usually retrieved using FromFirewall or FromPanorama, but it can
+also be built by hand (for example, from a previously saved configuration).
+
+	eng := &compliance.Engine{}
+	eng.Register(compliance.Check{
+		Name:        "no-any-any-allow",
+		Description: "Allow rules must not be any / any",
+		Run: func(conf *compliance.Config) []compliance.Finding {
+			...
+		},
+	})
+
+	conf, err := compliance.FromFirewall(fw, "vsys1")
+	if err != nil {
+		return err
+	}
+
+	report := eng.Run(conf)
+	for _, f := range report.Failures() {
+		fmt.Printf("%s: %s (%s)\n", f.Check, f.Message, f.Xpath)
+	}
+*/
+package compliance
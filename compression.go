@@ -0,0 +1,100 @@
+package pango
+
+import (
+	"bufio"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// do performs the given HTTP request, returning the decompressed body.
+func (c *Client) do(req *http.Request) ([]byte, error) {
+	body, err := c.doStream(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer body.Close()
+	return ioutil.ReadAll(body)
+}
+
+// doStream performs the given HTTP request, returning a reader of the
+// decompressed body.  The caller must close the reader returned.
+//
+// The Accept-Encoding header is always set explicitly instead of relying on
+// the transport's implicit gzip support, so that deflate is supported as well,
+// and so that DisableCompression is honored regardless of the transport.
+func (c *Client) doStream(req *http.Request) (io.ReadCloser, error) {
+	if c.DisableCompression {
+		req.Header.Set("Accept-Encoding", "identity")
+	} else {
+		req.Header.Set("Accept-Encoding", "gzip, deflate")
+	}
+
+	res, err := c.con.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decompress(res)
+	if err != nil {
+		res.Body.Close()
+		return nil, err
+	}
+
+	return body, nil
+}
+
+// decompressed is a decompressing reader that closes both itself and the
+// underlying response body.
+type decompressed struct {
+	io.Reader
+	closers []io.Closer
+}
+
+func (d decompressed) Close() error {
+	var err error
+	for _, x := range d.closers {
+		if e2 := x.Close(); err == nil {
+			err = e2
+		}
+	}
+
+	return err
+}
+
+// decompress returns a reader of the given response's body, decompressing it
+// according to the response's Content-Encoding.
+func decompress(res *http.Response) (io.ReadCloser, error) {
+	switch strings.ToLower(strings.TrimSpace(res.Header.Get("Content-Encoding"))) {
+	case "", "identity":
+		return res.Body, nil
+	case "gzip", "x-gzip":
+		gr, err := gzip.NewReader(res.Body)
+		if err != nil {
+			return nil, err
+		}
+		return decompressed{gr, []io.Closer{gr, res.Body}}, nil
+	case "deflate":
+		// Deflate is supposed to be zlib wrapped, but some servers send raw
+		// deflate data instead, so check for a zlib header first.
+		br := bufio.NewReader(res.Body)
+		var zr io.ReadCloser
+		if h, _ := br.Peek(2); len(h) == 2 && h[0]&0x0f == 8 && (uint(h[0])<<8|uint(h[1]))%31 == 0 {
+			var err error
+			if zr, err = zlib.NewReader(br); err != nil {
+				return nil, err
+			}
+		} else {
+			zr = flate.NewReader(br)
+		}
+		return decompressed{zr, []io.Closer{zr, res.Body}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported content encoding: %s", res.Header.Get("Content-Encoding"))
+	}
+}
@@ -0,0 +1,100 @@
+package pango
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+const compressionResp = `<response status="success"><result>hello</result></response>`
+
+func compressionServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var buf bytes.Buffer
+
+		switch r.Header.Get("Accept-Encoding") {
+		case "identity":
+			w.Write([]byte(compressionResp))
+			return
+		case "gzip, deflate":
+		default:
+			t.Errorf("Unexpected Accept-Encoding: %q", r.Header.Get("Accept-Encoding"))
+		}
+
+		switch r.FormValue("encoding") {
+		case "gzip":
+			zw := gzip.NewWriter(&buf)
+			zw.Write([]byte(compressionResp))
+			zw.Close()
+		case "deflate":
+			zw := zlib.NewWriter(&buf)
+			zw.Write([]byte(compressionResp))
+			zw.Close()
+		default:
+			w.Write([]byte(compressionResp))
+			return
+		}
+
+		w.Header().Set("Content-Encoding", r.FormValue("encoding"))
+		w.Write(buf.Bytes())
+	}))
+}
+
+func TestCompression(t *testing.T) {
+	srv := compressionServer(t)
+	defer srv.Close()
+
+	testCases := []struct {
+		desc     string
+		encoding string
+		disable  bool
+	}{
+		{"gzip", "gzip", false},
+		{"deflate", "deflate", false},
+		{"uncompressed", "", false},
+		{"compression disabled", "gzip", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			c := &Client{
+				DisableCompression: tc.disable,
+				con:                srv.Client(),
+				api_url:            srv.URL,
+			}
+
+			body, err := c.post(url.Values{"encoding": []string{tc.encoding}})
+			if err != nil {
+				t.Fatalf("Error in post: %s", err)
+			} else if string(body) != compressionResp {
+				t.Errorf("Got %q", body)
+			}
+		})
+	}
+}
+
+func TestCompressionRawDeflate(t *testing.T) {
+	var buf bytes.Buffer
+	fw, _ := flate.NewWriter(&buf, flate.DefaultCompression)
+	fw.Write([]byte(compressionResp))
+	fw.Close()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "deflate")
+		w.Write(buf.Bytes())
+	}))
+	defer srv.Close()
+
+	c := &Client{con: srv.Client(), api_url: srv.URL}
+	body, err := c.post(url.Values{})
+	if err != nil {
+		t.Fatalf("Error in post: %s", err)
+	} else if string(body) != compressionResp {
+		t.Errorf("Got %q", body)
+	}
+}
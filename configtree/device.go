@@ -0,0 +1,54 @@
+package configtree
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango"
+)
+
+// Export retrieves the running config of the given PAN-OS device and writes it
+// into the given directory as an exploded config.
+func Export(c *pango.Client, dir string, f Format) error {
+	cfg, err := c.RunningConfig()
+	if err != nil {
+		return err
+	}
+
+	files, err := Explode(cfg, f)
+	if err != nil {
+		return err
+	}
+
+	return WriteDir(dir, files)
+}
+
+// Import reassembles the exploded config in the given directory, uploads it
+// to the given PAN-OS device as a named config file, then loads it as the
+// candidate config.
+//
+// The config is not committed.
+func Import(c *pango.Client, dir, filename string) error {
+	files, err := ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := Assemble(files)
+	if err != nil {
+		return err
+	}
+
+	c.LogAction("(import) config %q", filename)
+	if _, err = c.Import("configuration", string(cfg), filename, "file", nil, nil); err != nil {
+		return err
+	}
+
+	type req struct {
+		XMLName xml.Name `xml:"load"`
+		From    string   `xml:"config>from"`
+	}
+
+	c.LogOp("(op) loading config %q", filename)
+	_, err = c.Op(req{From: filename}, "", nil, nil)
+	return err
+}
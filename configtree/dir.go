@@ -0,0 +1,122 @@
+package configtree
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// WriteDir writes the given exploded config files into the given directory,
+// creating it if it does not already exist.
+//
+// Any exploded config files already in the directory that are not part of
+// the given files are removed, along with any directories left empty, so
+// that deleted objects show up as deleted files.  Other files (such as a
+// README or the .git directory) are left untouched.
+func WriteDir(dir string, files map[string][]byte) error {
+	for _, name := range sortedKeys(files) {
+		fp := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(fp), 0755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(fp, files[name], 0644); err != nil {
+			return err
+		}
+	}
+
+	var stale, dirs []string
+	err := walk(dir, func(rel string, d fs.DirEntry) {
+		if d.IsDir() {
+			dirs = append(dirs, rel)
+		} else if _, ok := files[rel]; !ok && isTreeFile(rel) {
+			stale = append(stale, rel)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, rel := range stale {
+		if err = os.Remove(filepath.Join(dir, filepath.FromSlash(rel))); err != nil {
+			return err
+		}
+	}
+
+	// Remove the deepest directories first.
+	sort.Sort(sort.Reverse(sort.StringSlice(dirs)))
+	for _, rel := range dirs {
+		fp := filepath.Join(dir, filepath.FromSlash(rel))
+		if list, err := os.ReadDir(fp); err == nil && len(list) == 0 {
+			if err = os.Remove(fp); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ReadDir reads the exploded config files in the given directory, returning a
+// map of relative file path to file content.
+//
+// Files that are not part of an exploded config are ignored.
+func ReadDir(dir string) (map[string][]byte, error) {
+	ans := make(map[string][]byte)
+	var names []string
+
+	err := walk(dir, func(rel string, d fs.DirEntry) {
+		if !d.IsDir() && isTreeFile(rel) {
+			names = append(names, rel)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rel := range names {
+		b, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(rel)))
+		if err != nil {
+			return nil, err
+		}
+		ans[rel] = b
+	}
+
+	return ans, nil
+}
+
+// walk invokes fn for every file and directory beneath dir, skipping hidden
+// directories such as .git.  The path given to fn is relative to dir and
+// uses forward slashes.
+func walk(dir string, fn func(string, fs.DirEntry)) error {
+	return filepath.WalkDir(dir, func(fp string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		} else if fp == dir {
+			return nil
+		}
+
+		if d.IsDir() && strings.HasPrefix(d.Name(), ".") {
+			return filepath.SkipDir
+		}
+
+		rel, err := filepath.Rel(dir, fp)
+		if err != nil {
+			return err
+		}
+		fn(filepath.ToSlash(rel), d)
+		return nil
+	})
+}
+
+func isTreeFile(rel string) bool {
+	base := path.Base(rel)
+	switch path.Ext(base) {
+	case "." + string(Xml), "." + string(Json):
+		return true
+	}
+
+	return base == OrderFile
+}
@@ -0,0 +1,48 @@
+package configtree
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestWriteReadDir(t *testing.T) {
+	dir := t.TempDir()
+	files, err := Explode([]byte(testConfig), Xml)
+	if err != nil {
+		t.Fatalf("Error exploding: %s", err)
+	}
+
+	// Pre-existing files: one stale, one unrelated, and one in .git.
+	stale := filepath.Join(dir, "devices", "old", "_node.xml")
+	readme := filepath.Join(dir, "README.md")
+	git := filepath.Join(dir, ".git", "HEAD.xml")
+	for _, fp := range []string{stale, readme, git} {
+		os.MkdirAll(filepath.Dir(fp), 0755)
+		if err = os.WriteFile(fp, []byte("x"), 0644); err != nil {
+			t.Fatalf("Error writing %s: %s", fp, err)
+		}
+	}
+
+	if err = WriteDir(dir, files); err != nil {
+		t.Fatalf("Error writing dir: %s", err)
+	}
+
+	if _, err = os.Stat(filepath.Dir(stale)); !os.IsNotExist(err) {
+		t.Errorf("Stale directory still present")
+	}
+	for _, fp := range []string{readme, git} {
+		if _, err = os.Stat(fp); err != nil {
+			t.Errorf("Unrelated file %s removed", fp)
+		}
+	}
+
+	got, err := ReadDir(dir)
+	if err != nil {
+		t.Fatalf("Error reading dir: %s", err)
+	}
+	if !reflect.DeepEqual(got, files) {
+		t.Errorf("Read %d files, expected %d", len(got), len(files))
+	}
+}
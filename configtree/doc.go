@@ -0,0 +1,39 @@
+/*
+Package configtree explodes a full PAN-OS config into a directory tree with
+one file per object or rule, and reassembles such a tree back into a config.
+
+Storing the config this way in a version control system such as git gives
+meaningful diffs:  changing a single address object or security rule only
+changes the file for that object.
+
+Each XML element that contains named entries becomes a directory.  Each
+named entry that does not itself contain named entries is written to its own
+file, while the rest of an element's content is kept in that directory's
+_node file.  The order of the entries in an element is kept in that
+directory's _order file, since the order of some entries (such as security
+rules) is significant:
+
+	_node.xml
+	devices/_node.xml
+	devices/_order
+	devices/localhost.localdomain/vsys/vsys1/address/_node.xml
+	devices/localhost.localdomain/vsys/vsys1/address/_order
+	devices/localhost.localdomain/vsys/vsys1/address/web-server.xml
+	...
+
+Files can be written as either XML or JSON.
+
+Export and Import work against a PAN-OS device directly, while Explode,
+Assemble, WriteDir, and ReadDir can be used to work with configs retrieved
+some other way:
+
+	if err := configtree.Export(&fw.Client, "repo/fw1", configtree.Xml); err != nil {
+		return err
+	}
+
+	// Later, after the tree has been changed in git...
+	if err := configtree.Import(&fw.Client, "repo/fw1", "gitops.xml"); err != nil {
+		return err
+	}
+*/
+package configtree
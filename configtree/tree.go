@@ -0,0 +1,345 @@
+package configtree
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+)
+
+// Format is the file format used for the files of an exploded config.
+type Format string
+
+// Valid values for Format.
+const (
+	Xml  Format = "xml"
+	Json Format = "json"
+)
+
+// Special file names in each directory of an exploded config.
+const (
+	NodeFile  = "_node"
+	OrderFile = "_order"
+)
+
+// Explode splits the given config into files, returning a map of relative
+// file path to file content.
+//
+// The config given should contain a <config> element, such as the output of
+// pango.Client.RunningConfig().
+func Explode(cfg []byte, f Format) (map[string][]byte, error) {
+	if f != Xml && f != Json {
+		return nil, fmt.Errorf("unsupported format: %q", f)
+	}
+
+	var root node
+	if err := xml.Unmarshal(cfg, &root); err != nil {
+		return nil, err
+	}
+
+	conf := root.find("config")
+	if conf == nil {
+		return nil, fmt.Errorf("no config element found")
+	}
+
+	ans := make(map[string][]byte)
+	if err := explode(conf, "", f, ans); err != nil {
+		return nil, err
+	}
+
+	return ans, nil
+}
+
+// Assemble reassembles the given exploded config files back into a config.
+//
+// The files param is a map of relative file path to file content, as
+// returned from Explode or ReadDir.  The format of each file is determined
+// by its extension.
+//
+// Entry files that were added to the tree without being listed in the
+// _order file are placed after the listed entries, sorted by name.
+func Assemble(files map[string][]byte) ([]byte, error) {
+	conf, err := assemble(files, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return xml.MarshalIndent(conf, "", "    ")
+}
+
+/** Internal functions **/
+
+// node is a generic XML element.
+type node struct {
+	XMLName xml.Name
+	Attrs   []xml.Attr `xml:",any,attr"`
+	Text    string     `xml:",chardata"`
+	Nodes   []*node    `xml:",any"`
+}
+
+func (n *node) name() string {
+	for _, a := range n.Attrs {
+		if a.Name.Local == "name" {
+			return a.Value
+		}
+	}
+
+	return ""
+}
+
+func (n *node) isEntry() bool {
+	return n.XMLName.Local == "entry" && n.name() != ""
+}
+
+// hasEntries returns if there are any entries beneath this node.
+func (n *node) hasEntries() bool {
+	for _, x := range n.Nodes {
+		if x.isEntry() || x.hasEntries() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (n *node) find(tag string) *node {
+	if n.XMLName.Local == tag {
+		return n
+	}
+
+	for _, x := range n.Nodes {
+		if ans := x.find(tag); ans != nil {
+			return ans
+		}
+	}
+
+	return nil
+}
+
+// normalize removes formatting whitespace from this node and its children.
+func (n *node) normalize() {
+	n.XMLName.Space = ""
+	if len(n.Nodes) > 0 {
+		n.Text = ""
+	}
+	for _, x := range n.Nodes {
+		x.normalize()
+	}
+}
+
+func explode(n *node, dir string, f Format, ans map[string][]byte) error {
+	self := &node{XMLName: n.XMLName, Attrs: n.Attrs, Text: strings.TrimSpace(n.Text)}
+	var order []string
+	seen := make(map[string]bool)
+
+	for _, x := range n.Nodes {
+		var name string
+		switch {
+		case x.isEntry():
+			name = escape(x.name())
+			order = append(order, x.name())
+		case x.hasEntries():
+			name = escape(x.XMLName.Local)
+		default:
+			self.Nodes = append(self.Nodes, x)
+			continue
+		}
+
+		if seen[name] {
+			return fmt.Errorf("%s: duplicate name %q", dir, name)
+		}
+		seen[name] = true
+
+		sub := path.Join(dir, name)
+		if x.isEntry() && !x.hasEntries() {
+			b, err := encode(x, f)
+			if err != nil {
+				return err
+			}
+			ans[sub+"."+string(f)] = b
+		} else if err := explode(x, sub, f, ans); err != nil {
+			return err
+		}
+	}
+
+	b, err := encode(self, f)
+	if err != nil {
+		return err
+	}
+	ans[path.Join(dir, NodeFile+"."+string(f))] = b
+
+	if len(order) > 0 {
+		ans[path.Join(dir, OrderFile)] = []byte(strings.Join(order, "\n") + "\n")
+	}
+
+	return nil
+}
+
+func assemble(files map[string][]byte, dir string) (*node, error) {
+	prefix := ""
+	if dir != "" {
+		prefix = dir + "/"
+	}
+
+	var self *node
+	var order []string
+	leaves := make(map[string]bool)
+	dirs := make(map[string]bool)
+
+	for name, data := range files {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		rel := strings.TrimPrefix(name, prefix)
+		if i := strings.Index(rel, "/"); i != -1 {
+			dirs[rel[:i]] = true
+			continue
+		}
+
+		switch {
+		case rel == OrderFile:
+			order = strings.Split(strings.TrimSpace(string(data)), "\n")
+		case strings.HasPrefix(rel, NodeFile+"."):
+			n, err := decode(rel, data)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", name, err)
+			}
+			self = n
+		default:
+			leaves[rel] = true
+		}
+	}
+
+	if self == nil {
+		return nil, fmt.Errorf("%s: no %s file", dir, NodeFile)
+	}
+
+	var others []*node
+	entries := make(map[string]*node)
+	for _, rel := range sortedKeys(leaves) {
+		n, err := decode(rel, files[prefix+rel])
+		if err != nil {
+			return nil, fmt.Errorf("%s%s: %s", prefix, rel, err)
+		}
+		entries[n.name()] = n
+	}
+	for _, rel := range sortedKeys(dirs) {
+		n, err := assemble(files, prefix+rel)
+		if err != nil {
+			return nil, err
+		}
+		if n.isEntry() {
+			entries[n.name()] = n
+		} else {
+			others = append(others, n)
+		}
+	}
+
+	self.Nodes = append(self.Nodes, others...)
+	for _, name := range order {
+		if n, ok := entries[name]; ok {
+			self.Nodes = append(self.Nodes, n)
+			delete(entries, name)
+		}
+	}
+	for _, name := range sortedKeys(entries) {
+		self.Nodes = append(self.Nodes, entries[name])
+	}
+
+	return self, nil
+}
+
+// escape returns a file name for the given entry name or tag.
+func escape(s string) string {
+	ans := url.PathEscape(s)
+	if strings.HasPrefix(ans, ".") || strings.HasPrefix(ans, "_") {
+		ans = fmt.Sprintf("%%%02X", ans[0]) + ans[1:]
+	}
+
+	return ans
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	ans := make([]string, 0, len(m))
+	for k := range m {
+		ans = append(ans, k)
+	}
+	sort.Strings(ans)
+
+	return ans
+}
+
+// jsonNode is the JSON representation of a node.
+type jsonNode struct {
+	Tag      string            `json:"tag"`
+	Attrs    map[string]string `json:"attrs,omitempty"`
+	Text     string            `json:"text,omitempty"`
+	Children []jsonNode        `json:"children,omitempty"`
+}
+
+func toJson(n *node) jsonNode {
+	ans := jsonNode{Tag: n.XMLName.Local, Text: n.Text}
+	if len(n.Attrs) > 0 {
+		ans.Attrs = make(map[string]string, len(n.Attrs))
+		for _, a := range n.Attrs {
+			ans.Attrs[a.Name.Local] = a.Value
+		}
+	}
+	for _, x := range n.Nodes {
+		ans.Children = append(ans.Children, toJson(x))
+	}
+
+	return ans
+}
+
+func fromJson(j jsonNode) *node {
+	ans := &node{XMLName: xml.Name{Local: j.Tag}, Text: j.Text}
+	for _, k := range sortedKeys(j.Attrs) {
+		ans.Attrs = append(ans.Attrs, xml.Attr{Name: xml.Name{Local: k}, Value: j.Attrs[k]})
+	}
+	for _, x := range j.Children {
+		ans.Nodes = append(ans.Nodes, fromJson(x))
+	}
+
+	return ans
+}
+
+func encode(n *node, f Format) ([]byte, error) {
+	n.normalize()
+
+	var b []byte
+	var err error
+	if f == Json {
+		b, err = json.MarshalIndent(toJson(n), "", "    ")
+	} else {
+		b, err = xml.MarshalIndent(n, "", "    ")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return append(b, '\n'), nil
+}
+
+func decode(name string, data []byte) (*node, error) {
+	switch path.Ext(name) {
+	case "." + string(Xml):
+		var n node
+		if err := xml.Unmarshal(data, &n); err != nil {
+			return nil, err
+		}
+		n.normalize()
+		return &n, nil
+	case "." + string(Json):
+		var j jsonNode
+		if err := json.Unmarshal(data, &j); err != nil {
+			return nil, err
+		}
+		return fromJson(j), nil
+	}
+
+	return nil, fmt.Errorf("unsupported file type")
+}
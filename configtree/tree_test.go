@@ -0,0 +1,139 @@
+package configtree
+
+import (
+	"encoding/xml"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+const testConfig = `<response status="success"><result><config version="10.1.0">
+  <devices>
+    <entry name="localhost.localdomain">
+      <deviceconfig><system><hostname>fw1</hostname></system></deviceconfig>
+      <vsys>
+        <entry name="vsys1">
+          <address>
+            <entry name="web/1"><ip-netmask>10.1.1.1</ip-netmask><tag><member>web</member></tag></entry>
+            <entry name="_db"><fqdn>db.example.com</fqdn></entry>
+          </address>
+          <rulebase><security><rules>
+            <entry name="zeta"><action>allow</action></entry>
+            <entry name="alpha"><action>deny</action></entry>
+          </rules></security></rulebase>
+        </entry>
+      </vsys>
+    </entry>
+  </devices>
+  <shared><botnet/></shared>
+</config></result></response>`
+
+func TestExplode(t *testing.T) {
+	files, err := Explode([]byte(testConfig), Xml)
+	if err != nil {
+		t.Fatalf("Error exploding: %s", err)
+	}
+
+	vsys := "devices/localhost.localdomain/vsys/vsys1/"
+	for _, name := range []string{
+		"_node.xml",
+		"devices/_order",
+		vsys + "address/web%2F1.xml",
+		vsys + "address/%5Fdb.xml",
+		vsys + "rulebase/security/rules/zeta.xml",
+		vsys + "rulebase/security/rules/_order",
+	} {
+		if _, ok := files[name]; !ok {
+			t.Errorf("File %q not present", name)
+		}
+	}
+
+	if s := string(files[vsys+"rulebase/security/rules/_order"]); s != "zeta\nalpha\n" {
+		t.Errorf("Rule order is %q", s)
+	}
+	if s := string(files["_node.xml"]); !strings.Contains(s, `version="10.1.0"`) || !strings.Contains(s, "<botnet>") {
+		t.Errorf("Root node is %q", s)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	var expected node
+	if err := xml.Unmarshal([]byte(testConfig), &expected); err != nil {
+		t.Fatalf("Error parsing test config: %s", err)
+	}
+	conf := expected.find("config")
+	conf.normalize()
+
+	for _, f := range []Format{Xml, Json} {
+		t.Run(string(f), func(t *testing.T) {
+			files, err := Explode([]byte(testConfig), f)
+			if err != nil {
+				t.Fatalf("Error exploding: %s", err)
+			}
+
+			b, err := Assemble(files)
+			if err != nil {
+				t.Fatalf("Error assembling: %s", err)
+			}
+
+			var got node
+			if err = xml.Unmarshal(b, &got); err != nil {
+				t.Fatalf("Error parsing assembled config: %s", err)
+			}
+			got.normalize()
+
+			// Element order is only kept for entries, so compare sorted.
+			gn, en := names(&got), names(conf)
+			sort.Strings(gn)
+			sort.Strings(en)
+			if !reflect.DeepEqual(gn, en) {
+				t.Errorf("Assembled config differs:\n%s", b)
+			}
+		})
+	}
+}
+
+func TestAssembleNewEntry(t *testing.T) {
+	files, err := Explode([]byte(testConfig), Xml)
+	if err != nil {
+		t.Fatalf("Error exploding: %s", err)
+	}
+	rules := "devices/localhost.localdomain/vsys/vsys1/rulebase/security/rules/"
+	files[rules+"beta.xml"] = []byte(`<entry name="beta"><action>allow</action></entry>`)
+	delete(files, rules+"alpha.xml")
+
+	b, err := Assemble(files)
+	if err != nil {
+		t.Fatalf("Error assembling: %s", err)
+	}
+
+	s := string(b)
+	if strings.Contains(s, `"alpha"`) {
+		t.Errorf("Deleted rule is present")
+	}
+	if z, n := strings.Index(s, `"zeta"`), strings.Index(s, `"beta"`); z == -1 || n < z {
+		t.Errorf("New rule not placed after listed rules: %s", s)
+	}
+}
+
+func TestExplodeInvalid(t *testing.T) {
+	if _, err := Explode([]byte(`<result/>`), Xml); err == nil {
+		t.Errorf("No error for missing config element")
+	}
+	if _, err := Explode([]byte(testConfig), Format("yaml")); err == nil {
+		t.Errorf("No error for unsupported format")
+	}
+}
+
+// names returns a flattened description of the given node for comparisons.
+func names(n *node) []string {
+	ans := []string{n.XMLName.Local + "|" + n.name() + "|" + strings.TrimSpace(n.Text)}
+	for _, x := range n.Nodes {
+		for _, s := range names(x) {
+			ans = append(ans, n.XMLName.Local+"/"+s)
+		}
+	}
+
+	return ans
+}
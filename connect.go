@@ -43,6 +43,7 @@ The order of preference for auth / connection settings is:
 
 * explicitly set
 * environment variable (set chkenv to true to enable this)
+* credential provider (if CredentialProvider is set)
 * json file
 */
 func ConnectUsing(c Client, filename string, chkenv bool) (interface{}, error) {
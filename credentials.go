@@ -0,0 +1,116 @@
+package pango
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// Credentials are the auth and connection settings returned by a
+// CredentialProvider.  Fields left empty are not used.
+//
+// The JSON tags match those of the Client's JSON config file, so the same
+// document can be used for either.
+type Credentials struct {
+	Hostname string `json:"hostname"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+	ApiKey   string `json:"api_key"`
+	Protocol string `json:"protocol"`
+	Port     uint   `json:"port"`
+	Timeout  int    `json:"timeout"`
+	Target   string `json:"target"`
+}
+
+// CredentialProvider is a source of fallback auth and connection settings,
+// such as a secrets manager (Vault, AWS Secrets Manager, etc).
+//
+// The hostname param is the hostname as known prior to asking the provider
+// (either explicitly set or taken from the environment), and may be empty.
+// Providers can use it to look up per-device secrets.
+type CredentialProvider interface {
+	Credentials(hostname string) (*Credentials, error)
+}
+
+// CredentialProviderFunc lets an ordinary function be used as a
+// CredentialProvider.
+type CredentialProviderFunc func(string) (*Credentials, error)
+
+// Credentials invokes f(hostname).
+func (f CredentialProviderFunc) Credentials(hostname string) (*Credentials, error) {
+	return f(hostname)
+}
+
+// CredentialHelper is a CredentialProvider that runs an external command
+// which prints Credentials as JSON to stdout.
+//
+// The hostname is passed to the command as the PANOS_HOSTNAME environment
+// variable.  Anything the command writes to stderr is included in the
+// returned error if the command fails.
+type CredentialHelper struct {
+	Command string
+	Args    []string
+}
+
+// Credentials runs the helper command and parses its output.
+func (o CredentialHelper) Credentials(hostname string) (*Credentials, error) {
+	if o.Command == "" {
+		return nil, fmt.Errorf("credential helper command is unspecified")
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(o.Command, o.Args...)
+	cmd.Env = append(os.Environ(), "PANOS_HOSTNAME="+hostname)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("credential helper %q failed: %s: %s", o.Command, err, msg)
+		}
+		return nil, fmt.Errorf("credential helper %q failed: %s", o.Command, err)
+	}
+
+	var ans Credentials
+	if err := json.Unmarshal(stdout.Bytes(), &ans); err != nil {
+		return nil, fmt.Errorf("credential helper %q returned invalid JSON: %s", o.Command, err)
+	}
+
+	return &ans, nil
+}
+
+// applyCredentials overwrites the settings of the given fallback client with
+// the non-empty fields of the credentials.
+func applyCredentials(dst *Client, src *Credentials) {
+	if src == nil {
+		return
+	}
+
+	if src.Hostname != "" {
+		dst.Hostname = src.Hostname
+	}
+	if src.Username != "" {
+		dst.Username = src.Username
+	}
+	if src.Password != "" {
+		dst.Password = src.Password
+	}
+	if src.ApiKey != "" {
+		dst.ApiKey = src.ApiKey
+	}
+	if src.Protocol != "" {
+		dst.Protocol = src.Protocol
+	}
+	if src.Port != 0 {
+		dst.Port = src.Port
+	}
+	if src.Timeout != 0 {
+		dst.Timeout = src.Timeout
+	}
+	if src.Target != "" {
+		dst.Target = src.Target
+	}
+}
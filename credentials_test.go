@@ -0,0 +1,114 @@
+package pango
+
+import (
+	"fmt"
+	"os/exec"
+	"testing"
+)
+
+func TestCredentialProviderOverridesFile(t *testing.T) {
+	var host string
+	c := &Client{
+		Username: "explicit",
+		CredentialProvider: CredentialProviderFunc(func(h string) (*Credentials, error) {
+			host = h
+			return &Credentials{
+				Hostname: "provider.example.com",
+				Username: "provider",
+				ApiKey:   "secret",
+			}, nil
+		}),
+		rb:              [][]byte{[]byte("")},
+		credsFile:       "creds.json",
+		authFileContent: []byte(`{"hostname": "file.example.com", "password": "filepass", "port": 8443}`),
+	}
+
+	if err := c.initCon(); err != nil {
+		t.Fatalf("Error in initCon: %s", err)
+	}
+
+	if host != "" {
+		t.Errorf("Provider given hostname %q", host)
+	}
+	if c.Hostname != "provider.example.com" {
+		t.Errorf("Hostname is %q", c.Hostname)
+	}
+	if c.Username != "explicit" {
+		t.Errorf("Username is %q", c.Username)
+	}
+	if c.Password != "filepass" {
+		t.Errorf("Password is %q", c.Password)
+	}
+	if c.ApiKey != "secret" {
+		t.Errorf("ApiKey is %q", c.ApiKey)
+	}
+	if c.Port != 8443 {
+		t.Errorf("Port is %d", c.Port)
+	}
+}
+
+func TestCredentialProviderGivenHostname(t *testing.T) {
+	var host string
+	c := &Client{
+		Hostname: "fw1",
+		ApiKey:   "key",
+		CredentialProvider: CredentialProviderFunc(func(h string) (*Credentials, error) {
+			host = h
+			return nil, nil
+		}),
+	}
+
+	if err := c.initCon(); err != nil {
+		t.Fatalf("Error in initCon: %s", err)
+	}
+
+	if host != "fw1" {
+		t.Errorf("Provider given hostname %q", host)
+	}
+}
+
+func TestCredentialProviderError(t *testing.T) {
+	c := &Client{
+		CredentialProvider: CredentialProviderFunc(func(h string) (*Credentials, error) {
+			return nil, fmt.Errorf("vault sealed")
+		}),
+	}
+
+	if err := c.initCon(); err == nil || err.Error() != "vault sealed" {
+		t.Errorf("Got error: %v", err)
+	}
+}
+
+func TestCredentialHelper(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh is unavailable")
+	}
+
+	h := CredentialHelper{
+		Command: "sh",
+		Args:    []string{"-c", `printf '{"hostname": "%s", "api_key": "helperkey"}' "$PANOS_HOSTNAME"`},
+	}
+
+	creds, err := h.Credentials("fw2")
+	if err != nil {
+		t.Fatalf("Error in helper: %s", err)
+	}
+	if creds.Hostname != "fw2" || creds.ApiKey != "helperkey" {
+		t.Errorf("Got %#v", creds)
+	}
+}
+
+func TestCredentialHelperFailure(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh is unavailable")
+	}
+
+	h := CredentialHelper{
+		Command: "sh",
+		Args:    []string{"-c", "echo denied >&2; exit 1"},
+	}
+
+	if _, err := h.Credentials(""); err == nil {
+		t.Errorf("Expected an error")
+	}
+}
@@ -0,0 +1,19 @@
+package certificate
+
+// Valid values for Algorithm.
+const (
+	AlgorithmRsa   = "RSA"
+	AlgorithmEcdsa = "ECDSA"
+)
+
+// Valid values for Generate.SignedBy, in addition to the name of a CA
+// certificate.  SignedByExternal creates a CSR to be signed by an external CA.
+const (
+	SignedBySelf     = ""
+	SignedByExternal = "external"
+)
+
+const (
+	singular = "certificate"
+	plural   = "certificates"
+)
@@ -0,0 +1,19 @@
+/*
+Package certificate is the client.Device.Certificate namespace.
+
+Certificates are normally installed by generating them on the PAN-OS device
+(Generate / GenerateCsr) or by importing them with the pango.Client's
+ImportCertificate and ImportKeyPair functions.  This namespace can then be
+used to list the installed certificates along with their expiry info, to
+update flags such as whether or not it is a trusted root CA, or to delete
+them.
+
+For firewalls, the vsys defaults to "shared" if unspecified.
+
+For Panorama, specify the template ("tmpl") or template stack ("ts") along
+with the vsys (defaults to "shared") to manage firewall certificates, or leave
+both empty to manage Panorama's own certificates.
+
+Normalized object:  Entry
+*/
+package certificate
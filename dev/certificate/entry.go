@@ -0,0 +1,161 @@
+package certificate
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an installed
+// certificate.
+//
+// Subject, Issuer, NotValidBefore, NotValidAfter, and ExpiryEpoch are set by
+// PAN-OS when the certificate is installed.  PublicKey is the certificate in
+// PEM format, while PrivateKey is the encrypted private key (if present).
+// Csr is set if a CSR has been generated but the signed certificate has not
+// been imported yet.
+type Entry struct {
+	Name           string
+	CommonName     string
+	Algorithm      string
+	Ca             bool
+	Subject        string
+	Issuer         string
+	NotValidBefore string
+	NotValidAfter  string
+	ExpiryEpoch    int64
+	PublicKey      string
+	PrivateKey     string // encrypted
+	Csr            string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.CommonName = s.CommonName
+	o.Algorithm = s.Algorithm
+	o.Ca = s.Ca
+	o.Subject = s.Subject
+	o.Issuer = s.Issuer
+	o.NotValidBefore = s.NotValidBefore
+	o.NotValidAfter = s.NotValidAfter
+	o.ExpiryEpoch = s.ExpiryEpoch
+	o.PublicKey = s.PublicKey
+	o.PrivateKey = s.PrivateKey
+	o.Csr = s.Csr
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this certificate.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+// Expires returns when this certificate expires.  The zero time is returned if
+// the expiry is unknown, such as for a pending CSR.
+func (o Entry) Expires() time.Time {
+	if o.ExpiryEpoch == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(o.ExpiryEpoch, 0)
+}
+
+// ExpiresWithin returns true if this certificate expires within the given
+// duration from now.  Certificates with an unknown expiry never expire.
+func (o Entry) ExpiresWithin(d time.Duration) bool {
+	exp := o.Expires()
+	if exp.IsZero() {
+		return false
+	}
+
+	return time.Until(exp) < d
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:           o.Name,
+		CommonName:     o.CommonName,
+		Algorithm:      o.Algorithm,
+		Ca:             util.AsBool(o.Ca),
+		Subject:        o.Subject,
+		Issuer:         o.Issuer,
+		NotValidBefore: o.NotValidBefore,
+		NotValidAfter:  o.NotValidAfter,
+		ExpiryEpoch:    o.ExpiryEpoch,
+		PublicKey:      o.PublicKey,
+		PrivateKey:     o.PrivateKey,
+		Csr:            o.Csr,
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName        xml.Name `xml:"entry"`
+	Name           string   `xml:"name,attr"`
+	CommonName     string   `xml:"common-name,omitempty"`
+	Algorithm      string   `xml:"algorithm,omitempty"`
+	Ca             string   `xml:"ca"`
+	Subject        string   `xml:"subject,omitempty"`
+	Issuer         string   `xml:"issuer,omitempty"`
+	NotValidBefore string   `xml:"not-valid-before,omitempty"`
+	NotValidAfter  string   `xml:"not-valid-after,omitempty"`
+	ExpiryEpoch    int64    `xml:"expiry-epoch,omitempty"`
+	PublicKey      string   `xml:"public-key,omitempty"`
+	PrivateKey     string   `xml:"private-key,omitempty"`
+	Csr            string   `xml:"csr,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:           e.Name,
+		CommonName:     e.CommonName,
+		Algorithm:      e.Algorithm,
+		Ca:             util.YesNo(e.Ca),
+		Subject:        e.Subject,
+		Issuer:         e.Issuer,
+		NotValidBefore: e.NotValidBefore,
+		NotValidAfter:  e.NotValidAfter,
+		ExpiryEpoch:    e.ExpiryEpoch,
+		PublicKey:      e.PublicKey,
+		PrivateKey:     e.PrivateKey,
+		Csr:            e.Csr,
+	}
+
+	return ans
+}
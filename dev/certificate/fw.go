@@ -0,0 +1,105 @@
+package certificate
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwCertificate is a namespace struct, included as part of pango.Firewall.
+type FwCertificate struct {
+	con util.XapiClient
+	ns  *namespace.Standard[Entry]
+}
+
+// Initialize is invoked when Initialize on the pango.Firewall is called.
+func (c *FwCertificate) Initialize(con util.XapiClient) {
+	c.con = con
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *FwCertificate) GetList(vsys string) ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(vsys, nil))
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *FwCertificate) ShowList(vsys string) ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(vsys, nil))
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *FwCertificate) Get(vsys, name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath(vsys, []string{name}), name)
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *FwCertificate) GetAll(vsys string) ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(vsys, nil))
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *FwCertificate) Show(vsys, name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath(vsys, []string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *FwCertificate) ShowAll(vsys string) ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(vsys, nil))
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *FwCertificate) Set(vsys string, e ...Entry) error {
+	return c.ns.SetEntries(c.pather(vsys), e...)
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *FwCertificate) Edit(vsys string, e Entry) error {
+	return c.ns.EditEntry(c.pather(vsys), e)
+}
+
+// Delete removes the given objects.
+//
+// Objects can be either a string or an Entry object.
+func (c *FwCertificate) Delete(vsys string, e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(vsys), e...)
+}
+
+// Generate generates a certificate on the firewall in the given vsys.
+func (c *FwCertificate) Generate(vsys string, g Generate) error {
+	return generate(c.con, vsys, g)
+}
+
+// GenerateCsr generates a certificate signing request on the firewall in the
+// given vsys.  Once signed by the external CA, import the signed certificate
+// under the same name.
+func (c *FwCertificate) GenerateCsr(vsys string, g Generate) error {
+	g.SignedBy = SignedByExternal
+	return generate(c.con, vsys, g)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *FwCertificate) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *FwCertificate) pather(vsys string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(vsys, v)
+	}
+}
+
+func (c *FwCertificate) xpath(vsys string, vals []string) []string {
+	if vsys == "" {
+		vsys = "shared"
+	}
+
+	ans := make([]string, 0, 7)
+	ans = append(ans, util.VsysXpathPrefix(vsys)...)
+	ans = append(ans,
+		"certificate",
+		util.AsEntryXpath(vals),
+	)
+
+	return ans
+}
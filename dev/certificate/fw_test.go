@@ -0,0 +1,35 @@
+package certificate
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestFwNormalization(t *testing.T) {
+	testCases := getTests()
+
+	mc := &testdata.MockClient{}
+	ns := &FwCertificate{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.AddResp("")
+			err := ns.Set("vsys2", tc.conf)
+			if err != nil {
+				t.Errorf("Error in set: %s", err)
+			} else {
+				mc.AddResp(mc.Elm)
+				r, err := ns.Get("vsys2", tc.conf.Name)
+				if err != nil {
+					t.Errorf("Error in get: %s", err)
+				} else if !reflect.DeepEqual(tc.conf, r) {
+					t.Errorf("%#v != %#v", tc.conf, r)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package certificate
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Generate is a request to generate a certificate on the PAN-OS device.
+//
+// For AlgorithmRsa, Bits is the RSA key size (such as 2048), and for
+// AlgorithmEcdsa it is the curve size (256 or 384).
+//
+// SignedBy is the name of the CA certificate to sign with.  Leave this empty
+// for a self-signed certificate, or use SignedByExternal to create a CSR.
+type Generate struct {
+	Name           string
+	CommonName     string
+	Algorithm      string
+	Bits           int
+	Digest         string
+	Ca             bool
+	SignedBy       string
+	DaysTillExpiry int
+	Hostnames      []string
+	Ips            []string
+	Emails         []string
+	Country        string
+	State          string
+	Locality       string
+	Organization   string
+	Department     []string
+}
+
+type genReq struct {
+	XMLName        xml.Name         `xml:"request"`
+	Name           string           `xml:"certificate>generate>certificate-name"`
+	CommonName     string           `xml:"certificate>generate>name"`
+	Algorithm      genAlgorithm     `xml:"certificate>generate>algorithm"`
+	Digest         string           `xml:"certificate>generate>digest,omitempty"`
+	Ca             string           `xml:"certificate>generate>ca,omitempty"`
+	SignedBy       string           `xml:"certificate>generate>signed-by,omitempty"`
+	DaysTillExpiry int              `xml:"certificate>generate>days-till-expiry,omitempty"`
+	Hostnames      *util.MemberType `xml:"certificate>generate>hostname"`
+	Ips            *util.MemberType `xml:"certificate>generate>ip"`
+	Emails         *util.MemberType `xml:"certificate>generate>alt-email"`
+	Country        string           `xml:"certificate>generate>country-code,omitempty"`
+	State          string           `xml:"certificate>generate>state,omitempty"`
+	Locality       string           `xml:"certificate>generate>locality,omitempty"`
+	Organization   string           `xml:"certificate>generate>organization,omitempty"`
+	Department     *util.MemberType `xml:"certificate>generate>organization-unit"`
+	Vsys           string           `xml:"certificate>generate>vsys,omitempty"`
+}
+
+type genAlgorithm struct {
+	Rsa   *genBits `xml:"RSA"`
+	Ecdsa *genBits `xml:"ECDSA"`
+}
+
+type genBits struct {
+	Rsa   int `xml:"rsa-nbits,omitempty"`
+	Ecdsa int `xml:"ecdsa-nbits,omitempty"`
+}
+
+func generate(con util.XapiClient, vsys string, g Generate) error {
+	req := genReq{
+		Name:           g.Name,
+		CommonName:     g.CommonName,
+		Digest:         g.Digest,
+		SignedBy:       g.SignedBy,
+		DaysTillExpiry: g.DaysTillExpiry,
+		Hostnames:      util.StrToMem(g.Hostnames),
+		Ips:            util.StrToMem(g.Ips),
+		Emails:         util.StrToMem(g.Emails),
+		Country:        g.Country,
+		State:          g.State,
+		Locality:       g.Locality,
+		Organization:   g.Organization,
+		Department:     util.StrToMem(g.Department),
+	}
+
+	if g.Ca {
+		req.Ca = util.YesNo(g.Ca)
+	}
+
+	switch g.Algorithm {
+	case "", AlgorithmRsa:
+		req.Algorithm.Rsa = &genBits{Rsa: g.Bits}
+	case AlgorithmEcdsa:
+		req.Algorithm.Ecdsa = &genBits{Ecdsa: g.Bits}
+	default:
+		return fmt.Errorf("unknown algorithm: %q", g.Algorithm)
+	}
+
+	if vsys != "" && vsys != "shared" {
+		req.Vsys = vsys
+	}
+
+	con.LogOp("(op) generating %s %q", singular, g.Name)
+	_, err := con.Op(req, "", nil, nil)
+	return err
+}
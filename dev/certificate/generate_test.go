@@ -0,0 +1,83 @@
+package certificate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestGenerate(t *testing.T) {
+	testCases := []struct {
+		desc string
+		vsys string
+		csr  bool
+		conf Generate
+		want string
+	}{
+		{"self signed rsa", "", false, Generate{
+			Name:       "c1",
+			CommonName: "fw.example.com",
+			Bits:       2048,
+			Digest:     "sha256",
+		}, "<request><certificate><generate><certificate-name>c1</certificate-name><name>fw.example.com</name><algorithm><RSA><rsa-nbits>2048</rsa-nbits></RSA></algorithm><digest>sha256</digest></generate></certificate></request>"},
+		{"ca ecdsa in vsys", "vsys2", false, Generate{
+			Name:           "ca1",
+			CommonName:     "Example CA",
+			Algorithm:      AlgorithmEcdsa,
+			Bits:           384,
+			Ca:             true,
+			DaysTillExpiry: 3650,
+			Country:        "US",
+		}, "<request><certificate><generate><certificate-name>ca1</certificate-name><name>Example CA</name><algorithm><ECDSA><ecdsa-nbits>384</ecdsa-nbits></ECDSA></algorithm><ca>yes</ca><days-till-expiry>3650</days-till-expiry><country-code>US</country-code><vsys>vsys2</vsys></generate></certificate></request>"},
+		{"csr", "shared", true, Generate{
+			Name:       "c2",
+			CommonName: "vpn.example.com",
+			Bits:       4096,
+			Hostnames:  []string{"vpn.example.com"},
+			Ips:        []string{"192.0.2.1"},
+		}, "<request><certificate><generate><certificate-name>c2</certificate-name><name>vpn.example.com</name><algorithm><RSA><rsa-nbits>4096</rsa-nbits></RSA></algorithm><signed-by>external</signed-by><hostname><member>vpn.example.com</member></hostname><ip><member>192.0.2.1</member></ip></generate></certificate></request>"},
+	}
+
+	mc := &testdata.MockClient{}
+	ns := &FwCertificate{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var err error
+			mc.Reset()
+			mc.AddResp("")
+			if tc.csr {
+				err = ns.GenerateCsr(tc.vsys, tc.conf)
+			} else {
+				err = ns.Generate(tc.vsys, tc.conf)
+			}
+			if err != nil {
+				t.Fatalf("Error: %s", err)
+			}
+			if mc.Elm != tc.want {
+				t.Errorf("got %s\nwant %s", mc.Elm, tc.want)
+			}
+		})
+	}
+
+	if err := ns.Generate("", Generate{Name: "c3", Algorithm: "DSA"}); err == nil {
+		t.Errorf("Unknown algorithm did not error")
+	}
+}
+
+func TestExpires(t *testing.T) {
+	e := Entry{ExpiryEpoch: time.Now().Add(24 * time.Hour).Unix()}
+	if !e.ExpiresWithin(48 * time.Hour) {
+		t.Errorf("Not expiring within 48h")
+	}
+	if e.ExpiresWithin(time.Hour) {
+		t.Errorf("Expiring within 1h")
+	}
+
+	var csr Entry
+	if !csr.Expires().IsZero() || csr.ExpiresWithin(time.Hour) {
+		t.Errorf("Pending CSR has an expiry")
+	}
+}
@@ -0,0 +1,118 @@
+package certificate
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// PanoCertificate is a namespace struct, included as part of pango.Panorama.
+type PanoCertificate struct {
+	con util.XapiClient
+	ns  *namespace.Standard[Entry]
+}
+
+// Initialize is invoked when Initialize on the pango.Panorama is called.
+func (c *PanoCertificate) Initialize(con util.XapiClient) {
+	c.con = con
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *PanoCertificate) GetList(tmpl, ts, vsys string) ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(tmpl, ts, vsys, nil))
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *PanoCertificate) ShowList(tmpl, ts, vsys string) ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(tmpl, ts, vsys, nil))
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *PanoCertificate) Get(tmpl, ts, vsys, name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath(tmpl, ts, vsys, []string{name}), name)
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *PanoCertificate) GetAll(tmpl, ts, vsys string) ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(tmpl, ts, vsys, nil))
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *PanoCertificate) Show(tmpl, ts, vsys, name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath(tmpl, ts, vsys, []string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *PanoCertificate) ShowAll(tmpl, ts, vsys string) ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(tmpl, ts, vsys, nil))
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *PanoCertificate) Set(tmpl, ts, vsys string, e ...Entry) error {
+	return c.ns.SetEntries(c.pather(tmpl, ts, vsys), e...)
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *PanoCertificate) Edit(tmpl, ts, vsys string, e Entry) error {
+	return c.ns.EditEntry(c.pather(tmpl, ts, vsys), e)
+}
+
+// Delete removes the given objects.
+//
+// Objects can be either a string or an Entry object.
+func (c *PanoCertificate) Delete(tmpl, ts, vsys string, e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(tmpl, ts, vsys), e...)
+}
+
+// Generate generates one of Panorama's own certificates.
+//
+// Certificates cannot be generated inside of a template; import them into the
+// template instead.
+func (c *PanoCertificate) Generate(g Generate) error {
+	return generate(c.con, "", g)
+}
+
+// GenerateCsr generates a certificate signing request for one of Panorama's
+// own certificates.  Once signed by the external CA, import the signed
+// certificate under the same name.
+func (c *PanoCertificate) GenerateCsr(g Generate) error {
+	g.SignedBy = SignedByExternal
+	return generate(c.con, "", g)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *PanoCertificate) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *PanoCertificate) pather(tmpl, ts, vsys string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(tmpl, ts, vsys, v)
+	}
+}
+
+func (c *PanoCertificate) xpath(tmpl, ts, vsys string, vals []string) []string {
+	if tmpl == "" && ts == "" {
+		return []string{
+			"config",
+			"panorama",
+			"certificate",
+			util.AsEntryXpath(vals),
+		}
+	}
+
+	if vsys == "" {
+		vsys = "shared"
+	}
+
+	ans := make([]string, 0, 12)
+	ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
+	ans = append(ans, util.VsysXpathPrefix(vsys)...)
+	ans = append(ans,
+		"certificate",
+		util.AsEntryXpath(vals),
+	)
+
+	return ans
+}
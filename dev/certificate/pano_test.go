@@ -0,0 +1,45 @@
+package certificate
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+func TestPanoNormalization(t *testing.T) {
+	testCases := getTests()
+
+	mc := &testdata.MockClient{}
+	ns := &PanoCertificate{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.AddResp("")
+			err := ns.Set("my template", "", "", tc.conf)
+			if err != nil {
+				t.Errorf("Error in set: %s", err)
+			} else {
+				mc.AddResp(mc.Elm)
+				r, err := ns.Get("my template", "", "", tc.conf.Name)
+				if err != nil {
+					t.Errorf("Error in get: %s", err)
+				} else if !reflect.DeepEqual(tc.conf, r) {
+					t.Errorf("%#v != %#v", tc.conf, r)
+				}
+			}
+		})
+	}
+}
+
+func TestPanoLocalXpath(t *testing.T) {
+	ns := &PanoCertificate{}
+
+	path := util.AsXpath(ns.xpath("", "", "", []string{"c1"}))
+	if path != "/config/panorama/certificate/entry[@name='c1']" {
+		t.Errorf("Xpath is %s", path)
+	}
+}
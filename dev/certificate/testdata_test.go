@@ -0,0 +1,30 @@
+package certificate
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"pending csr", Entry{
+			Name:       "c1",
+			CommonName: "fw.example.com",
+			Algorithm:  AlgorithmRsa,
+			Csr:        "-----BEGIN CERTIFICATE REQUEST-----\nMIIB\n-----END CERTIFICATE REQUEST-----",
+		}},
+		{"installed ca", Entry{
+			Name:           "c2",
+			CommonName:     "Example Root CA",
+			Algorithm:      AlgorithmEcdsa,
+			Ca:             true,
+			Subject:        "/CN=Example Root CA",
+			Issuer:         "/CN=Example Root CA",
+			NotValidBefore: "Jan  1 00:00:00 2024 GMT",
+			NotValidAfter:  "Jan  1 00:00:00 2034 GMT",
+			ExpiryEpoch:    2019686400,
+			PublicKey:      "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----",
+			PrivateKey:     "-AQ==encrypted",
+		}},
+	}
+}
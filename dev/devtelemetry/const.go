@@ -0,0 +1,8 @@
+package devtelemetry
+
+// Valid values for Region.
+const (
+	RegionAmericas = "Americas"
+	RegionEurope   = "Europe"
+	RegionApac     = "APAC"
+)
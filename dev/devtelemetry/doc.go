@@ -0,0 +1,13 @@
+/*
+Package devtelemetry is the firewall.Device.DeviceTelemetry and
+panorama.Device.DeviceTelemetry namespace.
+
+Device telemetry replaces the statistics service (the telemetry package) as
+of PAN-OS 10.0.
+
+For Panorama, the settings are managed in a template or template stack so
+they can be pushed out to the firewalls.
+
+Normalized object: Settings
+*/
+package devtelemetry
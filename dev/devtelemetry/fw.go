@@ -0,0 +1,89 @@
+package devtelemetry
+
+import (
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwDeviceTelemetry is a namespace struct, included as part of pango.Firewall.
+type FwDeviceTelemetry struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *FwDeviceTelemetry) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// Show performs SHOW to retrieve device telemetry settings.
+func (c *FwDeviceTelemetry) Show() (Settings, error) {
+	c.con.LogQuery("(show) device telemetry settings")
+	return c.details(c.con.Show)
+}
+
+// Get performs GET to retrieve device telemetry settings.
+func (c *FwDeviceTelemetry) Get() (Settings, error) {
+	c.con.LogQuery("(get) device telemetry settings")
+	return c.details(c.con.Get)
+}
+
+// Set performs SET to update device telemetry settings.
+func (c *FwDeviceTelemetry) Set(e Settings) error {
+	var err error
+	_, fn := c.versioning()
+	c.con.LogAction("(set) device telemetry settings")
+
+	path := c.xpath()
+	path = path[:len(path)-1]
+
+	_, err = c.con.Set(path, fn(e), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to update device telemetry settings.
+func (c *FwDeviceTelemetry) Edit(e Settings) error {
+	var err error
+	_, fn := c.versioning()
+	c.con.LogAction("(edit) device telemetry settings")
+
+	path := c.xpath()
+
+	_, err = c.con.Edit(path, fn(e), nil, nil)
+	return err
+}
+
+// Delete removes the device telemetry settings.
+func (c *FwDeviceTelemetry) Delete() error {
+	c.con.LogAction("(delete) device telemetry settings")
+	path := c.xpath()
+
+	_, err := c.con.Delete(path, nil, nil)
+	return err
+}
+
+/** Internal functions for the FwDeviceTelemetry struct **/
+
+func (c *FwDeviceTelemetry) versioning() (normalizer, func(Settings) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *FwDeviceTelemetry) details(fn util.Retriever) (Settings, error) {
+	path := c.xpath()
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Settings{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *FwDeviceTelemetry) xpath() []string {
+	return []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"deviceconfig",
+		"system",
+		"device-telemetry",
+	}
+}
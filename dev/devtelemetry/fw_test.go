@@ -0,0 +1,50 @@
+package devtelemetry
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestFwNormalization(t *testing.T) {
+	testCases := []struct {
+		desc string
+		conf Settings
+	}{
+		{"all no", Settings{}},
+		{"all yes", Settings{
+			DeviceHealthPerformance: true,
+			ProductUsage:            true,
+			ThreatPrevention:        true,
+			Region:                  RegionAmericas,
+		}},
+		{"mix", Settings{
+			ProductUsage: true,
+			Region:       RegionEurope,
+		}},
+	}
+
+	mc := &testdata.MockClient{}
+	ns := &FwDeviceTelemetry{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.AddResp("")
+			err := ns.Set(tc.conf)
+			if err != nil {
+				t.Errorf("Error in set: %s", err)
+			} else {
+				mc.AddResp(mc.Elm)
+				r, err := ns.Get()
+				if err != nil {
+					t.Errorf("Error in get: %s", err)
+				} else if !reflect.DeepEqual(tc.conf, r) {
+					t.Errorf("%#v != %#v", tc.conf, r)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package devtelemetry
+
+import (
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// PanoDeviceTelemetry is a namespace struct, included as part of pango.Panorama.
+type PanoDeviceTelemetry struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *PanoDeviceTelemetry) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// Show performs SHOW to retrieve device telemetry settings.
+func (c *PanoDeviceTelemetry) Show(tmpl, ts string) (Settings, error) {
+	c.con.LogQuery("(show) device telemetry settings")
+	return c.details(c.con.Show, tmpl, ts)
+}
+
+// Get performs GET to retrieve device telemetry settings.
+func (c *PanoDeviceTelemetry) Get(tmpl, ts string) (Settings, error) {
+	c.con.LogQuery("(get) device telemetry settings")
+	return c.details(c.con.Get, tmpl, ts)
+}
+
+// Set performs SET to update device telemetry settings.
+func (c *PanoDeviceTelemetry) Set(tmpl, ts string, e Settings) error {
+	var err error
+
+	if tmpl == "" && ts == "" {
+		return fmt.Errorf("tmpl or ts must be specified")
+	}
+
+	_, fn := c.versioning()
+	c.con.LogAction("(set) device telemetry settings")
+
+	path := c.xpath(tmpl, ts)
+	path = path[:len(path)-1]
+
+	_, err = c.con.Set(path, fn(e), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to update device telemetry settings.
+func (c *PanoDeviceTelemetry) Edit(tmpl, ts string, e Settings) error {
+	var err error
+
+	if tmpl == "" && ts == "" {
+		return fmt.Errorf("tmpl or ts must be specified")
+	}
+
+	_, fn := c.versioning()
+	c.con.LogAction("(edit) device telemetry settings")
+
+	path := c.xpath(tmpl, ts)
+
+	_, err = c.con.Edit(path, fn(e), nil, nil)
+	return err
+}
+
+// Delete removes the device telemetry settings from the template.
+func (c *PanoDeviceTelemetry) Delete(tmpl, ts string) error {
+	if tmpl == "" && ts == "" {
+		return fmt.Errorf("tmpl or ts must be specified")
+	}
+
+	c.con.LogAction("(delete) device telemetry settings")
+	path := c.xpath(tmpl, ts)
+
+	_, err := c.con.Delete(path, nil, nil)
+	return err
+}
+
+/** Internal functions for the PanoDeviceTelemetry struct **/
+
+func (c *PanoDeviceTelemetry) versioning() (normalizer, func(Settings) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *PanoDeviceTelemetry) details(fn util.Retriever, tmpl, ts string) (Settings, error) {
+	path := c.xpath(tmpl, ts)
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Settings{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *PanoDeviceTelemetry) xpath(tmpl, ts string) []string {
+	ans := make([]string, 0, 12)
+	ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"deviceconfig",
+		"system",
+		"device-telemetry",
+	)
+
+	return ans
+}
@@ -0,0 +1,60 @@
+package devtelemetry
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestPanoNormalization(t *testing.T) {
+	testCases := []struct {
+		desc string
+		conf Settings
+	}{
+		{"all no", Settings{}},
+		{"all yes", Settings{
+			DeviceHealthPerformance: true,
+			ProductUsage:            true,
+			ThreatPrevention:        true,
+			Region:                  RegionAmericas,
+		}},
+		{"mix", Settings{
+			ProductUsage: true,
+			Region:       RegionEurope,
+		}},
+	}
+
+	mc := &testdata.MockClient{}
+	ns := &PanoDeviceTelemetry{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.AddResp("")
+			err := ns.Set("my template", "", tc.conf)
+			if err != nil {
+				t.Errorf("Error in set: %s", err)
+			} else {
+				mc.AddResp(mc.Elm)
+				r, err := ns.Get("my template", "")
+				if err != nil {
+					t.Errorf("Error in get: %s", err)
+				} else if !reflect.DeepEqual(tc.conf, r) {
+					t.Errorf("%#v != %#v", tc.conf, r)
+				}
+			}
+		})
+	}
+}
+
+func TestPanoNoTemplate(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &PanoDeviceTelemetry{}
+	ns.Initialize(mc)
+
+	if err := ns.Set("", "", Settings{}); err == nil {
+		t.Errorf("No error without a template or template stack")
+	}
+}
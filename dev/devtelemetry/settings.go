@@ -0,0 +1,67 @@
+package devtelemetry
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Settings is a normalized, version independent representation of device
+// telemetry configuration.
+//
+// Region is the region of the Cortex Data Lake instance that the telemetry
+// is sent to.
+type Settings struct {
+	DeviceHealthPerformance bool
+	ProductUsage            bool
+	ThreatPrevention        bool
+	Region                  string
+}
+
+// Copy copies the information from source Settings `s` to this object.
+func (o *Settings) Copy(s Settings) {
+	o.DeviceHealthPerformance = s.DeviceHealthPerformance
+	o.ProductUsage = s.ProductUsage
+	o.ThreatPrevention = s.ThreatPrevention
+	o.Region = s.Region
+}
+
+/** Structs / functions for normalization. **/
+
+type normalizer interface {
+	Normalize() Settings
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>device-telemetry"`
+}
+
+func (o *container_v1) Normalize() Settings {
+	ans := Settings{
+		DeviceHealthPerformance: util.AsBool(o.Answer.DeviceHealthPerformance),
+		ProductUsage:            util.AsBool(o.Answer.ProductUsage),
+		ThreatPrevention:        util.AsBool(o.Answer.ThreatPrevention),
+		Region:                  o.Answer.Region,
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName                 xml.Name `xml:"device-telemetry"`
+	DeviceHealthPerformance string   `xml:"device-health-performance"`
+	ProductUsage            string   `xml:"product-usage"`
+	ThreatPrevention        string   `xml:"threat-prevention"`
+	Region                  string   `xml:"region,omitempty"`
+}
+
+func specify_v1(e Settings) interface{} {
+	ans := entry_v1{
+		DeviceHealthPerformance: util.YesNo(e.DeviceHealthPerformance),
+		ProductUsage:            util.YesNo(e.ProductUsage),
+		ThreatPrevention:        util.YesNo(e.ThreatPrevention),
+		Region:                  e.Region,
+	}
+
+	return ans
+}
@@ -3,40 +3,66 @@ package dev
 import (
 	"github.com/PaloAltoNetworks/pango/util"
 
+	"github.com/PaloAltoNetworks/pango/dev/certificate"
+	"github.com/PaloAltoNetworks/pango/dev/devtelemetry"
 	"github.com/PaloAltoNetworks/pango/dev/general"
+	"github.com/PaloAltoNetworks/pango/dev/inlinecloud"
+	"github.com/PaloAltoNetworks/pango/dev/mgmttls"
+	"github.com/PaloAltoNetworks/pango/dev/password/complexity"
 	"github.com/PaloAltoNetworks/pango/dev/profile/email"
 	emailsrv "github.com/PaloAltoNetworks/pango/dev/profile/email/server"
 	"github.com/PaloAltoNetworks/pango/dev/profile/http"
 	"github.com/PaloAltoNetworks/pango/dev/profile/http/header"
 	"github.com/PaloAltoNetworks/pango/dev/profile/http/param"
 	httpsrv "github.com/PaloAltoNetworks/pango/dev/profile/http/server"
+	"github.com/PaloAltoNetworks/pango/dev/profile/mfa"
 	"github.com/PaloAltoNetworks/pango/dev/profile/snmp"
 	"github.com/PaloAltoNetworks/pango/dev/profile/snmp/v2c"
 	"github.com/PaloAltoNetworks/pango/dev/profile/snmp/v3"
+	"github.com/PaloAltoNetworks/pango/dev/profile/ssltls"
 	"github.com/PaloAltoNetworks/pango/dev/profile/syslog"
 	syslogsrv "github.com/PaloAltoNetworks/pango/dev/profile/syslog/server"
+	"github.com/PaloAltoNetworks/pango/dev/profile/tacacs"
+	"github.com/PaloAltoNetworks/pango/dev/ssh"
 	"github.com/PaloAltoNetworks/pango/dev/telemetry"
+	"github.com/PaloAltoNetworks/pango/dev/vminfo"
 )
 
 // FwDev is the client.Device namespace.
 type FwDev struct {
-	EmailServer         *emailsrv.FwServer
-	EmailServerProfile  *email.FwEmail
-	GeneralSettings     *general.FwGeneral
-	HttpHeader          *header.FwHeader
-	HttpParam           *param.FwParam
-	HttpServer          *httpsrv.FwServer
-	HttpServerProfile   *http.FwHttp
-	SnmpServerProfile   *snmp.FwSnmp
-	SnmpV2cServer       *v2c.FwV2c
-	SnmpV3Server        *v3.FwV3
-	SyslogServer        *syslogsrv.FwServer
-	SyslogServerProfile *syslog.FwSyslog
-	Telemetry           *telemetry.FwTelemetry
+	Certificate          *certificate.FwCertificate
+	DeviceTelemetry      *devtelemetry.FwDeviceTelemetry
+	EmailServer          *emailsrv.FwServer
+	EmailServerProfile   *email.FwEmail
+	GeneralSettings      *general.FwGeneral
+	HttpHeader           *header.FwHeader
+	HttpParam            *param.FwParam
+	HttpServer           *httpsrv.FwServer
+	HttpServerProfile    *http.FwHttp
+	InlineCloud          *inlinecloud.FwInlineCloud
+	ManagementTls        *mgmttls.FwMgmtTls
+	MfaServerProfile     *mfa.FwMfa
+	PasswordComplexity   *complexity.FwComplexity
+	SnmpServerProfile    *snmp.FwSnmp
+	SnmpV2cServer        *v2c.FwV2c
+	SnmpV3Server         *v3.FwV3
+	SshServerProfile     *ssh.FwSsh
+	SslTlsServiceProfile *ssltls.FwSslTls
+	SyslogServer         *syslogsrv.FwServer
+	SyslogServerProfile  *syslog.FwSyslog
+	TacacsServerProfile  *tacacs.FwTacacs
+	Telemetry            *telemetry.FwTelemetry
+	VmInfoSource         *vminfo.FwVmInfo
 }
 
 // Initialize is invoked on client.Initialize().
 func (c *FwDev) Initialize(i util.XapiClient) {
+	c.Certificate = &certificate.FwCertificate{}
+	c.Certificate.Initialize(i)
+
+	c.DeviceTelemetry = &devtelemetry.FwDeviceTelemetry{}
+	c.DeviceTelemetry.Initialize(i)
+
 	c.EmailServer = &emailsrv.FwServer{}
 	c.EmailServer.Initialize(i)
 
@@ -58,6 +84,18 @@ func (c *FwDev) Initialize(i util.XapiClient) {
 	c.HttpServerProfile = &http.FwHttp{}
 	c.HttpServerProfile.Initialize(i)
 
+	c.InlineCloud = &inlinecloud.FwInlineCloud{}
+	c.InlineCloud.Initialize(i)
+
+	c.ManagementTls = &mgmttls.FwMgmtTls{}
+	c.ManagementTls.Initialize(i)
+
+	c.MfaServerProfile = &mfa.FwMfa{}
+	c.MfaServerProfile.Initialize(i)
+
+	c.PasswordComplexity = &complexity.FwComplexity{}
+	c.PasswordComplexity.Initialize(i)
+
 	c.SnmpServerProfile = &snmp.FwSnmp{}
 	c.SnmpServerProfile.Initialize(i)
 
@@ -67,12 +105,24 @@ func (c *FwDev) Initialize(i util.XapiClient) {
 	c.SnmpV3Server = &v3.FwV3{}
 	c.SnmpV3Server.Initialize(i)
 
+	c.SshServerProfile = &ssh.FwSsh{}
+	c.SshServerProfile.Initialize(i)
+
+	c.SslTlsServiceProfile = &ssltls.FwSslTls{}
+	c.SslTlsServiceProfile.Initialize(i)
+
 	c.SyslogServer = &syslogsrv.FwServer{}
 	c.SyslogServer.Initialize(i)
 
 	c.SyslogServerProfile = &syslog.FwSyslog{}
 	c.SyslogServerProfile.Initialize(i)
 
+	c.TacacsServerProfile = &tacacs.FwTacacs{}
+	c.TacacsServerProfile.Initialize(i)
+
 	c.Telemetry = &telemetry.FwTelemetry{}
 	c.Telemetry.Initialize(i)
+
+	c.VmInfoSource = &vminfo.FwVmInfo{}
+	c.VmInfoSource.Initialize(i)
 }
@@ -0,0 +1,10 @@
+/*
+Package inlinecloud is the firewall.Device.InlineCloud namespace.
+
+This namespace manages the device wide App-ID cloud engine settings, which
+enable inline categorization of SaaS applications using the App-ID cloud
+engine on PAN-OS 10.2+.
+
+Normalized object: Settings
+*/
+package inlinecloud
@@ -0,0 +1,71 @@
+package inlinecloud
+
+import (
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwInlineCloud is a namespace struct, included as part of pango.Firewall.
+type FwInlineCloud struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *FwInlineCloud) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// Show performs SHOW to retrieve the App-ID cloud engine settings.
+func (c *FwInlineCloud) Show() (Settings, error) {
+	c.con.LogQuery("(show) app-id cloud engine settings")
+	return c.details(c.con.Show)
+}
+
+// Get performs GET to retrieve the App-ID cloud engine settings.
+func (c *FwInlineCloud) Get() (Settings, error) {
+	c.con.LogQuery("(get) app-id cloud engine settings")
+	return c.details(c.con.Get)
+}
+
+// Set performs SET to update the App-ID cloud engine settings.
+//
+// There is no Edit or Delete, as these settings share their parent config
+// with the other device wide application settings.
+func (c *FwInlineCloud) Set(e Settings) error {
+	var err error
+	_, fn := c.versioning()
+	c.con.LogAction("(set) app-id cloud engine settings")
+
+	path := c.xpath()
+	path = path[:len(path)-1]
+
+	_, err = c.con.Set(path, fn(e), nil, nil)
+	return err
+}
+
+/** Internal functions for the FwInlineCloud struct **/
+
+func (c *FwInlineCloud) versioning() (normalizer, func(Settings) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *FwInlineCloud) details(fn util.Retriever) (Settings, error) {
+	path := c.xpath()
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Settings{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *FwInlineCloud) xpath() []string {
+	return []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"deviceconfig",
+		"setting",
+		"application",
+	}
+}
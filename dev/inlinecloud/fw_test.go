@@ -0,0 +1,43 @@
+package inlinecloud
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestFwNormalization(t *testing.T) {
+	testCases := []struct {
+		desc string
+		conf Settings
+	}{
+		{"disabled", Settings{}},
+		{"enabled", Settings{
+			AppIdCloudEngine: true,
+		}},
+	}
+
+	mc := &testdata.MockClient{}
+	ns := &FwInlineCloud{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.AddResp("")
+			err := ns.Set(tc.conf)
+			if err != nil {
+				t.Errorf("Error in set: %s", err)
+			} else {
+				mc.AddResp(mc.Elm)
+				r, err := ns.Get()
+				if err != nil {
+					t.Errorf("Error in get: %s", err)
+				} else if !reflect.DeepEqual(tc.conf, r) {
+					t.Errorf("%#v != %#v", tc.conf, r)
+				}
+			}
+		})
+	}
+}
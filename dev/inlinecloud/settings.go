@@ -0,0 +1,49 @@
+package inlinecloud
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Settings is a normalized, version independent representation of the
+// App-ID cloud engine settings.
+type Settings struct {
+	AppIdCloudEngine bool
+}
+
+// Copy copies the information from source Settings `s` to this object.
+func (o *Settings) Copy(s Settings) {
+	o.AppIdCloudEngine = s.AppIdCloudEngine
+}
+
+/** Structs / functions for normalization. **/
+
+type normalizer interface {
+	Normalize() Settings
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>application"`
+}
+
+func (o *container_v1) Normalize() Settings {
+	ans := Settings{
+		AppIdCloudEngine: util.AsBool(o.Answer.AppIdCloudEngine),
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName          xml.Name `xml:"application"`
+	AppIdCloudEngine string   `xml:"cloud-appid>enabled"`
+}
+
+func specify_v1(e Settings) interface{} {
+	ans := entry_v1{
+		AppIdCloudEngine: util.YesNo(e.AppIdCloudEngine),
+	}
+
+	return ans
+}
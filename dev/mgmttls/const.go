@@ -0,0 +1,48 @@
+package mgmttls
+
+// Valid values for MinVersion and MaxVersion.  VersionMax is only valid for
+// MaxVersion, and means the latest version that PAN-OS supports.
+const (
+	VersionTls10 = "tls1-0"
+	VersionTls11 = "tls1-1"
+	VersionTls12 = "tls1-2"
+	VersionTls13 = "tls1-3"
+	VersionMax   = "max"
+)
+
+// Valid values for KeyExchange.
+const (
+	KeyExchangeRsa   = "rsa"
+	KeyExchangeDhe   = "dhe"
+	KeyExchangeEcdhe = "ecdhe"
+)
+
+// Valid values for Encryption.
+const (
+	Encryption3des      = "3des"
+	EncryptionRc4       = "rc4"
+	EncryptionAes128Cbc = "aes-128-cbc"
+	EncryptionAes256Cbc = "aes-256-cbc"
+	EncryptionAes128Gcm = "aes-128-gcm"
+	EncryptionAes256Gcm = "aes-256-gcm"
+)
+
+// Valid values for Authentication.
+const (
+	AuthenticationSha1   = "sha1"
+	AuthenticationSha256 = "sha256"
+	AuthenticationSha384 = "sha384"
+)
+
+// Prefixes of the algorithm flags in the protocol settings.
+const (
+	keyExchangePrefix    = "keyxchg-algo-"
+	encryptionPrefix     = "enc-algo-"
+	authenticationPrefix = "auth-algo-"
+)
+
+var (
+	keyExchanges    = []string{KeyExchangeRsa, KeyExchangeDhe, KeyExchangeEcdhe}
+	encryptions     = []string{Encryption3des, EncryptionRc4, EncryptionAes128Cbc, EncryptionAes256Cbc, EncryptionAes128Gcm, EncryptionAes256Gcm}
+	authentications = []string{AuthenticationSha1, AuthenticationSha256, AuthenticationSha384}
+)
@@ -0,0 +1,14 @@
+/*
+Package mgmttls is the client.Device.ManagementTls namespace.
+
+The TLS protocol versions and cipher suites offered by the management web
+interface and API come from the SSL/TLS service profile bound to the
+management interface.  This namespace manages that binding and the protocol
+settings of SSL/TLS service profiles.
+
+For Panorama, specify either the template ("tmpl") or the template stack
+("ts").
+
+Normalized object: Settings
+*/
+package mgmttls
@@ -0,0 +1,42 @@
+package mgmttls
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+func serviceProfile(con util.XapiClient, path []string) (string, error) {
+	var ans bindResp
+
+	if _, err := con.Get(path, nil, &ans); err != nil {
+		if err.Error() == "No such node" || err.Error() == "Object not found" {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return ans.Profile, nil
+}
+
+func setServiceProfile(con util.XapiClient, path []string, name string) error {
+	if name == "" {
+		_, err := con.Delete(path, nil, nil)
+		if err != nil && (err.Error() == "No such node" || err.Error() == "Object not found") {
+			return nil
+		}
+		return err
+	}
+
+	_, err := con.Edit(path, bindElm{Profile: name}, nil, nil)
+	return err
+}
+
+type bindElm struct {
+	XMLName xml.Name `xml:"ssl-tls-service-profile"`
+	Profile string   `xml:",chardata"`
+}
+
+type bindResp struct {
+	Profile string `xml:"result>ssl-tls-service-profile"`
+}
@@ -0,0 +1,108 @@
+package mgmttls
+
+import (
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwMgmtTls is a namespace struct, included as part of pango.Firewall.
+type FwMgmtTls struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *FwMgmtTls) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// ServiceProfile returns the name of the SSL/TLS service profile bound to the
+// management interface.  An empty string means the PAN-OS default is in use.
+func (c *FwMgmtTls) ServiceProfile() (string, error) {
+	c.con.LogQuery("(get) management ssl/tls service profile")
+	return serviceProfile(c.con, c.bindPath())
+}
+
+// SetServiceProfile binds the given SSL/TLS service profile to the management
+// interface.  An empty name removes the binding.
+func (c *FwMgmtTls) SetServiceProfile(name string) error {
+	c.con.LogAction("(edit) management ssl/tls service profile: %q", name)
+	return setServiceProfile(c.con, c.bindPath(), name)
+}
+
+// Show performs SHOW to retrieve the protocol settings of the given SSL/TLS
+// service profile.
+func (c *FwMgmtTls) Show(profile string) (Settings, error) {
+	c.con.LogQuery("(show) ssl/tls protocol settings for %q", profile)
+	return c.details(c.con.Show, profile)
+}
+
+// Get performs GET to retrieve the protocol settings of the given SSL/TLS
+// service profile.
+func (c *FwMgmtTls) Get(profile string) (Settings, error) {
+	c.con.LogQuery("(get) ssl/tls protocol settings for %q", profile)
+	return c.details(c.con.Get, profile)
+}
+
+// Set performs SET to update the protocol settings of the given SSL/TLS
+// service profile.
+func (c *FwMgmtTls) Set(profile string, e Settings) error {
+	var err error
+	_, fn := c.versioning()
+	c.con.LogAction("(set) ssl/tls protocol settings for %q", profile)
+
+	path := c.xpath(profile)
+	path = path[:len(path)-1]
+
+	_, err = c.con.Set(path, fn(e), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to update the protocol settings of the given SSL/TLS
+// service profile.
+func (c *FwMgmtTls) Edit(profile string, e Settings) error {
+	var err error
+	_, fn := c.versioning()
+	c.con.LogAction("(edit) ssl/tls protocol settings for %q", profile)
+
+	path := c.xpath(profile)
+
+	_, err = c.con.Edit(path, fn(e), nil, nil)
+	return err
+}
+
+/** Internal functions for the FwMgmtTls struct **/
+
+func (c *FwMgmtTls) versioning() (normalizer, func(Settings) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *FwMgmtTls) details(fn util.Retriever, profile string) (Settings, error) {
+	path := c.xpath(profile)
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Settings{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *FwMgmtTls) bindPath() []string {
+	return []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"deviceconfig",
+		"system",
+		"ssl-tls-service-profile",
+	}
+}
+
+func (c *FwMgmtTls) xpath(profile string) []string {
+	return []string{
+		"config",
+		"shared",
+		"ssl-tls-service-profile",
+		util.AsEntryXpath([]string{profile}),
+		"protocol-settings",
+	}
+}
@@ -0,0 +1,65 @@
+package mgmttls
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestFwNormalization(t *testing.T) {
+	testCases := getTests()
+
+	mc := &testdata.MockClient{}
+	ns := &FwMgmtTls{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.AddResp("")
+			err := ns.Edit("mgmt", tc.conf)
+			if err != nil {
+				t.Errorf("Error in edit: %s", err)
+			} else {
+				mc.AddResp(mc.Elm)
+				r, err := ns.Get("mgmt")
+				if err != nil {
+					t.Errorf("Error in get: %s", err)
+				} else if !reflect.DeepEqual(tc.conf, r) {
+					t.Errorf("%#v != %#v", tc.conf, r)
+				}
+			}
+		})
+	}
+}
+
+func TestFwServiceProfile(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &FwMgmtTls{}
+	ns.Initialize(mc)
+
+	mc.AddResp("")
+	if err := ns.SetServiceProfile("mgmt"); err != nil {
+		t.Fatalf("Error in set: %s", err)
+	}
+	if mc.Function != "edit" {
+		t.Errorf("Function is %q, not edit", mc.Function)
+	}
+
+	mc.AddResp(mc.Elm)
+	name, err := ns.ServiceProfile()
+	if err != nil {
+		t.Fatalf("Error in get: %s", err)
+	} else if name != "mgmt" {
+		t.Errorf("Profile is %q, not mgmt", name)
+	}
+
+	mc.AddResp("")
+	if err := ns.SetServiceProfile(""); err != nil {
+		t.Fatalf("Error in unset: %s", err)
+	}
+	if mc.Function != "delete" {
+		t.Errorf("Function is %q, not delete", mc.Function)
+	}
+}
@@ -0,0 +1,136 @@
+package mgmttls
+
+import (
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// PanoMgmtTls is a namespace struct, included as part of pango.Panorama.
+type PanoMgmtTls struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *PanoMgmtTls) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// ServiceProfile returns the name of the SSL/TLS service profile bound to the
+// management interface.  An empty string means the PAN-OS default is in use.
+func (c *PanoMgmtTls) ServiceProfile(tmpl, ts string) (string, error) {
+	if tmpl == "" && ts == "" {
+		return "", fmt.Errorf("tmpl or ts must be specified")
+	}
+
+	c.con.LogQuery("(get) management ssl/tls service profile")
+	return serviceProfile(c.con, c.bindPath(tmpl, ts))
+}
+
+// SetServiceProfile binds the given SSL/TLS service profile to the management
+// interface.  An empty name removes the binding.
+func (c *PanoMgmtTls) SetServiceProfile(tmpl, ts, name string) error {
+	if tmpl == "" && ts == "" {
+		return fmt.Errorf("tmpl or ts must be specified")
+	}
+
+	c.con.LogAction("(edit) management ssl/tls service profile: %q", name)
+	return setServiceProfile(c.con, c.bindPath(tmpl, ts), name)
+}
+
+// Show performs SHOW to retrieve the protocol settings of the given SSL/TLS
+// service profile.
+func (c *PanoMgmtTls) Show(tmpl, ts, profile string) (Settings, error) {
+	c.con.LogQuery("(show) ssl/tls protocol settings for %q", profile)
+	return c.details(c.con.Show, tmpl, ts, profile)
+}
+
+// Get performs GET to retrieve the protocol settings of the given SSL/TLS
+// service profile.
+func (c *PanoMgmtTls) Get(tmpl, ts, profile string) (Settings, error) {
+	c.con.LogQuery("(get) ssl/tls protocol settings for %q", profile)
+	return c.details(c.con.Get, tmpl, ts, profile)
+}
+
+// Set performs SET to update the protocol settings of the given SSL/TLS
+// service profile.
+func (c *PanoMgmtTls) Set(tmpl, ts, profile string, e Settings) error {
+	var err error
+
+	if tmpl == "" && ts == "" {
+		return fmt.Errorf("tmpl or ts must be specified")
+	}
+
+	_, fn := c.versioning()
+	c.con.LogAction("(set) ssl/tls protocol settings for %q", profile)
+
+	path := c.xpath(tmpl, ts, profile)
+	path = path[:len(path)-1]
+
+	_, err = c.con.Set(path, fn(e), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to update the protocol settings of the given SSL/TLS
+// service profile.
+func (c *PanoMgmtTls) Edit(tmpl, ts, profile string, e Settings) error {
+	var err error
+
+	if tmpl == "" && ts == "" {
+		return fmt.Errorf("tmpl or ts must be specified")
+	}
+
+	_, fn := c.versioning()
+	c.con.LogAction("(edit) ssl/tls protocol settings for %q", profile)
+
+	path := c.xpath(tmpl, ts, profile)
+
+	_, err = c.con.Edit(path, fn(e), nil, nil)
+	return err
+}
+
+/** Internal functions for the PanoMgmtTls struct **/
+
+func (c *PanoMgmtTls) versioning() (normalizer, func(Settings) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *PanoMgmtTls) details(fn util.Retriever, tmpl, ts, profile string) (Settings, error) {
+	path := c.xpath(tmpl, ts, profile)
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Settings{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *PanoMgmtTls) bindPath(tmpl, ts string) []string {
+	ans := make([]string, 0, 11)
+	ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"deviceconfig",
+		"system",
+		"ssl-tls-service-profile",
+	)
+
+	return ans
+}
+
+func (c *PanoMgmtTls) xpath(tmpl, ts, profile string) []string {
+	ans := make([]string, 0, 10)
+	ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
+	ans = append(ans,
+		"config",
+		"shared",
+		"ssl-tls-service-profile",
+		util.AsEntryXpath([]string{profile}),
+		"protocol-settings",
+	)
+
+	return ans
+}
@@ -0,0 +1,78 @@
+package mgmttls
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestPanoNormalization(t *testing.T) {
+	testCases := getTests()
+
+	mc := &testdata.MockClient{}
+	ns := &PanoMgmtTls{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.AddResp("")
+			err := ns.Edit("my template", "", "mgmt", tc.conf)
+			if err != nil {
+				t.Errorf("Error in edit: %s", err)
+			} else {
+				mc.AddResp(mc.Elm)
+				r, err := ns.Get("my template", "", "mgmt")
+				if err != nil {
+					t.Errorf("Error in get: %s", err)
+				} else if !reflect.DeepEqual(tc.conf, r) {
+					t.Errorf("%#v != %#v", tc.conf, r)
+				}
+			}
+		})
+	}
+}
+
+func TestPanoServiceProfile(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &PanoMgmtTls{}
+	ns.Initialize(mc)
+
+	mc.AddResp("")
+	if err := ns.SetServiceProfile("my template", "", "mgmt"); err != nil {
+		t.Fatalf("Error in set: %s", err)
+	}
+	if mc.Function != "edit" {
+		t.Errorf("Function is %q, not edit", mc.Function)
+	}
+
+	mc.AddResp(mc.Elm)
+	name, err := ns.ServiceProfile("my template", "")
+	if err != nil {
+		t.Fatalf("Error in get: %s", err)
+	} else if name != "mgmt" {
+		t.Errorf("Profile is %q, not mgmt", name)
+	}
+
+	mc.AddResp("")
+	if err := ns.SetServiceProfile("my template", "", ""); err != nil {
+		t.Fatalf("Error in unset: %s", err)
+	}
+	if mc.Function != "delete" {
+		t.Errorf("Function is %q, not delete", mc.Function)
+	}
+}
+
+func TestPanoRequiresTemplate(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &PanoMgmtTls{}
+	ns.Initialize(mc)
+
+	if err := ns.Edit("", "", "mgmt", Settings{}); err == nil {
+		t.Errorf("Edit without a template did not error")
+	}
+	if err := ns.SetServiceProfile("", "", "mgmt"); err == nil {
+		t.Errorf("SetServiceProfile without a template did not error")
+	}
+}
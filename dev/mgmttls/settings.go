@@ -0,0 +1,127 @@
+package mgmttls
+
+import (
+	"encoding/xml"
+	"strings"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Settings is a normalized, version independent representation of the
+// protocol settings of an SSL/TLS service profile.
+//
+// KeyExchange, Encryption, and Authentication are the allowed algorithms.
+// Leaving a list empty leaves those algorithms at the PAN-OS defaults, while
+// specifying a list disables all of the known algorithms not in the list.
+//
+// In FIPS-CC mode, PAN-OS rejects TLS versions before 1.1 as well as the 3DES
+// and RC4 algorithms.
+type Settings struct {
+	MinVersion     string
+	MaxVersion     string
+	KeyExchange    []string // unordered
+	Encryption     []string // unordered
+	Authentication []string // unordered
+}
+
+// Copy copies the information from source Settings `s` to this object.
+func (o *Settings) Copy(s Settings) {
+	o.MinVersion = s.MinVersion
+	o.MaxVersion = s.MaxVersion
+	o.KeyExchange = s.KeyExchange
+	o.Encryption = s.Encryption
+	o.Authentication = s.Authentication
+}
+
+/** Structs / functions for normalization. **/
+
+type normalizer interface {
+	Normalize() Settings
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>protocol-settings"`
+}
+
+func (o *container_v1) Normalize() Settings {
+	ans := Settings{
+		MinVersion: o.Answer.MinVersion,
+		MaxVersion: o.Answer.MaxVersion,
+	}
+
+	for _, x := range o.Answer.Algorithms {
+		if x.Value != "yes" {
+			continue
+		}
+		name := x.XMLName.Local
+		switch {
+		case strings.HasPrefix(name, keyExchangePrefix):
+			ans.KeyExchange = append(ans.KeyExchange, strings.TrimPrefix(name, keyExchangePrefix))
+		case strings.HasPrefix(name, encryptionPrefix):
+			ans.Encryption = append(ans.Encryption, strings.TrimPrefix(name, encryptionPrefix))
+		case strings.HasPrefix(name, authenticationPrefix):
+			ans.Authentication = append(ans.Authentication, strings.TrimPrefix(name, authenticationPrefix))
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName    xml.Name    `xml:"protocol-settings"`
+	MinVersion string      `xml:"min-version,omitempty"`
+	MaxVersion string      `xml:"max-version,omitempty"`
+	Algorithms []algorithm `xml:",any"`
+}
+
+type algorithm struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
+
+func specifyAlgorithms(prefix string, known, allowed []string) []algorithm {
+	if len(allowed) == 0 {
+		return nil
+	}
+
+	ans := make([]algorithm, 0, len(known)+len(allowed))
+	for _, name := range known {
+		ans = append(ans, algorithm{
+			XMLName: xml.Name{Local: prefix + name},
+			Value:   util.YesNo(contains(allowed, name)),
+		})
+	}
+	for _, name := range allowed {
+		if !contains(known, name) {
+			ans = append(ans, algorithm{
+				XMLName: xml.Name{Local: prefix + name},
+				Value:   "yes",
+			})
+		}
+	}
+
+	return ans
+}
+
+func contains(list []string, v string) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func specify_v1(e Settings) interface{} {
+	ans := entry_v1{
+		MinVersion: e.MinVersion,
+		MaxVersion: e.MaxVersion,
+	}
+
+	ans.Algorithms = append(ans.Algorithms, specifyAlgorithms(keyExchangePrefix, keyExchanges, e.KeyExchange)...)
+	ans.Algorithms = append(ans.Algorithms, specifyAlgorithms(encryptionPrefix, encryptions, e.Encryption)...)
+	ans.Algorithms = append(ans.Algorithms, specifyAlgorithms(authenticationPrefix, authentications, e.Authentication)...)
+
+	return ans
+}
@@ -0,0 +1,26 @@
+package mgmttls
+
+type testCase struct {
+	desc string
+	conf Settings
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"versions only", Settings{
+			MinVersion: VersionTls12,
+			MaxVersion: VersionMax,
+		}},
+		{"restricted ciphers", Settings{
+			MinVersion:     VersionTls12,
+			MaxVersion:     VersionTls13,
+			KeyExchange:    []string{KeyExchangeEcdhe},
+			Encryption:     []string{EncryptionAes128Gcm, EncryptionAes256Gcm},
+			Authentication: []string{AuthenticationSha256, AuthenticationSha384},
+		}},
+		{"unknown algorithm", Settings{
+			MinVersion: VersionTls11,
+			Encryption: []string{EncryptionAes256Gcm, "chacha20-poly1305"},
+		}},
+	}
+}
@@ -3,36 +3,60 @@ package dev
 import (
 	"github.com/PaloAltoNetworks/pango/util"
 
+	"github.com/PaloAltoNetworks/pango/dev/certificate"
+	"github.com/PaloAltoNetworks/pango/dev/devtelemetry"
+	"github.com/PaloAltoNetworks/pango/dev/mgmttls"
 	"github.com/PaloAltoNetworks/pango/dev/profile/email"
 	emailsrv "github.com/PaloAltoNetworks/pango/dev/profile/email/server"
 	"github.com/PaloAltoNetworks/pango/dev/profile/http"
 	"github.com/PaloAltoNetworks/pango/dev/profile/http/header"
 	"github.com/PaloAltoNetworks/pango/dev/profile/http/param"
 	httpsrv "github.com/PaloAltoNetworks/pango/dev/profile/http/server"
+	"github.com/PaloAltoNetworks/pango/dev/profile/mfa"
 	"github.com/PaloAltoNetworks/pango/dev/profile/snmp"
 	"github.com/PaloAltoNetworks/pango/dev/profile/snmp/v2c"
 	"github.com/PaloAltoNetworks/pango/dev/profile/snmp/v3"
+	"github.com/PaloAltoNetworks/pango/dev/profile/ssltls"
 	"github.com/PaloAltoNetworks/pango/dev/profile/syslog"
 	syslogsrv "github.com/PaloAltoNetworks/pango/dev/profile/syslog/server"
+	"github.com/PaloAltoNetworks/pango/dev/profile/tacacs"
+	"github.com/PaloAltoNetworks/pango/dev/ssh"
+	"github.com/PaloAltoNetworks/pango/dev/telemetry"
+	"github.com/PaloAltoNetworks/pango/dev/vminfo"
 )
 
 // PanoDev is the client.Device namespace.
 type PanoDev struct {
-	EmailServer         *emailsrv.PanoServer
-	EmailServerProfile  *email.PanoEmail
-	HttpHeader          *header.PanoHeader
-	HttpParam           *param.PanoParam
-	HttpServer          *httpsrv.PanoServer
-	HttpServerProfile   *http.PanoHttp
-	SnmpServerProfile   *snmp.PanoSnmp
-	SnmpV2cServer       *v2c.PanoV2c
-	SnmpV3Server        *v3.PanoV3
-	SyslogServer        *syslogsrv.PanoServer
-	SyslogServerProfile *syslog.PanoSyslog
+	Certificate          *certificate.PanoCertificate
+	DeviceTelemetry      *devtelemetry.PanoDeviceTelemetry
+	EmailServer          *emailsrv.PanoServer
+	EmailServerProfile   *email.PanoEmail
+	HttpHeader           *header.PanoHeader
+	HttpParam            *param.PanoParam
+	HttpServer           *httpsrv.PanoServer
+	HttpServerProfile    *http.PanoHttp
+	ManagementTls        *mgmttls.PanoMgmtTls
+	MfaServerProfile     *mfa.PanoMfa
+	SnmpServerProfile    *snmp.PanoSnmp
+	SnmpV2cServer        *v2c.PanoV2c
+	SnmpV3Server         *v3.PanoV3
+	SshServerProfile     *ssh.PanoSsh
+	SslTlsServiceProfile *ssltls.PanoSslTls
+	SyslogServer         *syslogsrv.PanoServer
+	SyslogServerProfile  *syslog.PanoSyslog
+	TacacsServerProfile  *tacacs.PanoTacacs
+	Telemetry            *telemetry.PanoTelemetry
+	VmInfoSource         *vminfo.PanoVmInfo
 }
 
 // Initialize is invoked on client.Initialize().
 func (c *PanoDev) Initialize(i util.XapiClient) {
+	c.Certificate = &certificate.PanoCertificate{}
+	c.Certificate.Initialize(i)
+
+	c.DeviceTelemetry = &devtelemetry.PanoDeviceTelemetry{}
+	c.DeviceTelemetry.Initialize(i)
+
 	c.EmailServer = &emailsrv.PanoServer{}
 	c.EmailServer.Initialize(i)
 
@@ -51,6 +75,12 @@ func (c *PanoDev) Initialize(i util.XapiClient) {
 	c.HttpServerProfile = &http.PanoHttp{}
 	c.HttpServerProfile.Initialize(i)
 
+	c.ManagementTls = &mgmttls.PanoMgmtTls{}
+	c.ManagementTls.Initialize(i)
+
+	c.MfaServerProfile = &mfa.PanoMfa{}
+	c.MfaServerProfile.Initialize(i)
+
 	c.SnmpServerProfile = &snmp.PanoSnmp{}
 	c.SnmpServerProfile.Initialize(i)
 
@@ -60,9 +90,24 @@ func (c *PanoDev) Initialize(i util.XapiClient) {
 	c.SnmpV3Server = &v3.PanoV3{}
 	c.SnmpV3Server.Initialize(i)
 
+	c.SshServerProfile = &ssh.PanoSsh{}
+	c.SshServerProfile.Initialize(i)
+
+	c.SslTlsServiceProfile = &ssltls.PanoSslTls{}
+	c.SslTlsServiceProfile.Initialize(i)
+
 	c.SyslogServer = &syslogsrv.PanoServer{}
 	c.SyslogServer.Initialize(i)
 
 	c.SyslogServerProfile = &syslog.PanoSyslog{}
 	c.SyslogServerProfile.Initialize(i)
+
+	c.TacacsServerProfile = &tacacs.PanoTacacs{}
+	c.TacacsServerProfile.Initialize(i)
+
+	c.Telemetry = &telemetry.PanoTelemetry{}
+	c.Telemetry.Initialize(i)
+
+	c.VmInfoSource = &vminfo.PanoVmInfo{}
+	c.VmInfoSource.Initialize(i)
 }
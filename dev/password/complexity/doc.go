@@ -0,0 +1,6 @@
+/*
+Package complexity is the firewall.Device.PasswordComplexity namespace.
+
+Normalized object: Settings
+*/
+package complexity
@@ -0,0 +1,86 @@
+package complexity
+
+import (
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwComplexity is a namespace struct, included as part of pango.Firewall.
+type FwComplexity struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *FwComplexity) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// Show performs SHOW to retrieve password complexity settings.
+func (c *FwComplexity) Show() (Settings, error) {
+	c.con.LogQuery("(show) password complexity settings")
+	return c.details(c.con.Show)
+}
+
+// Get performs GET to retrieve password complexity settings.
+func (c *FwComplexity) Get() (Settings, error) {
+	c.con.LogQuery("(get) password complexity settings")
+	return c.details(c.con.Get)
+}
+
+// Set performs SET to update password complexity settings.
+func (c *FwComplexity) Set(e Settings) error {
+	var err error
+	_, fn := c.versioning()
+	c.con.LogAction("(set) password complexity settings")
+
+	path := c.xpath()
+	path = path[:len(path)-1]
+
+	_, err = c.con.Set(path, fn(e), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to update password complexity settings.
+func (c *FwComplexity) Edit(e Settings) error {
+	var err error
+	_, fn := c.versioning()
+	c.con.LogAction("(edit) password complexity settings")
+
+	path := c.xpath()
+
+	_, err = c.con.Edit(path, fn(e), nil, nil)
+	return err
+}
+
+// Delete removes the password complexity policy from the firewall.
+func (c *FwComplexity) Delete() error {
+	c.con.LogAction("(delete) password complexity settings")
+	path := c.xpath()
+
+	_, err := c.con.Delete(path, nil, nil)
+	return err
+}
+
+/** Internal functions for the FwComplexity struct **/
+
+func (c *FwComplexity) versioning() (normalizer, func(Settings) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *FwComplexity) details(fn util.Retriever) (Settings, error) {
+	path := c.xpath()
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Settings{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *FwComplexity) xpath() []string {
+	return []string{
+		"config",
+		"mgt-config",
+		"password-complexity",
+	}
+}
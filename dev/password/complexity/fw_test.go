@@ -0,0 +1,61 @@
+package complexity
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestFwNormalization(t *testing.T) {
+	testCases := []struct {
+		desc string
+		conf Settings
+	}{
+		{"disabled", Settings{}},
+		{"no expiration", Settings{
+			Enabled:                 true,
+			MinimumLength:           12,
+			MinimumUppercase:        1,
+			MinimumLowercase:        1,
+			MinimumNumeric:          1,
+			MinimumSpecial:          1,
+			BlockRepeatedCharacters: 2,
+			BlockUsernameInclusion:  true,
+			NewPasswordDiffersBy:    3,
+			ChangeOnFirstLogin:      true,
+			PasswordHistoryCount:    5,
+		}},
+		{"with expiration", Settings{
+			Enabled:                   true,
+			MinimumLength:             8,
+			ExpirationPeriod:          90,
+			ExpirationWarningPeriod:   7,
+			PostExpirationAdminLogins: 1,
+			PostExpirationGracePeriod: 3,
+		}},
+	}
+
+	mc := &testdata.MockClient{}
+	ns := &FwComplexity{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.AddResp("")
+			err := ns.Set(tc.conf)
+			if err != nil {
+				t.Errorf("Error in set: %s", err)
+			} else {
+				mc.AddResp(mc.Elm)
+				r, err := ns.Get()
+				if err != nil {
+					t.Errorf("Error in get: %s", err)
+				} else if !reflect.DeepEqual(tc.conf, r) {
+					t.Errorf("%#v != %#v", tc.conf, r)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package complexity
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Settings is a normalized, version independent representation of the
+// administrator password complexity policy.
+type Settings struct {
+	Enabled                   bool
+	MinimumLength             int
+	MinimumUppercase          int
+	MinimumLowercase          int
+	MinimumNumeric            int
+	MinimumSpecial            int
+	BlockRepeatedCharacters   int
+	BlockUsernameInclusion    bool
+	NewPasswordDiffersBy      int
+	ChangeOnFirstLogin        bool
+	PasswordHistoryCount      int
+	ExpirationPeriod          int
+	ExpirationWarningPeriod   int
+	PostExpirationAdminLogins int
+	PostExpirationGracePeriod int
+}
+
+// Copy copies the information from source Settings `s` to this object.
+func (o *Settings) Copy(s Settings) {
+	o.Enabled = s.Enabled
+	o.MinimumLength = s.MinimumLength
+	o.MinimumUppercase = s.MinimumUppercase
+	o.MinimumLowercase = s.MinimumLowercase
+	o.MinimumNumeric = s.MinimumNumeric
+	o.MinimumSpecial = s.MinimumSpecial
+	o.BlockRepeatedCharacters = s.BlockRepeatedCharacters
+	o.BlockUsernameInclusion = s.BlockUsernameInclusion
+	o.NewPasswordDiffersBy = s.NewPasswordDiffersBy
+	o.ChangeOnFirstLogin = s.ChangeOnFirstLogin
+	o.PasswordHistoryCount = s.PasswordHistoryCount
+	o.ExpirationPeriod = s.ExpirationPeriod
+	o.ExpirationWarningPeriod = s.ExpirationWarningPeriod
+	o.PostExpirationAdminLogins = s.PostExpirationAdminLogins
+	o.PostExpirationGracePeriod = s.PostExpirationGracePeriod
+}
+
+/** Structs / functions for normalization. **/
+
+type normalizer interface {
+	Normalize() Settings
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>password-complexity"`
+}
+
+func (o *container_v1) Normalize() Settings {
+	ans := Settings{
+		Enabled:                 util.AsBool(o.Answer.Enabled),
+		MinimumLength:           o.Answer.MinimumLength,
+		MinimumUppercase:        o.Answer.MinimumUppercase,
+		MinimumLowercase:        o.Answer.MinimumLowercase,
+		MinimumNumeric:          o.Answer.MinimumNumeric,
+		MinimumSpecial:          o.Answer.MinimumSpecial,
+		BlockRepeatedCharacters: o.Answer.BlockRepeatedCharacters,
+		BlockUsernameInclusion:  util.AsBool(o.Answer.BlockUsernameInclusion),
+		NewPasswordDiffersBy:    o.Answer.NewPasswordDiffersBy,
+		ChangeOnFirstLogin:      util.AsBool(o.Answer.ChangeOnFirstLogin),
+		PasswordHistoryCount:    o.Answer.PasswordHistoryCount,
+	}
+
+	if o.Answer.Change != nil {
+		ans.ExpirationPeriod = o.Answer.Change.ExpirationPeriod
+		ans.ExpirationWarningPeriod = o.Answer.Change.ExpirationWarningPeriod
+		ans.PostExpirationAdminLogins = o.Answer.Change.PostExpirationAdminLogins
+		ans.PostExpirationGracePeriod = o.Answer.Change.PostExpirationGracePeriod
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName                 xml.Name `xml:"password-complexity"`
+	Enabled                 string   `xml:"enabled"`
+	MinimumLength           int      `xml:"minimum-length,omitempty"`
+	MinimumUppercase        int      `xml:"minimum-uppercase-letters,omitempty"`
+	MinimumLowercase        int      `xml:"minimum-lowercase-letters,omitempty"`
+	MinimumNumeric          int      `xml:"minimum-numeric-letters,omitempty"`
+	MinimumSpecial          int      `xml:"minimum-special-characters,omitempty"`
+	BlockRepeatedCharacters int      `xml:"block-repeated-characters,omitempty"`
+	BlockUsernameInclusion  string   `xml:"block-username-inclusion,omitempty"`
+	NewPasswordDiffersBy    int      `xml:"new-password-differs-by-characters,omitempty"`
+	ChangeOnFirstLogin      string   `xml:"password-change-on-first-login,omitempty"`
+	PasswordHistoryCount    int      `xml:"password-history-count,omitempty"`
+	Change                  *change  `xml:"password-change"`
+}
+
+type change struct {
+	ExpirationPeriod          int `xml:"expiration-period,omitempty"`
+	ExpirationWarningPeriod   int `xml:"expiration-warning-period,omitempty"`
+	PostExpirationAdminLogins int `xml:"post-expiration-admin-login-count,omitempty"`
+	PostExpirationGracePeriod int `xml:"post-expiration-grace-period,omitempty"`
+}
+
+func specify_v1(e Settings) interface{} {
+	ans := entry_v1{
+		Enabled:                 util.YesNo(e.Enabled),
+		MinimumLength:           e.MinimumLength,
+		MinimumUppercase:        e.MinimumUppercase,
+		MinimumLowercase:        e.MinimumLowercase,
+		MinimumNumeric:          e.MinimumNumeric,
+		MinimumSpecial:          e.MinimumSpecial,
+		BlockRepeatedCharacters: e.BlockRepeatedCharacters,
+		BlockUsernameInclusion:  util.YesNo(e.BlockUsernameInclusion),
+		NewPasswordDiffersBy:    e.NewPasswordDiffersBy,
+		ChangeOnFirstLogin:      util.YesNo(e.ChangeOnFirstLogin),
+		PasswordHistoryCount:    e.PasswordHistoryCount,
+	}
+
+	if e.ExpirationPeriod != 0 || e.ExpirationWarningPeriod != 0 || e.PostExpirationAdminLogins != 0 || e.PostExpirationGracePeriod != 0 {
+		ans.Change = &change{
+			ExpirationPeriod:          e.ExpirationPeriod,
+			ExpirationWarningPeriod:   e.ExpirationWarningPeriod,
+			PostExpirationAdminLogins: e.PostExpirationAdminLogins,
+			PostExpirationGracePeriod: e.PostExpirationGracePeriod,
+		}
+	}
+
+	return ans
+}
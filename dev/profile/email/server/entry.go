@@ -2,6 +2,8 @@ package server
 
 import (
 	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
 )
 
 // Entry is a normalized, version independent representation of an email server.
@@ -26,6 +28,16 @@ func (o *Entry) Copy(s Entry) {
 	o.EmailGateway = s.EmailGateway
 }
 
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
 /** Structs / functions for this namespace. **/
 
 type normalizer interface {
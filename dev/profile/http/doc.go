@@ -10,6 +10,44 @@ set "dg" to "shared" (which is also the default).
 To manage objects in a template, specify the template name and the vsys (if
 unspecified, defaults to "shared").
 
+An HTTP server profile is made up of several pieces, each managed by its own
+namespace:
+
+  - the profile itself, along with the per-log-type name, URI format, and
+    payload format (this package)
+  - the servers to send logs to (the server package)
+  - the HTTP headers sent for each log type (the header package)
+  - the HTTP parameters sent for each log type (the param package)
+
+The servers, headers, and params are subconfig of the profile.  Set merges
+into the existing config, so it updates the profile while leaving its
+servers, headers, and params in place.  Edit replaces the profile, so only use
+Edit with an Entry retrieved using Get, as that Entry carries the subconfig
+along.  Use SetWithoutSubconfig to replace the profile and clear out any
+existing servers and formats.
+
+As an example, forwarding threat logs to a webhook might look like this:
+
+	prof := http.Entry{
+		Name: "webhook",
+		ThreatName: "threat-to-webhook",
+		ThreatUriFormat: "/api/events",
+		ThreatPayload: `{"severity": "$severity", "threat": "$threatid"}`,
+	}
+	err = fw.Device.HttpServerProfile.Set("vsys1", prof)
+
+	srv := server.Entry{
+		Name: "hook1",
+		Address: "hooks.example.com",
+		Protocol: server.ProtocolHttps,
+		Port: 443,
+		HttpMethod: "POST",
+	}
+	err = fw.Device.HttpServer.Set("vsys1", prof.Name, srv)
+
+	hdr := header.Entry{Name: "Content-Type", Value: "application/json"}
+	err = fw.Device.HttpHeader.Set("vsys1", prof.Name, header.Threat, hdr)
+
 Normalized object:  Entry
 */
 package http
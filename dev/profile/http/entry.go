@@ -106,6 +106,16 @@ func (o *Entry) Copy(s Entry) {
 	o.IptagPayload = s.IptagPayload
 }
 
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
 /** Structs / functions for this namespace. **/
 
 type normalizer interface {
@@ -2,6 +2,8 @@ package param
 
 import (
 	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
 )
 
 // Entry is a normalized, version independent representation of an http param.
@@ -18,6 +20,16 @@ func (o *Entry) Copy(s Entry) {
 	o.Value = s.Value
 }
 
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
 /** Structs / functions for this namespace. **/
 
 type normalizer interface {
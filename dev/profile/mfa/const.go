@@ -0,0 +1,14 @@
+package mfa
+
+// Valid values for Vendor.
+const (
+	VendorOkta   = "okta-adaptive-v1"
+	VendorDuo    = "duo-security-v2"
+	VendorPingId = "ping-identity-v1"
+	VendorRsa    = "rsa-securid-access-v1"
+)
+
+const (
+	singular = "mfa server profile"
+	plural   = "mfa server profiles"
+)
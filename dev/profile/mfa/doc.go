@@ -0,0 +1,12 @@
+/*
+Package mfa is the client.Device.MfaServerProfile namespace.
+
+MFA server profiles configure the multi-factor authentication vendor that
+authentication profiles use for additional authentication factors.
+
+For Panorama, this object is managed inside of a template.  Specify the
+template name and the vsys (if unspecified, defaults to "shared").
+
+Normalized object:  Entry
+*/
+package mfa
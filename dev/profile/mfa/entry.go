@@ -0,0 +1,201 @@
+package mfa
+
+import (
+	"encoding/xml"
+	"strconv"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an MFA
+// server profile.
+//
+// The vendor config fields that are used depend on the Vendor:
+//
+//      * VendorOkta: ApiHost, BaseUri, Token, Org, Timeout
+//      * VendorDuo: ApiHost, BaseUri, IntegrationKey, SecretKey, Timeout
+//      * VendorPingId: ApiHost, BaseUri, Token, Org, OrgAlias, Timeout
+//
+// Config for other vendors is preserved as-is when this object is updated.
+//
+// PAN-OS 8.0+.
+type Entry struct {
+	Name               string
+	CertificateProfile string
+	Vendor             string
+	ApiHost            string
+	BaseUri            string
+	Token              string // encrypted
+	Org                string
+	OrgAlias           string
+	IntegrationKey     string
+	SecretKey          string // encrypted
+	Timeout            int
+
+	raw []configEntry
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.CertificateProfile = s.CertificateProfile
+	o.Vendor = s.Vendor
+	o.ApiHost = s.ApiHost
+	o.BaseUri = s.BaseUri
+	o.Token = s.Token
+	o.Org = s.Org
+	o.OrgAlias = s.OrgAlias
+	o.IntegrationKey = s.IntegrationKey
+	o.SecretKey = s.SecretKey
+	o.Timeout = s.Timeout
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this MFA server profile.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+// configKeys maps each vendor's config names to the Entry fields.
+var configKeys = map[string][]struct {
+	key   string
+	field func(*Entry) *string
+}{
+	VendorOkta: {
+		{"okta-adaptive-api-host", func(e *Entry) *string { return &e.ApiHost }},
+		{"okta-adaptive-baseuri", func(e *Entry) *string { return &e.BaseUri }},
+		{"okta-adaptive-token", func(e *Entry) *string { return &e.Token }},
+		{"okta-adaptive-org", func(e *Entry) *string { return &e.Org }},
+	},
+	VendorDuo: {
+		{"duo-api-host", func(e *Entry) *string { return &e.ApiHost }},
+		{"duo-baseuri", func(e *Entry) *string { return &e.BaseUri }},
+		{"duo-integration-key", func(e *Entry) *string { return &e.IntegrationKey }},
+		{"duo-secret-key", func(e *Entry) *string { return &e.SecretKey }},
+	},
+	VendorPingId: {
+		{"ping-api-host", func(e *Entry) *string { return &e.ApiHost }},
+		{"ping-baseuri", func(e *Entry) *string { return &e.BaseUri }},
+		{"ping-token", func(e *Entry) *string { return &e.Token }},
+		{"ping-org", func(e *Entry) *string { return &e.Org }},
+		{"ping-org-alias", func(e *Entry) *string { return &e.OrgAlias }},
+	},
+}
+
+// timeoutKeys is the name of each vendor's timeout config.
+var timeoutKeys = map[string]string{
+	VendorOkta:   "okta-adaptive-timeout",
+	VendorDuo:    "duo-timeout",
+	VendorPingId: "ping-timeout",
+}
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:               o.Name,
+		CertificateProfile: o.CertificateProfile,
+		Vendor:             o.Vendor,
+	}
+
+	if o.Config == nil {
+		return ans
+	}
+
+	keys := configKeys[ans.Vendor]
+	tkey := timeoutKeys[ans.Vendor]
+	for _, x := range o.Config.Entries {
+		found := false
+		if tkey != "" && x.Name == tkey {
+			if v, err := strconv.Atoi(x.Value); err == nil {
+				ans.Timeout = v
+				found = true
+			}
+		}
+		for _, k := range keys {
+			if k.key == x.Name {
+				*k.field(&ans) = x.Value
+				found = true
+				break
+			}
+		}
+		if !found {
+			ans.raw = append(ans.raw, x)
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName            xml.Name `xml:"entry"`
+	Name               string   `xml:"name,attr"`
+	CertificateProfile string   `xml:"mfa-cert-profile,omitempty"`
+	Vendor             string   `xml:"mfa-vendor-type,omitempty"`
+	Config             *config  `xml:"mfa-config"`
+}
+
+type config struct {
+	Entries []configEntry `xml:"entry"`
+}
+
+type configEntry struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:               e.Name,
+		CertificateProfile: e.CertificateProfile,
+		Vendor:             e.Vendor,
+	}
+
+	var list []configEntry
+	for _, k := range configKeys[e.Vendor] {
+		if v := *k.field(&e); v != "" {
+			list = append(list, configEntry{Name: k.key, Value: v})
+		}
+	}
+	if tkey := timeoutKeys[e.Vendor]; tkey != "" && e.Timeout != 0 {
+		list = append(list, configEntry{Name: tkey, Value: strconv.Itoa(e.Timeout)})
+	}
+	list = append(list, e.raw...)
+
+	if len(list) > 0 {
+		ans.Config = &config{Entries: list}
+	}
+
+	return ans
+}
@@ -0,0 +1,55 @@
+package mfa
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"okta", Entry{
+			Name:               "okta",
+			CertificateProfile: "cp1",
+			Vendor:             VendorOkta,
+			ApiHost:            "example.okta.com",
+			BaseUri:            "/api/v1",
+			Token:              "secret",
+			Org:                "example",
+			Timeout:            30,
+		}},
+		{"duo", Entry{
+			Name:               "duo",
+			CertificateProfile: "cp1",
+			Vendor:             VendorDuo,
+			ApiHost:            "api-1234.duosecurity.com",
+			BaseUri:            "/auth/v2",
+			IntegrationKey:     "DIXXXX",
+			SecretKey:          "secret",
+			Timeout:            60,
+		}},
+		{"pingid", Entry{
+			Name:               "ping",
+			CertificateProfile: "cp2",
+			Vendor:             VendorPingId,
+			ApiHost:            "idpxnyl3m.pingidentity.com",
+			BaseUri:            "/pingid/rest/4",
+			Token:              "secret",
+			Org:                "org1",
+			OrgAlias:           "alias1",
+		}},
+		{"other vendor", Entry{
+			Name:               "rsa",
+			CertificateProfile: "cp3",
+			Vendor:             VendorRsa,
+			raw: []configEntry{
+				{Name: "rsa-api-host", Value: "rsa.example.com"},
+				{Name: "rsa-accesskey", Value: "secret"},
+			},
+		}},
+		{"no vendor config", Entry{
+			Name:               "empty",
+			CertificateProfile: "cp1",
+			Vendor:             VendorOkta,
+		}},
+	}
+}
@@ -0,0 +1,16 @@
+package ssltls
+
+// Valid values for MinVersion and MaxVersion.  VersionMax is only valid for
+// MaxVersion, and means the latest version that PAN-OS supports.
+const (
+	VersionTls10 = "tls1-0"
+	VersionTls11 = "tls1-1"
+	VersionTls12 = "tls1-2"
+	VersionTls13 = "tls1-3"
+	VersionMax   = "max"
+)
+
+const (
+	singular = "ssl/tls service profile"
+	plural   = "ssl/tls service profiles"
+)
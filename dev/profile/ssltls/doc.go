@@ -0,0 +1,19 @@
+/*
+Package ssltls is the client.Device.SslTlsServiceProfile namespace.
+
+SSL/TLS service profiles specify the certificate and the allowed protocol
+versions for services that accept TLS connections, such as the management
+interface, GlobalProtect portals and gateways, and syslog over TLS.
+
+The allowed ciphers of a profile are managed with the mgmttls namespace, and
+are left as-is when a profile is updated with this namespace.
+
+For firewalls, the vsys defaults to "shared" if unspecified.
+
+For Panorama, specify the template ("tmpl") or template stack ("ts") along
+with the vsys (defaults to "shared") to manage firewall profiles, or leave
+both empty to manage Panorama's own profiles.
+
+Normalized object:  Entry
+*/
+package ssltls
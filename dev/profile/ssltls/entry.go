@@ -0,0 +1,133 @@
+package ssltls
+
+import (
+	"encoding/xml"
+	"sort"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an SSL/TLS
+// service profile.
+type Entry struct {
+	Name        string
+	Certificate string
+	MinVersion  string
+	MaxVersion  string
+
+	raw map[string]string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Certificate = s.Certificate
+	o.MinVersion = s.MinVersion
+	o.MaxVersion = s.MaxVersion
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this SSL/TLS service profile.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:        o.Name,
+		Certificate: o.Certificate,
+	}
+
+	if o.Protocol != nil {
+		ans.MinVersion = o.Protocol.MinVersion
+		ans.MaxVersion = o.Protocol.MaxVersion
+
+		if len(o.Protocol.Algorithms) > 0 {
+			ans.raw = make(map[string]string, len(o.Protocol.Algorithms))
+			for _, x := range o.Protocol.Algorithms {
+				ans.raw[x.XMLName.Local] = x.Value
+			}
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName     xml.Name  `xml:"entry"`
+	Name        string    `xml:"name,attr"`
+	Certificate string    `xml:"certificate,omitempty"`
+	Protocol    *protocol `xml:"protocol-settings"`
+}
+
+type protocol struct {
+	MinVersion string      `xml:"min-version,omitempty"`
+	MaxVersion string      `xml:"max-version,omitempty"`
+	Algorithms []algorithm `xml:",any"`
+}
+
+type algorithm struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:        e.Name,
+		Certificate: e.Certificate,
+	}
+
+	if e.MinVersion != "" || e.MaxVersion != "" || len(e.raw) > 0 {
+		p := &protocol{
+			MinVersion: e.MinVersion,
+			MaxVersion: e.MaxVersion,
+		}
+		keys := make([]string, 0, len(e.raw))
+		for key := range e.raw {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			p.Algorithms = append(p.Algorithms, algorithm{
+				XMLName: xml.Name{Local: key},
+				Value:   e.raw[key],
+			})
+		}
+		ans.Protocol = p
+	}
+
+	return ans
+}
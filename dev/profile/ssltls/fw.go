@@ -0,0 +1,90 @@
+package ssltls
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwSslTls is a namespace struct, included as part of pango.Firewall.
+type FwSslTls struct {
+	ns *namespace.Standard[Entry]
+}
+
+// Initialize is invoked when Initialize on the pango.Firewall is called.
+func (c *FwSslTls) Initialize(con util.XapiClient) {
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *FwSslTls) GetList(vsys string) ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(vsys, nil))
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *FwSslTls) ShowList(vsys string) ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(vsys, nil))
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *FwSslTls) Get(vsys, name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath(vsys, []string{name}), name)
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *FwSslTls) GetAll(vsys string) ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(vsys, nil))
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *FwSslTls) Show(vsys, name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath(vsys, []string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *FwSslTls) ShowAll(vsys string) ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(vsys, nil))
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *FwSslTls) Set(vsys string, e ...Entry) error {
+	return c.ns.SetEntries(c.pather(vsys), e...)
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *FwSslTls) Edit(vsys string, e Entry) error {
+	return c.ns.EditEntry(c.pather(vsys), e)
+}
+
+// Delete removes the given objects.
+//
+// Objects can be either a string or an Entry object.
+func (c *FwSslTls) Delete(vsys string, e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(vsys), e...)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *FwSslTls) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *FwSslTls) pather(vsys string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(vsys, v)
+	}
+}
+
+func (c *FwSslTls) xpath(vsys string, vals []string) []string {
+	if vsys == "" {
+		vsys = "shared"
+	}
+
+	ans := make([]string, 0, 7)
+	ans = append(ans, util.VsysXpathPrefix(vsys)...)
+	ans = append(ans,
+		"ssl-tls-service-profile",
+		util.AsEntryXpath(vals),
+	)
+
+	return ans
+}
@@ -0,0 +1,58 @@
+package ssltls
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestFwNormalization(t *testing.T) {
+	testCases := getTests()
+
+	mc := &testdata.MockClient{}
+	ns := &FwSslTls{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.AddResp("")
+			err := ns.Set("", tc.conf)
+			if err != nil {
+				t.Errorf("Error in set: %s", err)
+			} else {
+				mc.AddResp(mc.Elm)
+				r, err := ns.Get("", tc.conf.Name)
+				if err != nil {
+					t.Errorf("Error in get: %s", err)
+				} else if !reflect.DeepEqual(tc.conf, r) {
+					t.Errorf("%#v != %#v", tc.conf, r)
+				}
+			}
+		})
+	}
+}
+
+func TestFwPreservesCiphers(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &FwSslTls{}
+	ns.Initialize(mc)
+
+	mc.AddResp(`<entry name="t1"><certificate>c1</certificate><protocol-settings><min-version>tls1-1</min-version><enc-algo-rc4>no</enc-algo-rc4><auth-algo-sha1>no</auth-algo-sha1></protocol-settings></entry>`)
+	e, err := ns.Get("", "t1")
+	if err != nil {
+		t.Fatalf("Error in get: %s", err)
+	}
+
+	e.MinVersion = VersionTls12
+	mc.AddResp("")
+	if err = ns.Edit("", e); err != nil {
+		t.Fatalf("Error in edit: %s", err)
+	}
+
+	expected := `<entry name="t1"><certificate>c1</certificate><protocol-settings><min-version>tls1-2</min-version><auth-algo-sha1>no</auth-algo-sha1><enc-algo-rc4>no</enc-algo-rc4></protocol-settings></entry>`
+	if mc.Elm != expected {
+		t.Errorf("Elm is %s", mc.Elm)
+	}
+}
@@ -0,0 +1,100 @@
+package ssltls
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// PanoSslTls is a namespace struct, included as part of pango.Panorama.
+type PanoSslTls struct {
+	ns *namespace.Standard[Entry]
+}
+
+// Initialize is invoked when Initialize on the pango.Panorama is called.
+func (c *PanoSslTls) Initialize(con util.XapiClient) {
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *PanoSslTls) GetList(tmpl, ts, vsys string) ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(tmpl, ts, vsys, nil))
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *PanoSslTls) ShowList(tmpl, ts, vsys string) ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(tmpl, ts, vsys, nil))
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *PanoSslTls) Get(tmpl, ts, vsys, name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath(tmpl, ts, vsys, []string{name}), name)
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *PanoSslTls) GetAll(tmpl, ts, vsys string) ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(tmpl, ts, vsys, nil))
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *PanoSslTls) Show(tmpl, ts, vsys, name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath(tmpl, ts, vsys, []string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *PanoSslTls) ShowAll(tmpl, ts, vsys string) ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(tmpl, ts, vsys, nil))
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *PanoSslTls) Set(tmpl, ts, vsys string, e ...Entry) error {
+	return c.ns.SetEntries(c.pather(tmpl, ts, vsys), e...)
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *PanoSslTls) Edit(tmpl, ts, vsys string, e Entry) error {
+	return c.ns.EditEntry(c.pather(tmpl, ts, vsys), e)
+}
+
+// Delete removes the given objects.
+//
+// Objects can be either a string or an Entry object.
+func (c *PanoSslTls) Delete(tmpl, ts, vsys string, e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(tmpl, ts, vsys), e...)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *PanoSslTls) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *PanoSslTls) pather(tmpl, ts, vsys string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(tmpl, ts, vsys, v)
+	}
+}
+
+func (c *PanoSslTls) xpath(tmpl, ts, vsys string, vals []string) []string {
+	if tmpl == "" && ts == "" {
+		return []string{
+			"config",
+			"panorama",
+			"ssl-tls-service-profile",
+			util.AsEntryXpath(vals),
+		}
+	}
+
+	if vsys == "" {
+		vsys = "shared"
+	}
+
+	ans := make([]string, 0, 12)
+	ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
+	ans = append(ans, util.VsysXpathPrefix(vsys)...)
+	ans = append(ans,
+		"ssl-tls-service-profile",
+		util.AsEntryXpath(vals),
+	)
+
+	return ans
+}
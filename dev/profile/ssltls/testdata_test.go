@@ -0,0 +1,21 @@
+package ssltls
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"certificate only", Entry{
+			Name:        "t1",
+			Certificate: "c1",
+		}},
+		{"versions", Entry{
+			Name:        "t2",
+			Certificate: "c2",
+			MinVersion:  VersionTls12,
+			MaxVersion:  VersionMax,
+		}},
+	}
+}
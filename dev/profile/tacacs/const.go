@@ -0,0 +1,12 @@
+package tacacs
+
+// Valid values for Protocol.
+const (
+	ProtocolChap = "CHAP"
+	ProtocolPap  = "PAP"
+)
+
+const (
+	singular = "tacacs+ server profile"
+	plural   = "tacacs+ server profiles"
+)
@@ -0,0 +1,12 @@
+/*
+Package tacacs is the client.Device.TacacsServerProfile namespace.
+
+TACACS+ server profiles are referenced by authentication profiles.  Setting
+AdminUseOnly restricts the profile to administrator authentication.
+
+For Panorama, this object is managed inside of a template.  Specify the
+template name and the vsys (if unspecified, defaults to "shared").
+
+Normalized object:  Entry
+*/
+package tacacs
@@ -0,0 +1,153 @@
+package tacacs
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a TACACS+
+// server profile.
+//
+// PAN-OS 8.0+.
+type Entry struct {
+	Name                string
+	AdminUseOnly        bool
+	Timeout             int
+	UseSingleConnection bool
+	Protocol            string
+	Servers             []Server
+}
+
+// Server is a TACACS+ server.
+type Server struct {
+	Name    string
+	Address string
+	Secret  string // encrypted
+	Port    int
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.AdminUseOnly = s.AdminUseOnly
+	o.Timeout = s.Timeout
+	o.UseSingleConnection = s.UseSingleConnection
+	o.Protocol = s.Protocol
+	if s.Servers == nil {
+		o.Servers = nil
+	} else {
+		o.Servers = make([]Server, len(s.Servers))
+		copy(o.Servers, s.Servers)
+	}
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this TACACS+ server profile.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:                o.Name,
+		AdminUseOnly:        util.AsBool(o.AdminUseOnly),
+		Timeout:             o.Timeout,
+		UseSingleConnection: util.AsBool(o.UseSingleConnection),
+		Protocol:            o.Protocol,
+	}
+
+	if o.Servers != nil {
+		list := make([]Server, 0, len(o.Servers.Entries))
+		for _, x := range o.Servers.Entries {
+			list = append(list, Server{
+				Name:    x.Name,
+				Address: x.Address,
+				Secret:  x.Secret,
+				Port:    x.Port,
+			})
+		}
+		ans.Servers = list
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName             xml.Name `xml:"entry"`
+	Name                string   `xml:"name,attr"`
+	AdminUseOnly        string   `xml:"admin-use-only"`
+	Timeout             int      `xml:"timeout,omitempty"`
+	UseSingleConnection string   `xml:"use-single-connection"`
+	Protocol            string   `xml:"protocol,omitempty"`
+	Servers             *servers `xml:"server"`
+}
+
+type servers struct {
+	Entries []server `xml:"entry"`
+}
+
+type server struct {
+	Name    string `xml:"name,attr"`
+	Address string `xml:"address"`
+	Secret  string `xml:"secret,omitempty"`
+	Port    int    `xml:"port,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:                e.Name,
+		AdminUseOnly:        util.YesNo(e.AdminUseOnly),
+		Timeout:             e.Timeout,
+		UseSingleConnection: util.YesNo(e.UseSingleConnection),
+		Protocol:            e.Protocol,
+	}
+
+	if len(e.Servers) > 0 {
+		list := make([]server, 0, len(e.Servers))
+		for _, x := range e.Servers {
+			list = append(list, server{
+				Name:    x.Name,
+				Address: x.Address,
+				Secret:  x.Secret,
+				Port:    x.Port,
+			})
+		}
+		ans.Servers = &servers{Entries: list}
+	}
+
+	return ans
+}
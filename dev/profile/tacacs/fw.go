@@ -0,0 +1,91 @@
+package tacacs
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwTacacs is a namespace struct, included as part of pango.Firewall.
+type FwTacacs struct {
+	ns *namespace.Standard[Entry]
+}
+
+// Initialize is invoked when Initialize on the pango.Firewall is called.
+func (c *FwTacacs) Initialize(con util.XapiClient) {
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *FwTacacs) GetList(vsys string) ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(vsys, nil))
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *FwTacacs) ShowList(vsys string) ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(vsys, nil))
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *FwTacacs) Get(vsys, name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath(vsys, []string{name}), name)
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *FwTacacs) GetAll(vsys string) ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(vsys, nil))
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *FwTacacs) Show(vsys, name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath(vsys, []string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *FwTacacs) ShowAll(vsys string) ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(vsys, nil))
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *FwTacacs) Set(vsys string, e ...Entry) error {
+	return c.ns.SetEntries(c.pather(vsys), e...)
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *FwTacacs) Edit(vsys string, e Entry) error {
+	return c.ns.EditEntry(c.pather(vsys), e)
+}
+
+// Delete removes the given objects.
+//
+// Objects can be either a string or an Entry object.
+func (c *FwTacacs) Delete(vsys string, e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(vsys), e...)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *FwTacacs) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *FwTacacs) pather(vsys string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(vsys, v)
+	}
+}
+
+func (c *FwTacacs) xpath(vsys string, vals []string) []string {
+	if vsys == "" {
+		vsys = "shared"
+	}
+
+	ans := make([]string, 0, 8)
+	ans = append(ans, util.VsysXpathPrefix(vsys)...)
+	ans = append(ans,
+		"server-profile",
+		"tacplus",
+		util.AsEntryXpath(vals),
+	)
+
+	return ans
+}
@@ -0,0 +1,33 @@
+package tacacs
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"no servers", Entry{
+			Name:     "t1",
+			Timeout:  3,
+			Protocol: ProtocolChap,
+		}},
+		{"admin use only", Entry{
+			Name:                "t2",
+			AdminUseOnly:        true,
+			UseSingleConnection: true,
+			Protocol:            ProtocolPap,
+			Servers: []Server{
+				{Name: "s1", Address: "10.1.1.1", Secret: "secret", Port: 49},
+			},
+		}},
+		{"multiple servers", Entry{
+			Name:    "t3",
+			Timeout: 10,
+			Servers: []Server{
+				{Name: "s1", Address: "10.1.1.1", Secret: "secret", Port: 49},
+				{Name: "s2", Address: "tacacs.example.com", Port: 4949},
+			},
+		}},
+	}
+}
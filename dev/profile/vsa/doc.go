@@ -0,0 +1,11 @@
+/*
+Package vsa contains the Palo Alto Networks vendor-specific attributes (VSAs)
+that RADIUS and TACACS+ servers return to PAN-OS.
+
+When an authentication profile uses a RADIUS or TACACS+ server profile for
+administrator authentication, the server assigns the admin role and access
+domain by returning these attributes, so no local administrator account is
+needed.  This package builds and parses those attributes for server-side
+configuration and auditing tools.
+*/
+package vsa
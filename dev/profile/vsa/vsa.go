@@ -0,0 +1,117 @@
+package vsa
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// VendorId is the IANA enterprise number of Palo Alto Networks, used as the
+// RADIUS vendor ID.
+const VendorId = 25461
+
+// Palo Alto Networks vendor-specific attribute names.
+const (
+	AdminRole                 = "PaloAlto-Admin-Role"
+	AdminAccessDomain         = "PaloAlto-Admin-Access-Domain"
+	PanoramaAdminRole         = "PaloAlto-Panorama-Admin-Role"
+	PanoramaAdminAccessDomain = "PaloAlto-Panorama-Admin-Access-Domain"
+	UserGroup                 = "PaloAlto-User-Group"
+)
+
+// attributeIds maps each attribute name to its RADIUS attribute number.
+var attributeIds = map[string]int{
+	AdminRole:                 1,
+	AdminAccessDomain:         2,
+	PanoramaAdminRole:         3,
+	PanoramaAdminAccessDomain: 4,
+	UserGroup:                 5,
+}
+
+// AttributeId returns the RADIUS attribute number of the given attribute
+// name, or 0 if the name is not a known attribute.
+func AttributeId(name string) int {
+	return attributeIds[name]
+}
+
+// Admin is the admin role assignment returned by a RADIUS or TACACS+ server.
+//
+// Role is either a dynamic role (such as "superuser") or the name of an
+// admin role profile.
+type Admin struct {
+	Role                 string
+	AccessDomain         string
+	PanoramaRole         string
+	PanoramaAccessDomain string
+	UserGroup            string
+}
+
+// Attributes returns the non-empty attributes as a map of attribute name to
+// value.
+func (o Admin) Attributes() map[string]string {
+	ans := make(map[string]string)
+	for name, value := range map[string]string{
+		AdminRole:                 o.Role,
+		AdminAccessDomain:         o.AccessDomain,
+		PanoramaAdminRole:         o.PanoramaRole,
+		PanoramaAdminAccessDomain: o.PanoramaAccessDomain,
+		UserGroup:                 o.UserGroup,
+	} {
+		if value != "" {
+			ans[name] = value
+		}
+	}
+
+	return ans
+}
+
+// TacacsArgs returns the attributes as TACACS+ "name=value" arguments, sorted
+// by attribute number.
+func (o Admin) TacacsArgs() []string {
+	attrs := o.Attributes()
+	names := make([]string, 0, len(attrs))
+	for name := range attrs {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return attributeIds[names[i]] < attributeIds[names[j]]
+	})
+
+	ans := make([]string, 0, len(names))
+	for _, name := range names {
+		ans = append(ans, fmt.Sprintf("%s=%s", name, attrs[name]))
+	}
+
+	return ans
+}
+
+// ParseTacacsArgs parses TACACS+ "name=value" arguments into an Admin.
+//
+// Arguments that are not Palo Alto Networks attributes are ignored.  An error
+// is returned if an argument is malformed.
+func ParseTacacsArgs(args []string) (Admin, error) {
+	var ans Admin
+
+	for _, arg := range args {
+		idx := strings.IndexAny(arg, "=*")
+		if idx == -1 {
+			return Admin{}, fmt.Errorf("malformed argument: %q", arg)
+		}
+		name, value := strings.TrimSpace(arg[:idx]), strings.TrimSpace(arg[idx+1:])
+
+		switch name {
+		case AdminRole:
+			ans.Role = value
+		case AdminAccessDomain:
+			ans.AccessDomain = value
+		case PanoramaAdminRole:
+			ans.PanoramaRole = value
+		case PanoramaAdminAccessDomain:
+			ans.PanoramaAccessDomain = value
+		case UserGroup:
+			ans.UserGroup = value
+		}
+	}
+
+	return ans, nil
+}
@@ -0,0 +1,57 @@
+package vsa
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTacacsArgs(t *testing.T) {
+	a := Admin{
+		Role:         "superuser",
+		UserGroup:    "admins",
+		AccessDomain: "dom1",
+	}
+
+	args := a.TacacsArgs()
+	expected := []string{
+		"PaloAlto-Admin-Role=superuser",
+		"PaloAlto-Admin-Access-Domain=dom1",
+		"PaloAlto-User-Group=admins",
+	}
+	if !reflect.DeepEqual(args, expected) {
+		t.Errorf("Got %#v, expected %#v", args, expected)
+	}
+
+	r, err := ParseTacacsArgs(append(args, "service=PaloAlto"))
+	if err != nil {
+		t.Fatalf("Error in parse: %s", err)
+	}
+	if !reflect.DeepEqual(a, r) {
+		t.Errorf("%#v != %#v", a, r)
+	}
+}
+
+func TestParseTacacsArgsOptional(t *testing.T) {
+	r, err := ParseTacacsArgs([]string{"PaloAlto-Panorama-Admin-Role*panorama-admin"})
+	if err != nil {
+		t.Fatalf("Error in parse: %s", err)
+	}
+	if r.PanoramaRole != "panorama-admin" {
+		t.Errorf("Panorama role is %q", r.PanoramaRole)
+	}
+}
+
+func TestParseTacacsArgsMalformed(t *testing.T) {
+	if _, err := ParseTacacsArgs([]string{"PaloAlto-Admin-Role"}); err == nil {
+		t.Errorf("Expected an error")
+	}
+}
+
+func TestAttributeId(t *testing.T) {
+	if AttributeId(PanoramaAdminRole) != 3 {
+		t.Errorf("Panorama admin role id is %d", AttributeId(PanoramaAdminRole))
+	}
+	if AttributeId("foo") != 0 {
+		t.Errorf("Unknown attribute has an id")
+	}
+}
@@ -0,0 +1,19 @@
+package ssh
+
+// Valid values for the `svc` param.
+const (
+	ServiceMgmt = "mgmt"
+	ServiceHa   = "ha"
+)
+
+// Valid values for HostKeyType.
+const (
+	HostKeyEcdsa   = "ECDSA"
+	HostKeyRsa     = "RSA"
+	HostKeyEd25519 = "ED25519"
+)
+
+const (
+	singular = "ssh server profile"
+	plural   = "ssh server profiles"
+)
@@ -0,0 +1,16 @@
+/*
+Package ssh is the client.Device.SshServerProfile namespace.
+
+SSH server profiles restrict the ciphers, key exchange algorithms, and MACs
+offered by the management and HA SSH services, and set their host key and
+session rekey parameters.  A profile only takes effect once it is bound to
+its service with Bind and the SSH service is restarted with RestartService.
+
+Only valid for PAN-OS 10.0+.
+
+For Panorama, specify the template ("tmpl") or template stack ("ts") to manage
+firewall SSH profiles, or leave both empty to manage Panorama's own.
+
+Normalized object:  Entry
+*/
+package ssh
@@ -0,0 +1,207 @@
+package ssh
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an SSH
+// server profile.
+//
+// Ciphers, KeyExchanges, and Macs are the algorithm names as they appear in
+// the PAN-OS config (such as "aes256-gcm", "ecdh-sha2-nistp256", and
+// "hmac-sha2-256").  Leaving any of these empty allows the PAN-OS defaults.
+//
+// HostKeyLength is only used for ECDSA and RSA host keys.
+type Entry struct {
+	Name          string
+	Ciphers       []string // unordered
+	KeyExchanges  []string // unordered
+	Macs          []string // unordered
+	HostKeyType   string
+	HostKeyLength int
+	RekeyDataMb   int
+	RekeyInterval int
+	RekeyPackets  int
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Ciphers = s.Ciphers
+	o.KeyExchanges = s.KeyExchanges
+	o.Macs = s.Macs
+	o.HostKeyType = s.HostKeyType
+	o.HostKeyLength = s.HostKeyLength
+	o.RekeyDataMb = s.RekeyDataMb
+	o.RekeyInterval = s.RekeyInterval
+	o.RekeyPackets = s.RekeyPackets
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this SSH server profile.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:         o.Name,
+		Ciphers:      o.Ciphers.names(),
+		KeyExchanges: o.KeyExchanges.names(),
+		Macs:         o.Macs.names(),
+	}
+
+	if o.HostKey != nil && o.HostKey.KeyType != nil {
+		switch {
+		case o.HostKey.KeyType.Ecdsa != 0:
+			ans.HostKeyType = HostKeyEcdsa
+			ans.HostKeyLength = o.HostKey.KeyType.Ecdsa
+		case o.HostKey.KeyType.Rsa != 0:
+			ans.HostKeyType = HostKeyRsa
+			ans.HostKeyLength = o.HostKey.KeyType.Rsa
+		case o.HostKey.KeyType.Ed25519 != nil:
+			ans.HostKeyType = HostKeyEd25519
+		}
+	}
+
+	if o.Rekey != nil {
+		ans.RekeyInterval = o.Rekey.Interval
+		ans.RekeyPackets = o.Rekey.Packets
+		if o.Rekey.Data != nil {
+			ans.RekeyDataMb = o.Rekey.Data.Volume
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName      xml.Name  `xml:"entry"`
+	Name         string    `xml:"name,attr"`
+	Ciphers      *flagList `xml:"ciphers"`
+	KeyExchanges *flagList `xml:"kex"`
+	Macs         *flagList `xml:"mac"`
+	HostKey      *hostKey  `xml:"default-hostkey"`
+	Rekey        *rekey    `xml:"session-rekey"`
+}
+
+// flagList is a list of empty elements, where the element names are the
+// values of the list.
+type flagList struct {
+	Flags []flag `xml:",any"`
+}
+
+type flag struct {
+	XMLName xml.Name
+}
+
+func (o *flagList) names() []string {
+	if o == nil {
+		return nil
+	}
+
+	ans := make([]string, 0, len(o.Flags))
+	for _, x := range o.Flags {
+		ans = append(ans, x.XMLName.Local)
+	}
+
+	return ans
+}
+
+func specifyFlags(v []string) *flagList {
+	if len(v) == 0 {
+		return nil
+	}
+
+	ans := &flagList{Flags: make([]flag, 0, len(v))}
+	for _, name := range v {
+		ans.Flags = append(ans.Flags, flag{XMLName: xml.Name{Local: name}})
+	}
+
+	return ans
+}
+
+type hostKey struct {
+	KeyType *keyType `xml:"key-type"`
+}
+
+type keyType struct {
+	Ecdsa   int       `xml:"ECDSA,omitempty"`
+	Rsa     int       `xml:"RSA,omitempty"`
+	Ed25519 *struct{} `xml:"ED25519"`
+}
+
+type rekey struct {
+	Data     *rekeyData `xml:"data"`
+	Interval int        `xml:"interval,omitempty"`
+	Packets  int        `xml:"packets,omitempty"`
+}
+
+type rekeyData struct {
+	Volume int `xml:"volume,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:         e.Name,
+		Ciphers:      specifyFlags(e.Ciphers),
+		KeyExchanges: specifyFlags(e.KeyExchanges),
+		Macs:         specifyFlags(e.Macs),
+	}
+
+	switch e.HostKeyType {
+	case HostKeyEcdsa:
+		ans.HostKey = &hostKey{&keyType{Ecdsa: e.HostKeyLength}}
+	case HostKeyRsa:
+		ans.HostKey = &hostKey{&keyType{Rsa: e.HostKeyLength}}
+	case HostKeyEd25519:
+		ans.HostKey = &hostKey{&keyType{Ed25519: &struct{}{}}}
+	}
+
+	if e.RekeyDataMb != 0 || e.RekeyInterval != 0 || e.RekeyPackets != 0 {
+		ans.Rekey = &rekey{
+			Interval: e.RekeyInterval,
+			Packets:  e.RekeyPackets,
+		}
+		if e.RekeyDataMb != 0 {
+			ans.Rekey.Data = &rekeyData{e.RekeyDataMb}
+		}
+	}
+
+	return ans
+}
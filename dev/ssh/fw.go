@@ -0,0 +1,133 @@
+package ssh
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwSsh is a namespace struct, included as part of pango.Firewall.
+type FwSsh struct {
+	con util.XapiClient
+	ns  *namespace.Standard[Entry]
+}
+
+// Initialize is invoked when Initialize on the pango.Firewall is called.
+func (c *FwSsh) Initialize(con util.XapiClient) {
+	c.con = con
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *FwSsh) GetList(svc string) ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(svc, nil))
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *FwSsh) ShowList(svc string) ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(svc, nil))
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *FwSsh) Get(svc, name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath(svc, []string{name}), name)
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *FwSsh) GetAll(svc string) ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(svc, nil))
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *FwSsh) Show(svc, name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath(svc, []string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *FwSsh) ShowAll(svc string) ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(svc, nil))
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *FwSsh) Set(svc string, e ...Entry) error {
+	return c.ns.SetEntries(c.pather(svc), e...)
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *FwSsh) Edit(svc string, e Entry) error {
+	return c.ns.EditEntry(c.pather(svc), e)
+}
+
+// Delete removes the given objects.
+//
+// Objects can be either a string or an Entry object.
+func (c *FwSsh) Delete(svc string, e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(svc), e...)
+}
+
+// Bind sets the given SSH server profile as the profile used by the `svc`
+// SSH service.  An empty profile name removes the binding.
+//
+// The SSH service must be restarted for the change to take effect.
+func (c *FwSsh) Bind(svc, profile string) error {
+	return bind(c.con, c.bindPath(svc), svc, profile)
+}
+
+// Bound returns the name of the SSH server profile that the `svc` SSH
+// service is using.  An empty string is returned if no profile is bound.
+func (c *FwSsh) Bound(svc string) (string, error) {
+	return bound(c.con, c.bindPath(svc))
+}
+
+// RegenerateHostKeys regenerates the host keys of the `svc` SSH service, using
+// the given key type and length.
+//
+// The key length is ignored for ED25519 keys.  The SSH service must be
+// restarted for the new host keys to be used.
+func (c *FwSsh) RegenerateHostKeys(svc, keyType string, length int) error {
+	return regenerateHostKeys(c.con, svc, keyType, length)
+}
+
+// RestartService restarts the `svc` SSH service, which applies any profile
+// or host key changes.
+//
+// Restarting the management SSH service disconnects all SSH sessions.
+func (c *FwSsh) RestartService(svc string) error {
+	return restartService(c.con, svc)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *FwSsh) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *FwSsh) pather(svc string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(svc, v)
+	}
+}
+
+func (c *FwSsh) xpath(svc string, vals []string) []string {
+	ans := make([]string, 0, 10)
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+	)
+	ans = append(ans, profilesXpath(svc)...)
+	ans = append(ans, util.AsEntryXpath(vals))
+
+	return ans
+}
+
+func (c *FwSsh) bindPath(svc string) []string {
+	ans := make([]string, 0, 11)
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+	)
+	ans = append(ans, bindXpath(svc)...)
+
+	return ans
+}
@@ -0,0 +1,139 @@
+package ssh
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// PanoSsh is a namespace struct, included as part of pango.Panorama.
+type PanoSsh struct {
+	con util.XapiClient
+	ns  *namespace.Standard[Entry]
+}
+
+// Initialize is invoked when Initialize on the pango.Panorama is called.
+func (c *PanoSsh) Initialize(con util.XapiClient) {
+	c.con = con
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *PanoSsh) GetList(tmpl, ts, svc string) ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(tmpl, ts, svc, nil))
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *PanoSsh) ShowList(tmpl, ts, svc string) ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(tmpl, ts, svc, nil))
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *PanoSsh) Get(tmpl, ts, svc, name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath(tmpl, ts, svc, []string{name}), name)
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *PanoSsh) GetAll(tmpl, ts, svc string) ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(tmpl, ts, svc, nil))
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *PanoSsh) Show(tmpl, ts, svc, name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath(tmpl, ts, svc, []string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *PanoSsh) ShowAll(tmpl, ts, svc string) ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(tmpl, ts, svc, nil))
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *PanoSsh) Set(tmpl, ts, svc string, e ...Entry) error {
+	return c.ns.SetEntries(c.pather(tmpl, ts, svc), e...)
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *PanoSsh) Edit(tmpl, ts, svc string, e Entry) error {
+	return c.ns.EditEntry(c.pather(tmpl, ts, svc), e)
+}
+
+// Delete removes the given objects.
+//
+// Objects can be either a string or an Entry object.
+func (c *PanoSsh) Delete(tmpl, ts, svc string, e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(tmpl, ts, svc), e...)
+}
+
+// Bind sets the given SSH server profile as the profile used by the `svc`
+// SSH service.  An empty profile name removes the binding.
+//
+// The SSH service must be restarted for the change to take effect.
+func (c *PanoSsh) Bind(tmpl, ts, svc, profile string) error {
+	return bind(c.con, c.bindPath(tmpl, ts, svc), svc, profile)
+}
+
+// Bound returns the name of the SSH server profile that the `svc` SSH
+// service is using.  An empty string is returned if no profile is bound.
+func (c *PanoSsh) Bound(tmpl, ts, svc string) (string, error) {
+	return bound(c.con, c.bindPath(tmpl, ts, svc))
+}
+
+// RegenerateHostKeys regenerates Panorama's own host keys for the `svc` SSH
+// service, using the given key type and length.
+//
+// The key length is ignored for ED25519 keys.  The SSH service must be
+// restarted for the new host keys to be used.
+func (c *PanoSsh) RegenerateHostKeys(svc, keyType string, length int) error {
+	return regenerateHostKeys(c.con, svc, keyType, length)
+}
+
+// RestartService restarts Panorama's own `svc` SSH service, which applies any
+// profile or host key changes.
+//
+// Restarting the management SSH service disconnects all SSH sessions.
+func (c *PanoSsh) RestartService(svc string) error {
+	return restartService(c.con, svc)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *PanoSsh) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *PanoSsh) pather(tmpl, ts, svc string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(tmpl, ts, svc, v)
+	}
+}
+
+func (c *PanoSsh) xpath(tmpl, ts, svc string, vals []string) []string {
+	ans := make([]string, 0, 15)
+	if tmpl != "" || ts != "" {
+		ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
+	}
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+	)
+	ans = append(ans, profilesXpath(svc)...)
+	ans = append(ans, util.AsEntryXpath(vals))
+
+	return ans
+}
+
+func (c *PanoSsh) bindPath(tmpl, ts, svc string) []string {
+	ans := make([]string, 0, 11)
+	if tmpl != "" || ts != "" {
+		ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
+	}
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+	)
+	ans = append(ans, bindXpath(svc)...)
+
+	return ans
+}
@@ -0,0 +1,60 @@
+package ssh
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestPanoNormalization(t *testing.T) {
+	testCases := getTests()
+
+	mc := &testdata.MockClient{}
+	ns := &PanoSsh{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.AddResp("")
+			err := ns.Set("my template", "", ServiceMgmt, tc.conf)
+			if err != nil {
+				t.Errorf("Error in set: %s", err)
+			} else {
+				mc.AddResp(mc.Elm)
+				r, err := ns.Get("my template", "", ServiceMgmt, tc.conf.Name)
+				if err != nil {
+					t.Errorf("Error in get: %s", err)
+				} else if !reflect.DeepEqual(tc.conf, r) {
+					t.Errorf("%#v != %#v", tc.conf, r)
+				}
+			}
+		})
+	}
+}
+
+func TestPanoBind(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &PanoSsh{}
+	ns.Initialize(mc)
+
+	mc.AddResp("")
+	if err := ns.Bind("", "", ServiceHa, "p1"); err != nil {
+		t.Fatalf("Error in bind: %s", err)
+	}
+	if mc.Function != "edit" {
+		t.Errorf("Function is %q, not edit", mc.Function)
+	}
+	if mc.Elm != "<ha-profile>p1</ha-profile>" {
+		t.Errorf("Elm is %q", mc.Elm)
+	}
+
+	mc.AddResp(mc.Elm)
+	name, err := ns.Bound("", "", ServiceHa)
+	if err != nil {
+		t.Fatalf("Error in bound: %s", err)
+	} else if name != "p1" {
+		t.Errorf("Bound is %q, not p1", name)
+	}
+}
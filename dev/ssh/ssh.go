@@ -0,0 +1,143 @@
+package ssh
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+/** Internal functions and structs **/
+
+func regenerateHostKeys(con util.XapiClient, svc, keyType string, length int) error {
+	req := regenReq{}
+	kt := &regenKeyType{}
+	switch keyType {
+	case HostKeyEcdsa:
+		kt.Ecdsa = &regenKeyLength{length}
+	case HostKeyRsa:
+		kt.Rsa = &regenKeyLength{length}
+	case HostKeyEd25519:
+		kt.Ed25519 = &struct{}{}
+	default:
+		return fmt.Errorf("unknown host key type: %q", keyType)
+	}
+
+	switch svc {
+	case "", ServiceMgmt:
+		req.Mgmt = &regenSvc{kt}
+	case ServiceHa:
+		req.Ha = &regenSvc{kt}
+	default:
+		return fmt.Errorf("unknown ssh service: %q", svc)
+	}
+
+	con.LogOp("(op) regenerating %s ssh host keys", svc)
+	_, err := con.Op(req, "", nil, nil)
+	return err
+}
+
+func restartService(con util.XapiClient, svc string) error {
+	req := restartReq{}
+	switch svc {
+	case "", ServiceMgmt:
+		req.Mgmt = &struct{}{}
+	case ServiceHa:
+		req.Ha = &struct{}{}
+	default:
+		return fmt.Errorf("unknown ssh service: %q", svc)
+	}
+
+	con.LogOp("(op) restarting %s ssh service", svc)
+	_, err := con.Op(req, "", nil, nil)
+	return err
+}
+
+func profilesXpath(svc string) []string {
+	ans := []string{"deviceconfig", "system", "ssh", "profiles"}
+	if svc == ServiceHa {
+		return append(ans, "ha-profiles")
+	}
+
+	return append(ans, "mgmt-profiles", "server-profiles")
+}
+
+func bindXpath(svc string) []string {
+	if svc == ServiceHa {
+		return []string{"deviceconfig", "system", "ssh", "ha", "ha-profile"}
+	}
+
+	return []string{"deviceconfig", "system", "ssh", "mgmt", "server-profile"}
+}
+
+func bind(con util.XapiClient, path []string, svc, profile string) error {
+	if profile == "" {
+		con.LogAction("(delete) %s ssh server profile binding", svc)
+		_, err := con.Delete(path, nil, nil)
+		if err != nil && (err.Error() == "No such node" || err.Error() == "Object not found") {
+			return nil
+		}
+		return err
+	}
+
+	con.LogAction("(edit) %s ssh server profile binding: %q", svc, profile)
+	elm := bindElm{
+		XMLName: xml.Name{Local: path[len(path)-1]},
+		Profile: profile,
+	}
+	_, err := con.Edit(path, elm, nil, nil)
+	return err
+}
+
+func bound(con util.XapiClient, path []string) (string, error) {
+	var ans bindResp
+
+	con.LogQuery("(get) ssh server profile binding")
+	if _, err := con.Get(path, nil, &ans); err != nil {
+		if err.Error() == "No such node" || err.Error() == "Object not found" {
+			return "", nil
+		}
+		return "", err
+	}
+
+	if ans.Ha != "" {
+		return ans.Ha, nil
+	}
+	return ans.Profile, nil
+}
+
+type bindElm struct {
+	XMLName xml.Name
+	Profile string `xml:",chardata"`
+}
+
+type bindResp struct {
+	Profile string `xml:"result>server-profile"`
+	Ha      string `xml:"result>ha-profile"`
+}
+
+type regenReq struct {
+	XMLName xml.Name  `xml:"set"`
+	Mgmt    *regenSvc `xml:"ssh>regenerate-hostkeys>mgmt"`
+	Ha      *regenSvc `xml:"ssh>regenerate-hostkeys>ha"`
+}
+
+type regenSvc struct {
+	KeyType *regenKeyType `xml:"key-type"`
+}
+
+type regenKeyType struct {
+	Ecdsa   *regenKeyLength `xml:"ECDSA"`
+	Rsa     *regenKeyLength `xml:"RSA"`
+	Ed25519 *struct{}       `xml:"ED25519"`
+}
+
+type regenKeyLength struct {
+	Length int `xml:"key-length"`
+}
+
+type restartReq struct {
+	XMLName xml.Name  `xml:"set"`
+	Mgmt    *struct{} `xml:"ssh>service-restart>mgmt"`
+	Ha      *struct{} `xml:"ssh>service-restart>ha"`
+}
@@ -0,0 +1,86 @@
+package ssh
+
+import (
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+func TestXpath(t *testing.T) {
+	fw := &FwSsh{}
+	pano := &PanoSsh{}
+
+	testCases := []struct {
+		desc string
+		path []string
+		want string
+	}{
+		{"fw mgmt", fw.xpath(ServiceMgmt, []string{"p1"}), "/config/devices/entry[@name='localhost.localdomain']/deviceconfig/system/ssh/profiles/mgmt-profiles/server-profiles/entry[@name='p1']"},
+		{"fw ha", fw.xpath(ServiceHa, []string{"p1"}), "/config/devices/entry[@name='localhost.localdomain']/deviceconfig/system/ssh/profiles/ha-profiles/entry[@name='p1']"},
+		{"fw bind mgmt", fw.bindPath(""), "/config/devices/entry[@name='localhost.localdomain']/deviceconfig/system/ssh/mgmt/server-profile"},
+		{"pano local", pano.xpath("", "", ServiceMgmt, nil), "/config/devices/entry[@name='localhost.localdomain']/deviceconfig/system/ssh/profiles/mgmt-profiles/server-profiles/entry"},
+		{"pano template", pano.xpath("t1", "", ServiceHa, nil), "/config/devices/entry[@name='localhost.localdomain']/template/entry[@name='t1']/config/devices/entry[@name='localhost.localdomain']/deviceconfig/system/ssh/profiles/ha-profiles/entry"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := util.AsXpath(tc.path); got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRegenerateHostKeys(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		svc     string
+		keyType string
+		length  int
+		want    string
+	}{
+		{"mgmt ecdsa", ServiceMgmt, HostKeyEcdsa, 256, "<set><ssh><regenerate-hostkeys><mgmt><key-type><ECDSA><key-length>256</key-length></ECDSA></key-type></mgmt></regenerate-hostkeys></ssh></set>"},
+		{"ha rsa", ServiceHa, HostKeyRsa, 2048, "<set><ssh><regenerate-hostkeys><ha><key-type><RSA><key-length>2048</key-length></RSA></key-type></ha></regenerate-hostkeys></ssh></set>"},
+		{"mgmt ed25519", "", HostKeyEd25519, 0, "<set><ssh><regenerate-hostkeys><mgmt><key-type><ED25519></ED25519></key-type></mgmt></regenerate-hostkeys></ssh></set>"},
+	}
+
+	mc := &testdata.MockClient{}
+	ns := &FwSsh{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.AddResp("")
+			if err := ns.RegenerateHostKeys(tc.svc, tc.keyType, tc.length); err != nil {
+				t.Fatalf("Error: %s", err)
+			}
+			if mc.Elm != tc.want {
+				t.Errorf("got %s, want %s", mc.Elm, tc.want)
+			}
+		})
+	}
+
+	if err := ns.RegenerateHostKeys(ServiceMgmt, "DSA", 1024); err == nil {
+		t.Errorf("Unknown key type did not error")
+	}
+}
+
+func TestRestartService(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &FwSsh{}
+	ns.Initialize(mc)
+
+	mc.AddResp("")
+	if err := ns.RestartService(ServiceHa); err != nil {
+		t.Fatalf("Error: %s", err)
+	}
+	if mc.Elm != "<set><ssh><service-restart><ha></ha></service-restart></ssh></set>" {
+		t.Errorf("Elm is %s", mc.Elm)
+	}
+
+	if err := ns.RestartService("bogus"); err == nil {
+		t.Errorf("Unknown service did not error")
+	}
+}
@@ -0,0 +1,37 @@
+package ssh
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"defaults", Entry{
+			Name: "t1",
+		}},
+		{"algorithms", Entry{
+			Name:         "t2",
+			Ciphers:      []string{"aes256-ctr", "aes256-gcm"},
+			KeyExchanges: []string{"ecdh-sha2-nistp256"},
+			Macs:         []string{"hmac-sha2-256", "hmac-sha2-512"},
+		}},
+		{"ecdsa host key", Entry{
+			Name:          "t3",
+			HostKeyType:   HostKeyEcdsa,
+			HostKeyLength: 384,
+		}},
+		{"rsa host key with rekey", Entry{
+			Name:          "t4",
+			HostKeyType:   HostKeyRsa,
+			HostKeyLength: 4096,
+			RekeyDataMb:   64,
+			RekeyInterval: 3600,
+			RekeyPackets:  28,
+		}},
+		{"ed25519 host key", Entry{
+			Name:        "t5",
+			HostKeyType: HostKeyEd25519,
+		}},
+	}
+}
@@ -1,5 +1,12 @@
 /*
-Package telemetry is the firewall.Device.Telemetry namespace.
+Package telemetry is the firewall.Device.Telemetry and
+panorama.Device.Telemetry namespace.
+
+This is the statistics service, which is replaced by device telemetry (the
+devtelemetry package) as of PAN-OS 10.0.
+
+For Panorama, the settings are managed in a template or template stack so
+they can be pushed out to the firewalls.
 
 Normalized object: Settings
 */
@@ -0,0 +1,110 @@
+package telemetry
+
+import (
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// PanoTelemetry is a namespace struct, included as part of pango.Panorama.
+type PanoTelemetry struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *PanoTelemetry) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// Show performs SHOW to retrieve telemetry sharing settings.
+func (c *PanoTelemetry) Show(tmpl, ts string) (Settings, error) {
+	c.con.LogQuery("(show) telemetry settings")
+	return c.details(c.con.Show, tmpl, ts)
+}
+
+// Get performs GET to retrieve telemetry sharing settings.
+func (c *PanoTelemetry) Get(tmpl, ts string) (Settings, error) {
+	c.con.LogQuery("(get) telemetry settings")
+	return c.details(c.con.Get, tmpl, ts)
+}
+
+// Set performs SET to update telemetry sharing settings.
+func (c *PanoTelemetry) Set(tmpl, ts string, e Settings) error {
+	var err error
+
+	if tmpl == "" && ts == "" {
+		return fmt.Errorf("tmpl or ts must be specified")
+	}
+
+	_, fn := c.versioning()
+	c.con.LogAction("(set) telemetry settings")
+
+	path := c.xpath(tmpl, ts)
+	path = path[:len(path)-1]
+
+	_, err = c.con.Set(path, fn(e), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to update telemetry sharing settings.
+func (c *PanoTelemetry) Edit(tmpl, ts string, e Settings) error {
+	var err error
+
+	if tmpl == "" && ts == "" {
+		return fmt.Errorf("tmpl or ts must be specified")
+	}
+
+	_, fn := c.versioning()
+	c.con.LogAction("(edit) telemetry settings")
+
+	path := c.xpath(tmpl, ts)
+
+	_, err = c.con.Edit(path, fn(e), nil, nil)
+	return err
+}
+
+// Delete removes all telemetry sharing from the template.
+func (c *PanoTelemetry) Delete(tmpl, ts string) error {
+	if tmpl == "" && ts == "" {
+		return fmt.Errorf("tmpl or ts must be specified")
+	}
+
+	c.con.LogAction("(delete) telemetry settings")
+	path := c.xpath(tmpl, ts)
+
+	_, err := c.con.Delete(path, nil, nil)
+	return err
+}
+
+/** Internal functions for the PanoTelemetry struct **/
+
+func (c *PanoTelemetry) versioning() (normalizer, func(Settings) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *PanoTelemetry) details(fn util.Retriever, tmpl, ts string) (Settings, error) {
+	path := c.xpath(tmpl, ts)
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Settings{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *PanoTelemetry) xpath(tmpl, ts string) []string {
+	ans := make([]string, 0, 12)
+	ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"deviceconfig",
+		"system",
+		"update-schedule",
+		"statistics-service",
+	)
+
+	return ans
+}
@@ -0,0 +1,66 @@
+package telemetry
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestPanoNormalization(t *testing.T) {
+	testCases := []struct {
+		desc string
+		conf Settings
+	}{
+		{"all no", Settings{}},
+		{"all yes", Settings{
+			ApplicationReports:             true,
+			ThreatPreventionReports:        true,
+			UrlReports:                     true,
+			FileTypeIdentificationReports:  true,
+			ThreatPreventionData:           true,
+			ThreatPreventionPacketCaptures: true,
+			ProductUsageStats:              true,
+			PassiveDnsMonitoring:           true,
+		}},
+		{"mix", Settings{
+			ApplicationReports:   true,
+			UrlReports:           true,
+			ThreatPreventionData: true,
+			ProductUsageStats:    true,
+		}},
+	}
+
+	mc := &testdata.MockClient{}
+	ns := &PanoTelemetry{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.AddResp("")
+			err := ns.Set("my template", "", tc.conf)
+			if err != nil {
+				t.Errorf("Error in set: %s", err)
+			} else {
+				mc.AddResp(mc.Elm)
+				r, err := ns.Get("my template", "")
+				if err != nil {
+					t.Errorf("Error in get: %s", err)
+				} else if !reflect.DeepEqual(tc.conf, r) {
+					t.Errorf("%#v != %#v", tc.conf, r)
+				}
+			}
+		})
+	}
+}
+
+func TestPanoNoTemplate(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &PanoTelemetry{}
+	ns.Initialize(mc)
+
+	if err := ns.Set("", "", Settings{}); err == nil {
+		t.Errorf("No error without a template or template stack")
+	}
+}
@@ -0,0 +1,13 @@
+package vminfo
+
+// Valid values for Entry.Type.
+const (
+	TypeAwsVpc  = "AWS-VPC"
+	TypeEsxi    = "VMware-ESXi"
+	TypeVcenter = "VMware-vCenter"
+)
+
+const (
+	singular = "vm information source"
+	plural   = "vm information sources"
+)
@@ -0,0 +1,10 @@
+/*
+Package vminfo is the client.Device.VmInfoSource namespace.
+
+VM information sources let PAN-OS monitor virtual machines in AWS VPCs and
+VMware environments, providing the VM metadata (such as tags) that is used to
+populate dynamic address groups.
+
+Normalized object:  Entry
+*/
+package vminfo
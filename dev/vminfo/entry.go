@@ -0,0 +1,187 @@
+package vminfo
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a VM
+// information source.
+//
+// AccessKeyId, SecretAccessKey, and VpcId are only used by AWS VPC sources,
+// while Port, Username, and Password are only used by VMware sources.
+type Entry struct {
+	Name            string
+	Type            string
+	Description     string
+	Disabled        bool
+	Source          string
+	UpdateInterval  int
+	AccessKeyId     string
+	SecretAccessKey string // encrypted
+	VpcId           string
+	Port            int
+	Username        string
+	Password        string // encrypted
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Type = s.Type
+	o.Description = s.Description
+	o.Disabled = s.Disabled
+	o.Source = s.Source
+	o.UpdateInterval = s.UpdateInterval
+	o.AccessKeyId = s.AccessKeyId
+	o.SecretAccessKey = s.SecretAccessKey
+	o.VpcId = s.VpcId
+	o.Port = s.Port
+	o.Username = s.Username
+	o.Password = s.Password
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this VM information source.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name: o.Name,
+	}
+
+	switch {
+	case o.Aws != nil:
+		ans.Type = TypeAwsVpc
+		ans.Description = o.Aws.Description
+		ans.Disabled = util.AsBool(o.Aws.Disabled)
+		ans.Source = o.Aws.Source
+		ans.UpdateInterval = o.Aws.UpdateInterval
+		ans.AccessKeyId = o.Aws.AccessKeyId
+		ans.SecretAccessKey = o.Aws.SecretAccessKey
+		ans.VpcId = o.Aws.VpcId
+	case o.Esxi != nil:
+		ans.Type = TypeEsxi
+		o.Esxi.normalize(&ans)
+	case o.Vcenter != nil:
+		ans.Type = TypeVcenter
+		o.Vcenter.normalize(&ans)
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName xml.Name `xml:"entry"`
+	Name    string   `xml:"name,attr"`
+	Aws     *aws     `xml:"AWS-VPC"`
+	Esxi    *vmware  `xml:"VMware-ESXi"`
+	Vcenter *vmware  `xml:"VMware-vCenter"`
+}
+
+type aws struct {
+	Description     string `xml:"description,omitempty"`
+	Disabled        string `xml:"disabled,omitempty"`
+	Source          string `xml:"source"`
+	AccessKeyId     string `xml:"access-key-id"`
+	SecretAccessKey string `xml:"secret-access-key"`
+	UpdateInterval  int    `xml:"update-interval,omitempty"`
+	VpcId           string `xml:"vpc-id"`
+}
+
+type vmware struct {
+	Description    string `xml:"description,omitempty"`
+	Disabled       string `xml:"disabled,omitempty"`
+	Port           int    `xml:"port,omitempty"`
+	Source         string `xml:"source"`
+	Username       string `xml:"username"`
+	Password       string `xml:"password"`
+	UpdateInterval int    `xml:"update-interval,omitempty"`
+}
+
+func (o *vmware) normalize(e *Entry) {
+	e.Description = o.Description
+	e.Disabled = util.AsBool(o.Disabled)
+	e.Port = o.Port
+	e.Source = o.Source
+	e.Username = o.Username
+	e.Password = o.Password
+	e.UpdateInterval = o.UpdateInterval
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name: e.Name,
+	}
+
+	var disabled string
+	if e.Disabled {
+		disabled = util.YesNo(e.Disabled)
+	}
+
+	switch e.Type {
+	case TypeAwsVpc:
+		ans.Aws = &aws{
+			Description:     e.Description,
+			Disabled:        disabled,
+			Source:          e.Source,
+			AccessKeyId:     e.AccessKeyId,
+			SecretAccessKey: e.SecretAccessKey,
+			UpdateInterval:  e.UpdateInterval,
+			VpcId:           e.VpcId,
+		}
+	case TypeEsxi, TypeVcenter:
+		v := &vmware{
+			Description:    e.Description,
+			Disabled:       disabled,
+			Port:           e.Port,
+			Source:         e.Source,
+			Username:       e.Username,
+			Password:       e.Password,
+			UpdateInterval: e.UpdateInterval,
+		}
+		if e.Type == TypeEsxi {
+			ans.Esxi = v
+		} else {
+			ans.Vcenter = v
+		}
+	}
+
+	return ans
+}
@@ -0,0 +1,88 @@
+package vminfo
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwVmInfo is the client.Device.VmInfoSource namespace.
+type FwVmInfo struct {
+	con util.XapiClient
+	ns  *namespace.Standard[Entry]
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *FwVmInfo) Initialize(con util.XapiClient) {
+	c.con = con
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of all VM information sources.
+func (c *FwVmInfo) GetList(vsys string) ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(vsys, nil))
+}
+
+// ShowList performs SHOW to retrieve a list of all VM information sources.
+func (c *FwVmInfo) ShowList(vsys string) ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(vsys, nil))
+}
+
+// Get performs GET to retrieve information for the given VM information source.
+func (c *FwVmInfo) Get(vsys, name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath(vsys, []string{name}), name)
+}
+
+// GetAll performs GET to retrieve all VM information sources configured.
+func (c *FwVmInfo) GetAll(vsys string) ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(vsys, nil))
+}
+
+// Show performs SHOW to retrieve information for the given VM information source.
+func (c *FwVmInfo) Show(vsys, name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath(vsys, []string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve all VM information sources configured.
+func (c *FwVmInfo) ShowAll(vsys string) ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(vsys, nil))
+}
+
+// Set performs SET to create / update one or more VM information sources.
+func (c *FwVmInfo) Set(vsys string, e ...Entry) error {
+	return c.ns.SetEntries(c.pather(vsys), e...)
+}
+
+// Edit performs EDIT to create / update one VM information source.
+func (c *FwVmInfo) Edit(vsys string, e Entry) error {
+	return c.ns.EditEntry(c.pather(vsys), e)
+}
+
+// Delete removes the given VM information sources.
+//
+// Objects can be either a string or an Entry object.
+func (c *FwVmInfo) Delete(vsys string, e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(vsys), e...)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *FwVmInfo) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *FwVmInfo) pather(vsys string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(vsys, v)
+	}
+}
+
+func (c *FwVmInfo) xpath(vsys string, vals []string) []string {
+	ans := make([]string, 0, 7)
+	ans = append(ans, util.VsysXpathPrefix(vsys)...)
+	ans = append(ans,
+		"vm-info-source",
+		util.AsEntryXpath(vals),
+	)
+
+	return ans
+}
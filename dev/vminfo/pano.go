@@ -0,0 +1,100 @@
+package vminfo
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// PanoVmInfo is the client.Device.VmInfoSource namespace.
+//
+// If both tmpl and ts are empty, then the VM information sources of Panorama
+// itself are configured instead of those of a template vsys.
+type PanoVmInfo struct {
+	con util.XapiClient
+	ns  *namespace.Standard[Entry]
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *PanoVmInfo) Initialize(con util.XapiClient) {
+	c.con = con
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of all VM information sources.
+func (c *PanoVmInfo) GetList(tmpl, ts, vsys string) ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(tmpl, ts, vsys, nil))
+}
+
+// ShowList performs SHOW to retrieve a list of all VM information sources.
+func (c *PanoVmInfo) ShowList(tmpl, ts, vsys string) ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(tmpl, ts, vsys, nil))
+}
+
+// Get performs GET to retrieve information for the given VM information source.
+func (c *PanoVmInfo) Get(tmpl, ts, vsys, name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath(tmpl, ts, vsys, []string{name}), name)
+}
+
+// GetAll performs GET to retrieve all VM information sources configured.
+func (c *PanoVmInfo) GetAll(tmpl, ts, vsys string) ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(tmpl, ts, vsys, nil))
+}
+
+// Show performs SHOW to retrieve information for the given VM information source.
+func (c *PanoVmInfo) Show(tmpl, ts, vsys, name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath(tmpl, ts, vsys, []string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve all VM information sources configured.
+func (c *PanoVmInfo) ShowAll(tmpl, ts, vsys string) ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(tmpl, ts, vsys, nil))
+}
+
+// Set performs SET to create / update one or more VM information sources.
+func (c *PanoVmInfo) Set(tmpl, ts, vsys string, e ...Entry) error {
+	return c.ns.SetEntries(c.pather(tmpl, ts, vsys), e...)
+}
+
+// Edit performs EDIT to create / update one VM information source.
+func (c *PanoVmInfo) Edit(tmpl, ts, vsys string, e Entry) error {
+	return c.ns.EditEntry(c.pather(tmpl, ts, vsys), e)
+}
+
+// Delete removes the given VM information sources.
+//
+// Objects can be either a string or an Entry object.
+func (c *PanoVmInfo) Delete(tmpl, ts, vsys string, e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(tmpl, ts, vsys), e...)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *PanoVmInfo) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *PanoVmInfo) pather(tmpl, ts, vsys string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(tmpl, ts, vsys, v)
+	}
+}
+
+func (c *PanoVmInfo) xpath(tmpl, ts, vsys string, vals []string) []string {
+	var ans []string
+
+	if tmpl != "" || ts != "" {
+		ans = make([]string, 0, 12)
+		ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
+		ans = append(ans, util.VsysXpathPrefix(vsys)...)
+	} else {
+		ans = make([]string, 0, 4)
+		ans = append(ans, "config", "panorama")
+	}
+
+	ans = append(ans,
+		"vm-info-source",
+		util.AsEntryXpath(vals),
+	)
+
+	return ans
+}
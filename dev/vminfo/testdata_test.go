@@ -0,0 +1,48 @@
+package vminfo
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"aws vpc", Entry{
+			Name:            "t1",
+			Type:            TypeAwsVpc,
+			Description:     "my aws source",
+			Source:          "ec2.us-west-2.amazonaws.com",
+			UpdateInterval:  60,
+			AccessKeyId:     "AKIAEXAMPLE",
+			SecretAccessKey: "secret",
+			VpcId:           "vpc-12345678",
+		}},
+		{"aws vpc disabled", Entry{
+			Name:            "t2",
+			Type:            TypeAwsVpc,
+			Disabled:        true,
+			Source:          "ec2.us-east-1.amazonaws.com",
+			AccessKeyId:     "AKIAEXAMPLE",
+			SecretAccessKey: "secret",
+			VpcId:           "vpc-87654321",
+		}},
+		{"esxi", Entry{
+			Name:           "t3",
+			Type:           TypeEsxi,
+			Description:    "esxi host",
+			Source:         "10.1.1.5",
+			Port:           443,
+			Username:       "admin",
+			Password:       "password",
+			UpdateInterval: 5,
+		}},
+		{"vcenter disabled", Entry{
+			Name:     "t4",
+			Type:     TypeVcenter,
+			Disabled: true,
+			Source:   "vcenter.example.com",
+			Username: "administrator",
+			Password: "password",
+		}},
+	}
+}
@@ -0,0 +1,131 @@
+package pango
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Valid values for ConfigChange.Action.
+const (
+	ConfigAdded   = "added"
+	ConfigDeleted = "deleted"
+	ConfigChanged = "changed"
+	ConfigMoved   = "moved"
+)
+
+// ConfigChange is a single difference between two configs.
+//
+// Xpath is the location of the value.  For added values, Old is empty, while
+// for deleted values, New is empty.  For member lists, the values are the
+// sorted members joined with ", ".
+//
+// Rules that are in a different position are reported as moved, with the
+// Xpath of the rulebase's rules, and the values being the names of the rules
+// in both configs, in order, joined with ", ".  Added and deleted rules are
+// left out of the order.
+type ConfigChange struct {
+	Xpath  string
+	Action string
+	Old    string
+	New    string
+}
+
+// CandidateConfig retrieves the full candidate config of the PAN-OS device.
+func (c *Client) CandidateConfig() ([]byte, error) {
+	type req struct {
+		XMLName xml.Name `xml:"show"`
+		Cmd     string   `xml:"config>candidate"`
+	}
+
+	c.LogOp("(op) retrieving the candidate config")
+	b, err := c.Op(req{}, "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return util.StripPanosPackaging(b, ""), nil
+}
+
+// PendingChanges returns what will change in the running config if the
+// candidate config is committed.
+func (c *Client) PendingChanges() ([]ConfigChange, error) {
+	running, err := c.RunningConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	candidate, err := c.CandidateConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return DiffConfig(running, candidate)
+}
+
+// DiffConfig compares two configs, returning the changes needed to go from
+// the old config to the new config, sorted by xpath.
+//
+// Both configs are expected to be XML documents containing a <config> element.
+func DiffConfig(old, new []byte) ([]ConfigChange, error) {
+	ov, oo, err := configLeaves(old)
+	if err != nil {
+		return nil, fmt.Errorf("old config: %s", err)
+	}
+
+	nv, no, err := configLeaves(new)
+	if err != nil {
+		return nil, fmt.Errorf("new config: %s", err)
+	}
+
+	ans := make([]ConfigChange, 0)
+	for path, val := range nv {
+		if oval, ok := ov[path]; !ok {
+			ans = append(ans, ConfigChange{Xpath: path, Action: ConfigAdded, New: val})
+		} else if oval != val {
+			ans = append(ans, ConfigChange{Xpath: path, Action: ConfigChanged, Old: oval, New: val})
+		}
+	}
+	for path, val := range ov {
+		if _, ok := nv[path]; !ok {
+			ans = append(ans, ConfigChange{Xpath: path, Action: ConfigDeleted, Old: val})
+		}
+	}
+	for path, nlist := range no {
+		olist, ok := oo[path]
+		if !ok {
+			continue
+		}
+		oval := strings.Join(commonNames(olist, nlist), ", ")
+		nval := strings.Join(commonNames(nlist, olist), ", ")
+		if oval != nval {
+			ans = append(ans, ConfigChange{Xpath: path, Action: ConfigMoved, Old: oval, New: nval})
+		}
+	}
+
+	sort.Slice(ans, func(i, j int) bool {
+		return ans[i].Xpath < ans[j].Xpath
+	})
+
+	return ans, nil
+}
+
+// commonNames returns the names in a that are also in b, in the order of a.
+func commonNames(a, b []string) []string {
+	in := make(map[string]bool, len(b))
+	for _, v := range b {
+		in[v] = true
+	}
+
+	ans := make([]string, 0, len(a))
+	for _, v := range a {
+		if in[v] {
+			ans = append(ans, v)
+		}
+	}
+
+	return ans
+}
@@ -0,0 +1,92 @@
+package pango
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPendingChanges(t *testing.T) {
+	c := &Client{rb: [][]byte{
+		[]byte(`<response status="success"><result><config><shared><address><entry name="a1"><ip-netmask>10.1.1.1</ip-netmask></entry><entry name="a2"><fqdn>example.com</fqdn></entry></address><address-group><entry name="g1"><static><member>a2</member><member>a1</member></static></entry></address-group></shared></config></result></response>`),
+		[]byte(`<response status="success"><result><config><shared><address><entry name="a1"><ip-netmask>10.1.1.2</ip-netmask></entry><entry name="a3"/></address><address-group><entry name="g1"><static><member>a1</member><member>a2</member></static></entry></address-group></shared></config></result></response>`),
+	}}
+	c.Initialize()
+
+	ans, err := c.PendingChanges()
+	if err != nil {
+		t.Fatalf("Error in diff: %s", err)
+	}
+
+	if c.rp[1].Get("cmd") != "<show><config><candidate></candidate></config></show>" {
+		t.Errorf("Candidate cmd is %s", c.rp[1].Get("cmd"))
+	}
+
+	expected := []ConfigChange{
+		{
+			Xpath:  "/config/shared/address/entry[@name='a1']/ip-netmask",
+			Action: ConfigChanged,
+			Old:    "10.1.1.1",
+			New:    "10.1.1.2",
+		},
+		{
+			Xpath:  "/config/shared/address/entry[@name='a2']/fqdn",
+			Action: ConfigDeleted,
+			Old:    "example.com",
+		},
+		{
+			Xpath:  "/config/shared/address/entry[@name='a3']",
+			Action: ConfigAdded,
+		},
+	}
+	if !reflect.DeepEqual(ans, expected) {
+		t.Errorf("%#v != %#v", ans, expected)
+	}
+}
+
+func TestDiffConfigInvalid(t *testing.T) {
+	if _, err := DiffConfig([]byte("<foo/>"), []byte("<config/>")); err == nil {
+		t.Errorf("No error for missing config element")
+	}
+}
+
+func TestDiffConfigRuleMoved(t *testing.T) {
+	old := []byte(`<config><rulebase><security><rules><entry name="r1"><action>allow</action></entry><entry name="r2"><action>deny</action></entry><entry name="r3"><action>allow</action></entry></rules></security></rulebase></config>`)
+	new := []byte(`<config><rulebase><security><rules><entry name="r4"><action>allow</action></entry><entry name="r2"><action>deny</action></entry><entry name="r1"><action>allow</action></entry></rules></security></rulebase></config>`)
+
+	ans, err := DiffConfig(old, new)
+	if err != nil {
+		t.Fatalf("Error in diff: %s", err)
+	}
+
+	var moved []ConfigChange
+	for _, x := range ans {
+		if x.Action == ConfigMoved {
+			moved = append(moved, x)
+		}
+	}
+
+	expected := []ConfigChange{{
+		Xpath:  "/config/rulebase/security/rules",
+		Action: ConfigMoved,
+		Old:    "r1, r2",
+		New:    "r2, r1",
+	}}
+	if !reflect.DeepEqual(moved, expected) {
+		t.Errorf("%#v != %#v", moved, expected)
+	}
+}
+
+func TestDiffConfigQuotedName(t *testing.T) {
+	old := []byte(`<config><shared><address><entry name="bob's &quot;web&quot;"><fqdn>a.example.com</fqdn></entry></address></shared></config>`)
+	new := []byte(`<config><shared><address><entry name="bob's &quot;web&quot;"><fqdn>b.example.com</fqdn></entry></address></shared></config>`)
+
+	ans, err := DiffConfig(old, new)
+	if err != nil {
+		t.Fatalf("Error in diff: %s", err)
+	}
+
+	xp := `/config/shared/address/entry[@name=concat('bob', "'", 's "web"')]/fqdn`
+	if len(ans) != 1 || ans[0].Xpath != xp {
+		t.Errorf("Got %#v", ans)
+	}
+}
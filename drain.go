@@ -0,0 +1,161 @@
+package pango
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Valid values for Client.DrainMode.
+const (
+	DrainOff   = ""
+	DrainWait  = "wait"
+	DrainError = "error"
+)
+
+// drainPollInterval is how often a config change waiting on a commit checks
+// if the commit job has finished.
+var drainPollInterval = 2 * time.Second
+
+// CommitInProgressError is returned when the client's DrainMode is
+// DrainError and a config change is attempted while a commit job submitted by
+// this client is still running.
+type CommitInProgressError struct {
+	Action string
+	JobId  uint
+}
+
+// Error returns the error message.
+func (e CommitInProgressError) Error() string {
+	return fmt.Sprintf("%s not permitted: commit job %d is in progress", e.Action, e.JobId)
+}
+
+/** Internal functions **/
+
+// drainGate tracks in flight config changes and the pending commit job.
+type drainGate struct {
+	mu         sync.Mutex
+	cond       *sync.Cond
+	writers    int
+	committing bool
+	job        uint
+}
+
+// gate returns the client's drainGate, creating it if needed.
+func (c *Client) gate() *drainGate {
+	c.mu().drainGate.Lock()
+	defer c.mu().drainGate.Unlock()
+
+	if c.drain == nil {
+		c.drain = &drainGate{}
+		c.drain.cond = sync.NewCond(&c.drain.mu)
+	}
+
+	return c.drain
+}
+
+// drainBegin is invoked before a config change is sent.  If a commit is being
+// submitted, this waits for the submission to finish.  If a commit job is
+// pending, then this either waits for the job to finish or returns a
+// CommitInProgressError, depending on the DrainMode.
+//
+// The returned func must be invoked once the config change is done.
+func (c *Client) drainBegin(action string) (func(), error) {
+	if c.DrainMode == DrainOff {
+		return func() {}, nil
+	}
+
+	g := c.gate()
+	g.mu.Lock()
+	for {
+		for g.committing {
+			g.cond.Wait()
+		}
+		if g.job == 0 {
+			break
+		}
+
+		id := g.job
+		g.mu.Unlock()
+		done, err := c.drainJobFinished(id)
+		if err == nil && !done {
+			if c.DrainMode == DrainError {
+				return nil, CommitInProgressError{Action: action, JobId: id}
+			}
+			time.Sleep(drainPollInterval)
+		}
+		g.mu.Lock()
+
+		if err != nil {
+			g.mu.Unlock()
+			return nil, err
+		} else if done && g.job == id {
+			g.job = 0
+		}
+	}
+
+	g.writers++
+	g.mu.Unlock()
+
+	return func() {
+		g.mu.Lock()
+		g.writers--
+		g.cond.Broadcast()
+		g.mu.Unlock()
+	}, nil
+}
+
+// drainCommit is invoked before a commit is sent, waiting for in flight
+// config changes to finish and blocking new ones until the commit has been
+// submitted.
+//
+// The returned func must be invoked with the commit's job ID once the commit
+// has been submitted.
+func (c *Client) drainCommit() func(uint) {
+	if c.DrainMode == DrainOff {
+		return func(uint) {}
+	}
+
+	g := c.gate()
+	g.mu.Lock()
+	for g.committing || g.writers > 0 {
+		g.cond.Wait()
+	}
+	g.committing = true
+	g.mu.Unlock()
+
+	return func(id uint) {
+		g.mu.Lock()
+		g.committing = false
+		if id != 0 {
+			g.job = id
+		}
+		g.cond.Broadcast()
+		g.mu.Unlock()
+	}
+}
+
+// drainJobDone clears the pending commit job if it is the given job.
+func (c *Client) drainJobDone(id uint) {
+	if c.DrainMode == DrainOff {
+		return
+	}
+
+	g := c.gate()
+	g.mu.Lock()
+	if g.job == id {
+		g.job = 0
+	}
+	g.mu.Unlock()
+}
+
+// drainJobFinished checks the status of the given job once.
+func (c *Client) drainJobFinished(id uint) (bool, error) {
+	var ans commitJobAns
+	_, err := c.Op(commitJobCmd{Id: id}, "", nil, &ans)
+	if _, ok := err.(WarningError); err != nil && !ok {
+		return false, err
+	}
+
+	return ans.normalize(id).Done(), nil
+}
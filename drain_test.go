@@ -0,0 +1,135 @@
+package pango
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDrainError(t *testing.T) {
+	c := &Client{DrainMode: DrainError, rb: [][]byte{
+		[]byte(`<response status="success"><result><job>5</job></result></response>`),
+		[]byte(`<response status="success"><result><job><status>ACT</status><result>PEND</result><progress>10</progress></job></result></response>`),
+		[]byte(`<response status="success"><result><job><status>FIN</status><result>OK</result><progress>100</progress></job></result></response>`),
+		[]byte(`<response status="success"></response>`),
+	}}
+	c.Initialize()
+
+	if _, _, err := c.Commit("<commit></commit>", "", nil); err != nil {
+		t.Fatalf("Error in commit: %s", err)
+	}
+
+	_, err := c.Set("/config/devices", "<x/>", nil, nil)
+	if e, ok := err.(CommitInProgressError); !ok {
+		t.Fatalf("Expected CommitInProgressError, got %v", err)
+	} else if e.JobId != 5 || e.Action != "set" {
+		t.Errorf("Got %#v", e)
+	}
+
+	if _, err = c.WaitForCommit(5, 0, nil); err != nil {
+		t.Fatalf("Error in wait: %s", err)
+	}
+
+	if _, err = c.Set("/config/devices", "<x/>", nil, nil); err != nil {
+		t.Fatalf("Error in set: %s", err)
+	}
+	if len(c.rp) != 4 {
+		t.Errorf("Sent %d requests, not 4", len(c.rp))
+	} else if v := c.rp[3].Get("action"); v != "set" {
+		t.Errorf("Last request action is %q", v)
+	}
+}
+
+func TestDrainWait(t *testing.T) {
+	defer func(d time.Duration) { drainPollInterval = d }(drainPollInterval)
+	drainPollInterval = 0
+
+	c := &Client{DrainMode: DrainWait, rb: [][]byte{
+		[]byte(`<response status="success"><result><job>5</job></result></response>`),
+		[]byte(`<response status="success"><result><job><status>ACT</status><result>PEND</result><progress>10</progress></job></result></response>`),
+		[]byte(`<response status="success"><result><job><status>FIN</status><result>OK</result><progress>100</progress></job></result></response>`),
+		[]byte(`<response status="success"></response>`),
+		[]byte(`<response status="success"></response>`),
+	}}
+	c.Initialize()
+
+	if _, _, err := c.Commit("<commit></commit>", "", nil); err != nil {
+		t.Fatalf("Error in commit: %s", err)
+	}
+
+	if _, err := c.Edit("/config/devices", "<x/>", nil, nil); err != nil {
+		t.Fatalf("Error in edit: %s", err)
+	}
+	if len(c.rp) != 4 {
+		t.Fatalf("Sent %d requests, not 4", len(c.rp))
+	} else if v := c.rp[3].Get("action"); v != "edit" {
+		t.Errorf("Edit was sent as %q", v)
+	}
+
+	// The job is done, so this is sent right away.
+	if _, err := c.Delete("/config/devices", nil, nil); err != nil {
+		t.Fatalf("Error in delete: %s", err)
+	}
+	if len(c.rp) != 5 {
+		t.Errorf("Sent %d requests, not 5", len(c.rp))
+	}
+}
+
+func TestDrainCommitWaitsForWrites(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		order   []string
+		started = make(chan struct{})
+		release = make(chan struct{})
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		kind := r.FormValue("type")
+		mu.Lock()
+		order = append(order, kind)
+		mu.Unlock()
+
+		switch kind {
+		case "config":
+			close(started)
+			<-release
+			w.Write([]byte(`<response status="success"></response>`))
+		case "commit":
+			w.Write([]byte(`<response status="success"><result><job>5</job></result></response>`))
+		}
+	}))
+	defer srv.Close()
+
+	c := &Client{DrainMode: DrainWait, Logging: LogQuiet}
+	c.con = srv.Client()
+	c.api_url = srv.URL
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		c.Set("/config/devices", "<x/>", nil, nil)
+	}()
+
+	<-started
+	go func() {
+		defer wg.Done()
+		c.Commit("<commit></commit>", "", nil)
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	mu.Lock()
+	if len(order) != 1 {
+		t.Errorf("Commit sent while set was in flight: %v", order)
+	}
+	mu.Unlock()
+
+	close(release)
+	wg.Wait()
+
+	if len(order) != 2 || order[1] != "commit" {
+		t.Errorf("Request order: %v", order)
+	}
+}
@@ -0,0 +1,108 @@
+package pango
+
+import (
+	"bytes"
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// EffectiveRunningConfig retrieves the full effective running config of the
+// PAN-OS device.
+//
+// On a firewall managed by Panorama, this is the local running config merged
+// with the template and device group config that Panorama has pushed to it.
+func (c *Client) EffectiveRunningConfig() ([]byte, error) {
+	type req struct {
+		XMLName xml.Name `xml:"show"`
+		Cmd     string   `xml:"config>effective-running"`
+	}
+
+	c.LogOp("(op) retrieving the effective running config")
+	b, err := c.Op(req{}, "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return util.StripPanosPackaging(b, ""), nil
+}
+
+// EffectiveRunning retrieves the given xpath from the effective running
+// config, which includes any objects pushed from Panorama.
+//
+// This has the same signature as Get and Show, so it can be used anywhere a
+// util.Retriever is expected.  PAN-OS only supports retrieving the effective
+// running config in its entirety, so the xpath is resolved client side and
+// the response returned is formatted like the response from a SHOW.
+//
+// The extras param is ignored.
+//
+// If the xpath does not match anything, then a PanosError with code 7 is
+// returned.
+func (c *Client) EffectiveRunning(path, extras, ans interface{}) ([]byte, error) {
+	xp := util.AsXpath(path)
+	c.logXpath(xp)
+
+	b, err := c.EffectiveRunningConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return selectConfig(b, xp, ans)
+}
+
+// selectConfig resolves the given xpath against the given config document,
+// returning a response formatted like the response from a SHOW.
+func selectConfig(b []byte, xp string, ans interface{}) ([]byte, error) {
+	var root configNode
+	if err := xml.Unmarshal(b, &root); err != nil {
+		return nil, err
+	}
+
+	segs, err := splitXpath(xp)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := root.selectAll(segs)
+	if len(nodes) == 0 {
+		return nil, PanosError{Msg: "No such node", Code: 7}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`<response status="success"><result>`)
+	for _, node := range nodes {
+		nb, err := xml.Marshal(node)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(nb)
+	}
+	buf.WriteString(`</result></response>`)
+	body := buf.Bytes()
+
+	if ans != nil {
+		if err = xml.Unmarshal(body, ans); err != nil {
+			return body, err
+		}
+	}
+
+	return body, nil
+}
+
+// selectAll returns the nodes matching the given xpath segments, starting
+// with this node.
+func (n *configNode) selectAll(segs []xpathSeg) []*configNode {
+	if len(segs) == 0 || !segs[0].matches(n) {
+		return nil
+	} else if len(segs) == 1 {
+		return []*configNode{n}
+	}
+
+	var ans []*configNode
+	for _, x := range n.Nodes {
+		ans = append(ans, x.selectAll(segs[1:])...)
+	}
+
+	return ans
+}
@@ -0,0 +1,68 @@
+package pango
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+const effectiveRunning = `<response status="success"><result><config version="10.1.0">
+<devices><entry name="localhost.localdomain"><vsys><entry name="vsys1"><address>
+<entry name="local"><ip-netmask>10.1.1.1</ip-netmask></entry>
+<entry name="pushed"><ip-netmask>10.2.2.2</ip-netmask></entry>
+</address></entry></vsys></entry></devices></config></result></response>`
+
+func TestEffectiveRunning(t *testing.T) {
+	type entry struct {
+		Name  string `xml:"name,attr"`
+		Value string `xml:"ip-netmask"`
+	}
+	type container struct {
+		Entries []entry `xml:"result>entry"`
+	}
+
+	c := &Client{rb: [][]byte{[]byte(effectiveRunning)}}
+	c.Initialize()
+
+	var ans container
+	path := "/config/devices/entry[@name='localhost.localdomain']/vsys/entry[@name='vsys1']/address/entry"
+	if _, err := c.EffectiveRunning(path, nil, &ans); err != nil {
+		t.Fatalf("Error in retrieval: %s", err)
+	}
+
+	if len(ans.Entries) != 2 || ans.Entries[1].Name != "pushed" || ans.Entries[1].Value != "10.2.2.2" {
+		t.Errorf("Unexpected entries: %#v", ans.Entries)
+	}
+	if s := c.rp[len(c.rp)-1].Get("cmd"); !strings.Contains(s, "<effective-running>") {
+		t.Errorf("Cmd is %q", s)
+	}
+}
+
+func TestEffectiveRunningSingle(t *testing.T) {
+	c := &Client{rb: [][]byte{[]byte(effectiveRunning)}}
+	c.Initialize()
+
+	var ans struct {
+		XMLName xml.Name `xml:"response"`
+		Value   string   `xml:"result>entry>ip-netmask"`
+	}
+	path := "/config/devices/entry[@name='localhost.localdomain']/vsys/entry[@name='vsys1']/address/entry[@name='local']"
+	if _, err := c.EffectiveRunning(path, nil, &ans); err != nil {
+		t.Fatalf("Error in retrieval: %s", err)
+	} else if ans.Value != "10.1.1.1" {
+		t.Errorf("Value is %q", ans.Value)
+	}
+}
+
+func TestEffectiveRunningNotFound(t *testing.T) {
+	c := &Client{rb: [][]byte{[]byte(effectiveRunning)}}
+	c.Initialize()
+
+	_, err := c.EffectiveRunning("/config/shared/address", nil, nil)
+	if err == nil {
+		t.Fatalf("No error for missing node")
+	}
+	if e, ok := err.(PanosError); !ok || !e.ObjectNotFound() {
+		t.Errorf("Error is %#v", err)
+	}
+}
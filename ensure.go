@@ -0,0 +1,164 @@
+package pango
+
+import (
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/netw/interface/aggregate"
+	"github.com/PaloAltoNetworks/pango/netw/interface/eth"
+	"github.com/PaloAltoNetworks/pango/netw/interface/loopback"
+	"github.com/PaloAltoNetworks/pango/netw/interface/subinterface/layer3"
+	"github.com/PaloAltoNetworks/pango/netw/interface/tunnel"
+	"github.com/PaloAltoNetworks/pango/netw/interface/vlan"
+	"github.com/PaloAltoNetworks/pango/netw/routing/router"
+	"github.com/PaloAltoNetworks/pango/netw/zone"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// EnsureInterface creates / updates the given interface, imports it into the
+// given vsys, and makes it a member of the given zone and virtual router, all in
+// a single strictly transactional multi-config request (PAN-OS 9.0+).
+//
+// The interface can be any of the following:
+//
+//      * eth.Entry
+//      * aggregate.Entry
+//      * layer3.Entry (the parent interface is taken from the name)
+//      * loopback.Entry
+//      * tunnel.Entry
+//      * vlan.Entry
+//
+// The zone and virtual router are the desired state:  the interface is removed
+// from any other zone in the vsys and any other virtual router, and an empty
+// zone or virtual router means that the interface should not be in one.  The
+// zone and virtual router must already exist, and the interface must be in
+// layer3 mode in order to be in a virtual router.
+func (c *Firewall) EnsureInterface(iface interface{}, vsys, zoneName, vr string) error {
+	var name, mode string
+
+	b := c.NewBatch()
+
+	switch v := iface.(type) {
+	case eth.Entry:
+		name, mode = v.Name, v.Mode
+		ns := &eth.FwEth{}
+		ns.Initialize(b)
+		if err := ns.Set(vsys, v); err != nil {
+			return err
+		}
+	case aggregate.Entry:
+		name, mode = v.Name, v.Mode
+		ns := &aggregate.FwAggregate{}
+		ns.Initialize(b)
+		if err := ns.Set(vsys, v); err != nil {
+			return err
+		}
+	case layer3.Entry:
+		name, mode = v.Name, zone.ModeL3
+		p, err := util.ParseInterfaceName(v.Name)
+		if err != nil {
+			return err
+		}
+		r := layer3.VlanRange{Parent: p.Parent}
+		iType, err := r.InterfaceType()
+		if err != nil {
+			return err
+		} else if p.Unit == 0 {
+			return fmt.Errorf("%s: not a subinterface", v.Name)
+		}
+		ns := &layer3.FwLayer3{}
+		ns.Initialize(b)
+		if err = ns.Set(iType, p.Parent, vsys, v); err != nil {
+			return err
+		}
+	case loopback.Entry:
+		name, mode = v.Name, zone.ModeL3
+		ns := &loopback.FwLoopback{}
+		ns.Initialize(b)
+		if err := ns.Set(vsys, v); err != nil {
+			return err
+		}
+	case tunnel.Entry:
+		name, mode = v.Name, zone.ModeL3
+		ns := &tunnel.FwTunnel{}
+		ns.Initialize(b)
+		if err := ns.Set(vsys, v); err != nil {
+			return err
+		}
+	case vlan.Entry:
+		name, mode = v.Name, zone.ModeL3
+		ns := &vlan.FwVlan{}
+		ns.Initialize(b)
+		if err := ns.Set(vsys, v); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("Unknown type sent to ensure interface: %T", v)
+	}
+
+	if zoneName != "" && mode != zone.ModeL3 && mode != zone.ModeL2 && mode != zone.ModeVirtualWire {
+		return fmt.Errorf("%s: interfaces in %q mode cannot be in a zone", name, mode)
+	} else if vr != "" && mode != zone.ModeL3 {
+		return fmt.Errorf("%s: only layer3 interfaces can be in a virtual router", name)
+	}
+
+	// Zone membership.
+	zones := &zone.FwZone{}
+	zones.Initialize(b)
+	zlist, err := zones.GetAll(vsys)
+	if err != nil {
+		return err
+	}
+	var found bool
+	for _, z := range zlist {
+		has := containsString(z.Interfaces, name)
+		if z.Name == zoneName && z.Mode == mode {
+			found = has
+		} else if has {
+			if err = zones.DeleteInterface(vsys, z.Name, z.Mode, name); err != nil {
+				return err
+			}
+		}
+	}
+	if zoneName != "" && !found {
+		if err = zones.SetInterface(vsys, zoneName, mode, name); err != nil {
+			return err
+		}
+	}
+
+	// Virtual router membership.
+	routers := &router.FwRouter{}
+	routers.Initialize(b)
+	rlist, err := routers.GetAll()
+	if err != nil {
+		return err
+	}
+	found = false
+	for _, r := range rlist {
+		has := containsString(r.Interfaces, name)
+		if r.Name == vr {
+			found = has
+		} else if has {
+			if err = routers.DeleteInterface(r.Name, name); err != nil {
+				return err
+			}
+		}
+	}
+	if vr != "" && !found {
+		if err = routers.SetInterface(vr, name); err != nil {
+			return err
+		}
+	}
+
+	_, err = b.Apply()
+	return err
+}
+
+func containsString(list []string, s string) bool {
+	for _, x := range list {
+		if x == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,65 @@
+package pango
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/netw/interface/eth"
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+func TestEnsureInterface(t *testing.T) {
+	c := &Firewall{Client: Client{rb: [][]byte{
+		[]byte(vsysListResp),
+		[]byte(emptyGetResp),
+		[]byte(emptyGetResp),
+		[]byte(`<response status="success"><result><zone><entry name="outside"><network><layer3><member>ethernet1/1</member></layer3></network></entry><entry name="inside"><network><layer3><member>ethernet1/2</member></layer3></network></entry></zone></result></response>`),
+		[]byte(`<response status="success"><result><virtual-router><entry name="default"><interface><member>ethernet1/1</member></interface></entry></virtual-router></result></response>`),
+		[]byte(okMultiConfigResp),
+	}}}
+	c.Client.Initialize()
+	c.Version = version.Number{10, 1, 0, ""}
+
+	e := eth.Entry{Name: "ethernet1/1", Mode: "layer3", StaticIps: []string{"10.1.1.1/24"}}
+	if err := c.EnsureInterface(e, "vsys1", "inside", "default"); err != nil {
+		t.Fatalf("Error in ensure: %s", err)
+	}
+
+	if len(c.rp) != 6 {
+		t.Fatalf("Sent %d requests, not 6", len(c.rp))
+	}
+	rp := c.rp[5]
+	if rp.Get("action") != "multi-config" {
+		t.Fatalf("Action is %q", rp.Get("action"))
+	}
+	elm := rp.Get("element")
+	for _, x := range []string{
+		`<delete id="3" xpath="/config/devices/entry[@name=&#39;localhost.localdomain&#39;]/vsys/entry[@name=&#39;vsys1&#39;]/zone/entry[@name=&#39;outside&#39;]/network/layer3/member[text()=&#39;ethernet1/1&#39;]"`,
+		`/zone/entry[@name=&#39;inside&#39;]/network/layer3"><member>ethernet1/1</member>`,
+		"/import/network/interface",
+	} {
+		if !strings.Contains(elm, x) {
+			t.Errorf("Element missing %q", x)
+		}
+	}
+	if strings.Contains(elm, "virtual-router") {
+		t.Errorf("Virtual router was changed: %s", elm)
+	}
+	if strings.Contains(elm, "/vsys/entry/import") {
+		t.Errorf("Unimport staged for an interface that is not imported: %s", elm)
+	}
+}
+
+func TestEnsureInterfaceInvalidMode(t *testing.T) {
+	c := &Firewall{Client: Client{}}
+	c.Client.Initialize()
+	c.Version = version.Number{10, 1, 0, ""}
+
+	e := eth.Entry{Name: "ethernet1/1", Mode: "layer2"}
+	if err := c.EnsureInterface(e, "vsys1", "", "default"); err == nil {
+		t.Errorf("No error for layer2 interface in a virtual router")
+	}
+	if err := c.EnsureInterface("ethernet1/1", "vsys1", "", ""); err == nil {
+		t.Errorf("No error for unknown type")
+	}
+}
@@ -0,0 +1,54 @@
+package pango
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// Values of the "operational-mode" that PAN-OS reports in "show system info".
+const (
+	OperationalModeNormal = "normal"
+	OperationalModeFipsCc = "fips-cc"
+)
+
+// OperationalMode returns the operational mode of the PAN-OS appliance, such
+// as OperationalModeNormal or OperationalModeFipsCc.
+//
+// The value from the client's SystemInfo map is used if present, otherwise
+// "show system info" is run to get it.
+func (c *Client) OperationalMode() (string, error) {
+	if v, ok := c.SystemInfo["operational-mode"]; ok {
+		return v, nil
+	}
+
+	type req struct {
+		XMLName xml.Name `xml:"show"`
+		Cmd     string   `xml:"system>info"`
+	}
+
+	type resp struct {
+		Mode string `xml:"result>system>operational-mode"`
+	}
+
+	var ans resp
+
+	c.LogOp("(op) getting operational mode")
+	if _, err := c.Op(req{}, "", nil, &ans); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(ans.Mode), nil
+}
+
+// FipsCcMode returns true if the PAN-OS appliance is running in FIPS-CC mode.
+//
+// FIPS-CC mode restricts the allowed TLS versions and ciphers, so this is
+// useful to check before configuring the management TLS settings.
+func (c *Client) FipsCcMode() (bool, error) {
+	mode, err := c.OperationalMode()
+	if err != nil {
+		return false, err
+	}
+
+	return mode == OperationalModeFipsCc, nil
+}
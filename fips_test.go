@@ -0,0 +1,53 @@
+package pango
+
+import (
+	"testing"
+)
+
+func TestFipsCcMode(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		mode     string
+		expected bool
+	}{
+		{"normal", OperationalModeNormal, false},
+		{"fips-cc", OperationalModeFipsCc, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			resp := `<response status="success"><result><system><operational-mode>` + tc.mode + `</operational-mode></system></result></response>`
+
+			c := &Client{rb: [][]byte{[]byte(resp)}}
+			c.Initialize()
+
+			ans, err := c.FipsCcMode()
+			if err != nil {
+				t.Fatalf("Error getting fips-cc mode: %s", err)
+			}
+			if ans != tc.expected {
+				t.Errorf("Got %t, not %t", ans, tc.expected)
+			}
+			if c.rp[0].Get("cmd") != "<show><system><info></info></system></show>" {
+				t.Errorf("Command is %q", c.rp[0].Get("cmd"))
+			}
+		})
+	}
+}
+
+func TestFipsCcModeFromSystemInfo(t *testing.T) {
+	c := &Client{}
+	c.Initialize()
+	c.SystemInfo = map[string]string{"operational-mode": OperationalModeFipsCc}
+
+	ans, err := c.FipsCcMode()
+	if err != nil {
+		t.Fatalf("Error getting fips-cc mode: %s", err)
+	}
+	if !ans {
+		t.Errorf("Not in fips-cc mode")
+	}
+	if len(c.rp) != 0 {
+		t.Errorf("Op was run: %v", c.rp)
+	}
+}
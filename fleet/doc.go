@@ -0,0 +1,31 @@
+/*
+Package fleet runs the same operation against many firewalls concurrently.
+
+The operation is a plain function that is given each firewall client in turn,
+and the results are collected into a Results map keyed by the firewall's
+serial number:
+
+	ex := fleet.Executor{
+		Parallelism: 10,
+		Timeout:     30 * time.Second,
+	}
+
+	res, err := fleet.Run(ex, firewalls, func(fw *pango.Firewall) ([]addr.Entry, error) {
+		return fw.Objects.Address.GetAll("vsys1")
+	})
+	if err != nil {
+		return err
+	}
+
+	for serial, err := range res.Errors() {
+		fmt.Printf("%s: %s\n", serial, err)
+	}
+	for serial, list := range res.Values() {
+		fmt.Printf("%s: %d addresses\n", serial, len(list))
+	}
+
+The firewall clients should already be initialized.  Each firewall must have
+a unique serial number (or hostname, if the serial number is not known),
+otherwise Run returns a DuplicateKeyError without running the operation.
+*/
+package fleet
@@ -0,0 +1,242 @@
+package fleet
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango"
+)
+
+// DefaultParallelism is the number of firewalls operated on at once if the
+// Executor does not specify a parallelism.
+const DefaultParallelism = 10
+
+// Executor controls how an operation is run across firewalls.
+//
+// Parallelism is the maximum number of firewalls that the operation runs
+// against at once, which defaults to DefaultParallelism if left unset.
+//
+// Timeout is the maximum time the operation may take per firewall, with 0
+// meaning no limit.  The firewall API calls themselves can not be cancelled,
+// so an operation that times out keeps running in the background and its
+// result is discarded.  The operation holds its parallelism slot until it
+// actually returns, so timed out operations still count against Parallelism.
+type Executor struct {
+	Parallelism int
+	Timeout     time.Duration
+}
+
+// TimeoutError is the error recorded for a firewall whose operation did not
+// finish within the executor's timeout.
+type TimeoutError struct {
+	Hostname string
+	Timeout  time.Duration
+}
+
+func (e TimeoutError) Error() string {
+	return fmt.Sprintf("%s: operation timed out after %s", e.Hostname, e.Timeout)
+}
+
+// DuplicateKeyError is returned when more than one firewall would be stored
+// under the same key in the Results.
+type DuplicateKeyError struct {
+	Key       string
+	Hostnames []string
+}
+
+func (e DuplicateKeyError) Error() string {
+	return fmt.Sprintf("firewalls %s share the key %q", strings.Join(e.Hostnames, ", "), e.Key)
+}
+
+// Result is the outcome of an operation against a single firewall.
+type Result[T any] struct {
+	Serial   string
+	Hostname string
+	Value    T
+	Err      error
+	Elapsed  time.Duration
+}
+
+// Results are the per-firewall results of an operation, keyed by serial
+// number.  If a firewall's serial number is not known, then its hostname is
+// used as the key instead.
+type Results[T any] map[string]Result[T]
+
+// Keys returns the sorted keys of the results.
+func (o Results[T]) Keys() []string {
+	ans := make([]string, 0, len(o))
+	for key := range o {
+		ans = append(ans, key)
+	}
+	sort.Strings(ans)
+
+	return ans
+}
+
+// Values returns the values of the operations that succeeded.
+func (o Results[T]) Values() map[string]T {
+	ans := make(map[string]T)
+	for key, r := range o {
+		if r.Err == nil {
+			ans[key] = r.Value
+		}
+	}
+
+	return ans
+}
+
+// Errors returns the errors of the operations that failed.
+func (o Results[T]) Errors() map[string]error {
+	ans := make(map[string]error)
+	for key, r := range o {
+		if r.Err != nil {
+			ans[key] = r.Err
+		}
+	}
+
+	return ans
+}
+
+// Failed returns the sorted keys of the operations that failed.
+func (o Results[T]) Failed() []string {
+	ans := make([]string, 0, len(o))
+	for key, r := range o {
+		if r.Err != nil {
+			ans = append(ans, key)
+		}
+	}
+	sort.Strings(ans)
+
+	return ans
+}
+
+// Run runs fn against each of the given firewalls as specified by the
+// executor, and returns once every firewall has a result.
+//
+// If more than one firewall has the same key, then a DuplicateKeyError is
+// returned and fn is not run against any firewall.
+func Run[T any](ex Executor, fws []*pango.Firewall, fn func(*pango.Firewall) (T, error)) (Results[T], error) {
+	if err := checkKeys(fws); err != nil {
+		return nil, err
+	}
+
+	limit := ex.Parallelism
+	if limit <= 0 {
+		limit = DefaultParallelism
+	}
+
+	var (
+		wg  sync.WaitGroup
+		mu  sync.Mutex
+		sem = make(chan struct{}, limit)
+		ans = make(Results[T], len(fws))
+	)
+
+	for _, fw := range fws {
+		if fw == nil {
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(fw *pango.Firewall) {
+			r, finished := runOne(ex.Timeout, fw, fn)
+
+			mu.Lock()
+			ans[key(fw)] = r
+			mu.Unlock()
+			wg.Done()
+
+			<-finished
+			<-sem
+		}(fw)
+	}
+
+	wg.Wait()
+	return ans, nil
+}
+
+// key returns the key that the firewall's result is stored under.
+func key(fw *pango.Firewall) string {
+	if s := fw.SystemInfo["serial"]; s != "" {
+		return s
+	}
+
+	return fw.Hostname
+}
+
+// checkKeys returns a DuplicateKeyError if more than one firewall has the
+// same key.
+func checkKeys(fws []*pango.Firewall) error {
+	seen := make(map[string][]string, len(fws))
+	var dups []string
+	for _, fw := range fws {
+		if fw == nil {
+			continue
+		}
+
+		k := key(fw)
+		if len(seen[k]) == 1 {
+			dups = append(dups, k)
+		}
+		seen[k] = append(seen[k], fw.Hostname)
+	}
+
+	if len(dups) == 0 {
+		return nil
+	}
+
+	sort.Strings(dups)
+	return DuplicateKeyError{Key: dups[0], Hostnames: seen[dups[0]]}
+}
+
+// runOne runs fn against a single firewall, enforcing the timeout.
+//
+// The returned channel is closed once fn has returned, which may be after
+// runOne itself returns if fn timed out.
+func runOne[T any](timeout time.Duration, fw *pango.Firewall, fn func(*pango.Firewall) (T, error)) (Result[T], <-chan struct{}) {
+	ans := Result[T]{
+		Serial:   fw.SystemInfo["serial"],
+		Hostname: fw.Hostname,
+	}
+
+	type outcome struct {
+		val T
+		err error
+	}
+
+	start := time.Now()
+	done := make(chan outcome, 1)
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		defer func() {
+			if p := recover(); p != nil {
+				done <- outcome{err: fmt.Errorf("%s: panic: %v", fw.Hostname, p)}
+			}
+		}()
+
+		v, err := fn(fw)
+		done <- outcome{v, err}
+	}()
+
+	var timer <-chan time.Time
+	if timeout > 0 {
+		t := time.NewTimer(timeout)
+		defer t.Stop()
+		timer = t.C
+	}
+
+	select {
+	case o := <-done:
+		ans.Value, ans.Err = o.val, o.err
+	case <-timer:
+		ans.Err = TimeoutError{Hostname: fw.Hostname, Timeout: timeout}
+	}
+	ans.Elapsed = time.Since(start)
+
+	return ans, finished
+}
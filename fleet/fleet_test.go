@@ -0,0 +1,174 @@
+package fleet
+
+import (
+	"fmt"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango"
+)
+
+func firewall(hostname, serial string) *pango.Firewall {
+	fw := &pango.Firewall{}
+	fw.Hostname = hostname
+	if serial != "" {
+		fw.SystemInfo = map[string]string{"serial": serial}
+	}
+
+	return fw
+}
+
+func TestRunKeysResults(t *testing.T) {
+	fws := []*pango.Firewall{
+		firewall("fw1", "001"),
+		firewall("fw2", "002"),
+		firewall("fw3", ""),
+	}
+
+	res, err := Run(Executor{}, fws, func(fw *pango.Firewall) (string, error) {
+		if fw.Hostname == "fw2" {
+			return "", fmt.Errorf("failed")
+		}
+		return fw.Hostname, nil
+	})
+	if err != nil {
+		t.Fatalf("Error in run: %s", err)
+	}
+
+	if keys := res.Keys(); !reflect.DeepEqual(keys, []string{"001", "002", "fw3"}) {
+		t.Errorf("Keys: %#v", keys)
+	}
+	if vals := res.Values(); !reflect.DeepEqual(vals, map[string]string{"001": "fw1", "fw3": "fw3"}) {
+		t.Errorf("Values: %#v", vals)
+	}
+	if failed := res.Failed(); !reflect.DeepEqual(failed, []string{"002"}) {
+		t.Errorf("Failed: %#v", failed)
+	}
+	if r := res["002"]; r.Hostname != "fw2" || r.Err == nil {
+		t.Errorf("Result: %#v", r)
+	}
+}
+
+func TestRunBoundsParallelism(t *testing.T) {
+	var cur, max int32
+	fws := make([]*pango.Firewall, 0, 10)
+	for i := 0; i < 10; i++ {
+		fws = append(fws, firewall(fmt.Sprintf("fw%d", i), ""))
+	}
+
+	res, err := Run(Executor{Parallelism: 3}, fws, func(fw *pango.Firewall) (bool, error) {
+		n := atomic.AddInt32(&cur, 1)
+		for {
+			m := atomic.LoadInt32(&max)
+			if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&cur, -1)
+		return true, nil
+	})
+	if err != nil {
+		t.Fatalf("Error in run: %s", err)
+	}
+
+	if len(res.Values()) != 10 {
+		t.Errorf("Got %d values", len(res.Values()))
+	}
+	if max > 3 {
+		t.Errorf("Ran %d at once", max)
+	}
+}
+
+func TestRunTimeout(t *testing.T) {
+	fws := []*pango.Firewall{
+		firewall("fast", "001"),
+		firewall("slow", "002"),
+	}
+
+	res, err := Run(Executor{Timeout: 50 * time.Millisecond}, fws, func(fw *pango.Firewall) (int, error) {
+		if fw.Hostname == "slow" {
+			time.Sleep(time.Second)
+		}
+		return 1, nil
+	})
+	if err != nil {
+		t.Fatalf("Error in run: %s", err)
+	}
+
+	if _, ok := res["002"].Err.(TimeoutError); !ok {
+		t.Errorf("Slow err: %v", res["002"].Err)
+	}
+	if res["001"].Err != nil || res["001"].Value != 1 {
+		t.Errorf("Fast result: %#v", res["001"])
+	}
+}
+
+func TestRunPanic(t *testing.T) {
+	fws := []*pango.Firewall{firewall("fw1", "001")}
+
+	res, err := Run(Executor{}, fws, func(fw *pango.Firewall) (int, error) {
+		panic("boom")
+	})
+	if err != nil {
+		t.Fatalf("Error in run: %s", err)
+	}
+
+	if res["001"].Err == nil {
+		t.Errorf("Expected an error")
+	}
+}
+
+func TestRunTimeoutHoldsSlot(t *testing.T) {
+	var running, max int32
+	fws := []*pango.Firewall{
+		firewall("slow", "001"),
+		firewall("fast", "002"),
+	}
+
+	_, err := Run(Executor{Parallelism: 1, Timeout: 10 * time.Millisecond}, fws, func(fw *pango.Firewall) (int, error) {
+		n := atomic.AddInt32(&running, 1)
+		if n > atomic.LoadInt32(&max) {
+			atomic.StoreInt32(&max, n)
+		}
+		if fw.Hostname == "slow" {
+			time.Sleep(100 * time.Millisecond)
+		}
+		atomic.AddInt32(&running, -1)
+		return 1, nil
+	})
+	if err != nil {
+		t.Fatalf("Error in run: %s", err)
+	}
+
+	if n := atomic.LoadInt32(&max); n != 1 {
+		t.Errorf("Ran %d at once", n)
+	}
+}
+
+func TestRunDuplicateKeys(t *testing.T) {
+	var calls int32
+	fws := []*pango.Firewall{
+		firewall("fw1", "001"),
+		firewall("fw2", "001"),
+		firewall("fw3", ""),
+	}
+
+	res, err := Run(Executor{}, fws, func(fw *pango.Firewall) (int, error) {
+		atomic.AddInt32(&calls, 1)
+		return 1, nil
+	})
+
+	e, ok := err.(DuplicateKeyError)
+	if !ok {
+		t.Fatalf("Error is %#v, not DuplicateKeyError", err)
+	}
+	if e.Key != "001" || !reflect.DeepEqual(e.Hostnames, []string{"fw1", "fw2"}) {
+		t.Errorf("Error: %#v", e)
+	}
+	if res != nil || calls != 0 {
+		t.Errorf("Run with duplicate keys: %d calls, results %#v", calls, res)
+	}
+}
@@ -40,13 +40,20 @@ type Firewall struct {
 // info" to get the PAN-OS version.  The full results are saved into the
 // client's SystemInfo map.
 //
+// If ApiKey is set, then it is used as-is and no keygen is performed.  This
+// allows for using a long-lived key of a restricted admin, such as a vsys
+// admin.  If that admin is not permitted to run "show system info", then set
+// Version prior to invoking Initialize().
+//
 // If not specified, the following is assumed:
 //  * Protocol: https
 //  * Port: (unspecified)
 //  * Timeout: 10
 //  * Logging: LogAction | LogUid
 func (c *Firewall) Initialize() error {
-	if len(c.rb) == 0 {
+	if c.Offline {
+		c.initOffline()
+	} else if len(c.rb) == 0 {
 		var e error
 
 		if e = c.initCon(); e != nil {
@@ -0,0 +1,614 @@
+package pango
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/dev"
+	"github.com/PaloAltoNetworks/pango/netw"
+	"github.com/PaloAltoNetworks/pango/objs"
+	"github.com/PaloAltoNetworks/pango/poli"
+	"github.com/PaloAltoNetworks/pango/util"
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+// HaInfo is the HA state of a firewall or Panorama.
+//
+// Panorama does not report an HA mode, as it is always active/passive.
+type HaInfo struct {
+	Enabled   bool
+	Mode      string
+	State     string
+	PeerState string
+}
+
+// Active returns true if this is an active member of its HA pair.
+//
+// Both members of an active/active pair are active.  Panorama states are of
+// the form "primary-active" or "secondary-passive".
+func (o HaInfo) Active() bool {
+	return strings.HasPrefix(o.State, "active") || strings.HasSuffix(o.State, "-active")
+}
+
+// HaInfo returns the HA state of the firewall or Panorama.
+func (c *Client) HaInfo() (HaInfo, error) {
+	type req struct {
+		XMLName struct{} `xml:"show"`
+		Cmd     string   `xml:"high-availability>state"`
+	}
+
+	type resp struct {
+		Enabled   string `xml:"result>enabled"`
+		Mode      string `xml:"result>group>mode"`
+		State     string `xml:"result>group>local-info>state"`
+		PeerState string `xml:"result>group>peer-info>state"`
+
+		// Panorama.
+		PanoState     string `xml:"result>local-info>state"`
+		PanoPeerState string `xml:"result>peer-info>state"`
+	}
+
+	var ans resp
+	if _, err := c.Op(req{}, "", nil, &ans); err != nil {
+		return HaInfo{}, err
+	}
+
+	if ans.State == "" {
+		ans.State = ans.PanoState
+		ans.PeerState = ans.PanoPeerState
+	}
+
+	return HaInfo{
+		Enabled:   util.AsBool(ans.Enabled),
+		Mode:      ans.Mode,
+		State:     ans.State,
+		PeerState: ans.PeerState,
+	}, nil
+}
+
+// NoActivePeerError is returned when neither member of an HA pair is active.
+type NoActivePeerError struct {
+	Errors [2]error
+}
+
+func (e NoActivePeerError) Error() string {
+	msgs := make([]string, 0, 2)
+	for _, err := range e.Errors {
+		if err != nil {
+			msgs = append(msgs, err.Error())
+		}
+	}
+
+	if len(msgs) == 0 {
+		return "no active HA peer found"
+	}
+	return fmt.Sprintf("no active HA peer found: %s", strings.Join(msgs, "; "))
+}
+
+// HaPair is a firewall client for both members of an HA pair.
+//
+// Requests are sent to the active member.  If a request fails with a network
+// error (as opposed to an error reported by PAN-OS), then the active member is
+// detected again, and if the active member has changed, reads as well as set,
+// edit, and delete requests are retried against the new active member.  Op
+// and User-ID commands, moves, vsys imports, commits, and job waits are not
+// retried, as they may not be safe to repeat.
+//
+// An HaPair satisfies util.XapiClient, and it has the Network, Device,
+// Policies, and Objects namespaces of the Firewall, which send their requests
+// to the active member.
+//
+// Each peer should be initialized prior to invoking Initialize().  The peers
+// should also be running the same PAN-OS version.  For active/active pairs,
+// the active-primary member is preferred.
+type HaPair struct {
+	haPair[*Firewall]
+
+	Peers [2]*Firewall
+
+	// Namespaces
+	Network  *netw.FwNetw
+	Device   *dev.FwDev
+	Policies *poli.FwPoli
+	Objects  *objs.FwObjs
+}
+
+// NewHaPair returns an HaPair for the given peers.
+//
+// If either peer is nil, Initialize() will return an error.
+func NewHaPair(a, b *Firewall) *HaPair {
+	h := &HaPair{
+		Peers: [2]*Firewall{a, b},
+	}
+
+	var clients [2]*Client
+	if a != nil {
+		clients[0] = &a.Client
+	}
+	if b != nil {
+		clients[1] = &b.Client
+	}
+	h.setup("HaPair", h.Peers, clients)
+
+	return h
+}
+
+// Initialize detects the active member and sets up the namespaces.
+func (h *HaPair) Initialize() error {
+	if h.Peers[0] == nil || h.Peers[1] == nil {
+		return fmt.Errorf("both HA peers must be specified")
+	}
+
+	h.setup("HaPair", h.Peers, [2]*Client{&h.Peers[0].Client, &h.Peers[1].Client})
+	if _, err := h.Detect(); err != nil {
+		return err
+	}
+
+	h.Network = &netw.FwNetw{}
+	h.Network.Initialize(h)
+
+	h.Device = &dev.FwDev{}
+	h.Device.Initialize(h)
+
+	h.Policies = &poli.FwPoli{}
+	h.Policies.Initialize(h)
+
+	h.Objects = &objs.FwObjs{}
+	h.Objects.Initialize(h)
+
+	return nil
+}
+
+// Detect queries both peers for their HA state, and saves and returns the
+// active member.
+func (h *HaPair) Detect() (*Firewall, error) {
+	return h.detectPeer()
+}
+
+// Active returns the active member, detecting it first if it is not known.
+func (h *HaPair) Active() (*Firewall, error) {
+	return h.activePeer()
+}
+
+// haPair sends requests to the active member of an HA pair of firewalls or
+// Panoramas, and is what both HaPair and PanoramaHaPair are built on.
+type haPair[T any] struct {
+	name    string
+	peers   [2]T
+	clients [2]*Client
+
+	mu     sync.Mutex
+	active int
+}
+
+// String returns the active member's string representation.
+func (h *haPair[T]) String() string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.active < 0 {
+		var names [2]string
+		for i, c := range h.clients {
+			if c != nil {
+				names[i] = c.Hostname
+			}
+		}
+		return fmt.Sprintf("{%s %s / %s}", h.name, names[0], names[1])
+	}
+
+	return h.clients[h.active].String()
+}
+
+// Versioning returns the active member's PAN-OS version.
+func (h *haPair[T]) Versioning() version.Number {
+	if _, err := h.activePeer(); err == nil {
+		return h.logger().Versioning()
+	}
+
+	h.mu.Lock()
+	c := h.clients[0]
+	h.mu.Unlock()
+
+	if c == nil {
+		return version.Number{}
+	}
+	return c.Versioning()
+}
+
+// LogAction logs using the active member.
+func (h *haPair[T]) LogAction(msg string, i ...interface{}) {
+	h.logger().LogAction(msg, i...)
+}
+
+// LogQuery logs using the active member.
+func (h *haPair[T]) LogQuery(msg string, i ...interface{}) {
+	h.logger().LogQuery(msg, i...)
+}
+
+// LogOp logs using the active member.
+func (h *haPair[T]) LogOp(msg string, i ...interface{}) {
+	h.logger().LogOp(msg, i...)
+}
+
+// LogUid logs using the active member.
+func (h *haPair[T]) LogUid(msg string, i ...interface{}) {
+	h.logger().LogUid(msg, i...)
+}
+
+// SkipUnchangedWrites returns the active member's setting.
+func (h *haPair[T]) SkipUnchangedWrites() bool {
+	return h.logger().SkipUnchangedWrites()
+}
+
+// Op performs an op command on the active member.  This is not retried on
+// failover.
+func (h *haPair[T]) Op(req interface{}, vsys string, extras, ans interface{}) ([]byte, error) {
+	var b []byte
+	err := h.do(false, func(c *Client) (err error) {
+		b, err = c.Op(req, vsys, extras, ans)
+		return
+	})
+	return b, err
+}
+
+// Show performs SHOW on the active member.
+func (h *haPair[T]) Show(path, extras, ans interface{}) ([]byte, error) {
+	var b []byte
+	err := h.do(true, func(c *Client) (err error) {
+		b, err = c.Show(path, extras, ans)
+		return
+	})
+	return b, err
+}
+
+// Get performs GET on the active member.
+func (h *haPair[T]) Get(path, extras, ans interface{}) ([]byte, error) {
+	var b []byte
+	err := h.do(true, func(c *Client) (err error) {
+		b, err = c.Get(path, extras, ans)
+		return
+	})
+	return b, err
+}
+
+// EffectiveRunning retrieves the effective running config of the active
+// member.
+func (h *haPair[T]) EffectiveRunning(path, extras, ans interface{}) ([]byte, error) {
+	var b []byte
+	err := h.do(true, func(c *Client) (err error) {
+		b, err = c.EffectiveRunning(path, extras, ans)
+		return
+	})
+	return b, err
+}
+
+// PushedSharedPolicy retrieves the pushed shared policy of the active member.
+func (h *haPair[T]) PushedSharedPolicy(path, extras, ans interface{}) ([]byte, error) {
+	var b []byte
+	err := h.do(true, func(c *Client) (err error) {
+		b, err = c.PushedSharedPolicy(path, extras, ans)
+		return
+	})
+	return b, err
+}
+
+// Delete performs DELETE on the active member.
+func (h *haPair[T]) Delete(path, extras, ans interface{}) ([]byte, error) {
+	var b []byte
+	err := h.do(true, func(c *Client) (err error) {
+		b, err = c.Delete(path, extras, ans)
+		return
+	})
+	return b, err
+}
+
+// Set performs SET on the active member.
+func (h *haPair[T]) Set(path, element, extras, ans interface{}) ([]byte, error) {
+	var b []byte
+	err := h.do(true, func(c *Client) (err error) {
+		b, err = c.Set(path, element, extras, ans)
+		return
+	})
+	return b, err
+}
+
+// Edit performs EDIT on the active member.
+func (h *haPair[T]) Edit(path, element, extras, ans interface{}) ([]byte, error) {
+	var b []byte
+	err := h.do(true, func(c *Client) (err error) {
+		b, err = c.Edit(path, element, extras, ans)
+		return
+	})
+	return b, err
+}
+
+// Move performs MOVE on the active member.  This is not retried on failover.
+func (h *haPair[T]) Move(path interface{}, where, dst string, extras, ans interface{}) ([]byte, error) {
+	var b []byte
+	err := h.do(false, func(c *Client) (err error) {
+		b, err = c.Move(path, where, dst, extras, ans)
+		return
+	})
+	return b, err
+}
+
+// Uid performs a User-ID command on the active member.  This is not retried
+// on failover.
+func (h *haPair[T]) Uid(cmd interface{}, vsys string, extras, ans interface{}) ([]byte, error) {
+	var b []byte
+	err := h.do(false, func(c *Client) (err error) {
+		b, err = c.Uid(cmd, vsys, extras, ans)
+		return
+	})
+	return b, err
+}
+
+// EntryListUsing retrieves a list of entries from the active member.
+func (h *haPair[T]) EntryListUsing(fn util.Retriever, path []string) ([]string, error) {
+	var list []string
+	err := h.do(true, func(c *Client) (err error) {
+		list, err = c.EntryListUsing(fn, path)
+		return
+	})
+	return list, err
+}
+
+// MemberListUsing retrieves a list of members from the active member.
+func (h *haPair[T]) MemberListUsing(fn util.Retriever, path []string) ([]string, error) {
+	var list []string
+	err := h.do(true, func(c *Client) (err error) {
+		list, err = c.MemberListUsing(fn, path)
+		return
+	})
+	return list, err
+}
+
+// RequestPasswordHash requests a password hash from the active member.
+func (h *haPair[T]) RequestPasswordHash(val string) (string, error) {
+	var hash string
+	err := h.do(true, func(c *Client) (err error) {
+		hash, err = c.RequestPasswordHash(val)
+		return
+	})
+	return hash, err
+}
+
+// VsysImport performs a vsys import on the active member.  This is not
+// retried on failover.
+func (h *haPair[T]) VsysImport(loc, tmpl, ts, vsys string, names []string) error {
+	return h.do(false, func(c *Client) error {
+		return c.VsysImport(loc, tmpl, ts, vsys, names)
+	})
+}
+
+// VsysUnimport performs a vsys unimport on the active member.
+func (h *haPair[T]) VsysUnimport(loc, tmpl, ts string, names []string) error {
+	return h.do(true, func(c *Client) error {
+		return c.VsysUnimport(loc, tmpl, ts, names)
+	})
+}
+
+// IsImported checks if the given object is imported on the active member.
+func (h *haPair[T]) IsImported(loc, tmpl, ts, vsys, name string) (bool, error) {
+	var ok bool
+	err := h.do(true, func(c *Client) (err error) {
+		ok, err = c.IsImported(loc, tmpl, ts, vsys, name)
+		return
+	})
+	return ok, err
+}
+
+// ImportedVsys looks up the vsys imports on the active member.
+func (h *haPair[T]) ImportedVsys(loc, tmpl, ts string, names []string) (map[string]string, error) {
+	var ans map[string]string
+	err := h.do(true, func(c *Client) (err error) {
+		ans, err = c.ImportedVsys(loc, tmpl, ts, names)
+		return
+	})
+	return ans, err
+}
+
+// PositionFirstEntity positions the given entity on the active member.  As
+// this is done using moves, this is not retried on failover.
+func (h *haPair[T]) PositionFirstEntity(mvt int, rel, ent string, path, elms []string) error {
+	return h.do(false, func(c *Client) error {
+		return c.PositionFirstEntity(mvt, rel, ent, path, elms)
+	})
+}
+
+// WaitForJob waits for the given job on the active member.  This is not
+// retried on failover.
+func (h *haPair[T]) WaitForJob(id uint, sleep time.Duration, resp interface{}) error {
+	return h.do(false, func(c *Client) error {
+		return c.WaitForJob(id, sleep, resp)
+	})
+}
+
+// Commit performs a commit on the active member.  This is not retried on
+// failover.
+func (h *haPair[T]) Commit(cmd interface{}, action string, extras interface{}) (uint, []byte, error) {
+	var (
+		id uint
+		b  []byte
+	)
+	err := h.do(false, func(c *Client) (err error) {
+		id, b, err = c.Commit(cmd, action, extras)
+		return
+	})
+	return id, b, err
+}
+
+/** Internal functions for the haPair struct **/
+
+// detectPeer queries both peers for their HA state, and saves and returns
+// the active member.
+func (h *haPair[T]) detectPeer() (T, error) {
+	var zero T
+
+	i, err := h.detect()
+	if err != nil {
+		return zero, err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.peers[i], nil
+}
+
+// activePeer returns the active member, detecting it first if it is not
+// known.
+func (h *haPair[T]) activePeer() (T, error) {
+	h.mu.Lock()
+	if h.active >= 0 {
+		p := h.peers[h.active]
+		h.mu.Unlock()
+		return p, nil
+	}
+	h.mu.Unlock()
+
+	return h.detectPeer()
+}
+
+// setup saves the peers and their clients, and forgets the active member.
+func (h *haPair[T]) setup(name string, peers [2]T, clients [2]*Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.name = name
+	h.peers = peers
+	h.clients = clients
+	h.active = -1
+}
+
+// detect finds and saves the active member, returning its index.  The
+// mutex must not be held, as it is released while the peers are queried.
+func (h *haPair[T]) detect() (int, error) {
+	var (
+		errs  [2]error
+		found = -1
+	)
+
+	h.mu.Lock()
+	clients := h.clients
+	h.mu.Unlock()
+
+	if clients[0] == nil || clients[1] == nil {
+		return -1, fmt.Errorf("both HA peers must be specified")
+	}
+
+	for i, c := range clients {
+		info, err := c.HaInfo()
+		if err != nil {
+			errs[i] = fmt.Errorf("%s: %s", c.Hostname, err)
+			continue
+		} else if !info.Enabled {
+			errs[i] = fmt.Errorf("%s: HA is not enabled", c.Hostname)
+			continue
+		} else if !info.Active() {
+			continue
+		}
+
+		if found < 0 || info.State == "active-primary" {
+			found = i
+		}
+	}
+
+	// Only save the result if the peers were not changed in the meantime.
+	h.mu.Lock()
+	if h.clients == clients {
+		h.active = found
+	}
+	h.mu.Unlock()
+
+	if found < 0 {
+		return -1, NoActivePeerError{Errors: errs}
+	}
+
+	c := clients[found]
+	c.LogAction("(ha) %s is the active peer", c.Hostname)
+	return found, nil
+}
+
+// logger returns the client to use for logging, which is the active member
+// if known.
+func (h *haPair[T]) logger() *Client {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.active >= 0 {
+		return h.clients[h.active]
+	}
+	return h.clients[0]
+}
+
+// do runs fn against the active member.  If fn fails with a network error,
+// the active member is detected again, and fn is retried once against the
+// new active member if retry is true and the active member has changed.
+func (h *haPair[T]) do(retry bool, fn func(*Client) error) error {
+	prev, c, err := h.activeClient()
+	if err != nil {
+		return err
+	}
+
+	err = fn(c)
+	if err == nil || !isConnectionError(err) {
+		return err
+	}
+
+	h.mu.Lock()
+	cur := h.active
+	h.mu.Unlock()
+
+	if cur == prev {
+		var e2 error
+		if cur, e2 = h.detect(); e2 != nil {
+			return err
+		}
+	}
+
+	if !retry || cur < 0 || cur == prev {
+		return err
+	}
+
+	h.mu.Lock()
+	c = h.clients[cur]
+	h.mu.Unlock()
+
+	return fn(c)
+}
+
+// activeClient returns the index and client of the active member, detecting
+// it first if it is not known.
+func (h *haPair[T]) activeClient() (int, *Client, error) {
+	h.mu.Lock()
+	i := h.active
+	if i >= 0 {
+		c := h.clients[i]
+		h.mu.Unlock()
+		return i, c, nil
+	}
+	h.mu.Unlock()
+
+	i, err := h.detect()
+	if err != nil {
+		return -1, nil, err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return i, h.clients[i], nil
+}
+
+// isConnectionError returns true if the given error is a network error, as
+// opposed to an error reported by PAN-OS.
+func isConnectionError(err error) bool {
+	var ne net.Error
+	return errors.As(err, &ne) || errors.Is(err, io.ErrUnexpectedEOF)
+}
@@ -0,0 +1,198 @@
+package pango
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+type optionalClient interface {
+	util.XapiClient
+	util.EffectiveRunningClient
+	util.PushedSharedPolicyClient
+	util.ImportChecker
+	util.UnchangedWriteSkipper
+}
+
+var (
+	_ optionalClient = &Client{}
+	_ optionalClient = &HaPair{}
+	_ optionalClient = &PanoramaHaPair{}
+)
+
+type haPeer struct {
+	srv   *httptest.Server
+	state atomic.Value
+	gets  int32
+}
+
+func newHaPeer(state string) *haPeer {
+	p := &haPeer{}
+	p.state.Store(state)
+	p.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.FormValue("cmd"), "high-availability") {
+			fmt.Fprintf(w, `<response status="success"><result><enabled>yes</enabled><group><mode>active-passive</mode><local-info><state>%s</state></local-info></group></result></response>`, p.state.Load())
+			return
+		}
+
+		atomic.AddInt32(&p.gets, 1)
+		w.Write([]byte(`<response status="success"><result><hostname>fw</hostname></result></response>`))
+	}))
+
+	return p
+}
+
+func (p *haPeer) firewall(name string) *Firewall {
+	fw := &Firewall{}
+	fw.Hostname = name
+	fw.Logging = LogQuiet
+	fw.con = p.srv.Client()
+	fw.api_url = p.srv.URL
+
+	return fw
+}
+
+func TestHaInfo(t *testing.T) {
+	c := &Client{}
+	c.rb = [][]byte{[]byte(`<response status="success"><result><enabled>yes</enabled><group><mode>active-passive</mode><local-info><state>passive</state></local-info><peer-info><state>active</state></peer-info></group></result></response>`)}
+	c.Initialize()
+
+	info, err := c.HaInfo()
+	if err != nil {
+		t.Fatalf("Error in HaInfo: %s", err)
+	}
+
+	if !info.Enabled || info.Mode != "active-passive" || info.State != "passive" || info.PeerState != "active" {
+		t.Errorf("Got %#v", info)
+	}
+	if info.Active() {
+		t.Errorf("Passive firewall is active")
+	}
+	if cmd := c.rp[0].Get("cmd"); cmd != "<show><high-availability><state></state></high-availability></show>" {
+		t.Errorf("cmd is %s", cmd)
+	}
+}
+
+func TestHaPairDetectsActive(t *testing.T) {
+	a := newHaPeer("passive")
+	defer a.srv.Close()
+	b := newHaPeer("active")
+	defer b.srv.Close()
+
+	h := NewHaPair(a.firewall("a"), b.firewall("b"))
+	if err := h.Initialize(); err != nil {
+		t.Fatalf("Error in initialize: %s", err)
+	}
+
+	fw, err := h.Active()
+	if err != nil {
+		t.Fatalf("Error in active: %s", err)
+	} else if fw.Hostname != "b" {
+		t.Errorf("Active is %q", fw.Hostname)
+	}
+
+	if _, err = h.Get("/config/devices", nil, nil); err != nil {
+		t.Fatalf("Error in get: %s", err)
+	}
+	if a.gets != 0 || b.gets != 1 {
+		t.Errorf("Gets: a=%d b=%d", a.gets, b.gets)
+	}
+}
+
+func TestHaPairFailover(t *testing.T) {
+	a := newHaPeer("active")
+	b := newHaPeer("passive")
+	defer b.srv.Close()
+
+	h := NewHaPair(a.firewall("a"), b.firewall("b"))
+	if err := h.Initialize(); err != nil {
+		t.Fatalf("Error in initialize: %s", err)
+	}
+
+	a.srv.Close()
+	b.state.Store("active")
+
+	if _, err := h.Get("/config/devices", nil, nil); err != nil {
+		t.Fatalf("Error in get: %s", err)
+	}
+	if b.gets != 1 {
+		t.Errorf("Gets on b: %d", b.gets)
+	}
+	if fw, _ := h.Active(); fw.Hostname != "b" {
+		t.Errorf("Active is %q", fw.Hostname)
+	}
+}
+
+func TestHaPairNoActivePeer(t *testing.T) {
+	a := newHaPeer("passive")
+	defer a.srv.Close()
+	b := newHaPeer("suspended")
+	defer b.srv.Close()
+
+	h := NewHaPair(a.firewall("a"), b.firewall("b"))
+	if _, ok := h.Initialize().(NoActivePeerError); !ok {
+		t.Errorf("Expected NoActivePeerError")
+	}
+}
+
+func TestHaPairNilPeer(t *testing.T) {
+	a := newHaPeer("active")
+	defer a.srv.Close()
+
+	h := NewHaPair(a.firewall("a"), nil)
+	if err := h.Initialize(); err == nil {
+		t.Errorf("Initialize succeeded with a nil peer")
+	}
+	if _, err := h.Get("/config/devices", nil, nil); err == nil {
+		t.Errorf("Get succeeded with a nil peer")
+	}
+	if a.gets != 0 {
+		t.Errorf("Gets on a: %d", a.gets)
+	}
+}
+
+func TestHaPairDetectReleasesLock(t *testing.T) {
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Write([]byte(`<response status="success"><result><enabled>yes</enabled><group><mode>active-passive</mode><local-info><state>active</state></local-info></group></result></response>`))
+	}))
+	defer slow.Close()
+	b := newHaPeer("passive")
+	defer b.srv.Close()
+
+	fw := &Firewall{}
+	fw.Hostname = "a"
+	fw.Logging = LogQuiet
+	fw.con = slow.Client()
+	fw.api_url = slow.URL
+
+	h := NewHaPair(fw, b.firewall("b"))
+	done := make(chan error)
+	go func() {
+		_, err := h.Detect()
+		done <- err
+	}()
+
+	str := make(chan string)
+	go func() {
+		str <- h.String()
+	}()
+
+	select {
+	case <-str:
+	case <-time.After(5 * time.Second):
+		t.Errorf("String blocked while detecting the active peer")
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("Error in detect: %s", err)
+	}
+}
@@ -0,0 +1,104 @@
+package pango
+
+import (
+	"context"
+	"encoding/xml"
+	"net/url"
+	"time"
+)
+
+// Health is the result of a health check.
+type Health struct {
+	// Reachable is true if PAN-OS responded at all.
+	Reachable bool
+
+	// Authenticated is true if PAN-OS accepted the client's API key.  An
+	// API key that is valid but not permitted to run the health check
+	// command is still considered authenticated.
+	Authenticated bool
+
+	// Latency is the round trip time of the health check command.
+	Latency time.Duration
+
+	// Checked is when the health check was performed.
+	Checked time.Time
+
+	// Err is the error encountered, if any.
+	Err error
+}
+
+// Ok returns true if PAN-OS is reachable and the API key is valid.
+func (h Health) Ok() bool {
+	return h.Reachable && h.Authenticated
+}
+
+// HealthCheck performs a lightweight op command ("show clock") to check that
+// PAN-OS is reachable and that the client's API key is still valid.
+//
+// The given context bounds how long to wait for a response, in addition to
+// the client's Timeout.
+func (c *Client) HealthCheck(ctx context.Context) Health {
+	type req struct {
+		XMLName xml.Name `xml:"show"`
+		Cmd     string   `xml:"clock"`
+	}
+
+	ans := Health{Checked: time.Now()}
+	if c.Offline {
+		ans.Err = errOffline
+		return ans
+	}
+
+	data := url.Values{}
+	data.Set("type", "op")
+	data.Set("key", c.ApiKey)
+	if err := addToData("cmd", req{}, true, &data); err != nil {
+		ans.Err = err
+		return ans
+	}
+
+	body, err := c.postContext(ctx, data)
+	ans.Latency = time.Since(ans.Checked)
+	if err != nil {
+		ans.Err = err
+		return ans
+	}
+	ans.Reachable = true
+
+	_, err = c.endCommunication(body, nil)
+	if e2, ok := err.(PanosError); ok {
+		ans.Authenticated = e2.PermissionDenied() || (e2.Code != 403 && e2.Code != 22)
+	} else {
+		ans.Authenticated = true
+	}
+	ans.Err = err
+
+	return ans
+}
+
+// Keepalive performs a health check every interval until the given context
+// is done, passing each result to fn.  This lets long-lived processes detect
+// an unreachable PAN-OS or an expired API key before they need the session.
+//
+// This function blocks, so it is typically run in its own goroutine.
+func (c *Client) Keepalive(ctx context.Context, interval time.Duration, fn func(Health)) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			cctx, cancel := context.WithTimeout(ctx, interval)
+			h := c.HealthCheck(cctx)
+			cancel()
+			if ctx.Err() != nil {
+				return
+			}
+			if fn != nil {
+				fn(h)
+			}
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package pango
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHealthCheck(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		resp          string
+		authenticated bool
+	}{
+		{"ok", `<response status="success"><result>Mon Jan  4 10:00:00 PST 2021
+</result></response>`, true},
+		{"bad key", `<response status = 'error' code = '403'><result><msg>Invalid Credential</msg></result></response>`, false},
+		{"restricted key", `<response status="error" code="16"><msg><line>Unauthorized request</line></msg></response>`, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			c := &Client{rb: [][]byte{[]byte(tc.resp)}}
+			c.Initialize()
+
+			h := c.HealthCheck(context.Background())
+			if !h.Reachable {
+				t.Errorf("Not reachable: %v", h.Err)
+			}
+			if h.Authenticated != tc.authenticated {
+				t.Errorf("Authenticated is %t, not %t", h.Authenticated, tc.authenticated)
+			}
+			if h.Ok() != tc.authenticated {
+				t.Errorf("Ok is %t", h.Ok())
+			}
+			if len(c.rp) != 1 || c.rp[0].Get("type") != "op" {
+				t.Errorf("Unexpected requests: %#v", c.rp)
+			}
+		})
+	}
+}
+
+func TestHealthCheckTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer srv.Close()
+
+	c := &Client{con: srv.Client(), api_url: srv.URL}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	h := c.HealthCheck(ctx)
+	if h.Reachable || h.Ok() || h.Err == nil {
+		t.Errorf("Expected an unreachable result, got %#v", h)
+	}
+}
+
+func TestKeepalive(t *testing.T) {
+	c := &Client{rb: [][]byte{[]byte(`<response status="success"><result>ok</result></response>`)}}
+	c.Initialize()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	results := make(chan Health, 10)
+	done := make(chan struct{})
+	go func() {
+		c.Keepalive(ctx, 5*time.Millisecond, func(h Health) { results <- h })
+		close(done)
+	}()
+
+	select {
+	case h := <-results:
+		if !h.Ok() {
+			t.Errorf("Keepalive reported %#v", h)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("No keepalive performed")
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Errorf("Keepalive did not stop")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/xml"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/PaloAltoNetworks/pango/util"
 )
@@ -25,6 +26,9 @@ type MultiConfigureRequest struct {
 	XMLName xml.Name
 	Id      string `xml:"id,attr,omitempty"`
 	Xpath   string `xml:"xpath,attr"`
+	Where   string `xml:"where,attr,omitempty"`
+	Dst     string `xml:"dst,attr,omitempty"`
+	NewName string `xml:"newname,attr,omitempty"`
 	Data    interface{}
 }
 
@@ -72,3 +76,121 @@ func (m *MultiConfigResponseElement) Message() string {
 
 	return m.Msg.Message
 }
+
+/** Internal functions for staging multi-config requests **/
+
+// newMultiConfigureRequest returns a request for the given action and path.
+func newMultiConfigureRequest(action string, path, element interface{}) MultiConfigureRequest {
+	r := MultiConfigureRequest{
+		XMLName: xml.Name{Local: action},
+		Xpath:   util.AsXpath(path),
+	}
+	if element != nil {
+		r.Data = element
+	}
+
+	return r
+}
+
+// mcQueue is a list of multi-config requests waiting to be sent, and is what
+// both BatchWriter and Batch stage their requests in.
+type mcQueue struct {
+	mu   sync.Mutex
+	reqs []MultiConfigureRequest
+}
+
+// add appends the given request, returning the number of pending requests.
+func (q *mcQueue) add(r MultiConfigureRequest) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.reqs = append(q.reqs, r)
+	return len(q.reqs)
+}
+
+// take removes and returns all pending requests.
+func (q *mcQueue) take() []MultiConfigureRequest {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	reqs := q.reqs
+	q.reqs = nil
+	return reqs
+}
+
+// pending returns the number of pending requests.
+func (q *mcQueue) pending() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return len(q.reqs)
+}
+
+// requests returns a copy of the pending requests.
+func (q *mcQueue) requests() []MultiConfigureRequest {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	ans := make([]MultiConfigureRequest, len(q.reqs))
+	copy(ans, q.reqs)
+	return ans
+}
+
+// queueMultiConfigure adds the request to the client's MultiConfigure, if
+// PrepareMultiConfigure() has been invoked.  Returns true if the request was
+// queued.
+func (c *Client) queueMultiConfigure(r MultiConfigureRequest) bool {
+	c.mu().multiConfigure.Lock()
+	defer c.mu().multiConfigure.Unlock()
+
+	if c.MultiConfigure == nil {
+		return false
+	}
+
+	c.MultiConfigure.Reqs = append(c.MultiConfigure.Reqs, r)
+	return true
+}
+
+// takeMultiConfigure removes and returns the client's MultiConfigure.
+func (c *Client) takeMultiConfigure() *MultiConfigure {
+	c.mu().multiConfigure.Lock()
+	defer c.mu().multiConfigure.Unlock()
+
+	mc := c.MultiConfigure
+	c.MultiConfigure = nil
+	return mc
+}
+
+// queuedMultiConfigure returns a copy of the requests in the client's
+// MultiConfigure.
+func (c *Client) queuedMultiConfigure() []MultiConfigureRequest {
+	c.mu().multiConfigure.Lock()
+	defer c.mu().multiConfigure.Unlock()
+
+	if c.MultiConfigure == nil {
+		return nil
+	}
+
+	ans := make([]MultiConfigureRequest, len(c.MultiConfigure.Reqs))
+	copy(ans, c.MultiConfigure.Reqs)
+	return ans
+}
+
+// sendBatch sends the given requests in a single multi-config request,
+// returning an error if PAN-OS rejected them.
+func (c *Client) sendBatch(reqs []MultiConfigureRequest, strict bool) (MultiConfigureResponse, error) {
+	mc := MultiConfigure{Reqs: reqs}
+	mc.IncrementalIds()
+
+	_, resp, err := c.MultiConfig(mc, strict, nil)
+	if err != nil {
+		return resp, err
+	} else if !resp.Ok() {
+		if len(resp.Results) > 0 {
+			return resp, fmt.Errorf("batch of %d requests failed: %s", len(reqs), resp.Error())
+		}
+		return resp, fmt.Errorf("batch of %d requests failed with code %d", len(reqs), resp.Code)
+	}
+
+	return resp, nil
+}
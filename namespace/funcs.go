@@ -3,6 +3,7 @@ package namespace
 import (
 	"bytes"
 	"encoding/xml"
+	"io"
 )
 
 // UnpackageXmlInto wraps XML content into a throw-away wrapper for further
@@ -18,3 +19,117 @@ func UnpackageXmlInto(b []byte, res interface{}) error {
 	data := buf.Bytes()
 	return xml.Unmarshal(data, res)
 }
+
+// SameConfig returns true if the two version specific objects marshal to the
+// same XML.
+func SameConfig(a, b interface{}) bool {
+	ab, err := xml.Marshal(a)
+	if err != nil {
+		return false
+	}
+
+	bb, err := xml.Marshal(b)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(ab, bb)
+}
+
+// packagedResult unmarshals a PAN-OS response into ans, removing the same
+// packaging that util.StripPanosPackaging() removes.  This allows a response
+// to be unmarshaled as it is read instead of being buffered first.
+type packagedResult struct {
+	tag string
+	ans interface{}
+}
+
+func (p *packagedResult) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		t, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch v := t.(type) {
+		case xml.StartElement:
+			if v.Name.Local != "result" {
+				if err = d.Skip(); err != nil {
+					return err
+				}
+				continue
+			}
+			if err = p.result(d); err != nil {
+				return err
+			}
+			return d.Skip()
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// result unmarshals the contents of the result, consuming its end element.
+func (p *packagedResult) result(d *xml.Decoder) error {
+	for {
+		t, err := d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch v := t.(type) {
+		case xml.StartElement:
+			if p.tag != "" && v.Name.Local == p.tag {
+				if err = d.DecodeElement(p.ans, &v); err != nil {
+					return err
+				}
+				return d.Skip()
+			}
+			r := &wrappedTokens{d: d, toks: []xml.Token{
+				xml.StartElement{Name: xml.Name{Local: "a"}},
+				v.Copy(),
+			}, depth: 1}
+			return xml.NewTokenDecoder(r).Decode(p.ans)
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// wrappedTokens is the equivalent of UnpackageXmlInto() for a token stream:
+// the remaining contents of the current element are wrapped into a throw-away
+// wrapper for further unmarshaling.
+type wrappedTokens struct {
+	d     *xml.Decoder
+	toks  []xml.Token
+	depth int
+	done  bool
+}
+
+func (w *wrappedTokens) Token() (xml.Token, error) {
+	if len(w.toks) > 0 {
+		t := w.toks[0]
+		w.toks = w.toks[1:]
+		return t, nil
+	} else if w.done {
+		return nil, io.EOF
+	}
+
+	t, err := w.d.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t.(type) {
+	case xml.StartElement:
+		w.depth++
+	case xml.EndElement:
+		if w.depth == 0 {
+			w.done = true
+			return xml.EndElement{Name: xml.Name{Local: "a"}}, nil
+		}
+		w.depth--
+	}
+
+	return xml.CopyToken(t), nil
+}
@@ -34,6 +34,17 @@ func (n *Namespace) Objects(cmd string, path []string, ans interface{}) error {
 	return n.retrieve(cmd, path, false, "", true, false, ans)
 }
 
+// Existing returns the objects at the given xpath, if any.  Unlike Object,
+// no error is returned if the objects do not exist.
+func (n *Namespace) Existing(cmd string, path []string, ans interface{}) error {
+	err := n.retrieve(cmd, path, true, "", false, false, ans)
+	if err != nil && (err.Error() == "No such node" || err.Error() == "Object not found") {
+		return nil
+	}
+
+	return err
+}
+
 // Listing returns a name listing at the given xpath.
 func (n *Namespace) Listing(cmd string, path []string, ans Namer) ([]string, error) {
 	if err := n.retrieve(cmd, path, false, "", true, true, ans); err != nil {
@@ -44,6 +55,9 @@ func (n *Namespace) Listing(cmd string, path []string, ans Namer) ([]string, err
 }
 
 // Set performs a SET to create / update one or more objects.
+//
+// If setting multiple objects fails and PAN-OS reports which objects were at
+// fault, then the error returned is a util.BulkSetError.
 func (n *Namespace) Set(names, path []string, data []interface{}) error {
 	n.con.LogAction("(set) %s: %v", n.Plural, names)
 
@@ -72,6 +86,13 @@ func (n *Namespace) Set(names, path []string, data []interface{}) error {
 	}
 
 	_, err := n.con.Set(path, elm.Config(), nil, nil)
+	if err != nil && len(data) > 1 {
+		// Report which objects were rejected, if that can be determined.
+		ee := elm.EntryErrors(err)
+		if len(ee) > 1 || (len(ee) == 1 && ee[""] == nil) {
+			return util.BulkSetError{Phase: util.PhaseSet, Failed: names, Err: err, EntryErrors: ee}
+		}
+	}
 	return err
 }
 
@@ -95,6 +116,38 @@ func (n *Namespace) Delete(names, path []string) error {
 	return err
 }
 
+// Restore reverts the given objects to a previously retrieved state after a
+// failed multi-step operation.
+//
+// Any name in `names` that is not present in `prev` is deleted, while the
+// config of every name in `prev` is restored using EDIT.
+func (n *Namespace) Restore(names []string, prev map[string]interface{}, pather Pather) error {
+	n.con.LogAction("(rollback) %s: %v", n.Plural, names)
+
+	created := make([]string, 0, len(names))
+	for _, name := range names {
+		if _, ok := prev[name]; !ok {
+			created = append(created, name)
+		}
+	}
+
+	if len(created) > 0 {
+		if _, err := n.con.Delete(pather(created), nil, nil); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range names {
+		if data, ok := prev[name]; ok {
+			if _, err := n.con.Edit(pather([]string{name}), data, nil, nil); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // MoveGroup places a logical group of objects in the desired location (rulebase
 // objects).
 //
@@ -130,6 +183,11 @@ func (n *Namespace) MoveGroup(pather MovePather, lister MoveLister, movement int
 			return fmt.Errorf("No policies found")
 		}
 
+		// Sanity check:  every rule in the group should be present.
+		if missing := missingNames(curList, grp); len(missing) != 0 {
+			return fmt.Errorf("%s not found: %v", n.Plural, missing)
+		}
+
 		switch movement {
 		case util.MoveTop:
 			_, em := n.con.Move(path, "top", "", nil, nil)
@@ -192,17 +250,94 @@ func (n *Namespace) MoveGroup(pather MovePather, lister MoveLister, movement int
 	return nil
 }
 
+// Reorder moves objects so that the given names are in the given order at the
+// top of the rulebase (rulebase objects).
+//
+// Any objects not present in `order` are left after the ordered objects, in
+// their current relative order.  Only the objects that are out of place are
+// moved, so reordering a rulebase that is already in the desired order does
+// not make any changes.
+func (n *Namespace) Reorder(pather MovePather, lister MoveLister, order []string) error {
+	n.con.LogAction("(reorder) %s: %v", n.Plural, order)
+
+	if len(order) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(order))
+	for _, name := range order {
+		if seen[name] {
+			return fmt.Errorf("%s %q is specified more than once", n.Singular, name)
+		}
+		seen[name] = true
+	}
+
+	curList, err := lister()
+	if err != nil {
+		return err
+	}
+
+	if missing := missingNames(curList, order); len(missing) != 0 {
+		return fmt.Errorf("%s not found: %v", n.Plural, missing)
+	}
+
+	cur := make([]string, len(curList))
+	copy(cur, curList)
+	for i, name := range order {
+		if cur[i] == name {
+			continue
+		}
+
+		if i == 0 {
+			_, err = n.con.Move(pather(name), "top", "", nil, nil)
+		} else {
+			_, err = n.con.Move(pather(name), "after", order[i-1], nil, nil)
+		}
+		if err != nil {
+			return err
+		}
+
+		// Keep the local copy of the rulebase in sync with the move.
+		for j := i + 1; j < len(cur); j++ {
+			if cur[j] == name {
+				copy(cur[i+1:j+1], cur[i:j])
+				cur[i] = name
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // Internal functions.
 
-// retrieve does either a GET or SHOW to retrieve config.
+// missingNames returns the names in `want` that are not present in `list`.
+func missingNames(list, want []string) []string {
+	present := make(map[string]bool, len(list))
+	for _, v := range list {
+		present[v] = true
+	}
+
+	var ans []string
+	for _, v := range want {
+		if !present[v] {
+			ans = append(ans, v)
+		}
+	}
+
+	return ans
+}
+
+// retrieve retrieves config using the given pango query type.
 func (n *Namespace) retrieve(cmd string, path []string, singular bool, singleDesc string, plural, namesOnly bool, ans interface{}) error {
 	var err error
 	var data []byte
 	var tag string
 
 	// Sanity check.
-	if cmd != util.Get && cmd != util.Show {
-		return fmt.Errorf("invalid cmd: %s", cmd)
+	if _, err = util.RetrieverFor(n.con, cmd); err != nil {
+		return err
 	}
 
 	// Do logging and determine the actual path to query.
@@ -214,7 +349,7 @@ func (n *Namespace) retrieve(cmd string, path []string, singular bool, singleDes
 		}
 	} else if plural {
 		tag = path[len(path)-2]
-		if cmd == util.Show {
+		if cmd != util.Get {
 			path = path[:len(path)-1]
 		}
 		if namesOnly {
@@ -230,18 +365,22 @@ func (n *Namespace) retrieve(cmd string, path []string, singular bool, singleDes
 		}
 	}
 
-	// Perform the query.
-	switch cmd {
-	case util.Get:
-		data, err = n.con.Get(path, nil, nil)
-	case util.Show:
-		data, err = n.con.Show(path, nil, nil)
+	// Perform the query.  If the client streams responses, then the response
+	// is unmarshaled into the given struct as it is read.
+	var pr interface{}
+	stream := util.StreamsResponses(n.con)
+	if stream {
+		pr = &packagedResult{tag: tag, ans: ans}
 	}
+	fn, _ := util.RetrieverFor(n.con, cmd)
+	data, err = fn(path, nil, pr)
 	if err != nil {
 		if plural && (err.Error() == "No such node" || err.Error() == "Object not found") {
 			return nil
 		}
 		return err
+	} else if stream {
+		return nil
 	}
 
 	// Unmarshal the response into the given struct.
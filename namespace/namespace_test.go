@@ -0,0 +1,111 @@
+package namespace
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// moveClient records the moves performed.
+type moveClient struct {
+	*testdata.MockClient
+	moves []string
+}
+
+func (c *moveClient) Move(path interface{}, where, dst string, extras, ans interface{}) ([]byte, error) {
+	p := path.([]string)
+	c.moves = append(c.moves, fmt.Sprintf("%s %s %s", p[len(p)-1], where, dst))
+	return nil, nil
+}
+
+func movePather(v string) []string {
+	return []string{"config", "shared", "rules", util.AsEntryXpath([]string{v})}
+}
+
+func moveLister(v ...string) MoveLister {
+	return func() ([]string, error) {
+		return v, nil
+	}
+}
+
+func TestReorder(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		cur      []string
+		order    []string
+		expected []string
+	}{
+		{"already ordered", []string{"a", "b", "c"}, []string{"a", "b", "c"}, nil},
+		{"reverse", []string{"a", "b", "c"}, []string{"c", "b", "a"}, []string{
+			"entry[@name='c'] top ",
+			"entry[@name='b'] after c",
+		}},
+		{"partial order", []string{"a", "b", "c", "d"}, []string{"c", "a"}, []string{
+			"entry[@name='c'] top ",
+		}},
+		{"one out of place", []string{"a", "b", "c", "d"}, []string{"a", "c", "b", "d"}, []string{
+			"entry[@name='c'] after a",
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc := &moveClient{MockClient: &testdata.MockClient{}}
+			ns := New("rule", "rules", mc)
+			if err := ns.Reorder(movePather, moveLister(tc.cur...), tc.order); err != nil {
+				t.Fatalf("Error in reorder: %s", err)
+			}
+			if !reflect.DeepEqual(mc.moves, tc.expected) {
+				t.Errorf("Moves are %#v, expected %#v", mc.moves, tc.expected)
+			}
+		})
+	}
+}
+
+func TestReorderErrors(t *testing.T) {
+	mc := &moveClient{MockClient: &testdata.MockClient{}}
+	ns := New("rule", "rules", mc)
+
+	if err := ns.Reorder(movePather, moveLister("a", "b"), []string{"a", "x"}); err == nil {
+		t.Errorf("Expected an error for a missing rule")
+	}
+	if err := ns.Reorder(movePather, moveLister("a", "b"), []string{"a", "a"}); err == nil {
+		t.Errorf("Expected an error for a duplicate rule")
+	}
+	if len(mc.moves) != 0 {
+		t.Errorf("Moves performed: %#v", mc.moves)
+	}
+}
+
+func TestMoveGroupMissingMember(t *testing.T) {
+	mc := &moveClient{MockClient: &testdata.MockClient{}}
+	ns := New("rule", "rules", mc)
+
+	err := ns.MoveGroup(movePather, moveLister("a", "b", "c"), util.MoveTop, "", []string{"b", "x"})
+	if err == nil {
+		t.Errorf("Expected an error for a missing group member")
+	}
+	if len(mc.moves) != 0 {
+		t.Errorf("Moves performed: %#v", mc.moves)
+	}
+}
+
+func TestMoveGroup(t *testing.T) {
+	mc := &moveClient{MockClient: &testdata.MockClient{}}
+	ns := New("rule", "rules", mc)
+
+	if err := ns.MoveGroup(movePather, moveLister("a", "b", "c", "d"), util.MoveBefore, "a", []string{"d", "b"}); err != nil {
+		t.Fatalf("Error in move group: %s", err)
+	}
+
+	expected := []string{
+		"entry[@name='d'] before a",
+		"entry[@name='b'] after d",
+	}
+	if !reflect.DeepEqual(mc.moves, expected) {
+		t.Errorf("Moves are %#v, expected %#v", mc.moves, expected)
+	}
+}
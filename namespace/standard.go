@@ -0,0 +1,215 @@
+package namespace
+
+import (
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is the constraint for normalized objects handled by a Standard
+// namespace.
+type Entry interface {
+	EntryName() string
+}
+
+// Normalizer is a version specific container that can be normalized into
+// a list of entries.
+type Normalizer[E Entry] interface {
+	Namer
+	Normalize() []E
+}
+
+// Versioner returns the version specific container and specifier function
+// for the current PAN-OS version.
+type Versioner[E Entry] func() (Normalizer[E], func(E) interface{})
+
+// Pather returns the xpath for the given names.
+type Pather func([]string) []string
+
+// Standard is a namespace for objects that have the standard list / get /
+// set / edit / delete workflow.  It is built on top of Namespace so that all
+// of the xpath handling for these workflows lives in one place instead of
+// being copy / pasted across each namespace.
+type Standard[E Entry] struct {
+	*Namespace
+	versioning Versioner[E]
+}
+
+// NewStandard returns a new standard namespace.
+func NewStandard[E Entry](s, p string, con util.XapiClient, fn Versioner[E]) *Standard[E] {
+	return &Standard[E]{
+		Namespace:  New(s, p, con),
+		versioning: fn,
+	}
+}
+
+// List returns a list of names at the given xpath.
+func (n *Standard[E]) List(cmd string, path []string) ([]string, error) {
+	result, _ := n.versioning()
+	return n.Listing(cmd, path, result)
+}
+
+// One returns the single object at the given xpath.
+func (n *Standard[E]) One(cmd string, path []string, name string) (E, error) {
+	var ans E
+
+	result, _ := n.versioning()
+	if err := n.Object(cmd, path, name, result); err != nil {
+		return ans, err
+	}
+
+	list := result.Normalize()
+	if len(list) == 0 {
+		return ans, fmt.Errorf("%s %q not found", n.Singular, name)
+	}
+
+	return list[0], nil
+}
+
+// All returns all objects at the given xpath.
+func (n *Standard[E]) All(cmd string, path []string) ([]E, error) {
+	result, _ := n.versioning()
+	if err := n.Objects(cmd, path, result); err != nil {
+		return nil, err
+	}
+
+	return result.Normalize(), nil
+}
+
+// ListPage returns the names of up to limit objects at the given xpath,
+// starting at the given zero-based offset.
+//
+// Paging uses an xpath position predicate, so only GET is supported.
+func (n *Standard[E]) ListPage(path []string, offset, limit int) ([]string, error) {
+	result, _ := n.versioning()
+	return n.Listing(util.Get, n.page(path, offset, limit), result)
+}
+
+// AllPage returns up to limit objects at the given xpath, starting at the
+// given zero-based offset.
+//
+// Paging uses an xpath position predicate, so only GET is supported.
+func (n *Standard[E]) AllPage(path []string, offset, limit int) ([]E, error) {
+	n.con.LogQuery("(get) %s %d - %d", n.Plural, offset+1, offset+limit)
+
+	result, _ := n.versioning()
+	if err := n.retrieve(util.Get, n.page(path, offset, limit), false, "", false, false, result); err != nil {
+		return nil, err
+	}
+
+	return result.Normalize(), nil
+}
+
+// SetEntries performs a SET to create / update one or more objects.
+//
+// If the client is skipping unchanged writes, then objects whose config
+// already matches are omitted.
+func (n *Standard[E]) SetEntries(pather Pather, e ...E) error {
+	e, err := n.changed(pather, e)
+	if err != nil {
+		return err
+	}
+
+	_, fn := n.versioning()
+	data := make([]interface{}, 0, len(e))
+	names := make([]string, 0, len(e))
+
+	for i := range e {
+		data = append(data, fn(e[i]))
+		names = append(names, e[i].EntryName())
+	}
+
+	return n.Set(names, pather(names), data)
+}
+
+// EditEntry performs an EDIT to create / update a single object.
+//
+// If the client is skipping unchanged writes and the object's config already
+// matches, then nothing is done.
+func (n *Standard[E]) EditEntry(pather Pather, e E) error {
+	list, err := n.changed(pather, []E{e})
+	if err != nil || len(list) == 0 {
+		return err
+	}
+
+	_, fn := n.versioning()
+	name := e.EntryName()
+
+	return n.Edit(name, pather([]string{name}), fn(e))
+}
+
+// DeleteEntries performs a DELETE to remove one or more objects.
+//
+// Objects can be either a string or an E.
+func (n *Standard[E]) DeleteEntries(pather Pather, e ...interface{}) error {
+	names, err := n.Names(e)
+	if err != nil {
+		return err
+	}
+
+	return n.Delete(names, pather(names))
+}
+
+// Names converts the given list of strings or entries into a list of names.
+func (n *Standard[E]) Names(e []interface{}) ([]string, error) {
+	names := make([]string, len(e))
+	for i := range e {
+		switch v := e[i].(type) {
+		case string:
+			names[i] = v
+		case E:
+			names[i] = v.EntryName()
+		default:
+			return nil, fmt.Errorf("Unsupported type for %s: %s", n.Singular, v)
+		}
+	}
+
+	return names, nil
+}
+
+// page returns a copy of the given xpath that selects only one page of
+// entries.
+func (n *Standard[E]) page(path []string, offset, limit int) []string {
+	ans := make([]string, len(path))
+	copy(ans, path)
+	ans[len(ans)-1] = util.AsEntryXpathPage(offset, limit)
+
+	return ans
+}
+
+// changed returns the given entries whose config differs from what is
+// currently configured.  If the client is not skipping unchanged writes, then
+// all entries are returned as-is.
+func (n *Standard[E]) changed(pather Pather, e []E) ([]E, error) {
+	if len(e) == 0 || !util.SkipUnchangedWrites(n.con) {
+		return e, nil
+	}
+
+	names := make([]string, 0, len(e))
+	for i := range e {
+		names = append(names, e[i].EntryName())
+	}
+
+	result, fn := n.versioning()
+	if err := n.Existing(util.Get, pather(names), result); err != nil {
+		return nil, err
+	}
+	cur := make(map[string]interface{})
+	for _, x := range result.Normalize() {
+		cur[x.EntryName()] = fn(x)
+	}
+
+	ans := make([]E, 0, len(e))
+	for i := range e {
+		if prev, ok := cur[e[i].EntryName()]; ok && SameConfig(prev, fn(e[i])) {
+			continue
+		}
+		ans = append(ans, e[i])
+	}
+
+	if len(ans) < len(e) {
+		n.con.LogAction("(skip) %d unchanged %s", len(e)-len(ans), n.Plural)
+	}
+
+	return ans, nil
+}
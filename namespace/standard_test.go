@@ -0,0 +1,259 @@
+package namespace
+
+import (
+	"encoding/xml"
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+type testEntry struct {
+	Name  string
+	Value string
+}
+
+func (o testEntry) EntryName() string { return o.Name }
+
+type testContainer struct {
+	Answer []testEntryXml `xml:"entry"`
+}
+
+func (o *testContainer) Normalize() []testEntry {
+	ans := make([]testEntry, 0, len(o.Answer))
+	for _, x := range o.Answer {
+		ans = append(ans, testEntry{Name: x.Name, Value: x.Value})
+	}
+	return ans
+}
+
+func (o *testContainer) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for _, x := range o.Answer {
+		ans = append(ans, x.Name)
+	}
+	return ans
+}
+
+type testEntryXml struct {
+	XMLName xml.Name `xml:"entry"`
+	Name    string   `xml:"name,attr"`
+	Value   string   `xml:"value"`
+}
+
+func testSpecify(e testEntry) interface{} {
+	return testEntryXml{Name: e.Name, Value: e.Value}
+}
+
+func testVersioning() (Normalizer[testEntry], func(testEntry) interface{}) {
+	return &testContainer{}, testSpecify
+}
+
+func testPather(v []string) []string {
+	return []string{"config", "shared", "thing", util.AsEntryXpath(v)}
+}
+
+func TestStandardSetOne(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp("")
+	ns := NewStandard("thing", "things", mc, testVersioning)
+
+	if err := ns.SetEntries(testPather, testEntry{Name: "one", Value: "1"}); err != nil {
+		t.Fatalf("Error in set: %s", err)
+	}
+
+	if mc.Path != "/config/shared/thing" {
+		t.Errorf("Path is %q", mc.Path)
+	}
+	if mc.Elm != `<entry name="one"><value>1</value></entry>` {
+		t.Errorf("Elm is %q", mc.Elm)
+	}
+}
+
+func TestStandardSetMultiple(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp("")
+	ns := NewStandard("thing", "things", mc, testVersioning)
+
+	err := ns.SetEntries(testPather, testEntry{Name: "one"}, testEntry{Name: "two"})
+	if err != nil {
+		t.Fatalf("Error in set: %s", err)
+	}
+
+	if mc.Path != "/config/shared" {
+		t.Errorf("Path is %q", mc.Path)
+	}
+	if mc.Elm != `<thing><entry name="one"><value></value></entry><entry name="two"><value></value></entry></thing>` {
+		t.Errorf("Elm is %q", mc.Elm)
+	}
+}
+
+func TestStandardSetDuplicateNames(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp("")
+	ns := NewStandard("thing", "things", mc, testVersioning)
+
+	if err := ns.SetEntries(testPather, testEntry{Name: "one"}, testEntry{Name: "one"}); err == nil {
+		t.Errorf("Expected an error on duplicate names")
+	}
+}
+
+func TestStandardOneAndAll(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp(`<entry name="one"><value>1</value></entry><entry name="two"><value>2</value></entry>`)
+	ns := NewStandard("thing", "things", mc, testVersioning)
+
+	one, err := ns.One(util.Get, testPather([]string{"one"}), "one")
+	if err != nil {
+		t.Fatalf("Error in get: %s", err)
+	} else if !reflect.DeepEqual(one, testEntry{"one", "1"}) {
+		t.Errorf("Got %#v", one)
+	}
+
+	all, err := ns.All(util.Get, testPather(nil))
+	if err != nil {
+		t.Fatalf("Error in get all: %s", err)
+	} else if len(all) != 2 || all[1].Value != "2" {
+		t.Errorf("Got %#v", all)
+	}
+}
+
+func TestStandardStreamed(t *testing.T) {
+	mc := &testdata.MockClient{Stream: true}
+	mc.AddResp(`<entry name="one"><value>1</value></entry>`)
+	mc.AddResp(`<thing><entry name="one"><value>1</value></entry><entry name="two"><value>2</value></entry></thing>`)
+	mc.AddResp(`<entry name="one"/><entry name="two"/>`)
+	ns := NewStandard("thing", "things", mc, testVersioning)
+
+	one, err := ns.One(util.Get, testPather([]string{"one"}), "one")
+	if err != nil {
+		t.Fatalf("Error in get: %s", err)
+	} else if !reflect.DeepEqual(one, testEntry{"one", "1"}) {
+		t.Errorf("Got %#v", one)
+	}
+
+	all, err := ns.All(util.Show, testPather(nil))
+	if err != nil {
+		t.Fatalf("Error in show all: %s", err)
+	} else if len(all) != 2 || all[1].Value != "2" {
+		t.Errorf("Got %#v", all)
+	}
+
+	names, err := ns.List(util.Get, testPather(nil))
+	if err != nil {
+		t.Fatalf("Error in list: %s", err)
+	} else if !reflect.DeepEqual(names, []string{"one", "two"}) {
+		t.Errorf("Got %#v", names)
+	}
+}
+
+func TestStandardOneNotFound(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp("")
+	ns := NewStandard("thing", "things", mc, testVersioning)
+
+	if _, err := ns.One(util.Get, testPather([]string{"one"}), "one"); err == nil {
+		t.Errorf("Expected an error on an empty response")
+	}
+}
+
+func TestStandardPaging(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp(`<entry name="three"><value>3</value></entry><entry name="four"><value>4</value></entry>`)
+	ns := NewStandard("thing", "things", mc, testVersioning)
+
+	all, err := ns.AllPage(testPather(nil), 2, 2)
+	if err != nil {
+		t.Fatalf("Error in get page: %s", err)
+	} else if len(all) != 2 || all[0].Name != "three" {
+		t.Errorf("Got %#v", all)
+	}
+	if mc.Path != "/config/shared/thing/entry[position() > 2 and position() <= 4]" {
+		t.Errorf("Path is %q", mc.Path)
+	}
+
+	names, err := ns.ListPage(testPather(nil), 2, 2)
+	if err != nil {
+		t.Fatalf("Error in list page: %s", err)
+	} else if !reflect.DeepEqual(names, []string{"three", "four"}) {
+		t.Errorf("Got %#v", names)
+	}
+	if mc.Path != "/config/shared/thing/entry[position() > 2 and position() <= 4]/@name" {
+		t.Errorf("Path is %q", mc.Path)
+	}
+}
+
+func TestStandardPagingPastEnd(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.Resp = []testdata.Response{{Raw: []byte(`<response status="success"><result total-count="0" count="0"/></response>`)}}
+	ns := NewStandard("thing", "things", mc, testVersioning)
+
+	all, err := ns.AllPage(testPather(nil), 100, 50)
+	if err != nil {
+		t.Fatalf("Error in get page: %s", err)
+	} else if len(all) != 0 {
+		t.Errorf("Got %#v", all)
+	}
+}
+
+func TestStandardNames(t *testing.T) {
+	ns := NewStandard("thing", "things", &testdata.MockClient{}, testVersioning)
+
+	names, err := ns.Names([]interface{}{"one", testEntry{Name: "two"}})
+	if err != nil {
+		t.Fatalf("Error in names: %s", err)
+	} else if !reflect.DeepEqual(names, []string{"one", "two"}) {
+		t.Errorf("Got %#v", names)
+	}
+
+	if _, err = ns.Names([]interface{}{1}); err == nil {
+		t.Errorf("Expected an error on an unsupported type")
+	}
+}
+
+func TestStandardSetSkipsUnchanged(t *testing.T) {
+	mc := &testdata.MockClient{SkipUnchanged: true}
+	mc.AddResp(`<entry name="one"><value>1</value></entry>`)
+	ns := NewStandard("thing", "things", mc, testVersioning)
+
+	err := ns.SetEntries(testPather, testEntry{"one", "1"}, testEntry{"two", "2"})
+	if err != nil {
+		t.Fatalf("Error in set: %s", err)
+	}
+
+	if mc.Function != "set" {
+		t.Fatalf("Function is %q", mc.Function)
+	}
+	if mc.Elm != `<entry name="two"><value>2</value></entry>` {
+		t.Errorf("Elm is %q", mc.Elm)
+	}
+
+	mc.Reset()
+	if err = ns.EditEntry(testPather, testEntry{"one", "1"}); err != nil {
+		t.Fatalf("Error in edit: %s", err)
+	}
+	if mc.Function != "get" {
+		t.Errorf("Unchanged entry was written: %q", mc.Function)
+	}
+}
+
+type testMessages []string
+
+func (e testMessages) Error() string      { return "bulk set failed" }
+func (e testMessages) Messages() []string { return e }
+
+func TestStandardSetEntryErrors(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.Resp = []testdata.Response{{Error: testMessages{"thing -> two -> value is invalid"}}}
+	ns := NewStandard("thing", "things", mc, testVersioning)
+
+	err := ns.SetEntries(testPather, testEntry{Name: "one"}, testEntry{Name: "two"})
+	be, ok := err.(util.BulkSetError)
+	if !ok {
+		t.Fatalf("Error is %T, not util.BulkSetError", err)
+	}
+	if len(be.EntryErrors) != 1 || len(be.EntryErrors["two"]) != 1 {
+		t.Errorf("EntryErrors is %#v", be.EntryErrors)
+	}
+}
@@ -0,0 +1,137 @@
+package pango
+
+import (
+	"encoding/xml"
+)
+
+// NatRulePool is the IP pool usage of a single dynamic IP or dynamic IP and
+// port NAT rule, as reported by "show running nat-rule-ippool".
+//
+// Used and Available are counts of addresses for dynamic IP rules, or of
+// address and port pairs for DIPP rules.  OversubscriptionRatio is the DIPP
+// oversubscription ratio applied to the pool, or 0 if not applicable.
+type NatRulePool struct {
+	Rule                  string
+	Vsys                  string
+	Type                  string
+	Used                  int
+	Available             int
+	OversubscriptionRatio int
+	MemorySize            int
+}
+
+// Utilization returns the percentage of the pool that is in use.
+func (o NatRulePool) Utilization() float64 {
+	total := o.Used + o.Available
+	if total == 0 {
+		return 0
+	}
+
+	return float64(o.Used) * 100 / float64(total)
+}
+
+// NatGlobalPool is a single NAT IP pool, as reported by "show running
+// global-ippool".
+//
+// Size is the number of addresses in the pool.  OversubscriptionRatio is the
+// DIPP oversubscription ratio of the pool.
+type NatGlobalPool struct {
+	Index                 int
+	Type                  string
+	Size                  int
+	OversubscriptionRatio int
+	MemorySize            int
+}
+
+// NatRulePools returns the IP pool usage of the given NAT rule, or of all
+// NAT rules if rule is an empty string.
+//
+// The vsys param limits the results to the given vsys, and may be empty.
+func (c *Client) NatRulePools(rule, vsys string) ([]NatRulePool, error) {
+	if rule == "" {
+		rule = "all"
+	}
+
+	type req struct {
+		XMLName xml.Name `xml:"show"`
+		Rule    string   `xml:"running>nat-rule-ippool>rule"`
+	}
+
+	var resp natRulePoolResp
+
+	c.LogOp("(op) getting nat rule ip pools: %s", rule)
+	if _, err := c.Op(req{Rule: rule}, vsys, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	ans := make([]NatRulePool, 0, len(resp.Entries))
+	for _, e := range resp.Entries {
+		ans = append(ans, NatRulePool{
+			Rule:                  e.Rule,
+			Vsys:                  e.Vsys,
+			Type:                  e.Type,
+			Used:                  e.Used,
+			Available:             e.Available,
+			OversubscriptionRatio: e.Ratio,
+			MemorySize:            e.MemorySize,
+		})
+	}
+
+	return ans, nil
+}
+
+// NatGlobalPools returns the statistics of all NAT IP pools.
+func (c *Client) NatGlobalPools() ([]NatGlobalPool, error) {
+	type req struct {
+		XMLName xml.Name `xml:"show"`
+		Cmd     string   `xml:"running>global-ippool"`
+	}
+
+	var resp natGlobalPoolResp
+
+	c.LogOp("(op) getting global nat ip pools")
+	if _, err := c.Op(req{}, "", nil, &resp); err != nil {
+		return nil, err
+	}
+
+	ans := make([]NatGlobalPool, 0, len(resp.Entries))
+	for _, e := range resp.Entries {
+		ans = append(ans, NatGlobalPool{
+			Index:                 e.Index,
+			Type:                  e.Type,
+			Size:                  e.Size,
+			OversubscriptionRatio: e.Ratio,
+			MemorySize:            e.MemorySize,
+		})
+	}
+
+	return ans, nil
+}
+
+type natRulePoolResp struct {
+	XMLName xml.Name           `xml:"response"`
+	Entries []natRulePoolEntry `xml:"result>entry"`
+}
+
+type natRulePoolEntry struct {
+	Rule       string `xml:"rule"`
+	Vsys       string `xml:"vsys"`
+	Type       string `xml:"type"`
+	Used       int    `xml:"used"`
+	Available  int    `xml:"available"`
+	Ratio      int    `xml:"ratio"`
+	MemorySize int    `xml:"mem-size"`
+}
+
+type natGlobalPoolResp struct {
+	XMLName xml.Name             `xml:"response"`
+	Entries []natGlobalPoolEntry `xml:"result>entry"`
+}
+
+type natGlobalPoolEntry struct {
+	Index      int    `xml:"idx"`
+	Type       string `xml:"type"`
+	Size       int    `xml:"size"`
+	Ratio      int    `xml:"ratio"`
+	MemorySize int    `xml:"mem-size"`
+}
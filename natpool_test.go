@@ -0,0 +1,65 @@
+package pango
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNatRulePools(t *testing.T) {
+	resp := `<response status="success"><result>
+<entry><rule>dipp1</rule><vsys>vsys1</vsys><type>Dynamic IP/Port</type><used>48384</used><available>16128</available><ratio>2</ratio><mem-size>1024</mem-size></entry>
+<entry><rule>dip1</rule><vsys>vsys1</vsys><type>Dynamic IP</type><used>0</used><available>254</available><mem-size>512</mem-size></entry>
+</result></response>`
+
+	c := &Client{rb: [][]byte{[]byte(resp)}}
+	c.Initialize()
+
+	ans, err := c.NatRulePools("", "vsys1")
+	if err != nil {
+		t.Fatalf("Error getting nat rule pools: %s", err)
+	}
+
+	expected := []NatRulePool{
+		{Rule: "dipp1", Vsys: "vsys1", Type: "Dynamic IP/Port", Used: 48384, Available: 16128, OversubscriptionRatio: 2, MemorySize: 1024},
+		{Rule: "dip1", Vsys: "vsys1", Type: "Dynamic IP", Available: 254, MemorySize: 512},
+	}
+	if !reflect.DeepEqual(ans, expected) {
+		t.Errorf("Got %#v", ans)
+	}
+	if u := ans[0].Utilization(); u != 75 {
+		t.Errorf("Utilization is %f", u)
+	}
+	if u := ans[1].Utilization(); u != 0 {
+		t.Errorf("Utilization is %f", u)
+	}
+	if cmd := c.rp[0].Get("cmd"); cmd != "<show><running><nat-rule-ippool><rule>all</rule></nat-rule-ippool></running></show>" {
+		t.Errorf("Command is %q", cmd)
+	}
+	if v := c.rp[0].Get("vsys"); v != "vsys1" {
+		t.Errorf("vsys is %q", v)
+	}
+}
+
+func TestNatGlobalPools(t *testing.T) {
+	resp := `<response status="success"><result>
+<entry><idx>1</idx><type>Dynamic IP/Port</type><size>1</size><ratio>2</ratio><mem-size>4096</mem-size></entry>
+</result></response>`
+
+	c := &Client{rb: [][]byte{[]byte(resp)}}
+	c.Initialize()
+
+	ans, err := c.NatGlobalPools()
+	if err != nil {
+		t.Fatalf("Error getting global pools: %s", err)
+	}
+
+	expected := []NatGlobalPool{
+		{Index: 1, Type: "Dynamic IP/Port", Size: 1, OversubscriptionRatio: 2, MemorySize: 4096},
+	}
+	if !reflect.DeepEqual(ans, expected) {
+		t.Errorf("Got %#v", ans)
+	}
+	if cmd := c.rp[0].Get("cmd"); cmd != "<show><running><global-ippool></global-ippool></running></show>" {
+		t.Errorf("Command is %q", cmd)
+	}
+}
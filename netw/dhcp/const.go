@@ -0,0 +1,13 @@
+package dhcp
+
+// Valid values for Server.Mode.
+const (
+	ModeAuto     = "auto"
+	ModeEnabled  = "enabled"
+	ModeDisabled = "disabled"
+)
+
+const (
+	singular = "dhcp interface"
+	plural   = "dhcp interfaces"
+)
@@ -0,0 +1,12 @@
+/*
+Package dhcp is the client.Network.Dhcp namespace.
+
+Each object is named after the interface it is configured on, and holds
+either the DHCP server or the DHCP relay config for that interface.
+
+For Panorama, specify either the template ("tmpl") or the template stack
+("ts").
+
+Normalized object:  Entry
+*/
+package dhcp
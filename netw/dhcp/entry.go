@@ -0,0 +1,444 @@
+package dhcp
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of the DHCP
+// config of an interface.
+//
+// The Name is the interface name.  Only one of Server or Relay should be
+// specified.
+type Entry struct {
+	Name   string
+	Server *Server
+	Relay  *Relay
+}
+
+// Server is the DHCP server config of an interface.
+//
+// LeaseTimeout is in minutes, and is only used if UnlimitedLease is false.
+//
+// InheritanceSource is the dynamic interface (such as a DHCP client) whose
+// options are inherited by the options set to "inherited".
+type Server struct {
+	Mode              string
+	ProbeIp           bool
+	IpPools           []string // ordered
+	Reservations      []Reservation
+	UnlimitedLease    bool
+	LeaseTimeout      int
+	InheritanceSource string
+	Gateway           string
+	SubnetMask        string
+	PrimaryDns        string
+	SecondaryDns      string
+	PrimaryWins       string
+	SecondaryWins     string
+	PrimaryNis        string
+	SecondaryNis      string
+	PrimaryNtp        string
+	SecondaryNtp      string
+	Pop3Server        string
+	SmtpServer        string
+	DnsSuffix         string
+	CustomOptions     []Option
+}
+
+// Reservation is a reserved address in a DHCP server pool.
+type Reservation struct {
+	Ip          string
+	Mac         string
+	Description string
+}
+
+// Option is a user defined DHCP option.
+//
+// Only one of Ips, Ascii, or Hex should be specified, unless Inherited is
+// true.
+type Option struct {
+	Name      string
+	Code      int
+	Inherited bool
+	Ips       []string // ordered
+	Ascii     []string // ordered
+	Hex       []string // ordered
+}
+
+// Relay is the DHCP relay config of an interface.
+type Relay struct {
+	Ipv4Enabled bool
+	Ipv4Servers []string // ordered
+	Ipv6Enabled bool
+	Ipv6Servers []Ipv6Server
+}
+
+// Ipv6Server is a DHCPv6 relay server.
+type Ipv6Server struct {
+	Address   string
+	Interface string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	if s.Server == nil {
+		o.Server = nil
+	} else {
+		v := *s.Server
+		o.Server = &v
+	}
+	if s.Relay == nil {
+		o.Relay = nil
+	} else {
+		v := *s.Relay
+		o.Relay = &v
+	}
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the interface name of this DHCP config.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name: o.Name,
+	}
+
+	if o.Server != nil {
+		s := &Server{
+			Mode:    o.Server.Mode,
+			ProbeIp: util.AsBool(o.Server.ProbeIp),
+			IpPools: util.MemToStr(o.Server.IpPools),
+		}
+
+		if o.Server.Reserved != nil {
+			list := make([]Reservation, 0, len(o.Server.Reserved.Entries))
+			for _, x := range o.Server.Reserved.Entries {
+				list = append(list, Reservation{
+					Ip:          x.Ip,
+					Mac:         x.Mac,
+					Description: x.Description,
+				})
+			}
+			s.Reservations = list
+		}
+
+		if opt := o.Server.Option; opt != nil {
+			if opt.Lease != nil {
+				s.UnlimitedLease = opt.Lease.Unlimited != nil
+				s.LeaseTimeout = opt.Lease.Timeout
+			}
+			if opt.Inheritance != nil {
+				s.InheritanceSource = opt.Inheritance.Source
+			}
+			s.Gateway = opt.Gateway
+			s.SubnetMask = opt.SubnetMask
+			if opt.Dns != nil {
+				s.PrimaryDns = opt.Dns.Primary
+				s.SecondaryDns = opt.Dns.Secondary
+			}
+			if opt.Wins != nil {
+				s.PrimaryWins = opt.Wins.Primary
+				s.SecondaryWins = opt.Wins.Secondary
+			}
+			if opt.Nis != nil {
+				s.PrimaryNis = opt.Nis.Primary
+				s.SecondaryNis = opt.Nis.Secondary
+			}
+			if opt.Ntp != nil {
+				s.PrimaryNtp = opt.Ntp.Primary
+				s.SecondaryNtp = opt.Ntp.Secondary
+			}
+			s.Pop3Server = opt.Pop3Server
+			s.SmtpServer = opt.SmtpServer
+			s.DnsSuffix = opt.DnsSuffix
+
+			if opt.UserDefined != nil {
+				list := make([]Option, 0, len(opt.UserDefined.Entries))
+				for _, x := range opt.UserDefined.Entries {
+					list = append(list, Option{
+						Name:      x.Name,
+						Code:      x.Code,
+						Inherited: util.AsBool(x.Inherited),
+						Ips:       util.MemToStr(x.Ips),
+						Ascii:     util.MemToStr(x.Ascii),
+						Hex:       util.MemToStr(x.Hex),
+					})
+				}
+				s.CustomOptions = list
+			}
+		}
+
+		ans.Server = s
+	}
+
+	if o.Relay != nil {
+		r := &Relay{}
+		if o.Relay.Ipv4 != nil {
+			r.Ipv4Enabled = util.AsBool(o.Relay.Ipv4.Enabled)
+			r.Ipv4Servers = util.MemToStr(o.Relay.Ipv4.Servers)
+		}
+		if o.Relay.Ipv6 != nil {
+			r.Ipv6Enabled = util.AsBool(o.Relay.Ipv6.Enabled)
+			if o.Relay.Ipv6.Servers != nil {
+				list := make([]Ipv6Server, 0, len(o.Relay.Ipv6.Servers.Entries))
+				for _, x := range o.Relay.Ipv6.Servers.Entries {
+					list = append(list, Ipv6Server{
+						Address:   x.Address,
+						Interface: x.Interface,
+					})
+				}
+				r.Ipv6Servers = list
+			}
+		}
+
+		ans.Relay = r
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName xml.Name `xml:"entry"`
+	Name    string   `xml:"name,attr"`
+	Server  *server  `xml:"server"`
+	Relay   *relay   `xml:"relay"`
+}
+
+type server struct {
+	Option   *option          `xml:"option"`
+	IpPools  *util.MemberType `xml:"ip-pool"`
+	Reserved *reserved        `xml:"reserved"`
+	Mode     string           `xml:"mode,omitempty"`
+	ProbeIp  string           `xml:"probe-ip"`
+}
+
+type option struct {
+	Lease       *lease       `xml:"lease"`
+	Inheritance *inheritance `xml:"inheritance"`
+	Gateway     string       `xml:"gateway,omitempty"`
+	SubnetMask  string       `xml:"subnet-mask,omitempty"`
+	Dns         *pair        `xml:"dns"`
+	Wins        *pair        `xml:"wins"`
+	Nis         *pair        `xml:"nis"`
+	Ntp         *pair        `xml:"ntp"`
+	Pop3Server  string       `xml:"pop3-server,omitempty"`
+	SmtpServer  string       `xml:"smtp-server,omitempty"`
+	DnsSuffix   string       `xml:"dns-suffix,omitempty"`
+	UserDefined *userDefined `xml:"user-defined"`
+}
+
+type lease struct {
+	Unlimited *string `xml:"unlimited"`
+	Timeout   int     `xml:"timeout,omitempty"`
+}
+
+type inheritance struct {
+	Source string `xml:"source"`
+}
+
+type pair struct {
+	Primary   string `xml:"primary,omitempty"`
+	Secondary string `xml:"secondary,omitempty"`
+}
+
+type userDefined struct {
+	Entries []userOption `xml:"entry"`
+}
+
+type userOption struct {
+	Name      string           `xml:"name,attr"`
+	Code      int              `xml:"code,omitempty"`
+	Inherited string           `xml:"inherited,omitempty"`
+	Ips       *util.MemberType `xml:"ip"`
+	Ascii     *util.MemberType `xml:"ascii"`
+	Hex       *util.MemberType `xml:"hex"`
+}
+
+type reserved struct {
+	Entries []reservation `xml:"entry"`
+}
+
+type reservation struct {
+	Ip          string `xml:"name,attr"`
+	Mac         string `xml:"mac,omitempty"`
+	Description string `xml:"description,omitempty"`
+}
+
+type relay struct {
+	Ipv4 *relayIpv4 `xml:"ip"`
+	Ipv6 *relayIpv6 `xml:"ipv6"`
+}
+
+type relayIpv4 struct {
+	Enabled string           `xml:"enabled"`
+	Servers *util.MemberType `xml:"server"`
+}
+
+type relayIpv6 struct {
+	Enabled string       `xml:"enabled"`
+	Servers *ipv6Servers `xml:"server"`
+}
+
+type ipv6Servers struct {
+	Entries []ipv6Server `xml:"entry"`
+}
+
+type ipv6Server struct {
+	Address   string `xml:"name,attr"`
+	Interface string `xml:"interface,omitempty"`
+}
+
+func specifyPair(primary, secondary string) *pair {
+	if primary == "" && secondary == "" {
+		return nil
+	}
+
+	return &pair{
+		Primary:   primary,
+		Secondary: secondary,
+	}
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name: e.Name,
+	}
+
+	if e.Server != nil {
+		s := &server{
+			IpPools: util.StrToMem(e.Server.IpPools),
+			Mode:    e.Server.Mode,
+			ProbeIp: util.YesNo(e.Server.ProbeIp),
+		}
+
+		if len(e.Server.Reservations) > 0 {
+			list := make([]reservation, 0, len(e.Server.Reservations))
+			for _, x := range e.Server.Reservations {
+				list = append(list, reservation{
+					Ip:          x.Ip,
+					Mac:         x.Mac,
+					Description: x.Description,
+				})
+			}
+			s.Reserved = &reserved{Entries: list}
+		}
+
+		opt := &option{
+			Gateway:    e.Server.Gateway,
+			SubnetMask: e.Server.SubnetMask,
+			Dns:        specifyPair(e.Server.PrimaryDns, e.Server.SecondaryDns),
+			Wins:       specifyPair(e.Server.PrimaryWins, e.Server.SecondaryWins),
+			Nis:        specifyPair(e.Server.PrimaryNis, e.Server.SecondaryNis),
+			Ntp:        specifyPair(e.Server.PrimaryNtp, e.Server.SecondaryNtp),
+			Pop3Server: e.Server.Pop3Server,
+			SmtpServer: e.Server.SmtpServer,
+			DnsSuffix:  e.Server.DnsSuffix,
+		}
+
+		if e.Server.UnlimitedLease {
+			unlimited := ""
+			opt.Lease = &lease{Unlimited: &unlimited}
+		} else if e.Server.LeaseTimeout != 0 {
+			opt.Lease = &lease{Timeout: e.Server.LeaseTimeout}
+		}
+
+		if e.Server.InheritanceSource != "" {
+			opt.Inheritance = &inheritance{Source: e.Server.InheritanceSource}
+		}
+
+		if len(e.Server.CustomOptions) > 0 {
+			list := make([]userOption, 0, len(e.Server.CustomOptions))
+			for _, x := range e.Server.CustomOptions {
+				var inherited string
+				if x.Inherited {
+					inherited = util.YesNo(x.Inherited)
+				}
+				list = append(list, userOption{
+					Name:      x.Name,
+					Code:      x.Code,
+					Inherited: inherited,
+					Ips:       util.StrToMem(x.Ips),
+					Ascii:     util.StrToMem(x.Ascii),
+					Hex:       util.StrToMem(x.Hex),
+				})
+			}
+			opt.UserDefined = &userDefined{Entries: list}
+		}
+
+		if *opt != (option{}) {
+			s.Option = opt
+		}
+
+		ans.Server = s
+	}
+
+	if e.Relay != nil {
+		r := &relay{}
+
+		if e.Relay.Ipv4Enabled || len(e.Relay.Ipv4Servers) > 0 {
+			r.Ipv4 = &relayIpv4{
+				Enabled: util.YesNo(e.Relay.Ipv4Enabled),
+				Servers: util.StrToMem(e.Relay.Ipv4Servers),
+			}
+		}
+
+		if e.Relay.Ipv6Enabled || len(e.Relay.Ipv6Servers) > 0 {
+			r.Ipv6 = &relayIpv6{
+				Enabled: util.YesNo(e.Relay.Ipv6Enabled),
+			}
+			if len(e.Relay.Ipv6Servers) > 0 {
+				list := make([]ipv6Server, 0, len(e.Relay.Ipv6Servers))
+				for _, x := range e.Relay.Ipv6Servers {
+					list = append(list, ipv6Server{
+						Address:   x.Address,
+						Interface: x.Interface,
+					})
+				}
+				r.Ipv6.Servers = &ipv6Servers{Entries: list}
+			}
+		}
+
+		ans.Relay = r
+	}
+
+	return ans
+}
@@ -0,0 +1,90 @@
+package dhcp
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwDhcp is a namespace struct, included as part of pango.Firewall.
+type FwDhcp struct {
+	ns *namespace.Standard[Entry]
+}
+
+// Initialize is invoked when Initialize on the pango.Firewall is called.
+func (c *FwDhcp) Initialize(con util.XapiClient) {
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *FwDhcp) GetList() ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(nil))
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *FwDhcp) ShowList() ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(nil))
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *FwDhcp) Get(name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath([]string{name}), name)
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *FwDhcp) GetAll() ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(nil))
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *FwDhcp) Show(name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath([]string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *FwDhcp) ShowAll() ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(nil))
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *FwDhcp) Set(e ...Entry) error {
+	return c.ns.SetEntries(c.pather(), e...)
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *FwDhcp) Edit(e Entry) error {
+	return c.ns.EditEntry(c.pather(), e)
+}
+
+// Delete removes the given objects.
+//
+// Objects can be either a string or an Entry object.
+func (c *FwDhcp) Delete(e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(), e...)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *FwDhcp) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *FwDhcp) pather() namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(v)
+	}
+}
+
+func (c *FwDhcp) xpath(vals []string) []string {
+	ans := make([]string, 0, 8)
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"network",
+		"dhcp",
+		"interface",
+		util.AsEntryXpath(vals),
+	)
+
+	return ans
+}
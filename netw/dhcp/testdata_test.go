@@ -0,0 +1,68 @@
+package dhcp
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"server basic", Entry{
+			Name: "ethernet1/2",
+			Server: &Server{
+				Mode:         ModeEnabled,
+				ProbeIp:      true,
+				IpPools:      []string{"192.168.1.10-192.168.1.100"},
+				LeaseTimeout: 1440,
+				Gateway:      "192.168.1.1",
+				SubnetMask:   "255.255.255.0",
+				PrimaryDns:   "8.8.8.8",
+				SecondaryDns: "8.8.4.4",
+			},
+		}},
+		{"server reservations and options", Entry{
+			Name: "ethernet1/3",
+			Server: &Server{
+				Mode:    ModeAuto,
+				IpPools: []string{"10.1.1.0/24", "10.1.2.0/24"},
+				Reservations: []Reservation{
+					{Ip: "10.1.1.5", Mac: "00:11:22:33:44:55", Description: "printer"},
+					{Ip: "10.1.1.6"},
+				},
+				UnlimitedLease:    true,
+				InheritanceSource: "ethernet1/1",
+				PrimaryDns:        "inherited",
+				PrimaryWins:       "10.1.1.2",
+				SecondaryNis:      "10.1.1.3",
+				PrimaryNtp:        "10.1.1.4",
+				Pop3Server:        "10.1.1.7",
+				SmtpServer:        "10.1.1.8",
+				DnsSuffix:         "example.com",
+				CustomOptions: []Option{
+					{Name: "tftp", Code: 150, Ips: []string{"10.1.1.9"}},
+					{Name: "vendor", Code: 43, Hex: []string{"0a0b0c"}},
+					{Name: "domain", Code: 119, Inherited: true},
+				},
+			},
+		}},
+		{"relay ipv4", Entry{
+			Name: "ethernet1/4",
+			Relay: &Relay{
+				Ipv4Enabled: true,
+				Ipv4Servers: []string{"10.2.2.2", "10.2.2.3"},
+			},
+		}},
+		{"relay ipv4 and ipv6", Entry{
+			Name: "ethernet1/5.10",
+			Relay: &Relay{
+				Ipv4Enabled: true,
+				Ipv4Servers: []string{"10.2.2.2"},
+				Ipv6Enabled: true,
+				Ipv6Servers: []Ipv6Server{
+					{Address: "2001:db8::1", Interface: "ethernet1/1"},
+					{Address: "2001:db8::2"},
+				},
+			},
+		}},
+	}
+}
@@ -1,7 +1,14 @@
 package netw
 
 import (
+	"github.com/PaloAltoNetworks/pango/netw/dhcp"
+	gpagent "github.com/PaloAltoNetworks/pango/netw/globalprotect/agent"
+	gpclient "github.com/PaloAltoNetworks/pango/netw/globalprotect/client"
+	gpclientless "github.com/PaloAltoNetworks/pango/netw/globalprotect/clientless"
+	gpclientlessapp "github.com/PaloAltoNetworks/pango/netw/globalprotect/clientless/app"
+	gpclientlessappgroup "github.com/PaloAltoNetworks/pango/netw/globalprotect/clientless/appgroup"
 	"github.com/PaloAltoNetworks/pango/netw/ikegw"
+	"github.com/PaloAltoNetworks/pango/netw/imports"
 	aggeth "github.com/PaloAltoNetworks/pango/netw/interface/aggregate"
 	"github.com/PaloAltoNetworks/pango/netw/interface/arp"
 	"github.com/PaloAltoNetworks/pango/netw/interface/eth"
@@ -12,11 +19,16 @@ import (
 	vli "github.com/PaloAltoNetworks/pango/netw/interface/vlan"
 	"github.com/PaloAltoNetworks/pango/netw/ipsectunnel"
 	tpiv4 "github.com/PaloAltoNetworks/pango/netw/ipsectunnel/proxyid/ipv4"
+	"github.com/PaloAltoNetworks/pango/netw/lldp"
 	"github.com/PaloAltoNetworks/pango/netw/profile/bfd"
 	"github.com/PaloAltoNetworks/pango/netw/profile/ike"
 	"github.com/PaloAltoNetworks/pango/netw/profile/ipsec"
+	lldpprof "github.com/PaloAltoNetworks/pango/netw/profile/lldp"
 	"github.com/PaloAltoNetworks/pango/netw/profile/mngtprof"
 	"github.com/PaloAltoNetworks/pango/netw/profile/monitor"
+	qosprof "github.com/PaloAltoNetworks/pango/netw/profile/qos"
+	sdwanprof "github.com/PaloAltoNetworks/pango/netw/profile/sdwan"
+	qosiface "github.com/PaloAltoNetworks/pango/netw/qos/iface"
 	redist4 "github.com/PaloAltoNetworks/pango/netw/routing/profile/redist/ipv4"
 	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp"
 	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/aggregate"
@@ -32,6 +44,14 @@ import (
 	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/profile/auth"
 	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/profile/dampening"
 	bgpredist "github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/redist"
+	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/multicast"
+	mcifgroup "github.com/PaloAltoNetworks/pango/netw/routing/protocol/multicast/ifgroup"
+	mcrp "github.com/PaloAltoNetworks/pango/netw/routing/protocol/multicast/rp"
+	mcssm "github.com/PaloAltoNetworks/pango/netw/routing/protocol/multicast/ssm"
+	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/rip"
+	ripexp "github.com/PaloAltoNetworks/pango/netw/routing/protocol/rip/exp"
+	ripiface "github.com/PaloAltoNetworks/pango/netw/routing/protocol/rip/iface"
+	ripauth "github.com/PaloAltoNetworks/pango/netw/routing/protocol/rip/profile/auth"
 	"github.com/PaloAltoNetworks/pango/netw/routing/route/static/ipv4"
 	"github.com/PaloAltoNetworks/pango/netw/routing/router"
 	"github.com/PaloAltoNetworks/pango/netw/tunnel/gre"
@@ -42,42 +62,62 @@ import (
 
 // Netw is the client.Network namespace.
 type FwNetw struct {
-	AggregateInterface       *aggeth.FwAggregate
-	Arp                      *arp.FwArp
-	BfdProfile               *bfd.FwBfd
-	BgpAggregate             *aggregate.FwAggregate
-	BgpAggAdvertiseFilter    *agaf.FwAdvertise
-	BgpAggSuppressFilter     *suppress.FwSuppress
-	BgpAuthProfile           *auth.FwAuth
-	BgpConAdvAdvertiseFilter *advertise.FwAdvertise
-	BgpConAdvNonExistFilter  *nonexist.FwNonExist
-	BgpConditionalAdv        *conadv.FwConAdv
-	BgpConfig                *bgp.FwBgp
-	BgpDampeningProfile      *dampening.FwDampening
-	BgpExport                *exp.FwExp
-	BgpImport                *imp.FwImp
-	BgpPeer                  *peer.FwPeer
-	BgpPeerGroup             *group.FwGroup
-	BgpRedistRule            *bgpredist.FwRedist
-	EthernetInterface        *eth.FwEth
-	GreTunnel                *gre.FwGre
-	IkeCryptoProfile         *ike.FwIke
-	IkeGateway               *ikegw.FwIkeGw
-	IpsecCryptoProfile       *ipsec.FwIpsec
-	IpsecTunnel              *ipsectunnel.FwIpsecTunnel
-	IpsecTunnelProxyId       *tpiv4.FwIpv4
-	Layer2Subinterface       *layer2.FwLayer2
-	Layer3Subinterface       *layer3.FwLayer3
-	LoopbackInterface        *loopback.FwLoopback
-	ManagementProfile        *mngtprof.FwMngtProf
-	MonitorProfile           *monitor.FwMonitor
-	RedistributionProfile    *redist4.FwIpv4
-	StaticRoute              *ipv4.FwIpv4
-	TunnelInterface          *tunnel.FwTunnel
-	VirtualRouter            *router.FwRouter
-	Vlan                     *vlan.FwVlan
-	VlanInterface            *vli.FwVlan
-	Zone                     *zone.FwZone
+	AggregateInterface              *aggeth.FwAggregate
+	Arp                             *arp.FwArp
+	BfdProfile                      *bfd.FwBfd
+	BgpAggregate                    *aggregate.FwAggregate
+	BgpAggAdvertiseFilter           *agaf.FwAdvertise
+	BgpAggSuppressFilter            *suppress.FwSuppress
+	BgpAuthProfile                  *auth.FwAuth
+	BgpConAdvAdvertiseFilter        *advertise.FwAdvertise
+	BgpConAdvNonExistFilter         *nonexist.FwNonExist
+	BgpConditionalAdv               *conadv.FwConAdv
+	BgpConfig                       *bgp.FwBgp
+	BgpDampeningProfile             *dampening.FwDampening
+	BgpExport                       *exp.FwExp
+	BgpImport                       *imp.FwImp
+	BgpPeer                         *peer.FwPeer
+	BgpPeerGroup                    *group.FwGroup
+	BgpRedistRule                   *bgpredist.FwRedist
+	Dhcp                            *dhcp.FwDhcp
+	EthernetInterface               *eth.FwEth
+	GlobalProtectAgent              *gpagent.FwAgent
+	GlobalProtectClient             *gpclient.FwClient
+	GlobalProtectClientless         *gpclientless.FwClientless
+	GlobalProtectClientlessApp      *gpclientlessapp.FwClientlessApp
+	GlobalProtectClientlessAppGroup *gpclientlessappgroup.FwClientlessAppGroup
+	GreTunnel                       *gre.FwGre
+	IkeCryptoProfile                *ike.FwIke
+	IkeGateway                      *ikegw.FwIkeGw
+	IpsecCryptoProfile              *ipsec.FwIpsec
+	IpsecTunnel                     *ipsectunnel.FwIpsecTunnel
+	IpsecTunnelProxyId              *tpiv4.FwIpv4
+	Layer2Subinterface              *layer2.FwLayer2
+	Layer3Subinterface              *layer3.FwLayer3
+	Lldp                            *lldp.FwLldp
+	LldpProfile                     *lldpprof.FwLldp
+	LoopbackInterface               *loopback.FwLoopback
+	ManagementProfile               *mngtprof.FwMngtProf
+	MonitorProfile                  *monitor.FwMonitor
+	MulticastConfig                 *multicast.FwMulticast
+	MulticastExternalRp             *mcrp.FwRp
+	MulticastInterfaceGroup         *mcifgroup.FwIfGroup
+	MulticastSsmAddressSpace        *mcssm.FwSsm
+	QosInterface                    *qosiface.FwIface
+	QosProfile                      *qosprof.FwQos
+	RedistributionProfile           *redist4.FwIpv4
+	RipAuthProfile                  *ripauth.FwAuth
+	RipConfig                       *rip.FwRip
+	RipExport                       *ripexp.FwExp
+	RipInterface                    *ripiface.FwIface
+	SdwanInterfaceProfile           *sdwanprof.FwSdwan
+	StaticRoute                     *ipv4.FwIpv4
+	TunnelInterface                 *tunnel.FwTunnel
+	VirtualRouter                   *router.FwRouter
+	Vlan                            *vlan.FwVlan
+	VlanInterface                   *vli.FwVlan
+	VsysImport                      *imports.FwImports
+	Zone                            *zone.FwZone
 }
 
 // Initialize is invoked on client.Initialize().
@@ -133,9 +173,27 @@ func (c *FwNetw) Initialize(i util.XapiClient) {
 	c.BgpRedistRule = &bgpredist.FwRedist{}
 	c.BgpRedistRule.Initialize(i)
 
+	c.Dhcp = &dhcp.FwDhcp{}
+	c.Dhcp.Initialize(i)
+
 	c.EthernetInterface = &eth.FwEth{}
 	c.EthernetInterface.Initialize(i)
 
+	c.GlobalProtectAgent = &gpagent.FwAgent{}
+	c.GlobalProtectAgent.Initialize(i)
+
+	c.GlobalProtectClient = &gpclient.FwClient{}
+	c.GlobalProtectClient.Initialize(i)
+
+	c.GlobalProtectClientless = &gpclientless.FwClientless{}
+	c.GlobalProtectClientless.Initialize(i)
+
+	c.GlobalProtectClientlessApp = &gpclientlessapp.FwClientlessApp{}
+	c.GlobalProtectClientlessApp.Initialize(i)
+
+	c.GlobalProtectClientlessAppGroup = &gpclientlessappgroup.FwClientlessAppGroup{}
+	c.GlobalProtectClientlessAppGroup.Initialize(i)
+
 	c.GreTunnel = &gre.FwGre{}
 	c.GreTunnel.Initialize(i)
 
@@ -160,6 +218,12 @@ func (c *FwNetw) Initialize(i util.XapiClient) {
 	c.Layer3Subinterface = &layer3.FwLayer3{}
 	c.Layer3Subinterface.Initialize(i)
 
+	c.Lldp = &lldp.FwLldp{}
+	c.Lldp.Initialize(i)
+
+	c.LldpProfile = &lldpprof.FwLldp{}
+	c.LldpProfile.Initialize(i)
+
 	c.LoopbackInterface = &loopback.FwLoopback{}
 	c.LoopbackInterface.Initialize(i)
 
@@ -169,9 +233,42 @@ func (c *FwNetw) Initialize(i util.XapiClient) {
 	c.MonitorProfile = &monitor.FwMonitor{}
 	c.MonitorProfile.Initialize(i)
 
+	c.MulticastConfig = &multicast.FwMulticast{}
+	c.MulticastConfig.Initialize(i)
+
+	c.MulticastExternalRp = &mcrp.FwRp{}
+	c.MulticastExternalRp.Initialize(i)
+
+	c.MulticastInterfaceGroup = &mcifgroup.FwIfGroup{}
+	c.MulticastInterfaceGroup.Initialize(i)
+
+	c.MulticastSsmAddressSpace = &mcssm.FwSsm{}
+	c.MulticastSsmAddressSpace.Initialize(i)
+
+	c.QosInterface = &qosiface.FwIface{}
+	c.QosInterface.Initialize(i)
+
+	c.QosProfile = &qosprof.FwQos{}
+	c.QosProfile.Initialize(i)
+
 	c.RedistributionProfile = &redist4.FwIpv4{}
 	c.RedistributionProfile.Initialize(i)
 
+	c.RipAuthProfile = &ripauth.FwAuth{}
+	c.RipAuthProfile.Initialize(i)
+
+	c.RipConfig = &rip.FwRip{}
+	c.RipConfig.Initialize(i)
+
+	c.RipExport = &ripexp.FwExp{}
+	c.RipExport.Initialize(i)
+
+	c.RipInterface = &ripiface.FwIface{}
+	c.RipInterface.Initialize(i)
+
+	c.SdwanInterfaceProfile = &sdwanprof.FwSdwan{}
+	c.SdwanInterfaceProfile.Initialize(i)
+
 	c.StaticRoute = &ipv4.FwIpv4{}
 	c.StaticRoute.Initialize(i)
 
@@ -187,6 +284,9 @@ func (c *FwNetw) Initialize(i util.XapiClient) {
 	c.VlanInterface = &vli.FwVlan{}
 	c.VlanInterface.Initialize(i)
 
+	c.VsysImport = &imports.FwImports{}
+	c.VsysImport.Initialize(i)
+
 	c.Zone = &zone.FwZone{}
 	c.Zone.Initialize(i)
 }
@@ -0,0 +1,6 @@
+package agent
+
+const (
+	singular = "globalprotect portal agent config"
+	plural   = "globalprotect portal agent configs"
+)
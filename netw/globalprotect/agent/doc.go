@@ -0,0 +1,7 @@
+// Package agent is the client.Network.GlobalProtectAgent namespace.
+//
+// These are the agent configs of a GlobalProtect portal, which specify the
+// settings that the portal sends to GlobalProtect agents.
+//
+// Normalized object:  Entry
+package agent
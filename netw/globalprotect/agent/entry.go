@@ -0,0 +1,154 @@
+package agent
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a
+// GlobalProtect portal agent config.
+//
+// HipExclusionCategories are the HIP categories that agents do not collect.
+// Config that is not normalized (such as the external gateways or the agent
+// UI settings) is preserved as-is when this object is updated.
+type Entry struct {
+	Name                   string
+	SourceUsers            []string // unordered
+	Os                     []string // unordered
+	SaveUserCredentials    string
+	CollectHipData         bool
+	HipMaxWaitTime         int
+	HipExclusionCategories []string // unordered
+
+	raw map[string]string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.SourceUsers = s.SourceUsers
+	o.Os = s.Os
+	o.SaveUserCredentials = s.SaveUserCredentials
+	o.CollectHipData = s.CollectHipData
+	o.HipMaxWaitTime = s.HipMaxWaitTime
+	o.HipExclusionCategories = s.HipExclusionCategories
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this agent config.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:                o.Name,
+		SourceUsers:         util.MemToStr(o.SourceUsers),
+		Os:                  util.MemToStr(o.Os),
+		SaveUserCredentials: o.SaveUserCredentials,
+	}
+
+	if o.Hip != nil {
+		ans.CollectHipData = util.AsBool(o.Hip.CollectHipData)
+		ans.HipMaxWaitTime = o.Hip.MaxWaitTime
+		ans.HipExclusionCategories = util.EntToStr(o.Hip.Exclusions)
+	}
+
+	ans.raw = make(map[string]string)
+	if o.Gateways != nil {
+		ans.raw["gw"] = util.CleanRawXml(o.Gateways.Text)
+	}
+	if o.AgentUi != nil {
+		ans.raw["ui"] = util.CleanRawXml(o.AgentUi.Text)
+	}
+	if o.Hip != nil && o.Hip.CustomChecks != nil {
+		ans.raw["hcc"] = util.CleanRawXml(o.Hip.CustomChecks.Text)
+	}
+	if len(ans.raw) == 0 {
+		ans.raw = nil
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName             xml.Name         `xml:"entry"`
+	Name                string           `xml:"name,attr"`
+	SourceUsers         *util.MemberType `xml:"source-user"`
+	Os                  *util.MemberType `xml:"os"`
+	SaveUserCredentials string           `xml:"save-user-credentials,omitempty"`
+	Hip                 *hip             `xml:"hip-collection"`
+	Gateways            *util.RawXml     `xml:"gateways"`
+	AgentUi             *util.RawXml     `xml:"agent-ui"`
+}
+
+type hip struct {
+	MaxWaitTime    int             `xml:"max-wait-time,omitempty"`
+	CollectHipData string          `xml:"collect-hip-data"`
+	Exclusions     *util.EntryType `xml:"exclusion>category"`
+	CustomChecks   *util.RawXml    `xml:"custom-checks"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:                e.Name,
+		SourceUsers:         util.StrToMem(e.SourceUsers),
+		Os:                  util.StrToMem(e.Os),
+		SaveUserCredentials: e.SaveUserCredentials,
+	}
+
+	_, hcc := e.raw["hcc"]
+	if e.CollectHipData || e.HipMaxWaitTime != 0 || len(e.HipExclusionCategories) > 0 || hcc {
+		ans.Hip = &hip{
+			MaxWaitTime:    e.HipMaxWaitTime,
+			CollectHipData: util.YesNo(e.CollectHipData),
+			Exclusions:     util.StrToEnt(e.HipExclusionCategories),
+		}
+		if text, present := e.raw["hcc"]; present {
+			ans.Hip.CustomChecks = &util.RawXml{text}
+		}
+	}
+
+	if text, present := e.raw["gw"]; present {
+		ans.Gateways = &util.RawXml{text}
+	}
+	if text, present := e.raw["ui"]; present {
+		ans.AgentUi = &util.RawXml{text}
+	}
+
+	return ans
+}
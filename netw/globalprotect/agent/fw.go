@@ -0,0 +1,95 @@
+package agent
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwAgent is a namespace struct, included as part of pango.Client.
+type FwAgent struct {
+	ns *namespace.Standard[Entry]
+}
+
+// Initialize is invoked when Initialize on the pango.Client is called.
+func (c *FwAgent) Initialize(con util.XapiClient) {
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *FwAgent) GetList(vsys, portal string) ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(vsys, portal, nil))
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *FwAgent) ShowList(vsys, portal string) ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(vsys, portal, nil))
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *FwAgent) Get(vsys, portal, name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath(vsys, portal, []string{name}), name)
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *FwAgent) GetAll(vsys, portal string) ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(vsys, portal, nil))
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *FwAgent) Show(vsys, portal, name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath(vsys, portal, []string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *FwAgent) ShowAll(vsys, portal string) ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(vsys, portal, nil))
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *FwAgent) Set(vsys, portal string, e ...Entry) error {
+	return c.ns.SetEntries(c.pather(vsys, portal), e...)
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *FwAgent) Edit(vsys, portal string, e Entry) error {
+	return c.ns.EditEntry(c.pather(vsys, portal), e)
+}
+
+// Delete removes the given objects.
+//
+// Objects can be either a string or an Entry object.
+func (c *FwAgent) Delete(vsys, portal string, e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(vsys, portal), e...)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *FwAgent) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *FwAgent) pather(vsys, portal string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(vsys, portal, v)
+	}
+}
+
+func (c *FwAgent) xpath(vsys, portal string, vals []string) []string {
+	if vsys == "" {
+		vsys = "vsys1"
+	}
+
+	return []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"vsys",
+		util.AsEntryXpath([]string{vsys}),
+		"global-protect",
+		"global-protect-portal",
+		util.AsEntryXpath([]string{portal}),
+		"client-config",
+		"configs",
+		util.AsEntryXpath(vals),
+	}
+}
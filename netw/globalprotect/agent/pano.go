@@ -0,0 +1,99 @@
+package agent
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// PanoAgent is a namespace struct, included as part of pango.Client.
+type PanoAgent struct {
+	ns *namespace.Standard[Entry]
+}
+
+// Initialize is invoked when Initialize on the pango.Client is called.
+func (c *PanoAgent) Initialize(con util.XapiClient) {
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *PanoAgent) GetList(tmpl, ts, vsys, portal string) ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(tmpl, ts, vsys, portal, nil))
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *PanoAgent) ShowList(tmpl, ts, vsys, portal string) ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(tmpl, ts, vsys, portal, nil))
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *PanoAgent) Get(tmpl, ts, vsys, portal, name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath(tmpl, ts, vsys, portal, []string{name}), name)
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *PanoAgent) GetAll(tmpl, ts, vsys, portal string) ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(tmpl, ts, vsys, portal, nil))
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *PanoAgent) Show(tmpl, ts, vsys, portal, name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath(tmpl, ts, vsys, portal, []string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *PanoAgent) ShowAll(tmpl, ts, vsys, portal string) ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(tmpl, ts, vsys, portal, nil))
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *PanoAgent) Set(tmpl, ts, vsys, portal string, e ...Entry) error {
+	return c.ns.SetEntries(c.pather(tmpl, ts, vsys, portal), e...)
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *PanoAgent) Edit(tmpl, ts, vsys, portal string, e Entry) error {
+	return c.ns.EditEntry(c.pather(tmpl, ts, vsys, portal), e)
+}
+
+// Delete removes the given objects.
+//
+// Objects can be either a string or an Entry object.
+func (c *PanoAgent) Delete(tmpl, ts, vsys, portal string, e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(tmpl, ts, vsys, portal), e...)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *PanoAgent) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *PanoAgent) pather(tmpl, ts, vsys, portal string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(tmpl, ts, vsys, portal, v)
+	}
+}
+
+func (c *PanoAgent) xpath(tmpl, ts, vsys, portal string, vals []string) []string {
+	if vsys == "" {
+		vsys = "vsys1"
+	}
+
+	ans := make([]string, 0, 16)
+	ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"vsys",
+		util.AsEntryXpath([]string{vsys}),
+		"global-protect",
+		"global-protect-portal",
+		util.AsEntryXpath([]string{portal}),
+		"client-config",
+		"configs",
+		util.AsEntryXpath(vals),
+	)
+
+	return ans
+}
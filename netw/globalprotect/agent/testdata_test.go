@@ -0,0 +1,36 @@
+package agent
+
+type testCase struct {
+	desc string
+	vsys string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"empty config", "", Entry{
+			Name: "one",
+		}},
+		{"users and os", "vsys1", Entry{
+			Name:                "two",
+			SourceUsers:         []string{"any"},
+			Os:                  []string{"Windows", "Mac"},
+			SaveUserCredentials: "1",
+		}},
+		{"hip collection", "vsys2", Entry{
+			Name:                   "three",
+			CollectHipData:         true,
+			HipMaxWaitTime:         30,
+			HipExclusionCategories: []string{"data-loss-prevention", "patch-management"},
+		}},
+		{"raw gateways and custom checks", "vsys1", Entry{
+			Name:           "four",
+			CollectHipData: true,
+			raw: map[string]string{
+				"gw":  "<external><list><entry name=\"gw1\"><fqdn>gw1.example.com</fqdn></entry></list></external>",
+				"ui":  "<passcode>secret</passcode>",
+				"hcc": "<windows><registry-key><entry name=\"k\"/></registry-key></windows>",
+			},
+		}},
+	}
+}
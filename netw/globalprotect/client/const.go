@@ -0,0 +1,6 @@
+package client
+
+const (
+	singular = "globalprotect gateway client setting"
+	plural   = "globalprotect gateway client settings"
+)
@@ -0,0 +1,7 @@
+// Package client is the client.Network.GlobalProtectClient namespace.
+//
+// These are the client settings of a GlobalProtect gateway, which specify
+// the tunnel settings (such as split tunneling) for GlobalProtect agents.
+//
+// Normalized object:  Entry
+package client
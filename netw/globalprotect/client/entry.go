@@ -0,0 +1,251 @@
+package client
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a
+// GlobalProtect gateway client setting.
+//
+// The Include* and Exclude* params are the split tunnel include / exclude
+// lists.  Config that is not normalized (such as the IP pools or the
+// authentication override settings) is preserved as-is when this object is
+// updated.
+type Entry struct {
+	Name                string
+	SourceUsers         []string // unordered
+	Os                  []string // unordered
+	IncludeAccessRoutes []string // ordered
+	ExcludeAccessRoutes []string // ordered
+	IncludeDomains      []string // ordered
+	ExcludeDomains      []string // ordered
+	IncludeApplications []string // 9.1+, ordered
+	ExcludeApplications []string // 9.1+, ordered
+
+	raw map[string]string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.SourceUsers = s.SourceUsers
+	o.Os = s.Os
+	o.IncludeAccessRoutes = s.IncludeAccessRoutes
+	o.ExcludeAccessRoutes = s.ExcludeAccessRoutes
+	o.IncludeDomains = s.IncludeDomains
+	o.ExcludeDomains = s.ExcludeDomains
+	o.IncludeApplications = s.IncludeApplications
+	o.ExcludeApplications = s.ExcludeApplications
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this client setting.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:        o.Name,
+		SourceUsers: util.MemToStr(o.SourceUsers),
+		Os:          util.MemToStr(o.Os),
+	}
+
+	if o.Split != nil {
+		ans.IncludeAccessRoutes = util.MemToStr(o.Split.IncludeAccessRoutes)
+		ans.ExcludeAccessRoutes = util.MemToStr(o.Split.ExcludeAccessRoutes)
+		ans.IncludeDomains = util.EntToStr(o.Split.IncludeDomains)
+		ans.ExcludeDomains = util.EntToStr(o.Split.ExcludeDomains)
+	}
+
+	ans.raw = make(map[string]string)
+	if o.IpPool != nil {
+		ans.raw["pool"] = util.CleanRawXml(o.IpPool.Text)
+	}
+	if o.AuthOverride != nil {
+		ans.raw["ao"] = util.CleanRawXml(o.AuthOverride.Text)
+	}
+	if len(ans.raw) == 0 {
+		ans.raw = nil
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName      xml.Name         `xml:"entry"`
+	Name         string           `xml:"name,attr"`
+	SourceUsers  *util.MemberType `xml:"source-user"`
+	Os           *util.MemberType `xml:"os"`
+	Split        *split_v1        `xml:"split-tunneling"`
+	IpPool       *util.RawXml     `xml:"ip-pool"`
+	AuthOverride *util.RawXml     `xml:"authentication-override"`
+}
+
+type split_v1 struct {
+	IncludeAccessRoutes *util.MemberType `xml:"access-route"`
+	ExcludeAccessRoutes *util.MemberType `xml:"exclude-access-route"`
+	IncludeDomains      *util.EntryType  `xml:"include-domains>list"`
+	ExcludeDomains      *util.EntryType  `xml:"exclude-domains>list"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:        e.Name,
+		SourceUsers: util.StrToMem(e.SourceUsers),
+		Os:          util.StrToMem(e.Os),
+	}
+
+	if len(e.IncludeAccessRoutes) > 0 || len(e.ExcludeAccessRoutes) > 0 || len(e.IncludeDomains) > 0 || len(e.ExcludeDomains) > 0 {
+		ans.Split = &split_v1{
+			IncludeAccessRoutes: util.StrToMem(e.IncludeAccessRoutes),
+			ExcludeAccessRoutes: util.StrToMem(e.ExcludeAccessRoutes),
+			IncludeDomains:      util.StrToEnt(e.IncludeDomains),
+			ExcludeDomains:      util.StrToEnt(e.ExcludeDomains),
+		}
+	}
+
+	if text, present := e.raw["pool"]; present {
+		ans.IpPool = &util.RawXml{text}
+	}
+	if text, present := e.raw["ao"]; present {
+		ans.AuthOverride = &util.RawXml{text}
+	}
+
+	return ans
+}
+
+// PAN-OS 9.1+
+type container_v2 struct {
+	Answer []entry_v2 `xml:"entry"`
+}
+
+func (o *container_v2) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v2) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v2) normalize() Entry {
+	ans := Entry{
+		Name:        o.Name,
+		SourceUsers: util.MemToStr(o.SourceUsers),
+		Os:          util.MemToStr(o.Os),
+	}
+
+	if o.Split != nil {
+		ans.IncludeAccessRoutes = util.MemToStr(o.Split.IncludeAccessRoutes)
+		ans.ExcludeAccessRoutes = util.MemToStr(o.Split.ExcludeAccessRoutes)
+		ans.IncludeDomains = util.EntToStr(o.Split.IncludeDomains)
+		ans.ExcludeDomains = util.EntToStr(o.Split.ExcludeDomains)
+		ans.IncludeApplications = util.MemToStr(o.Split.IncludeApplications)
+		ans.ExcludeApplications = util.MemToStr(o.Split.ExcludeApplications)
+	}
+
+	ans.raw = make(map[string]string)
+	if o.IpPool != nil {
+		ans.raw["pool"] = util.CleanRawXml(o.IpPool.Text)
+	}
+	if o.AuthOverride != nil {
+		ans.raw["ao"] = util.CleanRawXml(o.AuthOverride.Text)
+	}
+	if len(ans.raw) == 0 {
+		ans.raw = nil
+	}
+
+	return ans
+}
+
+type entry_v2 struct {
+	XMLName      xml.Name         `xml:"entry"`
+	Name         string           `xml:"name,attr"`
+	SourceUsers  *util.MemberType `xml:"source-user"`
+	Os           *util.MemberType `xml:"os"`
+	Split        *split_v2        `xml:"split-tunneling"`
+	IpPool       *util.RawXml     `xml:"ip-pool"`
+	AuthOverride *util.RawXml     `xml:"authentication-override"`
+}
+
+type split_v2 struct {
+	IncludeAccessRoutes *util.MemberType `xml:"access-route"`
+	ExcludeAccessRoutes *util.MemberType `xml:"exclude-access-route"`
+	IncludeDomains      *util.EntryType  `xml:"include-domains>list"`
+	ExcludeDomains      *util.EntryType  `xml:"exclude-domains>list"`
+	IncludeApplications *util.MemberType `xml:"include-applications"`
+	ExcludeApplications *util.MemberType `xml:"exclude-applications"`
+}
+
+func specify_v2(e Entry) interface{} {
+	ans := entry_v2{
+		Name:        e.Name,
+		SourceUsers: util.StrToMem(e.SourceUsers),
+		Os:          util.StrToMem(e.Os),
+	}
+
+	if len(e.IncludeAccessRoutes) > 0 || len(e.ExcludeAccessRoutes) > 0 || len(e.IncludeDomains) > 0 || len(e.ExcludeDomains) > 0 || len(e.IncludeApplications) > 0 || len(e.ExcludeApplications) > 0 {
+		ans.Split = &split_v2{
+			IncludeAccessRoutes: util.StrToMem(e.IncludeAccessRoutes),
+			ExcludeAccessRoutes: util.StrToMem(e.ExcludeAccessRoutes),
+			IncludeDomains:      util.StrToEnt(e.IncludeDomains),
+			ExcludeDomains:      util.StrToEnt(e.ExcludeDomains),
+			IncludeApplications: util.StrToMem(e.IncludeApplications),
+			ExcludeApplications: util.StrToMem(e.ExcludeApplications),
+		}
+	}
+
+	if text, present := e.raw["pool"]; present {
+		ans.IpPool = &util.RawXml{text}
+	}
+	if text, present := e.raw["ao"]; present {
+		ans.AuthOverride = &util.RawXml{text}
+	}
+
+	return ans
+}
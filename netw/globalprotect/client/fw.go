@@ -0,0 +1,103 @@
+package client
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+// FwClient is a namespace struct, included as part of pango.Client.
+type FwClient struct {
+	con util.XapiClient
+	ns  *namespace.Standard[Entry]
+}
+
+// Initialize is invoked when Initialize on the pango.Client is called.
+func (c *FwClient) Initialize(con util.XapiClient) {
+	c.con = con
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *FwClient) GetList(vsys, gateway string) ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(vsys, gateway, nil))
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *FwClient) ShowList(vsys, gateway string) ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(vsys, gateway, nil))
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *FwClient) Get(vsys, gateway, name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath(vsys, gateway, []string{name}), name)
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *FwClient) GetAll(vsys, gateway string) ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(vsys, gateway, nil))
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *FwClient) Show(vsys, gateway, name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath(vsys, gateway, []string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *FwClient) ShowAll(vsys, gateway string) ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(vsys, gateway, nil))
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *FwClient) Set(vsys, gateway string, e ...Entry) error {
+	return c.ns.SetEntries(c.pather(vsys, gateway), e...)
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *FwClient) Edit(vsys, gateway string, e Entry) error {
+	return c.ns.EditEntry(c.pather(vsys, gateway), e)
+}
+
+// Delete removes the given objects.
+//
+// Objects can be either a string or an Entry object.
+func (c *FwClient) Delete(vsys, gateway string, e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(vsys, gateway), e...)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *FwClient) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	v := c.con.Versioning()
+
+	if v.Gte(version.Number{9, 1, 0, ""}) {
+		return &container_v2{}, specify_v2
+	} else {
+		return &container_v1{}, specify_v1
+	}
+}
+
+func (c *FwClient) pather(vsys, gateway string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(vsys, gateway, v)
+	}
+}
+
+func (c *FwClient) xpath(vsys, gateway string, vals []string) []string {
+	if vsys == "" {
+		vsys = "vsys1"
+	}
+
+	return []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"vsys",
+		util.AsEntryXpath([]string{vsys}),
+		"global-protect",
+		"global-protect-gateway",
+		util.AsEntryXpath([]string{gateway}),
+		"remote-user-tunnel-configs",
+		util.AsEntryXpath(vals),
+	}
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+func TestFwNormalization(t *testing.T) {
+	testCases := getTests()
+
+	mc := &testdata.MockClient{}
+	ns := &FwClient{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Version = tc.version
+			mc.Reset()
+			mc.AddResp("")
+			err := ns.Set(tc.vsys, "gw1", tc.conf)
+			if err != nil {
+				t.Errorf("Error in set: %s", err)
+			} else {
+				mc.AddResp(mc.Elm)
+				r, err := ns.Get(tc.vsys, "gw1", tc.conf.Name)
+				if err != nil {
+					t.Errorf("Error in get: %s", err)
+				} else if !reflect.DeepEqual(tc.conf, r) {
+					t.Errorf("%#v != %#v", tc.conf, r)
+				}
+			}
+		})
+	}
+}
+
+func TestFwApplicationsNotSentBefore91(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &FwClient{}
+	ns.Initialize(mc)
+
+	mc.Version = version.Number{9, 0, 0, ""}
+	mc.AddResp("")
+	err := ns.Set("vsys1", "gw1", Entry{Name: "one", ExcludeApplications: []string{"zoom.exe"}})
+	if err != nil {
+		t.Fatalf("Error in set: %s", err)
+	}
+	if mc.Elm != `<entry name="one"></entry>` {
+		t.Errorf("Element is %s", mc.Elm)
+	}
+}
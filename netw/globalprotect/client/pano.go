@@ -0,0 +1,107 @@
+package client
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+// PanoClient is a namespace struct, included as part of pango.Client.
+type PanoClient struct {
+	con util.XapiClient
+	ns  *namespace.Standard[Entry]
+}
+
+// Initialize is invoked when Initialize on the pango.Client is called.
+func (c *PanoClient) Initialize(con util.XapiClient) {
+	c.con = con
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *PanoClient) GetList(tmpl, ts, vsys, gateway string) ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(tmpl, ts, vsys, gateway, nil))
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *PanoClient) ShowList(tmpl, ts, vsys, gateway string) ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(tmpl, ts, vsys, gateway, nil))
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *PanoClient) Get(tmpl, ts, vsys, gateway, name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath(tmpl, ts, vsys, gateway, []string{name}), name)
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *PanoClient) GetAll(tmpl, ts, vsys, gateway string) ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(tmpl, ts, vsys, gateway, nil))
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *PanoClient) Show(tmpl, ts, vsys, gateway, name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath(tmpl, ts, vsys, gateway, []string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *PanoClient) ShowAll(tmpl, ts, vsys, gateway string) ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(tmpl, ts, vsys, gateway, nil))
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *PanoClient) Set(tmpl, ts, vsys, gateway string, e ...Entry) error {
+	return c.ns.SetEntries(c.pather(tmpl, ts, vsys, gateway), e...)
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *PanoClient) Edit(tmpl, ts, vsys, gateway string, e Entry) error {
+	return c.ns.EditEntry(c.pather(tmpl, ts, vsys, gateway), e)
+}
+
+// Delete removes the given objects.
+//
+// Objects can be either a string or an Entry object.
+func (c *PanoClient) Delete(tmpl, ts, vsys, gateway string, e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(tmpl, ts, vsys, gateway), e...)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *PanoClient) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	v := c.con.Versioning()
+
+	if v.Gte(version.Number{9, 1, 0, ""}) {
+		return &container_v2{}, specify_v2
+	} else {
+		return &container_v1{}, specify_v1
+	}
+}
+
+func (c *PanoClient) pather(tmpl, ts, vsys, gateway string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(tmpl, ts, vsys, gateway, v)
+	}
+}
+
+func (c *PanoClient) xpath(tmpl, ts, vsys, gateway string, vals []string) []string {
+	if vsys == "" {
+		vsys = "vsys1"
+	}
+
+	ans := make([]string, 0, 15)
+	ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"vsys",
+		util.AsEntryXpath([]string{vsys}),
+		"global-protect",
+		"global-protect-gateway",
+		util.AsEntryXpath([]string{gateway}),
+		"remote-user-tunnel-configs",
+		util.AsEntryXpath(vals),
+	)
+
+	return ans
+}
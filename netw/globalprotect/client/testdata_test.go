@@ -0,0 +1,48 @@
+package client
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type testCase struct {
+	desc    string
+	version version.Number
+	vsys    string
+	conf    Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"v1 empty setting", version.Number{9, 0, 0, ""}, "", Entry{
+			Name: "one",
+		}},
+		{"v1 split tunnel", version.Number{9, 0, 0, ""}, "vsys1", Entry{
+			Name:                "two",
+			SourceUsers:         []string{"any"},
+			Os:                  []string{"any"},
+			IncludeAccessRoutes: []string{"10.0.0.0/8", "172.16.0.0/12"},
+			ExcludeAccessRoutes: []string{"10.9.0.0/16"},
+			IncludeDomains:      []string{"*.example.com"},
+			ExcludeDomains:      []string{"www.example.com"},
+		}},
+		{"v1 raw", version.Number{9, 0, 0, ""}, "vsys2", Entry{
+			Name: "three",
+			raw: map[string]string{
+				"pool": "<member>192.168.100.0/24</member>",
+				"ao":   "<accept-cookie><cookie-lifetime><lifetime-in-hours>24</lifetime-in-hours></cookie-lifetime></accept-cookie>",
+			},
+		}},
+		{"v2 app exclusions", version.Number{9, 1, 0, ""}, "vsys1", Entry{
+			Name:                "four",
+			IncludeAccessRoutes: []string{"0.0.0.0/0"},
+			ExcludeApplications: []string{"zoom.exe", "teams.exe"},
+		}},
+		{"v2 app inclusions", version.Number{10, 1, 0, ""}, "vsys1", Entry{
+			Name:                "five",
+			IncludeApplications: []string{"ssh"},
+			raw: map[string]string{
+				"pool": "<member>192.168.101.0/24</member>",
+			},
+		}},
+	}
+}
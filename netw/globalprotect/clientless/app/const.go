@@ -0,0 +1,6 @@
+package app
+
+const (
+	singular = "globalprotect clientless app"
+	plural   = "globalprotect clientless apps"
+)
@@ -0,0 +1,4 @@
+// Package app is the client.Network.GlobalProtectClientlessApp namespace.
+//
+// Normalized object:  Entry
+package app
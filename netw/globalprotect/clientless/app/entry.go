@@ -0,0 +1,101 @@
+package app
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a
+// GlobalProtect clientless VPN application.
+//
+// The application icon is preserved as-is when this object is updated.
+type Entry struct {
+	Name        string
+	HomeUrl     string
+	Description string
+
+	raw map[string]string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.HomeUrl = s.HomeUrl
+	o.Description = s.Description
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this clientless app.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:        o.Name,
+		HomeUrl:     o.HomeUrl,
+		Description: o.Description,
+	}
+
+	if o.Icon != nil {
+		ans.raw = map[string]string{"icon": util.CleanRawXml(o.Icon.Text)}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName     xml.Name     `xml:"entry"`
+	Name        string       `xml:"name,attr"`
+	HomeUrl     string       `xml:"application-home-url"`
+	Description string       `xml:"description,omitempty"`
+	Icon        *util.RawXml `xml:"app-icon"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:        e.Name,
+		HomeUrl:     e.HomeUrl,
+		Description: e.Description,
+	}
+
+	if text, present := e.raw["icon"]; present {
+		ans.Icon = &util.RawXml{text}
+	}
+
+	return ans
+}
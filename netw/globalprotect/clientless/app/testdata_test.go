@@ -0,0 +1,28 @@
+package app
+
+type testCase struct {
+	desc string
+	vsys string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"app", "", Entry{
+			Name:    "one",
+			HomeUrl: "https://wiki.example.com",
+		}},
+		{"app with description", "vsys2", Entry{
+			Name:        "two",
+			HomeUrl:     "https://mail.example.com",
+			Description: "webmail",
+		}},
+		{"app with icon", "vsys1", Entry{
+			Name:    "three",
+			HomeUrl: "https://hr.example.com",
+			raw: map[string]string{
+				"icon": "<file>aWNvbg==</file>",
+			},
+		}},
+	}
+}
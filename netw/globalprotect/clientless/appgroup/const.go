@@ -0,0 +1,6 @@
+package appgroup
+
+const (
+	singular = "globalprotect clientless app group"
+	plural   = "globalprotect clientless app groups"
+)
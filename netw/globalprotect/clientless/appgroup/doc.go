@@ -0,0 +1,5 @@
+// Package appgroup is the client.Network.GlobalProtectClientlessAppGroup
+// namespace.
+//
+// Normalized object:  Entry
+package appgroup
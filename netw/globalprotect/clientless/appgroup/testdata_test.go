@@ -0,0 +1,23 @@
+package appgroup
+
+type testCase struct {
+	desc string
+	vsys string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"no members", "", Entry{
+			Name: "one",
+		}},
+		{"one member", "vsys2", Entry{
+			Name:    "two",
+			Members: []string{"wiki"},
+		}},
+		{"multiple members", "vsys1", Entry{
+			Name:    "three",
+			Members: []string{"wiki", "mail", "hr"},
+		}},
+	}
+}
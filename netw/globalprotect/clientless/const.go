@@ -0,0 +1,3 @@
+package clientless
+
+const singular = "globalprotect clientless vpn settings"
@@ -0,0 +1,10 @@
+/*
+Package clientless is the client.Network.GlobalProtectClientless namespace.
+
+This namespace configures the clientless VPN settings of a GlobalProtect
+portal.  The clientless VPN applications and application groups themselves
+are configured in the app and appgroup subpackages.
+
+Normalized object: Settings
+*/
+package clientless
@@ -0,0 +1,96 @@
+package clientless
+
+import (
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwClientless is a namespace struct, included as part of pango.Firewall.
+type FwClientless struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *FwClientless) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// Show performs SHOW to retrieve the portal's clientless VPN settings.
+func (c *FwClientless) Show(vsys, portal string) (Settings, error) {
+	c.con.LogQuery("(show) %s for portal %q", singular, portal)
+	return c.details(c.con.Show, vsys, portal)
+}
+
+// Get performs GET to retrieve the portal's clientless VPN settings.
+func (c *FwClientless) Get(vsys, portal string) (Settings, error) {
+	c.con.LogQuery("(get) %s for portal %q", singular, portal)
+	return c.details(c.con.Get, vsys, portal)
+}
+
+// Set performs SET to update the portal's clientless VPN settings.
+func (c *FwClientless) Set(vsys, portal string, e Settings) error {
+	var err error
+	_, fn := c.versioning()
+	c.con.LogAction("(set) %s for portal %q", singular, portal)
+
+	path := c.xpath(vsys, portal)
+	path = path[:len(path)-1]
+
+	_, err = c.con.Set(path, fn(e), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to update the portal's clientless VPN settings.
+func (c *FwClientless) Edit(vsys, portal string, e Settings) error {
+	var err error
+	_, fn := c.versioning()
+	c.con.LogAction("(edit) %s for portal %q", singular, portal)
+
+	path := c.xpath(vsys, portal)
+
+	_, err = c.con.Edit(path, fn(e), nil, nil)
+	return err
+}
+
+// Delete removes the clientless VPN settings from the portal.
+func (c *FwClientless) Delete(vsys, portal string) error {
+	c.con.LogAction("(delete) %s for portal %q", singular, portal)
+	path := c.xpath(vsys, portal)
+
+	_, err := c.con.Delete(path, nil, nil)
+	return err
+}
+
+/** Internal functions for the FwClientless struct **/
+
+func (c *FwClientless) versioning() (normalizer, func(Settings) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *FwClientless) details(fn util.Retriever, vsys, portal string) (Settings, error) {
+	path := c.xpath(vsys, portal)
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Settings{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *FwClientless) xpath(vsys, portal string) []string {
+	if vsys == "" {
+		vsys = "vsys1"
+	}
+
+	return []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"vsys",
+		util.AsEntryXpath([]string{vsys}),
+		"global-protect",
+		"global-protect-portal",
+		util.AsEntryXpath([]string{portal}),
+		"clientless-vpn",
+	}
+}
@@ -0,0 +1,100 @@
+package clientless
+
+import (
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// PanoClientless is a namespace struct, included as part of pango.Panorama.
+type PanoClientless struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *PanoClientless) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// Show performs SHOW to retrieve the portal's clientless VPN settings.
+func (c *PanoClientless) Show(tmpl, ts, vsys, portal string) (Settings, error) {
+	c.con.LogQuery("(show) %s for portal %q", singular, portal)
+	return c.details(c.con.Show, tmpl, ts, vsys, portal)
+}
+
+// Get performs GET to retrieve the portal's clientless VPN settings.
+func (c *PanoClientless) Get(tmpl, ts, vsys, portal string) (Settings, error) {
+	c.con.LogQuery("(get) %s for portal %q", singular, portal)
+	return c.details(c.con.Get, tmpl, ts, vsys, portal)
+}
+
+// Set performs SET to update the portal's clientless VPN settings.
+func (c *PanoClientless) Set(tmpl, ts, vsys, portal string, e Settings) error {
+	var err error
+	_, fn := c.versioning()
+	c.con.LogAction("(set) %s for portal %q", singular, portal)
+
+	path := c.xpath(tmpl, ts, vsys, portal)
+	path = path[:len(path)-1]
+
+	_, err = c.con.Set(path, fn(e), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to update the portal's clientless VPN settings.
+func (c *PanoClientless) Edit(tmpl, ts, vsys, portal string, e Settings) error {
+	var err error
+	_, fn := c.versioning()
+	c.con.LogAction("(edit) %s for portal %q", singular, portal)
+
+	path := c.xpath(tmpl, ts, vsys, portal)
+
+	_, err = c.con.Edit(path, fn(e), nil, nil)
+	return err
+}
+
+// Delete removes the clientless VPN settings from the portal.
+func (c *PanoClientless) Delete(tmpl, ts, vsys, portal string) error {
+	c.con.LogAction("(delete) %s for portal %q", singular, portal)
+	path := c.xpath(tmpl, ts, vsys, portal)
+
+	_, err := c.con.Delete(path, nil, nil)
+	return err
+}
+
+/** Internal functions for the PanoClientless struct **/
+
+func (c *PanoClientless) versioning() (normalizer, func(Settings) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *PanoClientless) details(fn util.Retriever, tmpl, ts, vsys, portal string) (Settings, error) {
+	path := c.xpath(tmpl, ts, vsys, portal)
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Settings{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *PanoClientless) xpath(tmpl, ts, vsys, portal string) []string {
+	if vsys == "" {
+		vsys = "vsys1"
+	}
+
+	ans := make([]string, 0, 14)
+	ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"vsys",
+		util.AsEntryXpath([]string{vsys}),
+		"global-protect",
+		"global-protect-portal",
+		util.AsEntryXpath([]string{portal}),
+		"clientless-vpn",
+	)
+
+	return ans
+}
@@ -0,0 +1,197 @@
+package clientless
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Settings is a normalized, version independent representation of the
+// clientless VPN settings of a GlobalProtect portal.
+//
+// Crypto settings, proxy server settings, and the rewrite exclude domain list
+// are preserved as-is when this object is updated.
+type Settings struct {
+	Hostname                string
+	SecurityZone            string
+	DnsProxy                string
+	LoginLifetimeMinutes    int
+	LoginLifetimeHours      int
+	InactivityLogoutMinutes int
+	InactivityLogoutHours   int
+	MaxUsers                int
+	AppMappings             []AppMapping
+
+	raw map[string]string
+}
+
+// AppMapping maps a set of users to the clientless applications that they
+// are given access to.
+type AppMapping struct {
+	Name                     string
+	SourceUsers              []string // ordered
+	Os                       []string // ordered
+	Applications             []string // ordered
+	EnableCustomAppUrl       bool
+	DisplayAgentDownloadLink bool
+}
+
+// Copy copies the information from source Settings `s` to this object.
+func (o *Settings) Copy(s Settings) {
+	o.Hostname = s.Hostname
+	o.SecurityZone = s.SecurityZone
+	o.DnsProxy = s.DnsProxy
+	o.LoginLifetimeMinutes = s.LoginLifetimeMinutes
+	o.LoginLifetimeHours = s.LoginLifetimeHours
+	o.InactivityLogoutMinutes = s.InactivityLogoutMinutes
+	o.InactivityLogoutHours = s.InactivityLogoutHours
+	o.MaxUsers = s.MaxUsers
+	if s.AppMappings == nil {
+		o.AppMappings = nil
+	} else {
+		o.AppMappings = make([]AppMapping, len(s.AppMappings))
+		copy(o.AppMappings, s.AppMappings)
+	}
+}
+
+/** Structs / functions for normalization. **/
+
+type normalizer interface {
+	Normalize() Settings
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>clientless-vpn"`
+}
+
+func (o *container_v1) Normalize() Settings {
+	ans := Settings{
+		Hostname:     o.Answer.Hostname,
+		SecurityZone: o.Answer.SecurityZone,
+		DnsProxy:     o.Answer.DnsProxy,
+		MaxUsers:     o.Answer.MaxUsers,
+	}
+
+	if o.Answer.LoginLifetime != nil {
+		ans.LoginLifetimeMinutes = o.Answer.LoginLifetime.Minutes
+		ans.LoginLifetimeHours = o.Answer.LoginLifetime.Hours
+	}
+
+	if o.Answer.InactivityLogout != nil {
+		ans.InactivityLogoutMinutes = o.Answer.InactivityLogout.Minutes
+		ans.InactivityLogoutHours = o.Answer.InactivityLogout.Hours
+	}
+
+	if o.Answer.AppMappings != nil {
+		list := make([]AppMapping, 0, len(o.Answer.AppMappings.Entries))
+		for _, x := range o.Answer.AppMappings.Entries {
+			list = append(list, AppMapping{
+				Name:                     x.Name,
+				SourceUsers:              util.MemToStr(x.SourceUsers),
+				Os:                       util.MemToStr(x.Os),
+				Applications:             util.MemToStr(x.Applications),
+				EnableCustomAppUrl:       util.AsBool(x.EnableCustomAppUrl),
+				DisplayAgentDownloadLink: util.AsBool(x.DisplayAgentDownloadLink),
+			})
+		}
+		ans.AppMappings = list
+	}
+
+	raw := make(map[string]string)
+	if o.Answer.Crypto != nil {
+		raw["crypto"] = util.CleanRawXml(o.Answer.Crypto.Text)
+	}
+	if o.Answer.Proxy != nil {
+		raw["proxy"] = util.CleanRawXml(o.Answer.Proxy.Text)
+	}
+	if o.Answer.RewriteExclude != nil {
+		raw["rewrite"] = util.CleanRawXml(o.Answer.RewriteExclude.Text)
+	}
+	if len(raw) != 0 {
+		ans.raw = raw
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName          xml.Name     `xml:"clientless-vpn"`
+	Hostname         string       `xml:"hostname,omitempty"`
+	SecurityZone     string       `xml:"security-zone,omitempty"`
+	DnsProxy         string       `xml:"dns-proxy,omitempty"`
+	LoginLifetime    *lifetime    `xml:"login-lifetime"`
+	InactivityLogout *lifetime    `xml:"inactivity-logout"`
+	MaxUsers         int          `xml:"max-user,omitempty"`
+	AppMappings      *appMappings `xml:"apps-to-user-mapping"`
+	Crypto           *util.RawXml `xml:"crypto-settings"`
+	Proxy            *util.RawXml `xml:"proxy-server-setting"`
+	RewriteExclude   *util.RawXml `xml:"rewrite-exclude-domain-list"`
+}
+
+type lifetime struct {
+	Minutes int `xml:"minutes,omitempty"`
+	Hours   int `xml:"hours,omitempty"`
+}
+
+type appMappings struct {
+	Entries []appMapping `xml:"entry"`
+}
+
+type appMapping struct {
+	Name                     string           `xml:"name,attr"`
+	SourceUsers              *util.MemberType `xml:"source-user"`
+	Os                       *util.MemberType `xml:"os"`
+	Applications             *util.MemberType `xml:"applications"`
+	EnableCustomAppUrl       string           `xml:"enable-custom-app-URL-address-bar"`
+	DisplayAgentDownloadLink string           `xml:"display-global-protect-agent-download-link"`
+}
+
+func specify_v1(e Settings) interface{} {
+	ans := entry_v1{
+		Hostname:     e.Hostname,
+		SecurityZone: e.SecurityZone,
+		DnsProxy:     e.DnsProxy,
+		MaxUsers:     e.MaxUsers,
+	}
+
+	if e.LoginLifetimeMinutes != 0 || e.LoginLifetimeHours != 0 {
+		ans.LoginLifetime = &lifetime{
+			Minutes: e.LoginLifetimeMinutes,
+			Hours:   e.LoginLifetimeHours,
+		}
+	}
+
+	if e.InactivityLogoutMinutes != 0 || e.InactivityLogoutHours != 0 {
+		ans.InactivityLogout = &lifetime{
+			Minutes: e.InactivityLogoutMinutes,
+			Hours:   e.InactivityLogoutHours,
+		}
+	}
+
+	if len(e.AppMappings) > 0 {
+		list := make([]appMapping, 0, len(e.AppMappings))
+		for _, x := range e.AppMappings {
+			list = append(list, appMapping{
+				Name:                     x.Name,
+				SourceUsers:              util.StrToMem(x.SourceUsers),
+				Os:                       util.StrToMem(x.Os),
+				Applications:             util.StrToMem(x.Applications),
+				EnableCustomAppUrl:       util.YesNo(x.EnableCustomAppUrl),
+				DisplayAgentDownloadLink: util.YesNo(x.DisplayAgentDownloadLink),
+			})
+		}
+		ans.AppMappings = &appMappings{Entries: list}
+	}
+
+	if text, present := e.raw["crypto"]; present {
+		ans.Crypto = &util.RawXml{text}
+	}
+	if text, present := e.raw["proxy"]; present {
+		ans.Proxy = &util.RawXml{text}
+	}
+	if text, present := e.raw["rewrite"]; present {
+		ans.RewriteExclude = &util.RawXml{text}
+	}
+
+	return ans
+}
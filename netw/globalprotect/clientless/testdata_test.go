@@ -0,0 +1,59 @@
+package clientless
+
+type testCase struct {
+	desc string
+	vsys string
+	conf Settings
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"basic", "", Settings{
+			Hostname:     "vpn.example.com",
+			SecurityZone: "untrust",
+		}},
+		{"lifetimes in minutes", "vsys2", Settings{
+			Hostname:                "vpn.example.com",
+			SecurityZone:            "untrust",
+			DnsProxy:                "dp1",
+			LoginLifetimeMinutes:    90,
+			InactivityLogoutMinutes: 30,
+			MaxUsers:                500,
+		}},
+		{"lifetimes in hours", "vsys1", Settings{
+			Hostname:              "vpn.example.com",
+			SecurityZone:          "untrust",
+			LoginLifetimeHours:    3,
+			InactivityLogoutHours: 1,
+		}},
+		{"app mappings", "vsys1", Settings{
+			Hostname:     "vpn.example.com",
+			SecurityZone: "untrust",
+			AppMappings: []AppMapping{
+				{
+					Name:                     "all",
+					SourceUsers:              []string{"any"},
+					Os:                       []string{"any"},
+					Applications:             []string{"wiki", "mail"},
+					DisplayAgentDownloadLink: true,
+				},
+				{
+					Name:               "admins",
+					SourceUsers:        []string{"admin1", "admin2"},
+					Os:                 []string{"windows", "mac"},
+					Applications:       []string{"hr"},
+					EnableCustomAppUrl: true,
+				},
+			},
+		}},
+		{"with raw", "vsys1", Settings{
+			Hostname:     "vpn.example.com",
+			SecurityZone: "untrust",
+			raw: map[string]string{
+				"crypto":  "<server-cert-verification><block-expired-certificate>yes</block-expired-certificate></server-cert-verification>",
+				"proxy":   "<entry name=\"p1\"><domains><member>*.example.com</member></domains><use-proxy>no</use-proxy></entry>",
+				"rewrite": "<member>static.example.com</member>",
+			},
+		}},
+	}
+}
@@ -666,3 +666,24 @@ func TestFwNormalization(t *testing.T) {
 		})
 	}
 }
+
+func TestFwRestart(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &FwIkeGw{}
+	ns.Initialize(mc)
+	mc.AddResp("")
+
+	if err := ns.Restart("gw1"); err != nil {
+		t.Fatalf("Error in restart: %s", err)
+	}
+	if mc.Elm != "<test><vpn><ike-sa><gateway>gw1</gateway></ike-sa></vpn></test>" {
+		t.Errorf("Last op is %s", mc.Elm)
+	}
+
+	if err := ns.ClearSa(""); err != nil {
+		t.Fatalf("Error in clear: %s", err)
+	}
+	if mc.Elm != "<clear><vpn><ike-sa></ike-sa></vpn></clear>" {
+		t.Errorf("Clear all op is %s", mc.Elm)
+	}
+}
@@ -0,0 +1,44 @@
+package ikegw
+
+import (
+	"encoding/xml"
+)
+
+// ClearSa clears the IKE SAs of the given IKE gateway.
+//
+// If name is an empty string, then the IKE SAs of all gateways are cleared.
+func (c *FwIkeGw) ClearSa(name string) error {
+	c.con.LogOp("(op) clearing ike-sa for gateway %q", name)
+	_, err := c.con.Op(saReq{XMLName: xml.Name{Local: "clear"}, Sa: sa{Gateway: name}}, "", nil, nil)
+	return err
+}
+
+// Negotiate triggers IKE negotiation with the given IKE gateway.
+//
+// If name is an empty string, then negotiation is triggered for all gateways.
+func (c *FwIkeGw) Negotiate(name string) error {
+	c.con.LogOp("(op) testing ike-sa for gateway %q", name)
+	_, err := c.con.Op(saReq{XMLName: xml.Name{Local: "test"}, Sa: sa{Gateway: name}}, "", nil, nil)
+	return err
+}
+
+// Restart clears the IKE SAs of the given IKE gateway, then triggers IKE
+// negotiation with it.
+func (c *FwIkeGw) Restart(name string) error {
+	if err := c.ClearSa(name); err != nil {
+		return err
+	}
+
+	return c.Negotiate(name)
+}
+
+/** Structs for the op commands. **/
+
+type saReq struct {
+	XMLName xml.Name
+	Sa      sa `xml:"vpn>ike-sa"`
+}
+
+type sa struct {
+	Gateway string `xml:"gateway,omitempty"`
+}
@@ -0,0 +1,6 @@
+package imports
+
+const (
+	singular = "vsys import"
+	plural   = "vsys imports"
+)
@@ -0,0 +1,7 @@
+// Package imports is the client.Network.VsysImport namespace.
+//
+// This namespace shows which importable network objects (interfaces, virtual
+// routers, virtual wires, and vlans) are imported into which vsys.
+//
+// Normalized object:  Entry
+package imports
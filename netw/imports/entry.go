@@ -0,0 +1,79 @@
+package imports
+
+import (
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of the network
+// objects imported into a vsys.
+type Entry struct {
+	Vsys           string
+	Interfaces     []string // ordered
+	VirtualRouters []string // ordered
+	VirtualWires   []string // ordered
+	Vlans          []string // ordered
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// Imports returns the objects of the given import type, which should be one
+// of the util.*Import constants.
+func (o Entry) Imports(loc string) []string {
+	switch loc {
+	case util.InterfaceImport:
+		return o.Interfaces
+	case util.VirtualRouterImport:
+		return o.VirtualRouters
+	case util.VirtualWireImport:
+		return o.VirtualWires
+	case util.VlanImport:
+		return o.Vlans
+	}
+
+	return nil
+}
+
+// Has returns true if the named object of the given import type is imported
+// into this vsys.
+func (o Entry) Has(loc, name string) bool {
+	for _, x := range o.Imports(loc) {
+		if x == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer *entry_v1 `xml:"network"`
+}
+
+func (o *container_v1) Normalize(vsys string) Entry {
+	ans := Entry{Vsys: vsys}
+	if o.Answer != nil {
+		ans.Interfaces = util.MemToStr(o.Answer.Interfaces)
+		ans.VirtualRouters = util.MemToStr(o.Answer.VirtualRouters)
+		ans.VirtualWires = util.MemToStr(o.Answer.VirtualWires)
+		ans.Vlans = util.MemToStr(o.Answer.Vlans)
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	Interfaces     *util.MemberType `xml:"interface"`
+	VirtualRouters *util.MemberType `xml:"virtual-router"`
+	VirtualWires   *util.MemberType `xml:"virtual-wire"`
+	Vlans          *util.MemberType `xml:"vlan"`
+}
@@ -0,0 +1,128 @@
+package imports
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwImports is the client.Network.VsysImport namespace.
+type FwImports struct {
+	con util.XapiClient
+	ns  *namespace.Namespace
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *FwImports) Initialize(con util.XapiClient) {
+	c.con = con
+	c.ns = namespace.New(singular, plural, con)
+}
+
+// Get performs GET to retrieve the imports of the given vsys.
+func (c *FwImports) Get(vsys string) (Entry, error) {
+	ans := container_v1{}
+	if err := c.ns.Existing(util.Get, c.xpath(vsys), &ans); err != nil {
+		return Entry{}, err
+	}
+
+	return ans.Normalize(vsys), nil
+}
+
+// GetAll performs GET to retrieve the imports of every vsys.
+func (c *FwImports) GetAll() ([]Entry, error) {
+	c.con.LogQuery("(get) list of vsys")
+	list, err := c.con.EntryListUsing(c.con.Get, c.vsysXpath())
+	if err != nil {
+		return nil, err
+	}
+
+	ans := make([]Entry, 0, len(list))
+	for _, vsys := range list {
+		e, err := c.Get(vsys)
+		if err != nil {
+			return nil, err
+		}
+		ans = append(ans, e)
+	}
+
+	return ans, nil
+}
+
+// Find returns the vsys the named object of the given import type is
+// imported into, or an empty string if it is not imported anywhere.
+//
+// Param loc should be one of the util.*Import constants.
+func (c *FwImports) Find(loc, name string) (string, error) {
+	list, err := c.GetAll()
+	if err != nil {
+		return "", err
+	}
+
+	for _, e := range list {
+		if e.Has(loc, name) {
+			return e.Vsys, nil
+		}
+	}
+
+	return "", nil
+}
+
+// EnsureImported makes sure that the given objects are imported into the
+// given vsys.  Objects already imported into the vsys are left alone, while
+// the rest are removed from whatever vsys they are currently in and then
+// imported into the given vsys.
+//
+// Param loc should be one of the util.*Import constants.
+func (c *FwImports) EnsureImported(loc, vsys string, names ...string) error {
+	if vsys == "" || len(names) == 0 {
+		return nil
+	}
+
+	cur, err := c.Get(vsys)
+	if err != nil {
+		return err
+	}
+
+	missing := make([]string, 0, len(names))
+	for _, name := range names {
+		if !cur.Has(loc, name) {
+			missing = append(missing, name)
+		}
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	if err = c.con.VsysUnimport(loc, "", "", missing); err != nil {
+		return err
+	}
+
+	return c.con.VsysImport(loc, "", "", vsys, missing)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *FwImports) vsysXpath() []string {
+	return []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"vsys",
+	}
+}
+
+func (c *FwImports) xpath(vsys string) []string {
+	if vsys == "" {
+		vsys = "vsys1"
+	}
+
+	return []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"vsys",
+		util.AsEntryXpath([]string{vsys}),
+		"import",
+		"network",
+	}
+}
@@ -0,0 +1,74 @@
+package imports
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+const importXml = `<network><interface><member>ethernet1/1</member><member>ethernet1/2</member></interface><virtual-router><member>vr1</member></virtual-router></network>`
+
+func TestFwGet(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp(importXml)
+	ns := &FwImports{}
+	ns.Initialize(mc)
+
+	e, err := ns.Get("vsys2")
+	if err != nil {
+		t.Fatalf("Error in get: %s", err)
+	}
+
+	expected := Entry{
+		Vsys:           "vsys2",
+		Interfaces:     []string{"ethernet1/1", "ethernet1/2"},
+		VirtualRouters: []string{"vr1"},
+	}
+	if !reflect.DeepEqual(e, expected) {
+		t.Errorf("%#v != %#v", e, expected)
+	}
+	if !e.Has(util.InterfaceImport, "ethernet1/2") {
+		t.Errorf("ethernet1/2 not found")
+	}
+	if e.Has(util.VlanImport, "vr1") {
+		t.Errorf("vr1 is not a vlan")
+	}
+}
+
+func TestFwEnsureImported(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp(importXml)
+	ns := &FwImports{}
+	ns.Initialize(mc)
+
+	if err := ns.EnsureImported(util.InterfaceImport, "vsys2", "ethernet1/1", "ethernet1/3"); err != nil {
+		t.Fatalf("Error in ensure imported: %s", err)
+	}
+
+	if !reflect.DeepEqual(mc.Unimports, []string{"ethernet1/3"}) {
+		t.Errorf("unimports: %#v", mc.Unimports)
+	}
+	if !reflect.DeepEqual(mc.Imports, []string{"ethernet1/3"}) {
+		t.Errorf("imports: %#v", mc.Imports)
+	}
+	if mc.Vsys != "vsys2" {
+		t.Errorf("vsys: %q", mc.Vsys)
+	}
+}
+
+func TestFwEnsureImportedNoop(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp(importXml)
+	ns := &FwImports{}
+	ns.Initialize(mc)
+
+	if err := ns.EnsureImported(util.VirtualRouterImport, "vsys2", "vr1"); err != nil {
+		t.Fatalf("Error in ensure imported: %s", err)
+	}
+
+	if mc.Imports != nil || mc.Unimports != nil {
+		t.Errorf("Expected no changes, got imports:%#v unimports:%#v", mc.Imports, mc.Unimports)
+	}
+}
@@ -0,0 +1,37 @@
+package imports
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+func TestPanoEnsureImported(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp(importXml)
+	ns := &PanoImports{}
+	ns.Initialize(mc)
+
+	if err := ns.EnsureImported("t1", "", util.InterfaceImport, "vsys2", "ethernet1/2", "ethernet1/4"); err != nil {
+		t.Fatalf("Error in ensure imported: %s", err)
+	}
+
+	if !reflect.DeepEqual(mc.Imports, []string{"ethernet1/4"}) {
+		t.Errorf("imports: %#v", mc.Imports)
+	}
+	if mc.Template != "t1" {
+		t.Errorf("template: %q", mc.Template)
+	}
+}
+
+func TestPanoGetRequiresTemplate(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &PanoImports{}
+	ns.Initialize(mc)
+
+	if _, err := ns.Get("", "", "vsys1"); err == nil {
+		t.Errorf("Expected an error without a template or template stack")
+	}
+}
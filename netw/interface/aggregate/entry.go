@@ -12,12 +12,14 @@ type Entry struct {
 	Name                       string
 	Mode                       string
 	NetflowProfile             string
+	LldpEnabled                bool
+	LldpProfile                string
 	Mtu                        int
 	AdjustTcpMss               bool
 	Ipv4MssAdjust              int
 	Ipv6MssAdjust              int
 	EnableUntaggedSubinterface bool
-	StaticIps                  []string // ordered
+	StaticIps                  []string // ordered, may contain template variables
 	Ipv6Enabled                bool
 	Ipv6InterfaceId            string
 	ManagementProfile          string
@@ -37,6 +39,8 @@ type Entry struct {
 func (o *Entry) Copy(s Entry) {
 	o.Mode = s.Mode
 	o.NetflowProfile = s.NetflowProfile
+	o.LldpEnabled = s.LldpEnabled
+	o.LldpProfile = s.LldpProfile
 	o.Mtu = s.Mtu
 	o.AdjustTcpMss = s.AdjustTcpMss
 	o.Ipv4MssAdjust = s.Ipv4MssAdjust
@@ -55,6 +59,27 @@ func (o *Entry) Copy(s Entry) {
 	o.DhcpSendHostnameValue = s.DhcpSendHostnameValue
 }
 
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// Variables returns the template variables referenced by this interface.
+func (o Entry) Variables() []string {
+	return util.TemplateVariables(o.StaticIps...)
+}
+
+// ValidateStaticIps checks that the static IPs that look like IP addresses
+// are valid.  Template variables and address object names are skipped.
+func (o Entry) ValidateStaticIps() error {
+	return util.ValidateIps(o.StaticIps)
+}
+
 /** Structs / functions for this namespace. **/
 
 type normalizer interface {
@@ -99,12 +124,20 @@ func (o *entry_v1) normalize() Entry {
 	case o.VirtualWire != nil:
 		ans.Mode = ModeVirtualWire
 		ans.NetflowProfile = o.VirtualWire.NetflowProfile
+		if o.VirtualWire.Lldp != nil {
+			ans.LldpEnabled = util.AsBool(o.VirtualWire.Lldp.LldpEnabled)
+			ans.LldpProfile = o.VirtualWire.Lldp.LldpProfile
+		}
 		if o.VirtualWire.Subinterfaces != nil {
 			ans.raw["vwsi"] = util.CleanRawXml(o.VirtualWire.Subinterfaces.Text)
 		}
 	case o.L2 != nil:
 		ans.Mode = ModeLayer2
 		ans.NetflowProfile = o.L2.NetflowProfile
+		if o.L2.Lldp != nil {
+			ans.LldpEnabled = util.AsBool(o.L2.Lldp.LldpEnabled)
+			ans.LldpProfile = o.L2.Lldp.LldpProfile
+		}
 		if o.L2.Subinterfaces != nil {
 			ans.raw["l2si"] = util.CleanRawXml(o.L2.Subinterfaces.Text)
 		}
@@ -195,12 +228,20 @@ func (o *entry_v2) normalize() Entry {
 	case o.VirtualWire != nil:
 		ans.Mode = ModeVirtualWire
 		ans.NetflowProfile = o.VirtualWire.NetflowProfile
+		if o.VirtualWire.Lldp != nil {
+			ans.LldpEnabled = util.AsBool(o.VirtualWire.Lldp.LldpEnabled)
+			ans.LldpProfile = o.VirtualWire.Lldp.LldpProfile
+		}
 		if o.VirtualWire.Subinterfaces != nil {
 			ans.raw["vwsi"] = util.CleanRawXml(o.VirtualWire.Subinterfaces.Text)
 		}
 	case o.L2 != nil:
 		ans.Mode = ModeLayer2
 		ans.NetflowProfile = o.L2.NetflowProfile
+		if o.L2.Lldp != nil {
+			ans.LldpEnabled = util.AsBool(o.L2.Lldp.LldpEnabled)
+			ans.LldpProfile = o.L2.Lldp.LldpProfile
+		}
 		if o.L2.Subinterfaces != nil {
 			ans.raw["l2si"] = util.CleanRawXml(o.L2.Subinterfaces.Text)
 		}
@@ -292,12 +333,20 @@ func (o *entry_v3) normalize() Entry {
 	case o.VirtualWire != nil:
 		ans.Mode = ModeVirtualWire
 		ans.NetflowProfile = o.VirtualWire.NetflowProfile
+		if o.VirtualWire.Lldp != nil {
+			ans.LldpEnabled = util.AsBool(o.VirtualWire.Lldp.LldpEnabled)
+			ans.LldpProfile = o.VirtualWire.Lldp.LldpProfile
+		}
 		if o.VirtualWire.Subinterfaces != nil {
 			ans.raw["vwsi"] = util.CleanRawXml(o.VirtualWire.Subinterfaces.Text)
 		}
 	case o.L2 != nil:
 		ans.Mode = ModeLayer2
 		ans.NetflowProfile = o.L2.NetflowProfile
+		if o.L2.Lldp != nil {
+			ans.LldpEnabled = util.AsBool(o.L2.Lldp.LldpEnabled)
+			ans.LldpProfile = o.L2.Lldp.LldpProfile
+		}
 		if o.L2.Subinterfaces != nil {
 			ans.raw["l2si"] = util.CleanRawXml(o.L2.Subinterfaces.Text)
 		}
@@ -370,9 +419,15 @@ type entry_v1 struct {
 
 type layer2 struct {
 	NetflowProfile string       `xml:"netflow-profile,omitempty"`
+	Lldp           *lldp        `xml:"lldp"`
 	Subinterfaces  *util.RawXml `xml:"units"`
 }
 
+type lldp struct {
+	LldpEnabled string `xml:"enable"`
+	LldpProfile string `xml:"profile,omitempty"`
+}
+
 type layer3_v1 struct {
 	Mtu                        int              `xml:"mtu,omitempty"`
 	Mss                        *mss             `xml:"adjust-tcp-mss"`
@@ -423,6 +478,12 @@ func specify_v1(e Entry) interface{} {
 		ans.VirtualWire = &layer2{
 			NetflowProfile: e.NetflowProfile,
 		}
+		if e.LldpEnabled || e.LldpProfile != "" {
+			ans.VirtualWire.Lldp = &lldp{
+				LldpEnabled: util.YesNo(e.LldpEnabled),
+				LldpProfile: e.LldpProfile,
+			}
+		}
 
 		if text := e.raw["vwsi"]; text != "" {
 			ans.VirtualWire.Subinterfaces = &util.RawXml{text}
@@ -431,6 +492,12 @@ func specify_v1(e Entry) interface{} {
 		ans.L2 = &layer2{
 			NetflowProfile: e.NetflowProfile,
 		}
+		if e.LldpEnabled || e.LldpProfile != "" {
+			ans.L2.Lldp = &lldp{
+				LldpEnabled: util.YesNo(e.LldpEnabled),
+				LldpProfile: e.LldpProfile,
+			}
+		}
 
 		if text := e.raw["l2si"]; text != "" {
 			ans.L2.Subinterfaces = &util.RawXml{text}
@@ -533,6 +600,12 @@ func specify_v2(e Entry) interface{} {
 		ans.VirtualWire = &layer2{
 			NetflowProfile: e.NetflowProfile,
 		}
+		if e.LldpEnabled || e.LldpProfile != "" {
+			ans.VirtualWire.Lldp = &lldp{
+				LldpEnabled: util.YesNo(e.LldpEnabled),
+				LldpProfile: e.LldpProfile,
+			}
+		}
 
 		if text := e.raw["vwsi"]; text != "" {
 			ans.VirtualWire.Subinterfaces = &util.RawXml{text}
@@ -541,6 +614,12 @@ func specify_v2(e Entry) interface{} {
 		ans.L2 = &layer2{
 			NetflowProfile: e.NetflowProfile,
 		}
+		if e.LldpEnabled || e.LldpProfile != "" {
+			ans.L2.Lldp = &lldp{
+				LldpEnabled: util.YesNo(e.LldpEnabled),
+				LldpProfile: e.LldpProfile,
+			}
+		}
 
 		if text := e.raw["l2si"]; text != "" {
 			ans.L2.Subinterfaces = &util.RawXml{text}
@@ -659,6 +738,12 @@ func specify_v3(e Entry) interface{} {
 		ans.VirtualWire = &layer2{
 			NetflowProfile: e.NetflowProfile,
 		}
+		if e.LldpEnabled || e.LldpProfile != "" {
+			ans.VirtualWire.Lldp = &lldp{
+				LldpEnabled: util.YesNo(e.LldpEnabled),
+				LldpProfile: e.LldpProfile,
+			}
+		}
 
 		if text := e.raw["vwsi"]; text != "" {
 			ans.VirtualWire.Subinterfaces = &util.RawXml{text}
@@ -667,6 +752,12 @@ func specify_v3(e Entry) interface{} {
 		ans.L2 = &layer2{
 			NetflowProfile: e.NetflowProfile,
 		}
+		if e.LldpEnabled || e.LldpProfile != "" {
+			ans.L2.Lldp = &lldp{
+				LldpEnabled: util.YesNo(e.LldpEnabled),
+				LldpProfile: e.LldpProfile,
+			}
+		}
 
 		if text := e.raw["l2si"]; text != "" {
 			ans.L2.Subinterfaces = &util.RawXml{text}
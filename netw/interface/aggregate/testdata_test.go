@@ -29,6 +29,8 @@ func getTests() []tc {
 			Name:           "ae1",
 			Mode:           ModeVirtualWire,
 			NetflowProfile: "my netflow profile",
+			LldpEnabled:    true,
+			LldpProfile:    "my lldp profile",
 			raw: map[string]string{
 				"vwsi": "subinterfaces",
 			},
@@ -41,6 +43,8 @@ func getTests() []tc {
 			Name:           "ae1",
 			Mode:           ModeLayer2,
 			NetflowProfile: "my netflow profile",
+			LldpEnabled:    true,
+			LldpProfile:    "my lldp profile",
 			raw: map[string]string{
 				"l2si": "subinterfaces",
 			},
@@ -98,6 +102,8 @@ func getTests() []tc {
 			Name:           "ae1",
 			Mode:           ModeVirtualWire,
 			NetflowProfile: "my netflow profile",
+			LldpEnabled:    true,
+			LldpProfile:    "my lldp profile",
 			raw: map[string]string{
 				"vwsi": "subinterfaces",
 			},
@@ -110,6 +116,8 @@ func getTests() []tc {
 			Name:           "ae1",
 			Mode:           ModeLayer2,
 			NetflowProfile: "my netflow profile",
+			LldpEnabled:    true,
+			LldpProfile:    "my lldp profile",
 			raw: map[string]string{
 				"l2si": "subinterfaces",
 			},
@@ -175,6 +183,8 @@ func getTests() []tc {
 			Name:           "ae1",
 			Mode:           ModeVirtualWire,
 			NetflowProfile: "my netflow profile",
+			LldpEnabled:    true,
+			LldpProfile:    "my lldp profile",
 			raw: map[string]string{
 				"vwsi": "subinterfaces",
 			},
@@ -187,6 +197,8 @@ func getTests() []tc {
 			Name:           "ae1",
 			Mode:           ModeLayer2,
 			NetflowProfile: "my netflow profile",
+			LldpEnabled:    true,
+			LldpProfile:    "my lldp profile",
 			raw: map[string]string{
 				"l2si": "subinterfaces",
 			},
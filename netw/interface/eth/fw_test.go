@@ -3,6 +3,7 @@ package eth
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/PaloAltoNetworks/pango/testdata"
 )
@@ -42,3 +43,109 @@ func TestFwNormalization(t *testing.T) {
 		})
 	}
 }
+
+func TestFwSetSkipsUnchanged(t *testing.T) {
+	e := Entry{Name: "ethernet1/1", Mode: "layer3", StaticIps: []string{"10.1.1.1/24"}}
+
+	mc := &testdata.MockClient{}
+	ns := &FwEth{}
+	ns.Initialize(mc)
+	mc.AddResp("")
+	if err := ns.Set("vsys1", e); err != nil {
+		t.Fatalf("Error in set: %s", err)
+	}
+	current := mc.Elm
+
+	mc = &testdata.MockClient{
+		SkipUnchanged: true,
+		ImportedInto:  map[string]string{"ethernet1/1": "vsys1"},
+	}
+	ns.Initialize(mc)
+	mc.AddResp(current)
+	if err := ns.Set("vsys1", e); err != nil {
+		t.Fatalf("Error in set: %s", err)
+	}
+	if mc.Function != "get" || len(mc.Unimports) != 0 || len(mc.Imports) != 0 {
+		t.Errorf("Unchanged interface was written: %s %v %v", mc.Function, mc.Unimports, mc.Imports)
+	}
+
+	mc.ImportedInto["ethernet1/1"] = "vsys2"
+	if err := ns.Set("vsys1", e); err != nil {
+		t.Fatalf("Error in set: %s", err)
+	}
+	if mc.Function != "set" || !reflect.DeepEqual(mc.Imports, []string{"ethernet1/1"}) {
+		t.Errorf("Interface in the wrong vsys was not written: %s %v", mc.Function, mc.Imports)
+	}
+}
+
+func TestFwShowHardware(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &FwEth{}
+	ns.Initialize(mc)
+
+	mc.AddResp(`<ifnet><zone>trust</zone><vr>default</vr><vsys>vsys1</vsys><addr><member>10.1.1.1/24</member></addr></ifnet><hw><name>ethernet1/1</name><id>16</id><mac>00:1b:17:00:01:10</mac><state>up</state><speed>1000</speed><duplex>full</duplex><mode>(autoneg)</mode><mtu>1500</mtu><state_c>auto</state_c><speed_c>auto</speed_c><duplex_c>auto</duplex_c></hw><counters><ifnet><entry><ipackets>10</ipackets><opackets>20</opackets><ierrors>1</ierrors><idrops>2</idrops></entry></ifnet><hw><entry><ibytes>300</ibytes><obytes>400</obytes></entry></hw></counters>`)
+	hw, err := ns.ShowHardware("ethernet1/1")
+	if err != nil {
+		t.Fatalf("Error in show: %s", err)
+	}
+
+	expected := Hardware{
+		Name:             "ethernet1/1",
+		Id:               "16",
+		Mac:              "00:1b:17:00:01:10",
+		State:            "up",
+		Speed:            "1000",
+		Duplex:           "full",
+		Mode:             "(autoneg)",
+		Mtu:              1500,
+		ConfiguredState:  "auto",
+		ConfiguredSpeed:  "auto",
+		ConfiguredDuplex: "auto",
+		Zone:             "trust",
+		VirtualRouter:    "default",
+		Vsys:             "vsys1",
+		Addresses:        []string{"10.1.1.1/24"},
+		PacketsReceived:  10,
+		PacketsSent:      20,
+		ErrorsReceived:   1,
+		DropsReceived:    2,
+		BytesReceived:    300,
+		BytesTransmitted: 400,
+	}
+	if !reflect.DeepEqual(hw, expected) {
+		t.Errorf("Expected %#v, got %#v", expected, hw)
+	}
+	if !hw.Up() {
+		t.Errorf("Link is not up")
+	}
+	if mc.Elm != "<show><interface>ethernet1/1</interface></show>" {
+		t.Errorf("Op request is %q", mc.Elm)
+	}
+}
+
+func TestLinkHistory(t *testing.T) {
+	h := &LinkHistory{MaxTransitions: 2}
+	start := time.Now()
+
+	states := []string{"up", "up", "down", "up", "down"}
+	var changed int
+	for i, state := range states {
+		if h.Record(Hardware{Name: "ethernet1/1", State: state}, start.Add(time.Duration(i)*time.Minute)) {
+			changed++
+		}
+	}
+
+	if changed != 3 {
+		t.Errorf("Recorded %d transitions", changed)
+	}
+	list := h.Transitions("ethernet1/1")
+	if len(list) != 2 || list[0].From != "down" || list[0].To != "up" || list[1].To != "down" {
+		t.Errorf("Transitions are %#v", list)
+	}
+	if h.State("ethernet1/1") != "down" {
+		t.Errorf("State is %q", h.State("ethernet1/1"))
+	}
+	if n := h.Flaps("ethernet1/1", start); n != 1 {
+		t.Errorf("Flaps is %d", n)
+	}
+}
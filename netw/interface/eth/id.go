@@ -0,0 +1,38 @@
+package eth
+
+import (
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwId returns the import ID of the ethernet interface `name`.
+func FwId(name string) string {
+	return util.BuildId(name)
+}
+
+// ParseFwId parses an import ID built by FwId, returning the
+// name given to it.
+func ParseFwId(id string) (string, error) {
+	parts, err := util.ParseId(id, 1)
+	if err != nil {
+		return "", err
+	}
+
+	return parts[0], nil
+}
+
+// PanoId returns the import ID of the ethernet interface `name` in
+// the given template, and template stack.
+func PanoId(tmpl, ts, name string) string {
+	return util.BuildId(tmpl, ts, name)
+}
+
+// ParsePanoId parses an import ID built by PanoId, returning the
+// tmpl, ts, name params given to it.
+func ParsePanoId(id string) (string, string, string, error) {
+	parts, err := util.ParseId(id, 3)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
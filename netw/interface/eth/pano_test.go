@@ -1,10 +1,13 @@
 package eth
 
 import (
+	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/PaloAltoNetworks/pango/testdata"
+	"github.com/PaloAltoNetworks/pango/util"
 )
 
 func TestPanoNormalization(t *testing.T) {
@@ -42,3 +45,138 @@ func TestPanoNormalization(t *testing.T) {
 		})
 	}
 }
+
+func TestPanoSetBulkErrors(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		unimport  error
+		imp       error
+		phase     string
+		created   []string
+		failed    []string
+		entryMode string
+	}{
+		{"unimport fails", fmt.Errorf("unimport"), nil, util.PhaseUnimport, []string{"ethernet1/1", "ethernet1/2"}, []string{"ethernet1/1", "ethernet1/2"}, "layer3"},
+		{"import fails", nil, fmt.Errorf("import"), util.PhaseImport, []string{"ethernet1/1", "ethernet1/2"}, []string{"ethernet1/1"}, "ha"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc := &testdata.MockClient{
+				UnimportError: tc.unimport,
+				ImportError:   tc.imp,
+			}
+			mc.AddResp("")
+			ns := &PanoEth{}
+			ns.Initialize(mc)
+
+			err := ns.Set("t", "", "vsys1",
+				Entry{Name: "ethernet1/1", Mode: "layer3"},
+				Entry{Name: "ethernet1/2", Mode: tc.entryMode},
+			)
+			be, ok := err.(util.BulkSetError)
+			if !ok {
+				t.Fatalf("Expected BulkSetError, got %#v", err)
+			}
+			if be.Phase != tc.phase {
+				t.Errorf("phase: %q != %q", be.Phase, tc.phase)
+			}
+			if !reflect.DeepEqual(be.Created, tc.created) {
+				t.Errorf("created: %#v != %#v", be.Created, tc.created)
+			}
+			if !reflect.DeepEqual(be.Failed, tc.failed) {
+				t.Errorf("failed: %#v != %#v", be.Failed, tc.failed)
+			}
+		})
+	}
+}
+
+func TestPanoSetError(t *testing.T) {
+	setErr := fmt.Errorf("set")
+	mc := &testdata.MockClient{}
+	mc.Resp = []testdata.Response{{Error: setErr}}
+	ns := &PanoEth{}
+	ns.Initialize(mc)
+
+	err := ns.Set("t", "", "vsys1", Entry{Name: "ethernet1/1", Mode: "layer3"})
+	if be, ok := err.(util.BulkSetError); !ok || be.Phase != util.PhaseSet || be.Err != setErr {
+		t.Errorf("Expected a set phase BulkSetError, got %#v", err)
+	}
+
+	mc.Resp = []testdata.Response{{}, {Error: setErr}}
+	mc.Called = 0
+	err = ns.SetWithRollback("t", "", "vsys1", Entry{Name: "ethernet1/1", Mode: "layer3"})
+	if be, ok := err.(util.BulkSetError); !ok || be.Phase != util.PhaseSet || be.RolledBack {
+		t.Errorf("Expected a set phase BulkSetError from rollback, got %#v", err)
+	}
+	if mc.Called != 2 {
+		t.Errorf("Called %d times, not 2", mc.Called)
+	}
+}
+
+func TestPanoSetWithRollback(t *testing.T) {
+	mc := &testdata.MockClient{
+		UnimportError: fmt.Errorf("unimport"),
+	}
+	mc.AddResp("")
+	ns := &PanoEth{}
+	ns.Initialize(mc)
+
+	err := ns.SetWithRollback("t", "", "vsys1", Entry{Name: "ethernet1/1", Mode: "layer3"})
+	be, ok := err.(util.BulkSetError)
+	if !ok {
+		t.Fatalf("Expected BulkSetError, got %#v", err)
+	}
+	if !be.RolledBack {
+		t.Errorf("Not rolled back: %s", be.RollbackErr)
+	}
+	if mc.Function != "delete" {
+		t.Errorf("Last function was %q, not delete", mc.Function)
+	}
+}
+
+func TestPanoSetWithRollbackRestoresExisting(t *testing.T) {
+	mc := &testdata.MockClient{
+		ImportError: fmt.Errorf("import"),
+	}
+	mc.AddResp(`<entry name="ethernet1/1"><layer3><mtu>1500</mtu></layer3><comment>old</comment></entry>`)
+	ns := &PanoEth{}
+	ns.Initialize(mc)
+
+	err := ns.SetWithRollback("t", "", "vsys1", Entry{Name: "ethernet1/1", Mode: "layer3", Comment: "new"})
+	be, ok := err.(util.BulkSetError)
+	if !ok {
+		t.Fatalf("Expected BulkSetError, got %#v", err)
+	}
+	if !be.RolledBack {
+		t.Errorf("Not rolled back: %s", be.RollbackErr)
+	}
+	if mc.Function != "edit" {
+		t.Errorf("Last function was %q, not edit", mc.Function)
+	}
+	if !strings.Contains(mc.Elm, "<comment>old</comment>") {
+		t.Errorf("Old config not restored: %s", mc.Elm)
+	}
+}
+
+func TestPanoSetWithRollbackRestoresImports(t *testing.T) {
+	mc := &testdata.MockClient{
+		UnimportError: fmt.Errorf("unimport"),
+		ImportedInto:  map[string]string{"ethernet1/1": "vsys2"},
+	}
+	mc.AddResp(`<entry name="ethernet1/1"><layer3><mtu>1500</mtu></layer3></entry>`)
+	ns := &PanoEth{}
+	ns.Initialize(mc)
+
+	err := ns.SetWithRollback("t", "", "vsys1", Entry{Name: "ethernet1/1", Mode: "layer3"})
+	be, ok := err.(util.BulkSetError)
+	if !ok {
+		t.Fatalf("Expected BulkSetError, got %#v", err)
+	}
+	if !be.RolledBack {
+		t.Errorf("Not rolled back: %s", be.RollbackErr)
+	}
+	if mc.Vsys != "vsys2" || !reflect.DeepEqual(mc.Imports, []string{"ethernet1/1"}) {
+		t.Errorf("Import not restored: %q %v", mc.Vsys, mc.Imports)
+	}
+}
@@ -0,0 +1,304 @@
+package eth
+
+import (
+	"encoding/xml"
+	"sync"
+	"time"
+)
+
+// Hardware is the physical layer state of an ethernet interface, as shown by
+// "show interface <name>".
+//
+// The Configured* fields are the configured values (such as "auto"), while
+// State, Speed, and Duplex are the negotiated values currently in effect.
+type Hardware struct {
+	Name             string
+	Id               string
+	Mac              string
+	State            string
+	Speed            string
+	Duplex           string
+	Mode             string
+	Mtu              int
+	ConfiguredState  string
+	ConfiguredSpeed  string
+	ConfiguredDuplex string
+	AggregateGroup   string
+	Zone             string
+	VirtualRouter    string
+	Vsys             string
+	Addresses        []string
+	PacketsReceived  uint64
+	PacketsSent      uint64
+	ErrorsReceived   uint64
+	DropsReceived    uint64
+	BytesReceived    uint64
+	BytesTransmitted uint64
+}
+
+// Up returns if the link is up.
+func (o Hardware) Up() bool {
+	return o.State == "up"
+}
+
+// Transceiver is the SFP / transceiver information of an ethernet interface,
+// as shown by "show transceiver-detail <name>".
+//
+// Fields that the transceiver does not report are left empty.
+type Transceiver struct {
+	Name         string
+	Type         string
+	Vendor       string
+	PartNumber   string
+	SerialNumber string
+	Wavelength   string
+	Temperature  string
+	Voltage      string
+	TxBias       string
+	TxPower      string
+	RxPower      string
+}
+
+// ShowHardware returns the physical layer state of the given interface.
+func (c *FwEth) ShowHardware(name string) (Hardware, error) {
+	c.con.LogOp("(op) show interface %q", name)
+
+	req := hwReq{Name: name}
+	var ans hwResp
+	if _, err := c.con.Op(req, "", nil, &ans); err != nil {
+		return Hardware{}, err
+	}
+
+	return ans.normalize(), nil
+}
+
+// ShowTransceiver returns the SFP / transceiver information of the given
+// interface.
+//
+// PAN-OS returns an error for interfaces without a transceiver.
+func (c *FwEth) ShowTransceiver(name string) (Transceiver, error) {
+	c.con.LogOp("(op) show transceiver-detail %q", name)
+
+	req := xcvrReq{Name: name}
+	var ans xcvrResp
+	if _, err := c.con.Op(req, "", nil, &ans); err != nil {
+		return Transceiver{}, err
+	}
+
+	o := ans.Entry
+	return Transceiver{
+		Name:         name,
+		Type:         o.Type,
+		Vendor:       o.Vendor,
+		PartNumber:   o.PartNumber,
+		SerialNumber: o.SerialNumber,
+		Wavelength:   o.Wavelength,
+		Temperature:  o.Temperature,
+		Voltage:      o.Voltage,
+		TxBias:       o.TxBias,
+		TxPower:      o.TxPower,
+		RxPower:      o.RxPower,
+	}, nil
+}
+
+// UpdateLinkHistory retrieves the physical layer state of the given
+// interfaces and records any link state transitions in the given history.
+//
+// Calling this periodically builds up the link flap history of the
+// interfaces, since PAN-OS does not keep one itself.
+func (c *FwEth) UpdateLinkHistory(h *LinkHistory, names ...string) error {
+	for _, name := range names {
+		hw, err := c.ShowHardware(name)
+		if err != nil {
+			return err
+		}
+		h.Record(hw, time.Now())
+	}
+
+	return nil
+}
+
+// LinkTransition is a change in the link state of an interface.
+type LinkTransition struct {
+	Interface string
+	Time      time.Time
+	From      string
+	To        string
+}
+
+// LinkHistory is the link state history of a set of interfaces, built from
+// repeated observations of their state.
+//
+// A LinkHistory is safe for concurrent use.  The zero value is ready to use.
+type LinkHistory struct {
+	// MaxTransitions is the number of transitions kept per interface.  If
+	// this is 0 or less, then all transitions are kept.
+	MaxTransitions int
+
+	mu          sync.Mutex
+	states      map[string]string
+	transitions map[string][]LinkTransition
+}
+
+// Record records the given observation of an interface's state, returning
+// true if the link state changed since the previous observation.
+//
+// The first observation of an interface is not a transition.
+func (h *LinkHistory) Record(hw Hardware, t time.Time) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.states == nil {
+		h.states = make(map[string]string)
+		h.transitions = make(map[string][]LinkTransition)
+	}
+
+	prev, ok := h.states[hw.Name]
+	h.states[hw.Name] = hw.State
+	if !ok || prev == hw.State {
+		return false
+	}
+
+	list := append(h.transitions[hw.Name], LinkTransition{
+		Interface: hw.Name,
+		Time:      t,
+		From:      prev,
+		To:        hw.State,
+	})
+	if h.MaxTransitions > 0 && len(list) > h.MaxTransitions {
+		list = list[len(list)-h.MaxTransitions:]
+	}
+	h.transitions[hw.Name] = list
+
+	return true
+}
+
+// State returns the last observed link state of the given interface.
+func (h *LinkHistory) State(name string) string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.states[name]
+}
+
+// Transitions returns the recorded link state transitions of the given
+// interface, oldest first.
+func (h *LinkHistory) Transitions(name string) []LinkTransition {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	list := h.transitions[name]
+	ans := make([]LinkTransition, len(list))
+	copy(ans, list)
+	return ans
+}
+
+// Flaps returns the number of times the given interface's link went down
+// since the given time.
+func (h *LinkHistory) Flaps(name string, since time.Time) int {
+	var ans int
+	for _, x := range h.Transitions(name) {
+		if x.To == "down" && !x.Time.Before(since) {
+			ans++
+		}
+	}
+
+	return ans
+}
+
+/** Structs for the op commands. **/
+
+type hwReq struct {
+	XMLName xml.Name `xml:"show"`
+	Name    string   `xml:"interface"`
+}
+
+type hwResp struct {
+	Hw       hwInfo       `xml:"result>hw"`
+	Ifnet    hwIfnet      `xml:"result>ifnet"`
+	Counters hwIfCounters `xml:"result>counters>ifnet>entry"`
+	HwCount  hwPortCount  `xml:"result>counters>hw>entry"`
+}
+
+type hwInfo struct {
+	Name             string `xml:"name"`
+	Id               string `xml:"id"`
+	Mac              string `xml:"mac"`
+	State            string `xml:"state"`
+	Speed            string `xml:"speed"`
+	Duplex           string `xml:"duplex"`
+	Mode             string `xml:"mode"`
+	Mtu              int    `xml:"mtu"`
+	ConfiguredState  string `xml:"state_c"`
+	ConfiguredSpeed  string `xml:"speed_c"`
+	ConfiguredDuplex string `xml:"duplex_c"`
+	AggregateGroup   string `xml:"ae_member"`
+}
+
+type hwIfnet struct {
+	Zone          string   `xml:"zone"`
+	VirtualRouter string   `xml:"vr"`
+	Vsys          string   `xml:"vsys"`
+	Addresses     []string `xml:"addr>member"`
+}
+
+type hwIfCounters struct {
+	PacketsReceived uint64 `xml:"ipackets"`
+	PacketsSent     uint64 `xml:"opackets"`
+	ErrorsReceived  uint64 `xml:"ierrors"`
+	DropsReceived   uint64 `xml:"idrops"`
+}
+
+type hwPortCount struct {
+	BytesReceived    uint64 `xml:"ibytes"`
+	BytesTransmitted uint64 `xml:"obytes"`
+}
+
+func (o hwResp) normalize() Hardware {
+	return Hardware{
+		Name:             o.Hw.Name,
+		Id:               o.Hw.Id,
+		Mac:              o.Hw.Mac,
+		State:            o.Hw.State,
+		Speed:            o.Hw.Speed,
+		Duplex:           o.Hw.Duplex,
+		Mode:             o.Hw.Mode,
+		Mtu:              o.Hw.Mtu,
+		ConfiguredState:  o.Hw.ConfiguredState,
+		ConfiguredSpeed:  o.Hw.ConfiguredSpeed,
+		ConfiguredDuplex: o.Hw.ConfiguredDuplex,
+		AggregateGroup:   o.Hw.AggregateGroup,
+		Zone:             o.Ifnet.Zone,
+		VirtualRouter:    o.Ifnet.VirtualRouter,
+		Vsys:             o.Ifnet.Vsys,
+		Addresses:        o.Ifnet.Addresses,
+		PacketsReceived:  o.Counters.PacketsReceived,
+		PacketsSent:      o.Counters.PacketsSent,
+		ErrorsReceived:   o.Counters.ErrorsReceived,
+		DropsReceived:    o.Counters.DropsReceived,
+		BytesReceived:    o.HwCount.BytesReceived,
+		BytesTransmitted: o.HwCount.BytesTransmitted,
+	}
+}
+
+type xcvrReq struct {
+	XMLName xml.Name `xml:"show"`
+	Name    string   `xml:"transceiver-detail"`
+}
+
+type xcvrResp struct {
+	Entry xcvrEntry `xml:"result>entry"`
+}
+
+type xcvrEntry struct {
+	Type         string `xml:"type"`
+	Vendor       string `xml:"vendor-name"`
+	PartNumber   string `xml:"vendor-part-number"`
+	SerialNumber string `xml:"vendor-serial-number"`
+	Wavelength   string `xml:"wavelength"`
+	Temperature  string `xml:"temperature"`
+	Voltage      string `xml:"voltage"`
+	TxBias       string `xml:"tx-bias"`
+	TxPower      string `xml:"tx-power"`
+	RxPower      string `xml:"rx-power"`
+}
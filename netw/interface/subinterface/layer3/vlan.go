@@ -0,0 +1,85 @@
+package layer3
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Valid VLAN tags.
+const (
+	MinVlanTag = 1
+	MaxVlanTag = 4094
+)
+
+// VlanRange describes a contiguous range of VLAN tagged subinterfaces
+// beneath a single ethernet or aggregate ethernet interface.
+//
+// Each subinterface is named after its VLAN tag (such as "ethernet1/3.100").
+// StaticIps are templates for the static IPs of each subinterface, in which
+// the following placeholders are replaced:
+//
+//      * {vlan}: the VLAN tag (such as "10.{vlan}.0.1/24")
+//      * {hi}: the VLAN tag divided by 256
+//      * {lo}: the VLAN tag modulo 256 (such as "10.{hi}.{lo}.1/24")
+//
+// All other settings are copied from Template.
+type VlanRange struct {
+	Parent    string
+	First     int
+	Last      int
+	StaticIps []string
+	Template  Entry
+}
+
+// InterfaceType returns the iType of the parent interface.
+func (o VlanRange) InterfaceType() (string, error) {
+	p, err := util.ParseInterfaceName(o.Parent)
+	if err != nil {
+		return "", err
+	} else if p.Unit != 0 {
+		return "", fmt.Errorf("%s: parent interface has a unit number", o.Parent)
+	}
+
+	switch p.Type {
+	case util.EthernetInterface:
+		return EthernetInterface, nil
+	case util.AggregateEthernetInterface:
+		return AggregateInterface, nil
+	}
+
+	return "", fmt.Errorf("%s: %s interfaces do not have layer3 subinterfaces", o.Parent, p.Type)
+}
+
+// Entries returns the subinterfaces of this range.
+func (o VlanRange) Entries() ([]Entry, error) {
+	if _, err := o.InterfaceType(); err != nil {
+		return nil, err
+	} else if o.First < MinVlanTag || o.Last > MaxVlanTag || o.First > o.Last {
+		return nil, fmt.Errorf("invalid VLAN range %d-%d: tags must be between %d and %d", o.First, o.Last, MinVlanTag, MaxVlanTag)
+	}
+
+	ans := make([]Entry, 0, o.Last-o.First+1)
+	for tag := o.First; tag <= o.Last; tag++ {
+		e := Entry{Name: fmt.Sprintf("%s.%d", o.Parent, tag)}
+		e.Copy(o.Template)
+		e.Tag = tag
+		e.StaticIps = nil
+		if len(o.StaticIps) > 0 {
+			r := strings.NewReplacer(
+				"{vlan}", strconv.Itoa(tag),
+				"{hi}", strconv.Itoa(tag/256),
+				"{lo}", strconv.Itoa(tag%256),
+			)
+			e.StaticIps = make([]string, 0, len(o.StaticIps))
+			for _, ip := range o.StaticIps {
+				e.StaticIps = append(e.StaticIps, r.Replace(ip))
+			}
+		}
+		ans = append(ans, e)
+	}
+
+	return ans, nil
+}
@@ -0,0 +1,55 @@
+package layer3
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestVlanRangeEntries(t *testing.T) {
+	r := VlanRange{
+		Parent:    "ae2",
+		First:     255,
+		Last:      257,
+		StaticIps: []string{"10.{hi}.{lo}.1/24", "fd00::{vlan}/64"},
+		Template:  Entry{Name: "ignored", Mtu: 1400, Comment: "bulk"},
+	}
+
+	if it, err := r.InterfaceType(); err != nil {
+		t.Fatalf("Error in interface type: %s", err)
+	} else if it != AggregateInterface {
+		t.Errorf("Interface type is %q", it)
+	}
+
+	list, err := r.Entries()
+	if err != nil {
+		t.Fatalf("Error in entries: %s", err)
+	}
+	if len(list) != 3 {
+		t.Fatalf("Got %d entries, not 3", len(list))
+	}
+
+	expected := Entry{
+		Name:      "ae2.256",
+		Tag:       256,
+		StaticIps: []string{"10.1.0.1/24", "fd00::256/64"},
+		Mtu:       1400,
+		Comment:   "bulk",
+	}
+	if !reflect.DeepEqual(list[1], expected) {
+		t.Errorf("%#v != %#v", list[1], expected)
+	}
+}
+
+func TestVlanRangeInvalid(t *testing.T) {
+	for _, r := range []VlanRange{
+		{Parent: "ethernet1/1", First: 0, Last: 10},
+		{Parent: "ethernet1/1", First: 10, Last: 4095},
+		{Parent: "ethernet1/1", First: 20, Last: 10},
+		{Parent: "ethernet1/1.5", First: 1, Last: 10},
+		{Parent: "tunnel", First: 1, Last: 10},
+	} {
+		if _, err := r.Entries(); err == nil {
+			t.Errorf("No error for %#v", r)
+		}
+	}
+}
@@ -76,6 +76,11 @@ func (c *PanoTunnel) ShowAll(tmpl, ts string) ([]Entry, error) {
 //
 // Specifying a non-empty vsys will import the interfaces into that vsys,
 // allowing the vsys to use them.
+//
+// If the client is skipping unchanged writes, then interfaces whose config
+// and vsys import already match are omitted.
+//
+// If any step fails, the error returned is a util.BulkSetError.
 func (c *PanoTunnel) Set(tmpl, ts, vsys string, e ...Entry) error {
 	var err error
 
@@ -87,6 +92,13 @@ func (c *PanoTunnel) Set(tmpl, ts, vsys string, e ...Entry) error {
 		return fmt.Errorf("vsys must be specified")
 	}
 
+	// Skip interfaces that are already configured as desired.
+	if e, err = c.changed(tmpl, ts, vsys, e); err != nil {
+		return err
+	} else if len(e) == 0 {
+		return nil
+	}
+
 	_, fn := c.versioning()
 	names := make([]string, len(e))
 
@@ -109,22 +121,29 @@ func (c *PanoTunnel) Set(tmpl, ts, vsys string, e ...Entry) error {
 	// Create the interfaces.
 	_, err = c.con.Set(path, d.Config(), nil, nil)
 	if err != nil {
-		return err
+		return util.BulkSetError{Phase: util.PhaseSet, Failed: names, Err: err, EntryErrors: d.EntryErrors(err)}
 	}
 
 	// Remove the interfaces from any vsys they're currently in.
 	if err = c.con.VsysUnimport(util.InterfaceImport, tmpl, ts, names); err != nil {
-		return err
+		return util.BulkSetError{Phase: util.PhaseUnimport, Created: names, Failed: names, Err: err}
 	}
 
 	// Perform vsys import next.
-	return c.con.VsysImport(util.InterfaceImport, tmpl, ts, vsys, names)
+	if err = c.con.VsysImport(util.InterfaceImport, tmpl, ts, vsys, names); err != nil {
+		return util.BulkSetError{Phase: util.PhaseImport, Created: names, Failed: names, Err: err}
+	}
+
+	return nil
 }
 
 // Edit performs EDIT to create / update the specified tunnel interface.
 //
 // Specifying a non-empty vsys will import the interface into that vsys,
 // allowing the vsys to use it.
+//
+// If the client is skipping unchanged writes and the interface is already
+// configured as desired, then nothing is done.
 func (c *PanoTunnel) Edit(tmpl, ts, vsys string, e Entry) error {
 	var err error
 
@@ -134,6 +153,11 @@ func (c *PanoTunnel) Edit(tmpl, ts, vsys string, e Entry) error {
 		return fmt.Errorf("vsys must be specified")
 	}
 
+	// Skip the interface if it is already configured as desired.
+	if list, err := c.changed(tmpl, ts, vsys, []Entry{e}); err != nil || len(list) == 0 {
+		return err
+	}
+
 	_, fn := c.versioning()
 
 	c.con.LogAction("(edit) %s: %q", singular, e.Name)
@@ -192,8 +216,99 @@ func (c *PanoTunnel) Delete(tmpl, ts string, e ...interface{}) error {
 	return err
 }
 
+// SetWithRollback performs Set, but if a step after the initial SET fails,
+// then the interfaces are restored to the config they had before this
+// function was invoked:  interfaces that did not exist are deleted, and
+// pre-existing interfaces have their previous config and vsys import
+// restored.
+//
+// The error returned is the util.BulkSetError from Set, with either
+// RolledBack or RollbackErr updated accordingly.
+func (c *PanoTunnel) SetWithRollback(tmpl, ts, vsys string, e ...Entry) error {
+	if len(e) == 0 {
+		return nil
+	}
+
+	names := make([]string, len(e))
+	for i := range e {
+		names[i] = e[i].Name
+	}
+
+	// Save the current config and vsys import of these interfaces.
+	result, fn := c.versioning()
+	if err := c.ns.Existing(util.Get, c.xpath(tmpl, ts, names), result); err != nil {
+		return err
+	}
+	prev := make(map[string]interface{})
+	for _, x := range result.Normalize() {
+		prev[x.Name] = fn(x)
+	}
+	imported, err := util.ImportedVsys(c.con, util.InterfaceImport, tmpl, ts, names)
+	if err != nil {
+		return err
+	}
+
+	err = c.Set(tmpl, ts, vsys, e...)
+	be, ok := err.(util.BulkSetError)
+	if !ok || be.Phase == util.PhaseSet {
+		return err
+	}
+
+	be.RollbackErr = c.ns.Restore(names, prev, func(v []string) []string {
+		return c.xpath(tmpl, ts, v)
+	})
+	if be.RollbackErr == nil {
+		be.RollbackErr = util.RestoreImports(c.con, util.InterfaceImport, tmpl, ts, imported)
+	}
+	be.RolledBack = be.RollbackErr == nil
+
+	return be
+}
+
 /** Internal functions for this namespace struct **/
 
+// changed returns the given interfaces whose config or vsys import differs
+// from what is currently configured.  If the client is not skipping unchanged
+// writes, then all interfaces are returned as-is.
+func (c *PanoTunnel) changed(tmpl, ts, vsys string, e []Entry) ([]Entry, error) {
+	if !util.SkipUnchangedWrites(c.con) {
+		return e, nil
+	}
+
+	names := make([]string, len(e))
+	for i := range e {
+		names[i] = e[i].Name
+	}
+
+	result, fn := c.versioning()
+	if err := c.ns.Existing(util.Get, c.xpath(tmpl, ts, names), result); err != nil {
+		return nil, err
+	}
+	cur := make(map[string]interface{})
+	for _, x := range result.Normalize() {
+		cur[x.Name] = fn(x)
+	}
+
+	ans := make([]Entry, 0, len(e))
+	for i := range e {
+		if prev, ok := cur[e[i].Name]; ok && namespace.SameConfig(prev, fn(e[i])) {
+			ok, err := util.IsImported(c.con, util.InterfaceImport, tmpl, ts, vsys, e[i].Name)
+			if err != nil {
+				return nil, err
+			} else if ok {
+				continue
+			}
+		}
+		ans = append(ans, e[i])
+	}
+
+	if len(ans) < len(e) {
+		c.con.LogAction("(skip) %d unchanged %s", len(e)-len(ans), plural)
+	}
+
+	return ans, nil
+}
+
 func (c *PanoTunnel) versioning() (normalizer, func(Entry) interface{}) {
 	return &container_v1{}, specify_v1
 }
@@ -12,7 +12,7 @@ type Entry struct {
 	Name                   string
 	Comment                string
 	NetflowProfile         string
-	StaticIps              []string // ordered
+	StaticIps              []string // ordered, may contain template variables
 	EnableDhcp             bool
 	CreateDhcpDefaultRoute bool
 	DhcpDefaultRouteMetric int
@@ -41,6 +41,27 @@ func (o *Entry) Copy(s Entry) {
 	o.Ipv6MssAdjust = s.Ipv6MssAdjust
 }
 
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// Variables returns the template variables referenced by this interface.
+func (o Entry) Variables() []string {
+	return util.TemplateVariables(o.StaticIps...)
+}
+
+// ValidateStaticIps checks that the static IPs that look like IP addresses
+// are valid.  Template variables and address object names are skipped.
+func (o Entry) ValidateStaticIps() error {
+	return util.ValidateIps(o.StaticIps)
+}
+
 /** Structs / functions for this namespace. **/
 
 type normalizer interface {
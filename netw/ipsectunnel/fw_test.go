@@ -709,3 +709,24 @@ func TestFwNormalization(t *testing.T) {
 		})
 	}
 }
+
+func TestFwRestart(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &FwIpsecTunnel{}
+	ns.Initialize(mc)
+	mc.AddResp("")
+
+	if err := ns.Restart("t1"); err != nil {
+		t.Fatalf("Error in restart: %s", err)
+	}
+	if mc.Elm != "<test><vpn><ipsec-sa><tunnel>t1</tunnel></ipsec-sa></vpn></test>" {
+		t.Errorf("Last op is %s", mc.Elm)
+	}
+
+	if err := ns.ClearSa(""); err != nil {
+		t.Fatalf("Error in clear: %s", err)
+	}
+	if mc.Elm != "<clear><vpn><ipsec-sa></ipsec-sa></vpn></clear>" {
+		t.Errorf("Clear all op is %s", mc.Elm)
+	}
+}
@@ -0,0 +1,47 @@
+package ipsectunnel
+
+import (
+	"encoding/xml"
+)
+
+// ClearSa clears the IPSec SAs of the given IPSec tunnel.
+//
+// If name is an empty string, then the IPSec SAs of all tunnels are cleared.
+func (c *FwIpsecTunnel) ClearSa(name string) error {
+	c.con.LogOp("(op) clearing ipsec-sa for tunnel %q", name)
+	_, err := c.con.Op(saReq{XMLName: xml.Name{Local: "clear"}, Sa: sa{Tunnel: name}}, "", nil, nil)
+	return err
+}
+
+// Negotiate triggers IPSec negotiation for the given IPSec tunnel.
+//
+// If name is an empty string, then negotiation is triggered for all tunnels.
+func (c *FwIpsecTunnel) Negotiate(name string) error {
+	c.con.LogOp("(op) testing ipsec-sa for tunnel %q", name)
+	_, err := c.con.Op(saReq{XMLName: xml.Name{Local: "test"}, Sa: sa{Tunnel: name}}, "", nil, nil)
+	return err
+}
+
+// Restart clears the IPSec SAs of the given IPSec tunnel, then triggers
+// renegotiation of the tunnel.
+//
+// Only the IPSec (phase 2) SAs are cleared.  To also renegotiate IKE (phase 1),
+// restart the tunnel's IKE gateway first.
+func (c *FwIpsecTunnel) Restart(name string) error {
+	if err := c.ClearSa(name); err != nil {
+		return err
+	}
+
+	return c.Negotiate(name)
+}
+
+/** Structs for the op commands. **/
+
+type saReq struct {
+	XMLName xml.Name
+	Sa      sa `xml:"vpn>ipsec-sa"`
+}
+
+type sa struct {
+	Tunnel string `xml:"tunnel,omitempty"`
+}
@@ -2,6 +2,8 @@ package ipv4
 
 import (
 	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
 )
 
 // Entry is a normalized, version independent representation of an interface
@@ -31,6 +33,16 @@ func (o *Entry) Copy(s Entry) {
 	o.ProtocolUdpRemote = s.ProtocolUdpRemote
 }
 
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
 /** Structs / functions for this namespace. **/
 
 type normalizer interface {
@@ -42,23 +54,40 @@ type container_v1 struct {
 }
 
 func (o *container_v1) Normalize() Entry {
+	return o.Answer.normalize()
+}
+
+type list_v1 struct {
+	Answer []entry_v1 `xml:"result>entry"`
+}
+
+func (o *list_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o entry_v1) normalize() Entry {
 	ans := Entry{
-		Name:   o.Answer.Name,
-		Local:  o.Answer.Local,
-		Remote: o.Answer.Remote,
+		Name:   o.Name,
+		Local:  o.Local,
+		Remote: o.Remote,
 	}
 
-	if o.Answer.Protocol != nil {
-		if o.Answer.Protocol.Any != nil {
+	if o.Protocol != nil {
+		if o.Protocol.Any != nil {
 			ans.ProtocolAny = true
-		} else if o.Answer.Protocol.Number != 0 {
-			ans.ProtocolNumber = o.Answer.Protocol.Number
-		} else if o.Answer.Protocol.Tcp != nil {
-			ans.ProtocolTcpLocal = o.Answer.Protocol.Tcp.Local
-			ans.ProtocolTcpRemote = o.Answer.Protocol.Tcp.Remote
-		} else if o.Answer.Protocol.Udp != nil {
-			ans.ProtocolUdpLocal = o.Answer.Protocol.Udp.Local
-			ans.ProtocolUdpRemote = o.Answer.Protocol.Udp.Remote
+		} else if o.Protocol.Number != 0 {
+			ans.ProtocolNumber = o.Protocol.Number
+		} else if o.Protocol.Tcp != nil {
+			ans.ProtocolTcpLocal = o.Protocol.Tcp.Local
+			ans.ProtocolTcpRemote = o.Protocol.Tcp.Remote
+		} else if o.Protocol.Udp != nil {
+			ans.ProtocolUdpLocal = o.Protocol.Udp.Local
+			ans.ProtocolUdpRemote = o.Protocol.Udp.Remote
 		}
 	}
 
@@ -43,6 +43,18 @@ func (c *FwIpv4) Show(tun, name string) (Entry, error) {
 	return c.details(c.con.Show, tun, name)
 }
 
+// GetAll performs GET to retrieve all proxy IDs of the given IPSec tunnel.
+func (c *FwIpv4) GetAll(tun string) ([]Entry, error) {
+	c.con.LogQuery("(get) ipsec tunnel proxy ids")
+	return c.all(c.con.Get, tun)
+}
+
+// ShowAll performs SHOW to retrieve all proxy IDs of the given IPSec tunnel.
+func (c *FwIpv4) ShowAll(tun string) ([]Entry, error) {
+	c.con.LogQuery("(show) ipsec tunnel proxy ids")
+	return c.all(c.con.Show, tun)
+}
+
 // Set performs SET to create / update one or more IPSec tunnel proxy IDs.
 func (c *FwIpv4) Set(tun string, e ...Entry) error {
 	var err error
@@ -127,6 +139,30 @@ func (c *FwIpv4) Delete(tun string, e ...interface{}) error {
 	return err
 }
 
+// Sync makes the proxy IDs of the given IPSec tunnel match the given
+// proxy IDs.
+//
+// Rather than replacing the whole list, only the proxy IDs that are new or
+// have changed are set, and only the proxy IDs that are no longer wanted are
+// deleted.  Unchanged proxy IDs are not touched, preserving their SAs.
+func (c *FwIpv4) Sync(tun string, e ...Entry) error {
+	current, err := c.GetAll(tun)
+	if err != nil {
+		return err
+	}
+
+	set, remove := Diff(current, e)
+	names := make([]interface{}, 0, len(remove))
+	for _, name := range remove {
+		names = append(names, name)
+	}
+	if err = c.Delete(tun, names...); err != nil {
+		return err
+	}
+
+	return c.Set(tun, set...)
+}
+
 /** Internal functions for this namespace struct **/
 
 func (c *FwIpv4) versioning() (normalizer, func(Entry) interface{}) {
@@ -145,6 +181,19 @@ func (c *FwIpv4) details(fn util.Retriever, tun, name string) (Entry, error) {
 	return ans, nil
 }
 
+func (c *FwIpv4) all(fn util.Retriever, tun string) ([]Entry, error) {
+	path := c.xpath(tun, nil)
+	obj := &list_v1{}
+	if _, err := fn(path, nil, obj); err != nil {
+		if err.Error() == "No such node" || err.Error() == "Object not found" {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return obj.Normalize(), nil
+}
+
 func (c *FwIpv4) xpath(tun string, vals []string) []string {
 	return []string{
 		"config",
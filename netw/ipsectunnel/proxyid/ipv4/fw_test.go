@@ -62,3 +62,53 @@ func TestFwNormalization(t *testing.T) {
 		})
 	}
 }
+
+func TestDiff(t *testing.T) {
+	current := []Entry{
+		{Name: "keep", Local: "10.1.0.0/16", Remote: "10.2.0.0/16", ProtocolAny: true},
+		{Name: "change", Local: "10.3.0.0/16", Remote: "10.4.0.0/16", ProtocolAny: true},
+		{Name: "remove", Local: "10.5.0.0/16", Remote: "10.6.0.0/16", ProtocolAny: true},
+	}
+	desired := []Entry{
+		// TCP ports are ignored when ProtocolAny is set, so this is unchanged.
+		{Name: "keep", Local: "10.1.0.0/16", Remote: "10.2.0.0/16", ProtocolAny: true, ProtocolTcpLocal: 22},
+		{Name: "change", Local: "10.3.0.0/16", Remote: "10.40.0.0/16", ProtocolAny: true},
+		{Name: "add", Local: "10.7.0.0/16", Remote: "10.8.0.0/16", ProtocolNumber: 47},
+	}
+
+	set, remove := Diff(current, desired)
+	if !reflect.DeepEqual(set, desired[1:]) {
+		t.Errorf("Set: %#v", set)
+	}
+	if !reflect.DeepEqual(remove, []string{"remove"}) {
+		t.Errorf("Remove: %#v", remove)
+	}
+}
+
+func TestFwSync(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &FwIpv4{}
+	ns.Initialize(mc)
+
+	mc.AddResp(`<entry name="keep"><local>10.1.0.0/16</local><remote>10.2.0.0/16</remote><protocol><any/></protocol></entry><entry name="remove"><local>10.5.0.0/16</local><remote>10.6.0.0/16</remote><protocol><any/></protocol></entry>`)
+	mc.AddResp("")
+	mc.AddResp("")
+
+	err := ns.Sync("tun1",
+		Entry{Name: "keep", Local: "10.1.0.0/16", Remote: "10.2.0.0/16", ProtocolAny: true},
+		Entry{Name: "add", Local: "10.7.0.0/16", Remote: "10.8.0.0/16", ProtocolAny: true},
+	)
+	if err != nil {
+		t.Fatalf("Error in sync: %s", err)
+	}
+
+	if mc.Called != 3 {
+		t.Errorf("Made %d calls, not 3", mc.Called)
+	}
+	if mc.Function != "set" {
+		t.Fatalf("Last function is %q", mc.Function)
+	}
+	if mc.Elm != `<entry name="add"><local>10.7.0.0/16</local><remote>10.8.0.0/16</remote><protocol><any></any></protocol></entry>` {
+		t.Errorf("Set element is %s", mc.Elm)
+	}
+}
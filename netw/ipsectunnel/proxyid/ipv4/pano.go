@@ -43,6 +43,18 @@ func (c *PanoIpv4) Show(tmpl, ts, tun, name string) (Entry, error) {
 	return c.details(c.con.Show, tmpl, ts, tun, name)
 }
 
+// GetAll performs GET to retrieve all proxy IDs of the given IPSec tunnel.
+func (c *PanoIpv4) GetAll(tmpl, ts, tun string) ([]Entry, error) {
+	c.con.LogQuery("(get) ipsec tunnel proxy ids")
+	return c.all(c.con.Get, tmpl, ts, tun)
+}
+
+// ShowAll performs SHOW to retrieve all proxy IDs of the given IPSec tunnel.
+func (c *PanoIpv4) ShowAll(tmpl, ts, tun string) ([]Entry, error) {
+	c.con.LogQuery("(show) ipsec tunnel proxy ids")
+	return c.all(c.con.Show, tmpl, ts, tun)
+}
+
 // Set performs SET to create / update one or more IPSec tunnel proxy IDs.
 func (c *PanoIpv4) Set(tmpl, ts, tun string, e ...Entry) error {
 	var err error
@@ -133,6 +145,30 @@ func (c *PanoIpv4) Delete(tmpl, ts, tun string, e ...interface{}) error {
 	return err
 }
 
+// Sync makes the proxy IDs of the given IPSec tunnel match the given
+// proxy IDs.
+//
+// Rather than replacing the whole list, only the proxy IDs that are new or
+// have changed are set, and only the proxy IDs that are no longer wanted are
+// deleted.  Unchanged proxy IDs are not touched, preserving their SAs.
+func (c *PanoIpv4) Sync(tmpl, ts, tun string, e ...Entry) error {
+	current, err := c.GetAll(tmpl, ts, tun)
+	if err != nil {
+		return err
+	}
+
+	set, remove := Diff(current, e)
+	names := make([]interface{}, 0, len(remove))
+	for _, name := range remove {
+		names = append(names, name)
+	}
+	if err = c.Delete(tmpl, ts, tun, names...); err != nil {
+		return err
+	}
+
+	return c.Set(tmpl, ts, tun, set...)
+}
+
 /** Internal functions for this namespace struct **/
 
 func (c *PanoIpv4) versioning() (normalizer, func(Entry) interface{}) {
@@ -151,6 +187,19 @@ func (c *PanoIpv4) details(fn util.Retriever, tmpl, ts, tun, name string) (Entry
 	return ans, nil
 }
 
+func (c *PanoIpv4) all(fn util.Retriever, tmpl, ts, tun string) ([]Entry, error) {
+	path := c.xpath(tmpl, ts, tun, nil)
+	obj := &list_v1{}
+	if _, err := fn(path, nil, obj); err != nil {
+		if err.Error() == "No such node" || err.Error() == "Object not found" {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return obj.Normalize(), nil
+}
+
 func (c *PanoIpv4) xpath(tmpl, ts, tun string, vals []string) []string {
 	ans := make([]string, 0, 15)
 	ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
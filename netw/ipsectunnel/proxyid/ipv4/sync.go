@@ -0,0 +1,44 @@
+package ipv4
+
+import (
+	"reflect"
+)
+
+// Diff compares the current proxy IDs of an IPSec tunnel against the desired
+// proxy IDs, returning the proxy IDs that need to be set (because they are
+// new or have changed) and the names of the proxy IDs that need to be
+// deleted.
+//
+// Proxy IDs that are unchanged are in neither list, so their SAs are left
+// alone.
+func Diff(current, desired []Entry) ([]Entry, []string) {
+	existing := make(map[string]Entry, len(current))
+	for _, e := range current {
+		existing[e.Name] = e.normalized()
+	}
+
+	set := make([]Entry, 0, len(desired))
+	wanted := make(map[string]bool, len(desired))
+	for _, e := range desired {
+		wanted[e.Name] = true
+		if cur, ok := existing[e.Name]; !ok || !reflect.DeepEqual(cur, e.normalized()) {
+			set = append(set, e)
+		}
+	}
+
+	remove := make([]string, 0)
+	for _, e := range current {
+		if !wanted[e.Name] {
+			remove = append(remove, e.Name)
+		}
+	}
+
+	return set, remove
+}
+
+// normalized returns this proxy ID as it would be read back from PAN-OS, so
+// that settings that are not sent (such as TCP ports when ProtocolAny is set)
+// do not count as differences.
+func (o Entry) normalized() Entry {
+	return specify_v1(o).(entry_v1).normalize()
+}
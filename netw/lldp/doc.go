@@ -0,0 +1,12 @@
+/*
+Package lldp is the client.Network.Lldp namespace.
+
+This namespace manages the global LLDP setting.  LLDP profiles are managed
+with the client.Network.LldpProfile namespace.
+
+For Panorama, specify either the template ("tmpl") or the template stack
+("ts").
+
+Normalized object:  Settings
+*/
+package lldp
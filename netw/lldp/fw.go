@@ -0,0 +1,79 @@
+package lldp
+
+import (
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwLldp is a namespace struct, included as part of pango.Firewall.
+type FwLldp struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *FwLldp) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// Show performs SHOW to retrieve the global LLDP settings.
+func (c *FwLldp) Show() (Settings, error) {
+	c.con.LogQuery("(show) lldp settings")
+	return c.details(c.con.Show)
+}
+
+// Get performs GET to retrieve the global LLDP settings.
+func (c *FwLldp) Get() (Settings, error) {
+	c.con.LogQuery("(get) lldp settings")
+	return c.details(c.con.Get)
+}
+
+// Set performs SET to update the global LLDP settings.
+func (c *FwLldp) Set(e Settings) error {
+	var err error
+	_, fn := c.versioning()
+	c.con.LogAction("(set) lldp settings")
+
+	path := c.xpath()
+	path = path[:len(path)-1]
+
+	_, err = c.con.Set(path, fn(e), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to update the global LLDP settings.
+func (c *FwLldp) Edit(e Settings) error {
+	var err error
+	_, fn := c.versioning()
+	c.con.LogAction("(edit) lldp settings")
+
+	path := c.xpath()
+
+	_, err = c.con.Edit(path, fn(e), nil, nil)
+	return err
+}
+
+/** Internal functions for the FwLldp struct **/
+
+func (c *FwLldp) versioning() (normalizer, func(Settings) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *FwLldp) details(fn util.Retriever) (Settings, error) {
+	path := c.xpath()
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Settings{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *FwLldp) xpath() []string {
+	return []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"network",
+		"lldp",
+	}
+}
@@ -0,0 +1,99 @@
+package lldp
+
+import (
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// PanoLldp is a namespace struct, included as part of pango.Panorama.
+type PanoLldp struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *PanoLldp) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// Show performs SHOW to retrieve the global LLDP settings.
+func (c *PanoLldp) Show(tmpl, ts string) (Settings, error) {
+	c.con.LogQuery("(show) lldp settings")
+	return c.details(c.con.Show, tmpl, ts)
+}
+
+// Get performs GET to retrieve the global LLDP settings.
+func (c *PanoLldp) Get(tmpl, ts string) (Settings, error) {
+	c.con.LogQuery("(get) lldp settings")
+	return c.details(c.con.Get, tmpl, ts)
+}
+
+// Set performs SET to update the global LLDP settings.
+func (c *PanoLldp) Set(tmpl, ts string, e Settings) error {
+	var err error
+
+	if tmpl == "" && ts == "" {
+		return fmt.Errorf("tmpl or ts must be specified")
+	}
+
+	_, fn := c.versioning()
+	c.con.LogAction("(set) lldp settings")
+
+	path := c.xpath(tmpl, ts)
+	path = path[:len(path)-1]
+
+	_, err = c.con.Set(path, fn(e), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to update the global LLDP settings.
+func (c *PanoLldp) Edit(tmpl, ts string, e Settings) error {
+	var err error
+
+	if tmpl == "" && ts == "" {
+		return fmt.Errorf("tmpl or ts must be specified")
+	}
+
+	_, fn := c.versioning()
+	c.con.LogAction("(edit) lldp settings")
+
+	path := c.xpath(tmpl, ts)
+
+	_, err = c.con.Edit(path, fn(e), nil, nil)
+	return err
+}
+
+/** Internal functions for the PanoLldp struct **/
+
+func (c *PanoLldp) versioning() (normalizer, func(Settings) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *PanoLldp) details(fn util.Retriever, tmpl, ts string) (Settings, error) {
+	if tmpl == "" && ts == "" {
+		return Settings{}, fmt.Errorf("tmpl or ts must be specified")
+	}
+
+	path := c.xpath(tmpl, ts)
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Settings{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *PanoLldp) xpath(tmpl, ts string) []string {
+	ans := make([]string, 0, 10)
+	ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"network",
+		"lldp",
+	)
+
+	return ans
+}
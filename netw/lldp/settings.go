@@ -0,0 +1,49 @@
+package lldp
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Settings is a normalized, version independent representation of the global
+// LLDP configuration.
+type Settings struct {
+	Enabled bool
+}
+
+// Copy copies the information from source Settings `s` to this object.
+func (o *Settings) Copy(s Settings) {
+	o.Enabled = s.Enabled
+}
+
+/** Structs / functions for normalization. **/
+
+type normalizer interface {
+	Normalize() Settings
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>lldp"`
+}
+
+func (o *container_v1) Normalize() Settings {
+	ans := Settings{
+		Enabled: util.AsBool(o.Answer.Enabled),
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName xml.Name `xml:"lldp"`
+	Enabled string   `xml:"enable"`
+}
+
+func specify_v1(e Settings) interface{} {
+	ans := entry_v1{
+		Enabled: util.YesNo(e.Enabled),
+	}
+
+	return ans
+}
@@ -1,7 +1,14 @@
 package netw
 
 import (
+	"github.com/PaloAltoNetworks/pango/netw/dhcp"
+	gpagent "github.com/PaloAltoNetworks/pango/netw/globalprotect/agent"
+	gpclient "github.com/PaloAltoNetworks/pango/netw/globalprotect/client"
+	gpclientless "github.com/PaloAltoNetworks/pango/netw/globalprotect/clientless"
+	gpclientlessapp "github.com/PaloAltoNetworks/pango/netw/globalprotect/clientless/app"
+	gpclientlessappgroup "github.com/PaloAltoNetworks/pango/netw/globalprotect/clientless/appgroup"
 	"github.com/PaloAltoNetworks/pango/netw/ikegw"
+	"github.com/PaloAltoNetworks/pango/netw/imports"
 	aggeth "github.com/PaloAltoNetworks/pango/netw/interface/aggregate"
 	"github.com/PaloAltoNetworks/pango/netw/interface/arp"
 	"github.com/PaloAltoNetworks/pango/netw/interface/eth"
@@ -12,11 +19,16 @@ import (
 	vli "github.com/PaloAltoNetworks/pango/netw/interface/vlan"
 	"github.com/PaloAltoNetworks/pango/netw/ipsectunnel"
 	tpiv4 "github.com/PaloAltoNetworks/pango/netw/ipsectunnel/proxyid/ipv4"
+	"github.com/PaloAltoNetworks/pango/netw/lldp"
 	"github.com/PaloAltoNetworks/pango/netw/profile/bfd"
 	"github.com/PaloAltoNetworks/pango/netw/profile/ike"
 	"github.com/PaloAltoNetworks/pango/netw/profile/ipsec"
+	lldpprof "github.com/PaloAltoNetworks/pango/netw/profile/lldp"
 	"github.com/PaloAltoNetworks/pango/netw/profile/mngtprof"
 	"github.com/PaloAltoNetworks/pango/netw/profile/monitor"
+	qosprof "github.com/PaloAltoNetworks/pango/netw/profile/qos"
+	sdwanprof "github.com/PaloAltoNetworks/pango/netw/profile/sdwan"
+	qosiface "github.com/PaloAltoNetworks/pango/netw/qos/iface"
 	redist4 "github.com/PaloAltoNetworks/pango/netw/routing/profile/redist/ipv4"
 	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp"
 	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/aggregate"
@@ -32,6 +44,14 @@ import (
 	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/profile/auth"
 	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/profile/dampening"
 	bgpredist "github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/redist"
+	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/multicast"
+	mcifgroup "github.com/PaloAltoNetworks/pango/netw/routing/protocol/multicast/ifgroup"
+	mcrp "github.com/PaloAltoNetworks/pango/netw/routing/protocol/multicast/rp"
+	mcssm "github.com/PaloAltoNetworks/pango/netw/routing/protocol/multicast/ssm"
+	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/rip"
+	ripexp "github.com/PaloAltoNetworks/pango/netw/routing/protocol/rip/exp"
+	ripiface "github.com/PaloAltoNetworks/pango/netw/routing/protocol/rip/iface"
+	ripauth "github.com/PaloAltoNetworks/pango/netw/routing/protocol/rip/profile/auth"
 	"github.com/PaloAltoNetworks/pango/netw/routing/route/static/ipv4"
 	"github.com/PaloAltoNetworks/pango/netw/routing/router"
 	"github.com/PaloAltoNetworks/pango/netw/tunnel/gre"
@@ -42,42 +62,62 @@ import (
 
 // PanoNetw is the client.Network namespace.
 type PanoNetw struct {
-	AggregateInterface       *aggeth.PanoAggregate
-	Arp                      *arp.PanoArp
-	BfdProfile               *bfd.PanoBfd
-	BgpAggregate             *aggregate.PanoAggregate
-	BgpAggAdvertiseFilter    *agaf.PanoAdvertise
-	BgpAggSuppressFilter     *suppress.PanoSuppress
-	BgpAuthProfile           *auth.PanoAuth
-	BgpConAdvAdvertiseFilter *advertise.PanoAdvertise
-	BgpConAdvNonExistFilter  *nonexist.PanoNonExist
-	BgpConditionalAdv        *conadv.PanoConAdv
-	BgpConfig                *bgp.PanoBgp
-	BgpDampeningProfile      *dampening.PanoDampening
-	BgpExport                *exp.PanoExp
-	BgpImport                *imp.PanoImp
-	BgpPeer                  *peer.PanoPeer
-	BgpPeerGroup             *group.PanoGroup
-	BgpRedistRule            *bgpredist.PanoRedist
-	EthernetInterface        *eth.PanoEth
-	GreTunnel                *gre.PanoGre
-	IkeCryptoProfile         *ike.PanoIke
-	IkeGateway               *ikegw.PanoIkeGw
-	IpsecCryptoProfile       *ipsec.PanoIpsec
-	IpsecTunnel              *ipsectunnel.PanoIpsecTunnel
-	IpsecTunnelProxyId       *tpiv4.PanoIpv4
-	Layer2Subinterface       *layer2.PanoLayer2
-	Layer3Subinterface       *layer3.PanoLayer3
-	LoopbackInterface        *loopback.PanoLoopback
-	ManagementProfile        *mngtprof.PanoMngtProf
-	MonitorProfile           *monitor.PanoMonitor
-	RedistributionProfile    *redist4.PanoIpv4
-	StaticRoute              *ipv4.PanoIpv4
-	TunnelInterface          *tunnel.PanoTunnel
-	VirtualRouter            *router.PanoRouter
-	Vlan                     *vlan.PanoVlan
-	VlanInterface            *vli.PanoVlan
-	Zone                     *zone.PanoZone
+	AggregateInterface              *aggeth.PanoAggregate
+	Arp                             *arp.PanoArp
+	BfdProfile                      *bfd.PanoBfd
+	BgpAggregate                    *aggregate.PanoAggregate
+	BgpAggAdvertiseFilter           *agaf.PanoAdvertise
+	BgpAggSuppressFilter            *suppress.PanoSuppress
+	BgpAuthProfile                  *auth.PanoAuth
+	BgpConAdvAdvertiseFilter        *advertise.PanoAdvertise
+	BgpConAdvNonExistFilter         *nonexist.PanoNonExist
+	BgpConditionalAdv               *conadv.PanoConAdv
+	BgpConfig                       *bgp.PanoBgp
+	BgpDampeningProfile             *dampening.PanoDampening
+	BgpExport                       *exp.PanoExp
+	BgpImport                       *imp.PanoImp
+	BgpPeer                         *peer.PanoPeer
+	BgpPeerGroup                    *group.PanoGroup
+	BgpRedistRule                   *bgpredist.PanoRedist
+	Dhcp                            *dhcp.PanoDhcp
+	EthernetInterface               *eth.PanoEth
+	GlobalProtectAgent              *gpagent.PanoAgent
+	GlobalProtectClient             *gpclient.PanoClient
+	GlobalProtectClientless         *gpclientless.PanoClientless
+	GlobalProtectClientlessApp      *gpclientlessapp.PanoClientlessApp
+	GlobalProtectClientlessAppGroup *gpclientlessappgroup.PanoClientlessAppGroup
+	GreTunnel                       *gre.PanoGre
+	IkeCryptoProfile                *ike.PanoIke
+	IkeGateway                      *ikegw.PanoIkeGw
+	IpsecCryptoProfile              *ipsec.PanoIpsec
+	IpsecTunnel                     *ipsectunnel.PanoIpsecTunnel
+	IpsecTunnelProxyId              *tpiv4.PanoIpv4
+	Layer2Subinterface              *layer2.PanoLayer2
+	Layer3Subinterface              *layer3.PanoLayer3
+	Lldp                            *lldp.PanoLldp
+	LldpProfile                     *lldpprof.PanoLldp
+	LoopbackInterface               *loopback.PanoLoopback
+	ManagementProfile               *mngtprof.PanoMngtProf
+	MonitorProfile                  *monitor.PanoMonitor
+	MulticastConfig                 *multicast.PanoMulticast
+	MulticastExternalRp             *mcrp.PanoRp
+	MulticastInterfaceGroup         *mcifgroup.PanoIfGroup
+	MulticastSsmAddressSpace        *mcssm.PanoSsm
+	QosInterface                    *qosiface.PanoIface
+	QosProfile                      *qosprof.PanoQos
+	RedistributionProfile           *redist4.PanoIpv4
+	RipAuthProfile                  *ripauth.PanoAuth
+	RipConfig                       *rip.PanoRip
+	RipExport                       *ripexp.PanoExp
+	RipInterface                    *ripiface.PanoIface
+	SdwanInterfaceProfile           *sdwanprof.PanoSdwan
+	StaticRoute                     *ipv4.PanoIpv4
+	TunnelInterface                 *tunnel.PanoTunnel
+	VirtualRouter                   *router.PanoRouter
+	Vlan                            *vlan.PanoVlan
+	VlanInterface                   *vli.PanoVlan
+	VsysImport                      *imports.PanoImports
+	Zone                            *zone.PanoZone
 }
 
 // Initialize is invoked on client.Initialize().
@@ -133,9 +173,27 @@ func (c *PanoNetw) Initialize(i util.XapiClient) {
 	c.BgpRedistRule = &bgpredist.PanoRedist{}
 	c.BgpRedistRule.Initialize(i)
 
+	c.Dhcp = &dhcp.PanoDhcp{}
+	c.Dhcp.Initialize(i)
+
 	c.EthernetInterface = &eth.PanoEth{}
 	c.EthernetInterface.Initialize(i)
 
+	c.GlobalProtectAgent = &gpagent.PanoAgent{}
+	c.GlobalProtectAgent.Initialize(i)
+
+	c.GlobalProtectClient = &gpclient.PanoClient{}
+	c.GlobalProtectClient.Initialize(i)
+
+	c.GlobalProtectClientless = &gpclientless.PanoClientless{}
+	c.GlobalProtectClientless.Initialize(i)
+
+	c.GlobalProtectClientlessApp = &gpclientlessapp.PanoClientlessApp{}
+	c.GlobalProtectClientlessApp.Initialize(i)
+
+	c.GlobalProtectClientlessAppGroup = &gpclientlessappgroup.PanoClientlessAppGroup{}
+	c.GlobalProtectClientlessAppGroup.Initialize(i)
+
 	c.GreTunnel = &gre.PanoGre{}
 	c.GreTunnel.Initialize(i)
 
@@ -160,6 +218,12 @@ func (c *PanoNetw) Initialize(i util.XapiClient) {
 	c.Layer3Subinterface = &layer3.PanoLayer3{}
 	c.Layer3Subinterface.Initialize(i)
 
+	c.Lldp = &lldp.PanoLldp{}
+	c.Lldp.Initialize(i)
+
+	c.LldpProfile = &lldpprof.PanoLldp{}
+	c.LldpProfile.Initialize(i)
+
 	c.LoopbackInterface = &loopback.PanoLoopback{}
 	c.LoopbackInterface.Initialize(i)
 
@@ -169,9 +233,42 @@ func (c *PanoNetw) Initialize(i util.XapiClient) {
 	c.MonitorProfile = &monitor.PanoMonitor{}
 	c.MonitorProfile.Initialize(i)
 
+	c.MulticastConfig = &multicast.PanoMulticast{}
+	c.MulticastConfig.Initialize(i)
+
+	c.MulticastExternalRp = &mcrp.PanoRp{}
+	c.MulticastExternalRp.Initialize(i)
+
+	c.MulticastInterfaceGroup = &mcifgroup.PanoIfGroup{}
+	c.MulticastInterfaceGroup.Initialize(i)
+
+	c.MulticastSsmAddressSpace = &mcssm.PanoSsm{}
+	c.MulticastSsmAddressSpace.Initialize(i)
+
+	c.QosInterface = &qosiface.PanoIface{}
+	c.QosInterface.Initialize(i)
+
+	c.QosProfile = &qosprof.PanoQos{}
+	c.QosProfile.Initialize(i)
+
 	c.RedistributionProfile = &redist4.PanoIpv4{}
 	c.RedistributionProfile.Initialize(i)
 
+	c.RipAuthProfile = &ripauth.PanoAuth{}
+	c.RipAuthProfile.Initialize(i)
+
+	c.RipConfig = &rip.PanoRip{}
+	c.RipConfig.Initialize(i)
+
+	c.RipExport = &ripexp.PanoExp{}
+	c.RipExport.Initialize(i)
+
+	c.RipInterface = &ripiface.PanoIface{}
+	c.RipInterface.Initialize(i)
+
+	c.SdwanInterfaceProfile = &sdwanprof.PanoSdwan{}
+	c.SdwanInterfaceProfile.Initialize(i)
+
 	c.StaticRoute = &ipv4.PanoIpv4{}
 	c.StaticRoute.Initialize(i)
 
@@ -187,6 +284,9 @@ func (c *PanoNetw) Initialize(i util.XapiClient) {
 	c.VlanInterface = &vli.PanoVlan{}
 	c.VlanInterface.Initialize(i)
 
+	c.VsysImport = &imports.PanoImports{}
+	c.VsysImport.Initialize(i)
+
 	c.Zone = &zone.PanoZone{}
 	c.Zone.Initialize(i)
 }
@@ -63,6 +63,16 @@ func (o *Entry) Copy(s Entry) {
 	o.LifesizeValue = s.LifesizeValue
 }
 
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
 // SpecifyEncryption takes normalizes encryption values and changes them to the
 // version specific values PAN-OS will be expecting.
 //
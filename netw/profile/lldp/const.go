@@ -0,0 +1,13 @@
+package lldp
+
+// Valid values for Entry.Mode.
+const (
+	ModeTransmitReceive = "transmit-receive"
+	ModeTransmitOnly    = "transmit-only"
+	ModeReceiveOnly     = "receive-only"
+)
+
+const (
+	singular = "lldp profile"
+	plural   = "lldp profiles"
+)
@@ -0,0 +1,13 @@
+/*
+Package lldp is the client.Network.LldpProfile namespace.
+
+LLDP profiles are attached to layer2, virtual-wire, and aggregate interfaces
+using the interface's LldpProfile field.  LLDP must also be enabled globally
+using the client.Network.Lldp namespace.
+
+For Panorama, specify either the template ("tmpl") or the template stack
+("ts").
+
+Normalized object:  Entry
+*/
+package lldp
@@ -0,0 +1,208 @@
+package lldp
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an LLDP
+// profile.
+type Entry struct {
+	Name                     string
+	Mode                     string
+	SnmpSyslogNotification   bool
+	PortDescription          bool
+	SystemName               bool
+	SystemDescription        bool
+	SystemCapabilities       bool
+	ManagementAddressEnabled bool
+	ManagementAddresses      []ManagementAddress
+}
+
+// ManagementAddress is a management address advertised in the optional
+// management address TLV.  Specify either Ipv4 or Ipv6.
+type ManagementAddress struct {
+	Name      string
+	Interface string
+	Ipv4      string
+	Ipv6      string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Mode = s.Mode
+	o.SnmpSyslogNotification = s.SnmpSyslogNotification
+	o.PortDescription = s.PortDescription
+	o.SystemName = s.SystemName
+	o.SystemDescription = s.SystemDescription
+	o.SystemCapabilities = s.SystemCapabilities
+	o.ManagementAddressEnabled = s.ManagementAddressEnabled
+	if s.ManagementAddresses == nil {
+		o.ManagementAddresses = nil
+	} else {
+		o.ManagementAddresses = make([]ManagementAddress, len(s.ManagementAddresses))
+		copy(o.ManagementAddresses, s.ManagementAddresses)
+	}
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this LLDP profile.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:                   o.Name,
+		Mode:                   o.Mode,
+		SnmpSyslogNotification: util.AsBool(o.SnmpSyslogNotification),
+	}
+
+	if o.Tlvs != nil {
+		ans.PortDescription = util.AsBool(o.Tlvs.PortDescription)
+		ans.SystemName = util.AsBool(o.Tlvs.SystemName)
+		ans.SystemDescription = util.AsBool(o.Tlvs.SystemDescription)
+		ans.SystemCapabilities = util.AsBool(o.Tlvs.SystemCapabilities)
+
+		if o.Tlvs.ManagementAddress != nil {
+			ans.ManagementAddressEnabled = util.AsBool(o.Tlvs.ManagementAddress.Enabled)
+
+			if o.Tlvs.ManagementAddress.Addresses != nil {
+				list := make([]ManagementAddress, 0, len(o.Tlvs.ManagementAddress.Addresses.Entries))
+				for _, x := range o.Tlvs.ManagementAddress.Addresses.Entries {
+					item := ManagementAddress{
+						Name:      x.Name,
+						Interface: x.Interface,
+					}
+					if x.Ipv4 != nil {
+						item.Ipv4 = x.Ipv4.Address
+					}
+					if x.Ipv6 != nil {
+						item.Ipv6 = x.Ipv6.Address
+					}
+					list = append(list, item)
+				}
+				ans.ManagementAddresses = list
+			}
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName                xml.Name `xml:"entry"`
+	Name                   string   `xml:"name,attr"`
+	Mode                   string   `xml:"mode,omitempty"`
+	SnmpSyslogNotification string   `xml:"snmp-syslog-notification"`
+	Tlvs                   *tlvs    `xml:"option-tlvs"`
+}
+
+type tlvs struct {
+	PortDescription    string   `xml:"port-description"`
+	SystemName         string   `xml:"system-name"`
+	SystemDescription  string   `xml:"system-description"`
+	SystemCapabilities string   `xml:"system-capabilities"`
+	ManagementAddress  *mgmtAdr `xml:"management-address"`
+}
+
+type mgmtAdr struct {
+	Enabled   string   `xml:"enabled"`
+	Addresses *adrList `xml:"iplist"`
+}
+
+type adrList struct {
+	Entries []adrEntry `xml:"entry"`
+}
+
+type adrEntry struct {
+	Name      string `xml:"name,attr"`
+	Interface string `xml:"interface,omitempty"`
+	Ipv4      *ipv4  `xml:"ipv4"`
+	Ipv6      *ipv6  `xml:"ipv6"`
+}
+
+type ipv4 struct {
+	Address string `xml:"ipv4"`
+}
+
+type ipv6 struct {
+	Address string `xml:"ipv6"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:                   e.Name,
+		Mode:                   e.Mode,
+		SnmpSyslogNotification: util.YesNo(e.SnmpSyslogNotification),
+		Tlvs: &tlvs{
+			PortDescription:    util.YesNo(e.PortDescription),
+			SystemName:         util.YesNo(e.SystemName),
+			SystemDescription:  util.YesNo(e.SystemDescription),
+			SystemCapabilities: util.YesNo(e.SystemCapabilities),
+		},
+	}
+
+	if e.ManagementAddressEnabled || len(e.ManagementAddresses) > 0 {
+		ma := &mgmtAdr{
+			Enabled: util.YesNo(e.ManagementAddressEnabled),
+		}
+
+		if len(e.ManagementAddresses) > 0 {
+			list := make([]adrEntry, 0, len(e.ManagementAddresses))
+			for _, x := range e.ManagementAddresses {
+				item := adrEntry{
+					Name:      x.Name,
+					Interface: x.Interface,
+				}
+				if x.Ipv4 != "" {
+					item.Ipv4 = &ipv4{Address: x.Ipv4}
+				}
+				if x.Ipv6 != "" {
+					item.Ipv6 = &ipv6{Address: x.Ipv6}
+				}
+				list = append(list, item)
+			}
+			ma.Addresses = &adrList{Entries: list}
+		}
+
+		ans.Tlvs.ManagementAddress = ma
+	}
+
+	return ans
+}
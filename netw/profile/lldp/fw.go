@@ -0,0 +1,90 @@
+package lldp
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwLldp is a namespace struct, included as part of pango.Firewall.
+type FwLldp struct {
+	ns *namespace.Standard[Entry]
+}
+
+// Initialize is invoked when Initialize on the pango.Firewall is called.
+func (c *FwLldp) Initialize(con util.XapiClient) {
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *FwLldp) GetList() ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(nil))
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *FwLldp) ShowList() ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(nil))
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *FwLldp) Get(name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath([]string{name}), name)
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *FwLldp) GetAll() ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(nil))
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *FwLldp) Show(name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath([]string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *FwLldp) ShowAll() ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(nil))
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *FwLldp) Set(e ...Entry) error {
+	return c.ns.SetEntries(c.pather(), e...)
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *FwLldp) Edit(e Entry) error {
+	return c.ns.EditEntry(c.pather(), e)
+}
+
+// Delete removes the given objects.
+//
+// Objects can be either a string or an Entry object.
+func (c *FwLldp) Delete(e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(), e...)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *FwLldp) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *FwLldp) pather() namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(v)
+	}
+}
+
+func (c *FwLldp) xpath(vals []string) []string {
+	ans := make([]string, 0, 7)
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"network",
+		"profiles",
+		"lldp-profile",
+		util.AsEntryXpath(vals),
+	)
+
+	return ans
+}
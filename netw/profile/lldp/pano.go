@@ -0,0 +1,91 @@
+package lldp
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// PanoLldp is a namespace struct, included as part of pango.Panorama.
+type PanoLldp struct {
+	ns *namespace.Standard[Entry]
+}
+
+// Initialize is invoked when Initialize on the pango.Panorama is called.
+func (c *PanoLldp) Initialize(con util.XapiClient) {
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *PanoLldp) GetList(tmpl, ts string) ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(tmpl, ts, nil))
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *PanoLldp) ShowList(tmpl, ts string) ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(tmpl, ts, nil))
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *PanoLldp) Get(tmpl, ts, name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath(tmpl, ts, []string{name}), name)
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *PanoLldp) GetAll(tmpl, ts string) ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(tmpl, ts, nil))
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *PanoLldp) Show(tmpl, ts, name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath(tmpl, ts, []string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *PanoLldp) ShowAll(tmpl, ts string) ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(tmpl, ts, nil))
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *PanoLldp) Set(tmpl, ts string, e ...Entry) error {
+	return c.ns.SetEntries(c.pather(tmpl, ts), e...)
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *PanoLldp) Edit(tmpl, ts string, e Entry) error {
+	return c.ns.EditEntry(c.pather(tmpl, ts), e)
+}
+
+// Delete removes the given objects.
+//
+// Objects can be either a string or an Entry object.
+func (c *PanoLldp) Delete(tmpl, ts string, e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(tmpl, ts), e...)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *PanoLldp) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *PanoLldp) pather(tmpl, ts string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(tmpl, ts, v)
+	}
+}
+
+func (c *PanoLldp) xpath(tmpl, ts string, vals []string) []string {
+	ans := make([]string, 0, 17)
+	ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"network",
+		"profiles",
+		"lldp-profile",
+		util.AsEntryXpath(vals),
+	)
+
+	return ans
+}
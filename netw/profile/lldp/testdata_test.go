@@ -0,0 +1,41 @@
+package lldp
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"defaults", Entry{
+			Name: "t1",
+		}},
+		{"tlvs", Entry{
+			Name:                   "t2",
+			Mode:                   ModeTransmitReceive,
+			SnmpSyslogNotification: true,
+			PortDescription:        true,
+			SystemName:             true,
+			SystemDescription:      true,
+			SystemCapabilities:     true,
+		}},
+		{"management addresses", Entry{
+			Name:                     "t3",
+			Mode:                     ModeTransmitOnly,
+			SystemName:               true,
+			ManagementAddressEnabled: true,
+			ManagementAddresses: []ManagementAddress{
+				{
+					Name:      "v4",
+					Interface: "ethernet1/1",
+					Ipv4:      "10.1.1.1",
+				},
+				{
+					Name:      "v6",
+					Interface: "ethernet1/2",
+					Ipv6:      "2001:db8::1",
+				},
+			},
+		}},
+	}
+}
@@ -0,0 +1,6 @@
+/*
+Package qos is the client.Network.QosProfile namespace.
+
+Normalized object:  Entry
+*/
+package qos
@@ -0,0 +1,267 @@
+package qos
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a QoS
+// profile.
+//
+// EgressMax and EgressGuaranteed are the aggregate bandwidth in Mbps.  The
+// class bandwidth is either in Mbps or a percentage, depending on the
+// ClassBandwidthType (PAN-OS 8.1+; Mbps is assumed for earlier versions).
+type Entry struct {
+	Name               string
+	EgressMax          float64
+	EgressGuaranteed   float64
+	ClassBandwidthType string
+	Classes            []Class
+}
+
+// Class is the bandwidth setting for one of the traffic classes, named
+// "class1" through "class8".
+type Class struct {
+	Name             string
+	Priority         string
+	EgressMax        float64
+	EgressGuaranteed float64
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.EgressMax = s.EgressMax
+	o.EgressGuaranteed = s.EgressGuaranteed
+	o.ClassBandwidthType = s.ClassBandwidthType
+	if s.Classes == nil {
+		o.Classes = nil
+	} else {
+		o.Classes = make([]Class, len(s.Classes))
+		copy(o.Classes, s.Classes)
+	}
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this QoS profile.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name: o.Name,
+	}
+
+	if o.Aggregate != nil {
+		ans.EgressMax = o.Aggregate.EgressMax
+		ans.EgressGuaranteed = o.Aggregate.EgressGuaranteed
+	}
+
+	if o.Classes != nil {
+		ans.Classes = o.Classes.normalize()
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName   xml.Name   `xml:"entry"`
+	Name      string     `xml:"name,attr"`
+	Aggregate *bandwidth `xml:"aggregate-bandwidth"`
+	Classes   *classes   `xml:"class"`
+}
+
+type bandwidth struct {
+	EgressMax        float64 `xml:"egress-max,omitempty"`
+	EgressGuaranteed float64 `xml:"egress-guaranteed,omitempty"`
+}
+
+func specifyBandwidth(max, guaranteed float64) *bandwidth {
+	if max == 0 && guaranteed == 0 {
+		return nil
+	}
+
+	return &bandwidth{
+		EgressMax:        max,
+		EgressGuaranteed: guaranteed,
+	}
+}
+
+type classes struct {
+	Entries []class `xml:"entry"`
+}
+
+func (o *classes) normalize() []Class {
+	if len(o.Entries) == 0 {
+		return nil
+	}
+
+	ans := make([]Class, 0, len(o.Entries))
+	for _, x := range o.Entries {
+		item := Class{
+			Name:     x.Name,
+			Priority: x.Priority,
+		}
+		if x.Bandwidth != nil {
+			item.EgressMax = x.Bandwidth.EgressMax
+			item.EgressGuaranteed = x.Bandwidth.EgressGuaranteed
+		}
+		ans = append(ans, item)
+	}
+
+	return ans
+}
+
+type class struct {
+	Name      string     `xml:"name,attr"`
+	Priority  string     `xml:"priority,omitempty"`
+	Bandwidth *bandwidth `xml:"class-bandwidth"`
+}
+
+func specifyClasses(list []Class) *classes {
+	if len(list) == 0 {
+		return nil
+	}
+
+	ans := &classes{
+		Entries: make([]class, 0, len(list)),
+	}
+	for _, x := range list {
+		ans.Entries = append(ans.Entries, class{
+			Name:      x.Name,
+			Priority:  x.Priority,
+			Bandwidth: specifyBandwidth(x.EgressMax, x.EgressGuaranteed),
+		})
+	}
+
+	return ans
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:      e.Name,
+		Aggregate: specifyBandwidth(e.EgressMax, e.EgressGuaranteed),
+		Classes:   specifyClasses(e.Classes),
+	}
+
+	return ans
+}
+
+// PAN-OS 8.1, adds class-bandwidth-type.
+type container_v2 struct {
+	Answer []entry_v2 `xml:"entry"`
+}
+
+func (o *container_v2) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v2) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v2) normalize() Entry {
+	ans := Entry{
+		Name: o.Name,
+	}
+
+	if o.Aggregate != nil {
+		ans.EgressMax = o.Aggregate.EgressMax
+		ans.EgressGuaranteed = o.Aggregate.EgressGuaranteed
+	}
+
+	if o.BandwidthType != nil {
+		switch {
+		case o.BandwidthType.Mbps != nil:
+			ans.ClassBandwidthType = ClassBandwidthTypeMbps
+			if o.BandwidthType.Mbps.Classes != nil {
+				ans.Classes = o.BandwidthType.Mbps.Classes.normalize()
+			}
+		case o.BandwidthType.Percentage != nil:
+			ans.ClassBandwidthType = ClassBandwidthTypePercentage
+			if o.BandwidthType.Percentage.Classes != nil {
+				ans.Classes = o.BandwidthType.Percentage.Classes.normalize()
+			}
+		}
+	}
+
+	return ans
+}
+
+type entry_v2 struct {
+	XMLName       xml.Name       `xml:"entry"`
+	Name          string         `xml:"name,attr"`
+	Aggregate     *bandwidth     `xml:"aggregate-bandwidth"`
+	BandwidthType *bandwidthType `xml:"class-bandwidth-type"`
+}
+
+type bandwidthType struct {
+	Mbps       *classList `xml:"mbps"`
+	Percentage *classList `xml:"percentage"`
+}
+
+type classList struct {
+	Classes *classes `xml:"class"`
+}
+
+func specify_v2(e Entry) interface{} {
+	ans := entry_v2{
+		Name:      e.Name,
+		Aggregate: specifyBandwidth(e.EgressMax, e.EgressGuaranteed),
+	}
+
+	list := &classList{Classes: specifyClasses(e.Classes)}
+	switch e.ClassBandwidthType {
+	case ClassBandwidthTypeMbps:
+		ans.BandwidthType = &bandwidthType{Mbps: list}
+	case ClassBandwidthTypePercentage:
+		ans.BandwidthType = &bandwidthType{Percentage: list}
+	}
+
+	return ans
+}
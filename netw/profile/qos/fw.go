@@ -0,0 +1,99 @@
+package qos
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+// FwQos is a namespace struct, included as part of pango.Firewall.
+type FwQos struct {
+	con util.XapiClient
+	ns  *namespace.Standard[Entry]
+}
+
+// Initialize is invoked when Initialize on the pango.Firewall is called.
+func (c *FwQos) Initialize(con util.XapiClient) {
+	c.con = con
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *FwQos) GetList() ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(nil))
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *FwQos) ShowList() ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(nil))
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *FwQos) Get(name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath([]string{name}), name)
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *FwQos) GetAll() ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(nil))
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *FwQos) Show(name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath([]string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *FwQos) ShowAll() ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(nil))
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *FwQos) Set(e ...Entry) error {
+	return c.ns.SetEntries(c.pather(), e...)
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *FwQos) Edit(e Entry) error {
+	return c.ns.EditEntry(c.pather(), e)
+}
+
+// Delete removes the given objects.
+//
+// Objects can be either a string or an Entry object.
+func (c *FwQos) Delete(e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(), e...)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *FwQos) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	v := c.con.Versioning()
+
+	if v.Gte(version.Number{8, 1, 0, ""}) {
+		return &container_v2{}, specify_v2
+	} else {
+		return &container_v1{}, specify_v1
+	}
+}
+
+func (c *FwQos) pather() namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(v)
+	}
+}
+
+func (c *FwQos) xpath(vals []string) []string {
+	ans := make([]string, 0, 7)
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"network",
+		"qos",
+		"profile",
+		util.AsEntryXpath(vals),
+	)
+
+	return ans
+}
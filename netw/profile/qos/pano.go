@@ -0,0 +1,100 @@
+package qos
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+// PanoQos is a namespace struct, included as part of pango.Panorama.
+type PanoQos struct {
+	con util.XapiClient
+	ns  *namespace.Standard[Entry]
+}
+
+// Initialize is invoked when Initialize on the pango.Panorama is called.
+func (c *PanoQos) Initialize(con util.XapiClient) {
+	c.con = con
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *PanoQos) GetList(tmpl, ts string) ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(tmpl, ts, nil))
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *PanoQos) ShowList(tmpl, ts string) ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(tmpl, ts, nil))
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *PanoQos) Get(tmpl, ts, name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath(tmpl, ts, []string{name}), name)
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *PanoQos) GetAll(tmpl, ts string) ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(tmpl, ts, nil))
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *PanoQos) Show(tmpl, ts, name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath(tmpl, ts, []string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *PanoQos) ShowAll(tmpl, ts string) ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(tmpl, ts, nil))
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *PanoQos) Set(tmpl, ts string, e ...Entry) error {
+	return c.ns.SetEntries(c.pather(tmpl, ts), e...)
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *PanoQos) Edit(tmpl, ts string, e Entry) error {
+	return c.ns.EditEntry(c.pather(tmpl, ts), e)
+}
+
+// Delete removes the given objects.
+//
+// Objects can be either a string or an Entry object.
+func (c *PanoQos) Delete(tmpl, ts string, e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(tmpl, ts), e...)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *PanoQos) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	v := c.con.Versioning()
+
+	if v.Gte(version.Number{8, 1, 0, ""}) {
+		return &container_v2{}, specify_v2
+	} else {
+		return &container_v1{}, specify_v1
+	}
+}
+
+func (c *PanoQos) pather(tmpl, ts string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(tmpl, ts, v)
+	}
+}
+
+func (c *PanoQos) xpath(tmpl, ts string, vals []string) []string {
+	ans := make([]string, 0, 17)
+	ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"network",
+		"qos",
+		"profile",
+		util.AsEntryXpath(vals),
+	)
+
+	return ans
+}
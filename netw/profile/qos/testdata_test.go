@@ -0,0 +1,48 @@
+package qos
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type tc struct {
+	desc    string
+	version version.Number
+	conf    Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"v1 basic", version.Number{8, 0, 0, ""}, Entry{
+			Name: "q1",
+		}},
+		{"v1 with classes", version.Number{8, 0, 0, ""}, Entry{
+			Name:             "q2",
+			EgressMax:        100,
+			EgressGuaranteed: 50.5,
+			Classes: []Class{
+				{Name: "class1", Priority: PriorityRealTime, EgressMax: 20, EgressGuaranteed: 10},
+				{Name: "class4", Priority: PriorityLow},
+			},
+		}},
+		{"v2 basic", version.Number{8, 1, 0, ""}, Entry{
+			Name:      "q1",
+			EgressMax: 1000,
+		}},
+		{"v2 mbps", version.Number{8, 1, 0, ""}, Entry{
+			Name:               "q2",
+			EgressMax:          1000,
+			ClassBandwidthType: ClassBandwidthTypeMbps,
+			Classes: []Class{
+				{Name: "class1", Priority: PriorityHigh, EgressMax: 200, EgressGuaranteed: 100},
+			},
+		}},
+		{"v2 percentage", version.Number{8, 1, 0, ""}, Entry{
+			Name:               "q3",
+			ClassBandwidthType: ClassBandwidthTypePercentage,
+			Classes: []Class{
+				{Name: "class2", Priority: PriorityMedium, EgressMax: 50, EgressGuaranteed: 25},
+				{Name: "class3", Priority: PriorityLow, EgressMax: 10},
+			},
+		}},
+	}
+}
@@ -0,0 +1,26 @@
+package sdwan
+
+// Valid values for LinkType.
+const (
+	LinkTypeAdslDsl    = "ADSL/DSL"
+	LinkTypeCableModem = "Cablemodem"
+	LinkTypeEthernet   = "Ethernet"
+	LinkTypeFiber      = "Fiber"
+	LinkTypeLte3g4g5g  = "LTE/3G/4G/5G"
+	LinkTypeMpls       = "MPLS"
+	LinkTypeMicrowave  = "Microwave/Radio"
+	LinkTypeSatellite  = "Satellite"
+	LinkTypeWifi       = "WiFi"
+	LinkTypeOther      = "Other"
+)
+
+// Valid values for PathMonitoring.
+const (
+	PathMonitoringAggressive = "Aggressive"
+	PathMonitoringRelaxed    = "Relaxed"
+)
+
+const (
+	singular = "sdwan interface profile"
+	plural   = "sdwan interface profiles"
+)
@@ -0,0 +1,14 @@
+/*
+Package sdwan is the client.Network.SdwanInterfaceProfile namespace.
+
+SD-WAN interface profiles define the link characteristics of the ethernet
+interfaces used by SD-WAN, and are referenced by those interfaces.
+
+Only valid for PAN-OS 9.1+.
+
+For Panorama, specify either the template ("tmpl") or the template stack
+("ts") along with the vsys.
+
+Normalized object:  Entry
+*/
+package sdwan
@@ -0,0 +1,135 @@
+package sdwan
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an SD-WAN
+// interface profile.
+//
+// MaximumDownload and MaximumUpload are in Mbps.
+type Entry struct {
+	Name                 string
+	LinkTag              string
+	Comment              string
+	LinkType             string
+	MaximumDownload      int
+	MaximumUpload        int
+	ErrorCorrection      bool
+	PathMonitoring       string
+	ProbeFrequency       int
+	ProbeIdleTime        int
+	FailbackHoldTime     int
+	VpnDataTunnelSupport bool
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.LinkTag = s.LinkTag
+	o.Comment = s.Comment
+	o.LinkType = s.LinkType
+	o.MaximumDownload = s.MaximumDownload
+	o.MaximumUpload = s.MaximumUpload
+	o.ErrorCorrection = s.ErrorCorrection
+	o.PathMonitoring = s.PathMonitoring
+	o.ProbeFrequency = s.ProbeFrequency
+	o.ProbeIdleTime = s.ProbeIdleTime
+	o.FailbackHoldTime = s.FailbackHoldTime
+	o.VpnDataTunnelSupport = s.VpnDataTunnelSupport
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this SD-WAN interface profile.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:                 o.Name,
+		LinkTag:              o.LinkTag,
+		Comment:              o.Comment,
+		LinkType:             o.LinkType,
+		MaximumDownload:      o.MaximumDownload,
+		MaximumUpload:        o.MaximumUpload,
+		ErrorCorrection:      util.AsBool(o.ErrorCorrection),
+		PathMonitoring:       o.PathMonitoring,
+		ProbeFrequency:       o.ProbeFrequency,
+		ProbeIdleTime:        o.ProbeIdleTime,
+		FailbackHoldTime:     o.FailbackHoldTime,
+		VpnDataTunnelSupport: util.AsBool(o.VpnDataTunnelSupport),
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName              xml.Name `xml:"entry"`
+	Name                 string   `xml:"name,attr"`
+	LinkTag              string   `xml:"link-tag,omitempty"`
+	Comment              string   `xml:"comment,omitempty"`
+	LinkType             string   `xml:"link-type,omitempty"`
+	MaximumDownload      int      `xml:"maximum-download,omitempty"`
+	MaximumUpload        int      `xml:"maximum-upload,omitempty"`
+	ErrorCorrection      string   `xml:"error-correction"`
+	PathMonitoring       string   `xml:"path-monitoring,omitempty"`
+	ProbeFrequency       int      `xml:"probe-frequency,omitempty"`
+	ProbeIdleTime        int      `xml:"probe-idle-time,omitempty"`
+	FailbackHoldTime     int      `xml:"failback-hold-time,omitempty"`
+	VpnDataTunnelSupport string   `xml:"vpn-data-tunnel-support"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:                 e.Name,
+		LinkTag:              e.LinkTag,
+		Comment:              e.Comment,
+		LinkType:             e.LinkType,
+		MaximumDownload:      e.MaximumDownload,
+		MaximumUpload:        e.MaximumUpload,
+		ErrorCorrection:      util.YesNo(e.ErrorCorrection),
+		PathMonitoring:       e.PathMonitoring,
+		ProbeFrequency:       e.ProbeFrequency,
+		ProbeIdleTime:        e.ProbeIdleTime,
+		FailbackHoldTime:     e.FailbackHoldTime,
+		VpnDataTunnelSupport: util.YesNo(e.VpnDataTunnelSupport),
+	}
+
+	return ans
+}
@@ -0,0 +1,30 @@
+package sdwan
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"basic", Entry{
+			Name:     "one",
+			LinkTag:  "broadband",
+			LinkType: LinkTypeCableModem,
+		}},
+		{"full", Entry{
+			Name:                 "two",
+			LinkTag:              "mpls",
+			Comment:              "primary mpls",
+			LinkType:             LinkTypeMpls,
+			MaximumDownload:      1000,
+			MaximumUpload:        500,
+			ErrorCorrection:      true,
+			PathMonitoring:       PathMonitoringAggressive,
+			ProbeFrequency:       5,
+			ProbeIdleTime:        60,
+			FailbackHoldTime:     120,
+			VpnDataTunnelSupport: true,
+		}},
+	}
+}
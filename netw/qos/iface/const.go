@@ -0,0 +1,6 @@
+package iface
+
+const (
+	singular = "qos interface"
+	plural   = "qos interfaces"
+)
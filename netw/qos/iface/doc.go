@@ -0,0 +1,10 @@
+/*
+Package iface is the client.Network.QosInterface namespace.
+
+This enables QoS on an interface and sets the QoS profiles used for its
+traffic.  The clear text and tunneled traffic groups configured beneath
+the interface are preserved as-is when the interface is updated.
+
+Normalized object:  Entry
+*/
+package iface
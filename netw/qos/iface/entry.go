@@ -0,0 +1,198 @@
+package iface
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of the QoS
+// config of an interface.  The Name is the interface name.
+//
+// Bandwidth values are in Mbps.
+type Entry struct {
+	Name                   string
+	Enabled                bool
+	EgressMax              float64
+	DefaultProfile         string
+	TunnelDefaultProfile   string
+	TunnelEgressMax        float64
+	TunnelEgressGuaranteed float64
+
+	raw map[string]string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Enabled = s.Enabled
+	o.EgressMax = s.EgressMax
+	o.DefaultProfile = s.DefaultProfile
+	o.TunnelDefaultProfile = s.TunnelDefaultProfile
+	o.TunnelEgressMax = s.TunnelEgressMax
+	o.TunnelEgressGuaranteed = s.TunnelEgressGuaranteed
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the interface name.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:    o.Name,
+		Enabled: util.AsBool(o.Enabled),
+	}
+
+	if o.Bandwidth != nil {
+		ans.EgressMax = o.Bandwidth.EgressMax
+	}
+
+	ans.raw = make(map[string]string)
+
+	if o.Regular != nil {
+		if o.Regular.Default != nil {
+			ans.DefaultProfile = o.Regular.Default.Profile
+		}
+		if o.Regular.Groups != nil {
+			ans.raw["rg"] = util.CleanRawXml(o.Regular.Groups.Text)
+		}
+	}
+
+	if o.Tunnel != nil {
+		if o.Tunnel.Default != nil {
+			ans.TunnelDefaultProfile = o.Tunnel.Default.Profile
+		}
+		if o.Tunnel.Bandwidth != nil {
+			ans.TunnelEgressMax = o.Tunnel.Bandwidth.EgressMax
+			ans.TunnelEgressGuaranteed = o.Tunnel.Bandwidth.EgressGuaranteed
+		}
+		if o.Tunnel.Groups != nil {
+			ans.raw["tg"] = util.CleanRawXml(o.Tunnel.Groups.Text)
+		}
+	}
+
+	if len(ans.raw) == 0 {
+		ans.raw = nil
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName   xml.Name        `xml:"entry"`
+	Name      string          `xml:"name,attr"`
+	Enabled   string          `xml:"enabled"`
+	Bandwidth *ifaceBandwidth `xml:"interface-bandwidth"`
+	Regular   *regular        `xml:"regular-traffic"`
+	Tunnel    *tunnel         `xml:"tunnel-traffic"`
+}
+
+type ifaceBandwidth struct {
+	EgressMax float64 `xml:"egress-max,omitempty"`
+}
+
+type regular struct {
+	Default *regularDefault `xml:"default-group"`
+	Groups  *util.RawXml    `xml:"groups"`
+}
+
+type regularDefault struct {
+	Profile string `xml:"qos-profile,omitempty"`
+}
+
+type tunnel struct {
+	Default   *tunnelDefault   `xml:"default-group"`
+	Bandwidth *tunnelBandwidth `xml:"bandwidth"`
+	Groups    *util.RawXml     `xml:"groups"`
+}
+
+type tunnelDefault struct {
+	Profile string `xml:"per-tunnel-qos-profile,omitempty"`
+}
+
+type tunnelBandwidth struct {
+	EgressMax        float64 `xml:"egress-max,omitempty"`
+	EgressGuaranteed float64 `xml:"egress-guaranteed,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:    e.Name,
+		Enabled: util.YesNo(e.Enabled),
+	}
+
+	if e.EgressMax != 0 {
+		ans.Bandwidth = &ifaceBandwidth{
+			EgressMax: e.EgressMax,
+		}
+	}
+
+	var rg *util.RawXml
+	if text, present := e.raw["rg"]; present {
+		rg = &util.RawXml{text}
+	}
+	if e.DefaultProfile != "" || rg != nil {
+		ans.Regular = &regular{Groups: rg}
+		if e.DefaultProfile != "" {
+			ans.Regular.Default = &regularDefault{
+				Profile: e.DefaultProfile,
+			}
+		}
+	}
+
+	var tg *util.RawXml
+	if text, present := e.raw["tg"]; present {
+		tg = &util.RawXml{text}
+	}
+	if e.TunnelDefaultProfile != "" || e.TunnelEgressMax != 0 || e.TunnelEgressGuaranteed != 0 || tg != nil {
+		ans.Tunnel = &tunnel{Groups: tg}
+		if e.TunnelDefaultProfile != "" {
+			ans.Tunnel.Default = &tunnelDefault{
+				Profile: e.TunnelDefaultProfile,
+			}
+		}
+		if e.TunnelEgressMax != 0 || e.TunnelEgressGuaranteed != 0 {
+			ans.Tunnel.Bandwidth = &tunnelBandwidth{
+				EgressMax:        e.TunnelEgressMax,
+				EgressGuaranteed: e.TunnelEgressGuaranteed,
+			}
+		}
+	}
+
+	return ans
+}
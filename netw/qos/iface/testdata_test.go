@@ -0,0 +1,37 @@
+package iface
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"disabled", Entry{
+			Name: "ethernet1/1",
+		}},
+		{"enabled with default profile", Entry{
+			Name:           "ethernet1/2",
+			Enabled:        true,
+			EgressMax:      500,
+			DefaultProfile: "default",
+		}},
+		{"tunnel traffic", Entry{
+			Name:                   "ethernet1/3",
+			Enabled:                true,
+			TunnelDefaultProfile:   "default",
+			TunnelEgressMax:        100,
+			TunnelEgressGuaranteed: 25.5,
+		}},
+		{"with groups", Entry{
+			Name:                 "ethernet1/4",
+			Enabled:              true,
+			DefaultProfile:       "p1",
+			TunnelDefaultProfile: "p2",
+			raw: map[string]string{
+				"rg": "<entry name=\"g1\"><members><entry name=\"m1\"><qos-profile>p3</qos-profile></entry></members></entry>",
+				"tg": "<entry name=\"tg1\"><members><entry name=\"tunnel.1\"><qos-profile>p4</qos-profile></entry></members></entry>",
+			},
+		}},
+	}
+}
@@ -0,0 +1,156 @@
+package multicast
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Config is a normalized, version independent representation of a virtual
+// router's multicast configuration.
+type Config struct {
+	Enable                 bool
+	RouteAgeoutTime        int
+	StaticRpInterface      string
+	StaticRpAddress        string
+	StaticRpOverride       bool
+	StaticRpGroupAddresses []string // unordered
+
+	raw map[string]string
+}
+
+// Copy copies the information from source Config `s` to this object.
+func (o *Config) Copy(s Config) {
+	o.Enable = s.Enable
+	o.RouteAgeoutTime = s.RouteAgeoutTime
+	o.StaticRpInterface = s.StaticRpInterface
+	o.StaticRpAddress = s.StaticRpAddress
+	o.StaticRpOverride = s.StaticRpOverride
+	o.StaticRpGroupAddresses = s.StaticRpGroupAddresses
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Config
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>multicast"`
+}
+
+func (o *container_v1) Normalize() Config {
+	ans := Config{
+		Enable:          util.AsBool(o.Answer.Enable),
+		RouteAgeoutTime: o.Answer.RouteAgeoutTime,
+	}
+
+	raw := make(map[string]string)
+
+	if o.Answer.Rp != nil {
+		if o.Answer.Rp.Local != nil {
+			if o.Answer.Rp.Local.Static != nil {
+				ans.StaticRpInterface = o.Answer.Rp.Local.Static.Interface
+				ans.StaticRpAddress = o.Answer.Rp.Local.Static.Address
+				ans.StaticRpOverride = util.AsBool(o.Answer.Rp.Local.Static.Override)
+				ans.StaticRpGroupAddresses = util.MemToStr(o.Answer.Rp.Local.Static.GroupAddresses)
+			}
+			if o.Answer.Rp.Local.Candidate != nil {
+				raw["crp"] = util.CleanRawXml(o.Answer.Rp.Local.Candidate.Text)
+			}
+		}
+		if o.Answer.Rp.External != nil {
+			raw["erp"] = util.CleanRawXml(o.Answer.Rp.External.Text)
+		}
+	}
+
+	if o.Answer.InterfaceGroup != nil {
+		raw["ifg"] = util.CleanRawXml(o.Answer.InterfaceGroup.Text)
+	}
+	if o.Answer.SsmAddressSpace != nil {
+		raw["ssm"] = util.CleanRawXml(o.Answer.SsmAddressSpace.Text)
+	}
+	if o.Answer.SptThreshold != nil {
+		raw["spt"] = util.CleanRawXml(o.Answer.SptThreshold.Text)
+	}
+
+	if len(raw) != 0 {
+		ans.raw = raw
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName         xml.Name     `xml:"multicast"`
+	Enable          string       `xml:"enable"`
+	RouteAgeoutTime int          `xml:"route-ageout-time,omitempty"`
+	Rp              *rp          `xml:"rp"`
+	InterfaceGroup  *util.RawXml `xml:"interface-group"`
+	SsmAddressSpace *util.RawXml `xml:"ssm-address-space"`
+	SptThreshold    *util.RawXml `xml:"spt-threshold"`
+}
+
+type rp struct {
+	Local    *localRp     `xml:"local-rp"`
+	External *util.RawXml `xml:"external-rp"`
+}
+
+type localRp struct {
+	Static    *staticRp    `xml:"static-rp"`
+	Candidate *util.RawXml `xml:"candidate-rp"`
+}
+
+type staticRp struct {
+	Interface      string           `xml:"interface,omitempty"`
+	Address        string           `xml:"address,omitempty"`
+	Override       string           `xml:"override"`
+	GroupAddresses *util.MemberType `xml:"group-addresses"`
+}
+
+func specify_v1(e Config) interface{} {
+	ans := entry_v1{
+		Enable:          util.YesNo(e.Enable),
+		RouteAgeoutTime: e.RouteAgeoutTime,
+	}
+
+	var local *localRp
+	if e.StaticRpInterface != "" || e.StaticRpAddress != "" || e.StaticRpOverride || len(e.StaticRpGroupAddresses) != 0 {
+		local = &localRp{
+			Static: &staticRp{
+				Interface:      e.StaticRpInterface,
+				Address:        e.StaticRpAddress,
+				Override:       util.YesNo(e.StaticRpOverride),
+				GroupAddresses: util.StrToMem(e.StaticRpGroupAddresses),
+			},
+		}
+	} else if text := e.raw["crp"]; text != "" {
+		local = &localRp{
+			Candidate: &util.RawXml{text},
+		}
+	}
+
+	var external *util.RawXml
+	if text := e.raw["erp"]; text != "" {
+		external = &util.RawXml{text}
+	}
+
+	if local != nil || external != nil {
+		ans.Rp = &rp{
+			Local:    local,
+			External: external,
+		}
+	}
+
+	if text := e.raw["ifg"]; text != "" {
+		ans.InterfaceGroup = &util.RawXml{text}
+	}
+	if text := e.raw["ssm"]; text != "" {
+		ans.SsmAddressSpace = &util.RawXml{text}
+	}
+	if text := e.raw["spt"]; text != "" {
+		ans.SptThreshold = &util.RawXml{text}
+	}
+
+	return ans
+}
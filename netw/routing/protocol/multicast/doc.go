@@ -0,0 +1,12 @@
+/*
+Package multicast is the client.Network.MulticastConfig namespace.
+
+This namespace manages the global multicast settings of a virtual router,
+including the static local rendezvous point.  Interface groups, external
+rendezvous points, and the SSM address space are managed using their own
+namespaces, and are left as-is when the multicast config is updated with this
+namespace.
+
+Normalized object:  Config
+*/
+package multicast
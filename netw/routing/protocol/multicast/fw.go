@@ -0,0 +1,107 @@
+package multicast
+
+import (
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwMulticast is the client.Network.MulticastConfig namespace.
+type FwMulticast struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *FwMulticast) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// Get performs GET to retrieve the multicast config.
+func (c *FwMulticast) Get(vr string) (Config, error) {
+	c.con.LogQuery("(get) multicast config for %q", vr)
+	return c.details(c.con.Get, vr)
+}
+
+// Show performs SHOW to retrieve the multicast config.
+func (c *FwMulticast) Show(vr string) (Config, error) {
+	c.con.LogQuery("(show) multicast config for %q", vr)
+	return c.details(c.con.Show, vr)
+}
+
+// Set performs SET to create / update the multicast config.
+func (c *FwMulticast) Set(vr string, e Config) error {
+	var err error
+
+	if vr == "" {
+		return fmt.Errorf("vr must be specified")
+	}
+
+	_, fn := c.versioning()
+	c.con.LogAction("(set) multicast config for %q", vr)
+	path := c.xpath(vr)
+	path = path[:len(path)-1]
+
+	_, err = c.con.Set(path, fn(e), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to create / update the multicast config.
+func (c *FwMulticast) Edit(vr string, e Config) error {
+	var err error
+
+	if vr == "" {
+		return fmt.Errorf("vr must be specified")
+	}
+
+	_, fn := c.versioning()
+	c.con.LogAction("(edit) multicast config for %q", vr)
+	path := c.xpath(vr)
+
+	_, err = c.con.Edit(path, fn(e), nil, nil)
+	return err
+}
+
+// Delete removes the multicast config for the given virtual router.
+func (c *FwMulticast) Delete(vr string) error {
+	var err error
+
+	if vr == "" {
+		return fmt.Errorf("vr must be specified")
+	}
+
+	c.con.LogAction("(delete) multicast config for %q", vr)
+
+	// Remove the objects.
+	path := c.xpath(vr)
+	_, err = c.con.Delete(path, nil, nil)
+	return err
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *FwMulticast) versioning() (normalizer, func(Config) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *FwMulticast) details(fn util.Retriever, vr string) (Config, error) {
+	path := c.xpath(vr)
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Config{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *FwMulticast) xpath(vr string) []string {
+	return []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"network",
+		"virtual-router",
+		util.AsEntryXpath([]string{vr}),
+		"multicast",
+	}
+}
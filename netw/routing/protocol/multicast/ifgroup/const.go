@@ -0,0 +1,13 @@
+package ifgroup
+
+// Valid values for IgmpVersion.
+const (
+	IgmpVersion1 = "1"
+	IgmpVersion2 = "2"
+	IgmpVersion3 = "3"
+)
+
+const (
+	singular = "multicast interface group"
+	plural   = "multicast interface groups"
+)
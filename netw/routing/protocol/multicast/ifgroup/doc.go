@@ -0,0 +1,9 @@
+/*
+Package ifgroup is the client.Network.MulticastInterfaceGroup namespace.
+
+Interface groups hold the IGMP and PIM settings for a set of interfaces, along
+with the multicast groups that the interfaces are permitted to join.
+
+Normalized object:  Entry
+*/
+package ifgroup
@@ -0,0 +1,307 @@
+package ifgroup
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a multicast
+// interface group.
+type Entry struct {
+	Name                        string
+	Description                 string
+	Interfaces                  []string // unordered
+	AnySourceMulticast          []AnySource
+	SourceSpecificMulticast     []SourceSpecific
+	IgmpEnable                  bool
+	IgmpVersion                 string
+	IgmpMaxQueryResponseTime    float64
+	IgmpQueryInterval           int
+	IgmpLastMemberQueryInterval float64
+	IgmpImmediateLeave          bool
+	IgmpRobustness              int
+	IgmpMaxGroups               string
+	IgmpMaxSources              string
+	IgmpRouterAlertPolicing     bool
+	PimEnable                   bool
+	PimAssertInterval           int
+	PimHelloInterval            int
+	PimJoinPruneInterval        int
+	PimDrPriority               int
+	PimBsrBorder                bool
+	PimAllowedNeighbors         []string // unordered
+}
+
+// AnySource is a group permission for any-source multicast (ASM).
+type AnySource struct {
+	Name         string
+	GroupAddress string
+	Included     bool
+}
+
+// SourceSpecific is a group permission for source-specific multicast (SSM).
+type SourceSpecific struct {
+	Name          string
+	GroupAddress  string
+	SourceAddress string
+	Included      bool
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Description = s.Description
+	o.Interfaces = s.Interfaces
+	if s.AnySourceMulticast == nil {
+		o.AnySourceMulticast = nil
+	} else {
+		o.AnySourceMulticast = make([]AnySource, len(s.AnySourceMulticast))
+		copy(o.AnySourceMulticast, s.AnySourceMulticast)
+	}
+	if s.SourceSpecificMulticast == nil {
+		o.SourceSpecificMulticast = nil
+	} else {
+		o.SourceSpecificMulticast = make([]SourceSpecific, len(s.SourceSpecificMulticast))
+		copy(o.SourceSpecificMulticast, s.SourceSpecificMulticast)
+	}
+	o.IgmpEnable = s.IgmpEnable
+	o.IgmpVersion = s.IgmpVersion
+	o.IgmpMaxQueryResponseTime = s.IgmpMaxQueryResponseTime
+	o.IgmpQueryInterval = s.IgmpQueryInterval
+	o.IgmpLastMemberQueryInterval = s.IgmpLastMemberQueryInterval
+	o.IgmpImmediateLeave = s.IgmpImmediateLeave
+	o.IgmpRobustness = s.IgmpRobustness
+	o.IgmpMaxGroups = s.IgmpMaxGroups
+	o.IgmpMaxSources = s.IgmpMaxSources
+	o.IgmpRouterAlertPolicing = s.IgmpRouterAlertPolicing
+	o.PimEnable = s.PimEnable
+	o.PimAssertInterval = s.PimAssertInterval
+	o.PimHelloInterval = s.PimHelloInterval
+	o.PimJoinPruneInterval = s.PimJoinPruneInterval
+	o.PimDrPriority = s.PimDrPriority
+	o.PimBsrBorder = s.PimBsrBorder
+	o.PimAllowedNeighbors = s.PimAllowedNeighbors
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this interface group.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:        o.Name,
+		Description: o.Description,
+		Interfaces:  util.MemToStr(o.Interfaces),
+	}
+
+	if o.Permissions != nil {
+		if o.Permissions.Asm != nil {
+			ans.AnySourceMulticast = make([]AnySource, 0, len(o.Permissions.Asm.Entries))
+			for _, x := range o.Permissions.Asm.Entries {
+				ans.AnySourceMulticast = append(ans.AnySourceMulticast, AnySource{
+					Name:         x.Name,
+					GroupAddress: x.GroupAddress,
+					Included:     util.AsBool(x.Included),
+				})
+			}
+		}
+
+		if o.Permissions.Ssm != nil {
+			ans.SourceSpecificMulticast = make([]SourceSpecific, 0, len(o.Permissions.Ssm.Entries))
+			for _, x := range o.Permissions.Ssm.Entries {
+				ans.SourceSpecificMulticast = append(ans.SourceSpecificMulticast, SourceSpecific{
+					Name:          x.Name,
+					GroupAddress:  x.GroupAddress,
+					SourceAddress: x.SourceAddress,
+					Included:      util.AsBool(x.Included),
+				})
+			}
+		}
+	}
+
+	if o.Igmp != nil {
+		ans.IgmpEnable = util.AsBool(o.Igmp.Enable)
+		ans.IgmpVersion = o.Igmp.Version
+		ans.IgmpMaxQueryResponseTime = o.Igmp.MaxQueryResponseTime
+		ans.IgmpQueryInterval = o.Igmp.QueryInterval
+		ans.IgmpLastMemberQueryInterval = o.Igmp.LastMemberQueryInterval
+		ans.IgmpImmediateLeave = util.AsBool(o.Igmp.ImmediateLeave)
+		ans.IgmpRobustness = o.Igmp.Robustness
+		ans.IgmpMaxGroups = o.Igmp.MaxGroups
+		ans.IgmpMaxSources = o.Igmp.MaxSources
+		ans.IgmpRouterAlertPolicing = util.AsBool(o.Igmp.RouterAlertPolicing)
+	}
+
+	if o.Pim != nil {
+		ans.PimEnable = util.AsBool(o.Pim.Enable)
+		ans.PimAssertInterval = o.Pim.AssertInterval
+		ans.PimHelloInterval = o.Pim.HelloInterval
+		ans.PimJoinPruneInterval = o.Pim.JoinPruneInterval
+		ans.PimDrPriority = o.Pim.DrPriority
+		ans.PimBsrBorder = util.AsBool(o.Pim.BsrBorder)
+		ans.PimAllowedNeighbors = util.EntToStr(o.Pim.AllowedNeighbors)
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName     xml.Name         `xml:"entry"`
+	Name        string           `xml:"name,attr"`
+	Description string           `xml:"description,omitempty"`
+	Interfaces  *util.MemberType `xml:"interface"`
+	Permissions *permissions     `xml:"group-permission"`
+	Igmp        *igmp            `xml:"igmp"`
+	Pim         *pim             `xml:"pim"`
+}
+
+type permissions struct {
+	Asm *asmList `xml:"any-source-multicast"`
+	Ssm *ssmList `xml:"source-specific-multicast"`
+}
+
+type asmList struct {
+	Entries []asmEntry `xml:"entry"`
+}
+
+type asmEntry struct {
+	Name         string `xml:"name,attr"`
+	GroupAddress string `xml:"group-address,omitempty"`
+	Included     string `xml:"included"`
+}
+
+type ssmList struct {
+	Entries []ssmEntry `xml:"entry"`
+}
+
+type ssmEntry struct {
+	Name          string `xml:"name,attr"`
+	GroupAddress  string `xml:"group-address,omitempty"`
+	SourceAddress string `xml:"source-address,omitempty"`
+	Included      string `xml:"included"`
+}
+
+type igmp struct {
+	Enable                  string  `xml:"enable"`
+	Version                 string  `xml:"version,omitempty"`
+	MaxQueryResponseTime    float64 `xml:"max-query-response-time,omitempty"`
+	QueryInterval           int     `xml:"query-interval,omitempty"`
+	LastMemberQueryInterval float64 `xml:"last-member-query-interval,omitempty"`
+	ImmediateLeave          string  `xml:"immediate-leave"`
+	Robustness              int     `xml:"robustness,omitempty"`
+	MaxGroups               string  `xml:"max-groups,omitempty"`
+	MaxSources              string  `xml:"max-sources,omitempty"`
+	RouterAlertPolicing     string  `xml:"router-alert-policing"`
+}
+
+type pim struct {
+	Enable            string          `xml:"enable"`
+	AssertInterval    int             `xml:"assert-interval,omitempty"`
+	HelloInterval     int             `xml:"hello-interval,omitempty"`
+	JoinPruneInterval int             `xml:"join-prune-interval,omitempty"`
+	DrPriority        int             `xml:"dr-priority,omitempty"`
+	BsrBorder         string          `xml:"bsr-border"`
+	AllowedNeighbors  *util.EntryType `xml:"allowed-neighbors"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:        e.Name,
+		Description: e.Description,
+		Interfaces:  util.StrToMem(e.Interfaces),
+	}
+
+	if len(e.AnySourceMulticast) != 0 || len(e.SourceSpecificMulticast) != 0 {
+		ans.Permissions = &permissions{}
+
+		if len(e.AnySourceMulticast) != 0 {
+			list := make([]asmEntry, 0, len(e.AnySourceMulticast))
+			for _, x := range e.AnySourceMulticast {
+				list = append(list, asmEntry{
+					Name:         x.Name,
+					GroupAddress: x.GroupAddress,
+					Included:     util.YesNo(x.Included),
+				})
+			}
+			ans.Permissions.Asm = &asmList{Entries: list}
+		}
+
+		if len(e.SourceSpecificMulticast) != 0 {
+			list := make([]ssmEntry, 0, len(e.SourceSpecificMulticast))
+			for _, x := range e.SourceSpecificMulticast {
+				list = append(list, ssmEntry{
+					Name:          x.Name,
+					GroupAddress:  x.GroupAddress,
+					SourceAddress: x.SourceAddress,
+					Included:      util.YesNo(x.Included),
+				})
+			}
+			ans.Permissions.Ssm = &ssmList{Entries: list}
+		}
+	}
+
+	if e.IgmpEnable || e.IgmpVersion != "" || e.IgmpMaxQueryResponseTime != 0 || e.IgmpQueryInterval != 0 || e.IgmpLastMemberQueryInterval != 0 || e.IgmpImmediateLeave || e.IgmpRobustness != 0 || e.IgmpMaxGroups != "" || e.IgmpMaxSources != "" || e.IgmpRouterAlertPolicing {
+		ans.Igmp = &igmp{
+			Enable:                  util.YesNo(e.IgmpEnable),
+			Version:                 e.IgmpVersion,
+			MaxQueryResponseTime:    e.IgmpMaxQueryResponseTime,
+			QueryInterval:           e.IgmpQueryInterval,
+			LastMemberQueryInterval: e.IgmpLastMemberQueryInterval,
+			ImmediateLeave:          util.YesNo(e.IgmpImmediateLeave),
+			Robustness:              e.IgmpRobustness,
+			MaxGroups:               e.IgmpMaxGroups,
+			MaxSources:              e.IgmpMaxSources,
+			RouterAlertPolicing:     util.YesNo(e.IgmpRouterAlertPolicing),
+		}
+	}
+
+	if e.PimEnable || e.PimAssertInterval != 0 || e.PimHelloInterval != 0 || e.PimJoinPruneInterval != 0 || e.PimDrPriority != 0 || e.PimBsrBorder || len(e.PimAllowedNeighbors) != 0 {
+		ans.Pim = &pim{
+			Enable:            util.YesNo(e.PimEnable),
+			AssertInterval:    e.PimAssertInterval,
+			HelloInterval:     e.PimHelloInterval,
+			JoinPruneInterval: e.PimJoinPruneInterval,
+			DrPriority:        e.PimDrPriority,
+			BsrBorder:         util.YesNo(e.PimBsrBorder),
+			AllowedNeighbors:  util.StrToEnt(e.PimAllowedNeighbors),
+		}
+	}
+
+	return ans
+}
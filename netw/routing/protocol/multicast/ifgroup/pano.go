@@ -0,0 +1,93 @@
+package ifgroup
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// PanoIfGroup is a namespace struct, included as part of pango.Panorama.
+type PanoIfGroup struct {
+	ns *namespace.Standard[Entry]
+}
+
+// Initialize is invoked when Initialize on the pango.Panorama is called.
+func (c *PanoIfGroup) Initialize(con util.XapiClient) {
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *PanoIfGroup) GetList(tmpl, ts, vr string) ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(tmpl, ts, vr, nil))
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *PanoIfGroup) ShowList(tmpl, ts, vr string) ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(tmpl, ts, vr, nil))
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *PanoIfGroup) Get(tmpl, ts, vr, name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath(tmpl, ts, vr, []string{name}), name)
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *PanoIfGroup) GetAll(tmpl, ts, vr string) ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(tmpl, ts, vr, nil))
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *PanoIfGroup) Show(tmpl, ts, vr, name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath(tmpl, ts, vr, []string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *PanoIfGroup) ShowAll(tmpl, ts, vr string) ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(tmpl, ts, vr, nil))
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *PanoIfGroup) Set(tmpl, ts, vr string, e ...Entry) error {
+	return c.ns.SetEntries(c.pather(tmpl, ts, vr), e...)
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *PanoIfGroup) Edit(tmpl, ts, vr string, e Entry) error {
+	return c.ns.EditEntry(c.pather(tmpl, ts, vr), e)
+}
+
+// Delete removes the given objects.
+//
+// Objects can be either a string or an Entry object.
+func (c *PanoIfGroup) Delete(tmpl, ts, vr string, e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(tmpl, ts, vr), e...)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *PanoIfGroup) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *PanoIfGroup) pather(tmpl, ts, vr string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(tmpl, ts, vr, v)
+	}
+}
+
+func (c *PanoIfGroup) xpath(tmpl, ts, vr string, vals []string) []string {
+	ans := make([]string, 0, 16)
+	ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"network",
+		"virtual-router",
+		util.AsEntryXpath([]string{vr}),
+		"multicast",
+		"interface-group",
+		util.AsEntryXpath(vals),
+	)
+
+	return ans
+}
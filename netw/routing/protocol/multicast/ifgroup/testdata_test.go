@@ -0,0 +1,54 @@
+package ifgroup
+
+type tc struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"interfaces only", Entry{
+			Name:        "g1",
+			Description: "my group",
+			Interfaces:  []string{"ethernet1/1", "ethernet1/2"},
+		}},
+		{"igmp", Entry{
+			Name:                        "g2",
+			Interfaces:                  []string{"ethernet1/1"},
+			IgmpEnable:                  true,
+			IgmpVersion:                 IgmpVersion3,
+			IgmpMaxQueryResponseTime:    10,
+			IgmpQueryInterval:           125,
+			IgmpLastMemberQueryInterval: 1.5,
+			IgmpImmediateLeave:          true,
+			IgmpRobustness:              2,
+			IgmpMaxGroups:               "unlimited",
+			IgmpMaxSources:              "100",
+			IgmpRouterAlertPolicing:     true,
+		}},
+		{"pim", Entry{
+			Name:                 "g3",
+			Interfaces:           []string{"ethernet1/3"},
+			PimEnable:            true,
+			PimAssertInterval:    177,
+			PimHelloInterval:     30,
+			PimJoinPruneInterval: 60,
+			PimDrPriority:        1,
+			PimBsrBorder:         true,
+			PimAllowedNeighbors:  []string{"10.1.1.1", "10.1.1.2"},
+		}},
+		{"group permissions", Entry{
+			Name:       "g4",
+			Interfaces: []string{"ethernet1/4"},
+			AnySourceMulticast: []AnySource{
+				{Name: "asm1", GroupAddress: "239.1.1.0/24", Included: true},
+				{Name: "asm2", GroupAddress: "239.2.1.0/24"},
+			},
+			SourceSpecificMulticast: []SourceSpecific{
+				{Name: "ssm1", GroupAddress: "232.1.1.1", SourceAddress: "10.2.2.2", Included: true},
+			},
+			IgmpEnable: true,
+			PimEnable:  true,
+		}},
+	}
+}
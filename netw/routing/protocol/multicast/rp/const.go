@@ -0,0 +1,6 @@
+package rp
+
+const (
+	singular = "external rendezvous point"
+	plural   = "external rendezvous points"
+)
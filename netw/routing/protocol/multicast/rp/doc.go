@@ -0,0 +1,10 @@
+/*
+Package rp is the client.Network.MulticastExternalRp namespace.
+
+Each external rendezvous point is named after its IP address.  The static
+local rendezvous point is part of the client.Network.MulticastConfig
+namespace.
+
+Normalized object:  Entry
+*/
+package rp
@@ -0,0 +1,88 @@
+package rp
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an external
+// multicast rendezvous point.
+type Entry struct {
+	Name           string
+	GroupAddresses []string // unordered
+	Override       bool
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.GroupAddresses = s.GroupAddresses
+	o.Override = s.Override
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the IP address of this rendezvous point.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:           o.Name,
+		GroupAddresses: util.MemToStr(o.GroupAddresses),
+		Override:       util.AsBool(o.Override),
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName        xml.Name         `xml:"entry"`
+	Name           string           `xml:"name,attr"`
+	GroupAddresses *util.MemberType `xml:"group-addresses"`
+	Override       string           `xml:"override"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:           e.Name,
+		GroupAddresses: util.StrToMem(e.GroupAddresses),
+		Override:       util.YesNo(e.Override),
+	}
+
+	return ans
+}
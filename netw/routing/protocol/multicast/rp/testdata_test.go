@@ -0,0 +1,19 @@
+package rp
+
+type tc struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"basic", Entry{
+			Name: "10.1.1.1",
+		}},
+		{"with groups", Entry{
+			Name:           "10.1.1.2",
+			GroupAddresses: []string{"239.1.0.0/16", "239.2.0.0/16"},
+			Override:       true,
+		}},
+	}
+}
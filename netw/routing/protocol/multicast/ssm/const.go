@@ -0,0 +1,6 @@
+package ssm
+
+const (
+	singular = "ssm address space"
+	plural   = "ssm address spaces"
+)
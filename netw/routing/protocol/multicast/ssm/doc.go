@@ -0,0 +1,6 @@
+/*
+Package ssm is the client.Network.MulticastSsmAddressSpace namespace.
+
+Normalized object:  Entry
+*/
+package ssm
@@ -0,0 +1,88 @@
+package ssm
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a
+// source-specific multicast (SSM) address space.
+type Entry struct {
+	Name         string
+	GroupAddress string
+	Included     bool
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.GroupAddress = s.GroupAddress
+	o.Included = s.Included
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this address space.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:         o.Name,
+		GroupAddress: o.GroupAddress,
+		Included:     util.AsBool(o.Included),
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName      xml.Name `xml:"entry"`
+	Name         string   `xml:"name,attr"`
+	GroupAddress string   `xml:"group-address,omitempty"`
+	Included     string   `xml:"included"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:         e.Name,
+		GroupAddress: e.GroupAddress,
+		Included:     util.YesNo(e.Included),
+	}
+
+	return ans
+}
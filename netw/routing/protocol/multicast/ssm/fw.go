@@ -0,0 +1,89 @@
+package ssm
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwSsm is a namespace struct, included as part of pango.Firewall.
+type FwSsm struct {
+	ns *namespace.Standard[Entry]
+}
+
+// Initialize is invoked when Initialize on the pango.Firewall is called.
+func (c *FwSsm) Initialize(con util.XapiClient) {
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *FwSsm) GetList(vr string) ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(vr, nil))
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *FwSsm) ShowList(vr string) ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(vr, nil))
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *FwSsm) Get(vr, name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath(vr, []string{name}), name)
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *FwSsm) GetAll(vr string) ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(vr, nil))
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *FwSsm) Show(vr, name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath(vr, []string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *FwSsm) ShowAll(vr string) ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(vr, nil))
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *FwSsm) Set(vr string, e ...Entry) error {
+	return c.ns.SetEntries(c.pather(vr), e...)
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *FwSsm) Edit(vr string, e Entry) error {
+	return c.ns.EditEntry(c.pather(vr), e)
+}
+
+// Delete removes the given objects.
+//
+// Objects can be either a string or an Entry object.
+func (c *FwSsm) Delete(vr string, e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(vr), e...)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *FwSsm) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *FwSsm) pather(vr string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(vr, v)
+	}
+}
+
+func (c *FwSsm) xpath(vr string, vals []string) []string {
+	return []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"network",
+		"virtual-router",
+		util.AsEntryXpath([]string{vr}),
+		"multicast",
+		"ssm-address-space",
+		util.AsEntryXpath(vals),
+	}
+}
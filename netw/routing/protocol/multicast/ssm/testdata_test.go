@@ -0,0 +1,20 @@
+package ssm
+
+type tc struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"excluded", Entry{
+			Name:         "s1",
+			GroupAddress: "232.0.0.0/8",
+		}},
+		{"included", Entry{
+			Name:         "s2",
+			GroupAddress: "232.1.0.0/16",
+			Included:     true,
+		}},
+	}
+}
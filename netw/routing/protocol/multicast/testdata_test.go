@@ -0,0 +1,40 @@
+package multicast
+
+type tc struct {
+	desc string
+	conf Config
+}
+
+func getTests() []tc {
+	return []tc{
+		{"disabled", Config{}},
+		{"enabled with ageout", Config{
+			Enable:          true,
+			RouteAgeoutTime: 300,
+		}},
+		{"static rp", Config{
+			Enable:                 true,
+			StaticRpInterface:      "ethernet1/1",
+			StaticRpAddress:        "10.1.1.1/24",
+			StaticRpOverride:       true,
+			StaticRpGroupAddresses: []string{"224.0.0.0/4"},
+		}},
+		{"static rp with raw", Config{
+			Enable:            true,
+			StaticRpInterface: "ethernet1/1",
+			StaticRpAddress:   "10.1.1.1/24",
+			raw: map[string]string{
+				"erp": "external rps",
+				"ifg": "interface groups",
+				"ssm": "ssm address space",
+				"spt": "spt threshold",
+			},
+		}},
+		{"candidate rp", Config{
+			Enable: true,
+			raw: map[string]string{
+				"crp": "candidate rp",
+			},
+		}},
+	}
+}
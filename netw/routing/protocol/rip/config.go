@@ -0,0 +1,207 @@
+package rip
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Config is a normalized, version independent representation of a virtual
+// router's RIP configuration.
+type Config struct {
+	Enable                        bool
+	RejectDefaultRoute            bool
+	AllowRedistributeDefaultRoute bool
+	BfdProfile                    string // 7.1+ ; XML: global-bfd/profile or the word "None"
+	IntervalSeconds               int
+	UpdateIntervals               int
+	ExpireIntervals               int
+	DeleteIntervals               int
+
+	raw map[string]string
+}
+
+// Copy copies the information from source Config `s` to this object.
+func (o *Config) Copy(s Config) {
+	o.Enable = s.Enable
+	o.RejectDefaultRoute = s.RejectDefaultRoute
+	o.AllowRedistributeDefaultRoute = s.AllowRedistributeDefaultRoute
+	o.BfdProfile = s.BfdProfile
+	o.IntervalSeconds = s.IntervalSeconds
+	o.UpdateIntervals = s.UpdateIntervals
+	o.ExpireIntervals = s.ExpireIntervals
+	o.DeleteIntervals = s.DeleteIntervals
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Config
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>rip"`
+}
+
+func (o *container_v1) Normalize() Config {
+	ans := Config{
+		Enable:                        util.AsBool(o.Answer.Enable),
+		RejectDefaultRoute:            util.AsBool(o.Answer.RejectDefaultRoute),
+		AllowRedistributeDefaultRoute: util.AsBool(o.Answer.AllowRedistributeDefaultRoute),
+	}
+
+	if o.Answer.Timers != nil {
+		ans.IntervalSeconds = o.Answer.Timers.IntervalSeconds
+		ans.UpdateIntervals = o.Answer.Timers.UpdateIntervals
+		ans.ExpireIntervals = o.Answer.Timers.ExpireIntervals
+		ans.DeleteIntervals = o.Answer.Timers.DeleteIntervals
+	}
+
+	ans.raw = o.Answer.raws()
+
+	return ans
+}
+
+type container_v2 struct {
+	Answer entry_v2 `xml:"result>rip"`
+}
+
+func (o *container_v2) Normalize() Config {
+	ans := Config{
+		Enable:                        util.AsBool(o.Answer.Enable),
+		RejectDefaultRoute:            util.AsBool(o.Answer.RejectDefaultRoute),
+		AllowRedistributeDefaultRoute: util.AsBool(o.Answer.AllowRedistributeDefaultRoute),
+	}
+
+	if o.Answer.GlobalBfd != nil {
+		ans.BfdProfile = o.Answer.GlobalBfd.BfdProfile
+	}
+
+	if o.Answer.Timers != nil {
+		ans.IntervalSeconds = o.Answer.Timers.IntervalSeconds
+		ans.UpdateIntervals = o.Answer.Timers.UpdateIntervals
+		ans.ExpireIntervals = o.Answer.Timers.ExpireIntervals
+		ans.DeleteIntervals = o.Answer.Timers.DeleteIntervals
+	}
+
+	ans.raw = o.Answer.raws()
+
+	return ans
+}
+
+// rawChildren are the RIP children managed by other namespaces.
+type rawChildren struct {
+	AuthProfile *util.RawXml `xml:"auth-profile"`
+	Interface   *util.RawXml `xml:"interface"`
+	ExportRules *util.RawXml `xml:"export-rules"`
+}
+
+func (o *rawChildren) raws() map[string]string {
+	raw := make(map[string]string)
+
+	if o.AuthProfile != nil {
+		raw["ap"] = util.CleanRawXml(o.AuthProfile.Text)
+	}
+	if o.Interface != nil {
+		raw["iface"] = util.CleanRawXml(o.Interface.Text)
+	}
+	if o.ExportRules != nil {
+		raw["er"] = util.CleanRawXml(o.ExportRules.Text)
+	}
+
+	if len(raw) == 0 {
+		return nil
+	}
+
+	return raw
+}
+
+func (o *rawChildren) specify(raw map[string]string) {
+	if text := raw["ap"]; text != "" {
+		o.AuthProfile = &util.RawXml{text}
+	}
+	if text := raw["iface"]; text != "" {
+		o.Interface = &util.RawXml{text}
+	}
+	if text := raw["er"]; text != "" {
+		o.ExportRules = &util.RawXml{text}
+	}
+}
+
+type entry_v1 struct {
+	XMLName                       xml.Name `xml:"rip"`
+	Enable                        string   `xml:"enable"`
+	RejectDefaultRoute            string   `xml:"reject-default-route"`
+	AllowRedistributeDefaultRoute string   `xml:"allow-redist-default-route"`
+	Timers                        *timers  `xml:"timers"`
+
+	rawChildren
+}
+
+type timers struct {
+	IntervalSeconds int `xml:"interval-seconds,omitempty"`
+	UpdateIntervals int `xml:"update-intervals,omitempty"`
+	ExpireIntervals int `xml:"expire-intervals,omitempty"`
+	DeleteIntervals int `xml:"delete-intervals,omitempty"`
+}
+
+func specifyTimers(e Config) *timers {
+	if e.IntervalSeconds == 0 && e.UpdateIntervals == 0 && e.ExpireIntervals == 0 && e.DeleteIntervals == 0 {
+		return nil
+	}
+
+	return &timers{
+		IntervalSeconds: e.IntervalSeconds,
+		UpdateIntervals: e.UpdateIntervals,
+		ExpireIntervals: e.ExpireIntervals,
+		DeleteIntervals: e.DeleteIntervals,
+	}
+}
+
+func specify_v1(e Config) interface{} {
+	ans := entry_v1{
+		Enable:                        util.YesNo(e.Enable),
+		RejectDefaultRoute:            util.YesNo(e.RejectDefaultRoute),
+		AllowRedistributeDefaultRoute: util.YesNo(e.AllowRedistributeDefaultRoute),
+		Timers:                        specifyTimers(e),
+	}
+
+	ans.rawChildren.specify(e.raw)
+
+	return ans
+}
+
+// PAN-OS 7.1, adds global-bfd.
+type entry_v2 struct {
+	XMLName                       xml.Name   `xml:"rip"`
+	Enable                        string     `xml:"enable"`
+	RejectDefaultRoute            string     `xml:"reject-default-route"`
+	AllowRedistributeDefaultRoute string     `xml:"allow-redist-default-route"`
+	Timers                        *timers    `xml:"timers"`
+	GlobalBfd                     *globalBfd `xml:"global-bfd"`
+
+	rawChildren
+}
+
+type globalBfd struct {
+	BfdProfile string `xml:"profile,omitempty"`
+}
+
+func specify_v2(e Config) interface{} {
+	ans := entry_v2{
+		Enable:                        util.YesNo(e.Enable),
+		RejectDefaultRoute:            util.YesNo(e.RejectDefaultRoute),
+		AllowRedistributeDefaultRoute: util.YesNo(e.AllowRedistributeDefaultRoute),
+		Timers:                        specifyTimers(e),
+	}
+
+	if e.BfdProfile != "" {
+		ans.GlobalBfd = &globalBfd{
+			BfdProfile: e.BfdProfile,
+		}
+	}
+
+	ans.rawChildren.specify(e.raw)
+
+	return ans
+}
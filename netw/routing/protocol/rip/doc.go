@@ -0,0 +1,10 @@
+/*
+Package rip is the client.Network.RipConfig namespace.
+
+The RIP interfaces, auth profiles, and export rules of a virtual router are
+managed using their own namespaces, and are left as-is when the RIP config is
+updated with this namespace.
+
+Normalized object:  Config
+*/
+package rip
@@ -0,0 +1,8 @@
+/*
+Package exp is the client.Network.RipExport namespace.
+
+Each export rule is named after the redistribution profile it uses.
+
+Normalized object:  Entry
+*/
+package exp
@@ -0,0 +1,23 @@
+package exp
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type tc struct {
+	desc    string
+	version version.Number
+	conf    Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"no metric", version.Number{7, 1, 0, ""}, Entry{
+			Name: "my redist profile",
+		}},
+		{"with metric", version.Number{7, 1, 0, ""}, Entry{
+			Name:   "my redist profile",
+			Metric: 3,
+		}},
+	}
+}
@@ -0,0 +1,26 @@
+package iface
+
+// Valid values for Mode.
+const (
+	ModeNormal   = "normal"
+	ModePassive  = "passive"
+	ModeSendOnly = "send-only"
+)
+
+// Valid values for SplitHorizon.
+const (
+	SplitHorizonEnabled       = "split-horizon"
+	SplitHorizonDisabled      = "no-split-horizon"
+	SplitHorizonPoisonReverse = "split-horizon-with-poison-reverse"
+)
+
+// Valid values for DefaultRoute.
+const (
+	DefaultRouteDisable   = "disable"
+	DefaultRouteAdvertise = "advertise"
+)
+
+const (
+	singular = "rip interface"
+	plural   = "rip interfaces"
+)
@@ -0,0 +1,8 @@
+/*
+Package iface is the client.Network.RipInterface namespace.
+
+Each object is named after the interface that RIP runs on.
+
+Normalized object:  Entry
+*/
+package iface
@@ -0,0 +1,221 @@
+package iface
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a RIP
+// interface.
+type Entry struct {
+	Name               string
+	Enable             bool
+	Mode               string
+	SplitHorizon       string
+	AuthProfile        string // XML: authentication
+	DefaultRoute       string
+	DefaultRouteMetric int
+	BfdProfile         string // 7.1+
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Enable = s.Enable
+	o.Mode = s.Mode
+	o.SplitHorizon = s.SplitHorizon
+	o.AuthProfile = s.AuthProfile
+	o.DefaultRoute = s.DefaultRoute
+	o.DefaultRouteMetric = s.DefaultRouteMetric
+	o.BfdProfile = s.BfdProfile
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of the interface.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:         o.Name,
+		Enable:       util.AsBool(o.Enable),
+		Mode:         o.Mode,
+		SplitHorizon: o.SplitHorizon,
+		AuthProfile:  o.AuthProfile,
+	}
+
+	ans.DefaultRoute, ans.DefaultRouteMetric = o.DefaultRoute.normalize()
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName      xml.Name      `xml:"entry"`
+	Name         string        `xml:"name,attr"`
+	Enable       string        `xml:"enable"`
+	AuthProfile  string        `xml:"authentication,omitempty"`
+	Mode         string        `xml:"mode,omitempty"`
+	SplitHorizon string        `xml:"split-horizon,omitempty"`
+	DefaultRoute *defaultRoute `xml:"default-route"`
+}
+
+type defaultRoute struct {
+	Disable   *string    `xml:"disable"`
+	Advertise *advertise `xml:"advertise"`
+}
+
+type advertise struct {
+	Metric int `xml:"metric,omitempty"`
+}
+
+func (o *defaultRoute) normalize() (string, int) {
+	switch {
+	case o == nil:
+		return "", 0
+	case o.Disable != nil:
+		return DefaultRouteDisable, 0
+	case o.Advertise != nil:
+		return DefaultRouteAdvertise, o.Advertise.Metric
+	}
+
+	return "", 0
+}
+
+func specifyDefaultRoute(e Entry) *defaultRoute {
+	switch e.DefaultRoute {
+	case DefaultRouteDisable:
+		s := ""
+		return &defaultRoute{Disable: &s}
+	case DefaultRouteAdvertise:
+		return &defaultRoute{
+			Advertise: &advertise{
+				Metric: e.DefaultRouteMetric,
+			},
+		}
+	}
+
+	return nil
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:         e.Name,
+		Enable:       util.YesNo(e.Enable),
+		AuthProfile:  e.AuthProfile,
+		Mode:         e.Mode,
+		SplitHorizon: e.SplitHorizon,
+		DefaultRoute: specifyDefaultRoute(e),
+	}
+
+	return ans
+}
+
+// PAN-OS 7.1, adds bfd.
+type container_v2 struct {
+	Answer []entry_v2 `xml:"entry"`
+}
+
+func (o *container_v2) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v2) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v2) normalize() Entry {
+	ans := Entry{
+		Name:         o.Name,
+		Enable:       util.AsBool(o.Enable),
+		Mode:         o.Mode,
+		SplitHorizon: o.SplitHorizon,
+		AuthProfile:  o.AuthProfile,
+	}
+
+	ans.DefaultRoute, ans.DefaultRouteMetric = o.DefaultRoute.normalize()
+
+	if o.Bfd != nil {
+		ans.BfdProfile = o.Bfd.BfdProfile
+	}
+
+	return ans
+}
+
+type entry_v2 struct {
+	XMLName      xml.Name      `xml:"entry"`
+	Name         string        `xml:"name,attr"`
+	Enable       string        `xml:"enable"`
+	AuthProfile  string        `xml:"authentication,omitempty"`
+	Mode         string        `xml:"mode,omitempty"`
+	SplitHorizon string        `xml:"split-horizon,omitempty"`
+	DefaultRoute *defaultRoute `xml:"default-route"`
+	Bfd          *bfd          `xml:"bfd"`
+}
+
+type bfd struct {
+	BfdProfile string `xml:"profile,omitempty"`
+}
+
+func specify_v2(e Entry) interface{} {
+	ans := entry_v2{
+		Name:         e.Name,
+		Enable:       util.YesNo(e.Enable),
+		AuthProfile:  e.AuthProfile,
+		Mode:         e.Mode,
+		SplitHorizon: e.SplitHorizon,
+		DefaultRoute: specifyDefaultRoute(e),
+	}
+
+	if e.BfdProfile != "" {
+		ans.Bfd = &bfd{
+			BfdProfile: e.BfdProfile,
+		}
+	}
+
+	return ans
+}
@@ -0,0 +1,99 @@
+package iface
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+// FwIface is a namespace struct, included as part of pango.Firewall.
+type FwIface struct {
+	con util.XapiClient
+	ns  *namespace.Standard[Entry]
+}
+
+// Initialize is invoked when Initialize on the pango.Firewall is called.
+func (c *FwIface) Initialize(con util.XapiClient) {
+	c.con = con
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *FwIface) GetList(vr string) ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(vr, nil))
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *FwIface) ShowList(vr string) ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(vr, nil))
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *FwIface) Get(vr, name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath(vr, []string{name}), name)
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *FwIface) GetAll(vr string) ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(vr, nil))
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *FwIface) Show(vr, name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath(vr, []string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *FwIface) ShowAll(vr string) ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(vr, nil))
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *FwIface) Set(vr string, e ...Entry) error {
+	return c.ns.SetEntries(c.pather(vr), e...)
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *FwIface) Edit(vr string, e Entry) error {
+	return c.ns.EditEntry(c.pather(vr), e)
+}
+
+// Delete removes the given objects.
+//
+// Objects can be either a string or an Entry object.
+func (c *FwIface) Delete(vr string, e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(vr), e...)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *FwIface) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	v := c.con.Versioning()
+
+	if v.Gte(version.Number{7, 1, 0, ""}) {
+		return &container_v2{}, specify_v2
+	} else {
+		return &container_v1{}, specify_v1
+	}
+}
+
+func (c *FwIface) pather(vr string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(vr, v)
+	}
+}
+
+func (c *FwIface) xpath(vr string, vals []string) []string {
+	return []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"network",
+		"virtual-router",
+		util.AsEntryXpath([]string{vr}),
+		"protocol",
+		"rip",
+		"interface",
+		util.AsEntryXpath(vals),
+	}
+}
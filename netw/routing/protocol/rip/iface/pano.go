@@ -0,0 +1,103 @@
+package iface
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+// PanoIface is a namespace struct, included as part of pango.Panorama.
+type PanoIface struct {
+	con util.XapiClient
+	ns  *namespace.Standard[Entry]
+}
+
+// Initialize is invoked when Initialize on the pango.Panorama is called.
+func (c *PanoIface) Initialize(con util.XapiClient) {
+	c.con = con
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *PanoIface) GetList(tmpl, ts, vr string) ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(tmpl, ts, vr, nil))
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *PanoIface) ShowList(tmpl, ts, vr string) ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(tmpl, ts, vr, nil))
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *PanoIface) Get(tmpl, ts, vr, name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath(tmpl, ts, vr, []string{name}), name)
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *PanoIface) GetAll(tmpl, ts, vr string) ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(tmpl, ts, vr, nil))
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *PanoIface) Show(tmpl, ts, vr, name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath(tmpl, ts, vr, []string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *PanoIface) ShowAll(tmpl, ts, vr string) ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(tmpl, ts, vr, nil))
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *PanoIface) Set(tmpl, ts, vr string, e ...Entry) error {
+	return c.ns.SetEntries(c.pather(tmpl, ts, vr), e...)
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *PanoIface) Edit(tmpl, ts, vr string, e Entry) error {
+	return c.ns.EditEntry(c.pather(tmpl, ts, vr), e)
+}
+
+// Delete removes the given objects.
+//
+// Objects can be either a string or an Entry object.
+func (c *PanoIface) Delete(tmpl, ts, vr string, e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(tmpl, ts, vr), e...)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *PanoIface) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	v := c.con.Versioning()
+
+	if v.Gte(version.Number{7, 1, 0, ""}) {
+		return &container_v2{}, specify_v2
+	} else {
+		return &container_v1{}, specify_v1
+	}
+}
+
+func (c *PanoIface) pather(tmpl, ts, vr string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(tmpl, ts, vr, v)
+	}
+}
+
+func (c *PanoIface) xpath(tmpl, ts, vr string, vals []string) []string {
+	ans := make([]string, 0, 16)
+	ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"network",
+		"virtual-router",
+		util.AsEntryXpath([]string{vr}),
+		"protocol",
+		"rip",
+		"interface",
+		util.AsEntryXpath(vals),
+	)
+
+	return ans
+}
@@ -0,0 +1,49 @@
+package iface
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type tc struct {
+	desc    string
+	version version.Number
+	conf    Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"v1 basic", version.Number{7, 0, 0, ""}, Entry{
+			Name:   "ethernet1/1",
+			Enable: true,
+		}},
+		{"v1 default route disabled", version.Number{7, 0, 0, ""}, Entry{
+			Name:         "ethernet1/1",
+			Enable:       true,
+			Mode:         ModePassive,
+			SplitHorizon: SplitHorizonDisabled,
+			AuthProfile:  "my auth profile",
+			DefaultRoute: DefaultRouteDisable,
+		}},
+		{"v1 default route advertised", version.Number{7, 0, 0, ""}, Entry{
+			Name:               "ethernet1/1",
+			Mode:               ModeNormal,
+			SplitHorizon:       SplitHorizonPoisonReverse,
+			DefaultRoute:       DefaultRouteAdvertise,
+			DefaultRouteMetric: 5,
+		}},
+		{"v2 basic", version.Number{7, 1, 0, ""}, Entry{
+			Name:   "ethernet1/1",
+			Enable: true,
+		}},
+		{"v2 with bfd", version.Number{7, 1, 0, ""}, Entry{
+			Name:               "ethernet1/2",
+			Enable:             true,
+			Mode:               ModeSendOnly,
+			SplitHorizon:       SplitHorizonEnabled,
+			AuthProfile:        "my auth profile",
+			DefaultRoute:       DefaultRouteAdvertise,
+			DefaultRouteMetric: 10,
+			BfdProfile:         "my bfd profile",
+		}},
+	}
+}
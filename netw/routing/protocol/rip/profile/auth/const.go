@@ -0,0 +1,12 @@
+package auth
+
+// Valid values for Type.
+const (
+	TypePassword = "password"
+	TypeMd5      = "md5"
+)
+
+const (
+	singular = "rip auth profile"
+	plural   = "rip auth profiles"
+)
@@ -0,0 +1,147 @@
+package auth
+
+import (
+	"encoding/xml"
+	"strconv"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a RIP auth
+// profile.
+//
+// Type is either TypePassword (with Password set) or TypeMd5 (with Md5Keys
+// set).
+type Entry struct {
+	Name     string
+	Type     string
+	Password string
+	Md5Keys  []Md5Key
+}
+
+// Md5Key is a single MD5 key of a RIP auth profile.
+type Md5Key struct {
+	KeyId     int
+	Key       string
+	Preferred bool
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Type = s.Type
+	o.Password = s.Password
+	if s.Md5Keys == nil {
+		o.Md5Keys = nil
+	} else {
+		o.Md5Keys = make([]Md5Key, len(s.Md5Keys))
+		copy(o.Md5Keys, s.Md5Keys)
+	}
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this auth profile.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name: o.Name,
+	}
+
+	switch {
+	case o.Password != nil:
+		ans.Type = TypePassword
+		ans.Password = *o.Password
+	case o.Md5 != nil:
+		ans.Type = TypeMd5
+		if len(o.Md5.Entries) > 0 {
+			ans.Md5Keys = make([]Md5Key, 0, len(o.Md5.Entries))
+			for _, x := range o.Md5.Entries {
+				id, _ := strconv.Atoi(x.Name)
+				ans.Md5Keys = append(ans.Md5Keys, Md5Key{
+					KeyId:     id,
+					Key:       x.Key,
+					Preferred: util.AsBool(x.Preferred),
+				})
+			}
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName  xml.Name `xml:"entry"`
+	Name     string   `xml:"name,attr"`
+	Password *string  `xml:"password"`
+	Md5      *md5List `xml:"md5"`
+}
+
+type md5List struct {
+	Entries []md5Entry `xml:"entry"`
+}
+
+type md5Entry struct {
+	Name      string `xml:"name,attr"`
+	Key       string `xml:"key,omitempty"`
+	Preferred string `xml:"preferred"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name: e.Name,
+	}
+
+	switch e.Type {
+	case TypePassword:
+		s := e.Password
+		ans.Password = &s
+	case TypeMd5:
+		list := make([]md5Entry, 0, len(e.Md5Keys))
+		for _, x := range e.Md5Keys {
+			list = append(list, md5Entry{
+				Name:      strconv.Itoa(x.KeyId),
+				Key:       x.Key,
+				Preferred: util.YesNo(x.Preferred),
+			})
+		}
+		ans.Md5 = &md5List{Entries: list}
+	}
+
+	return ans
+}
@@ -0,0 +1,29 @@
+package auth
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type tc struct {
+	desc    string
+	version version.Number
+	conf    Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"password", version.Number{7, 1, 0, ""}, Entry{
+			Name:     "t1",
+			Type:     TypePassword,
+			Password: "secret",
+		}},
+		{"md5", version.Number{7, 1, 0, ""}, Entry{
+			Name: "t2",
+			Type: TypeMd5,
+			Md5Keys: []Md5Key{
+				{KeyId: 1, Key: "first", Preferred: true},
+				{KeyId: 2, Key: "second"},
+			},
+		}},
+	}
+}
@@ -0,0 +1,52 @@
+package rip
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type tc struct {
+	desc    string
+	version version.Number
+	conf    Config
+}
+
+func getTests() []tc {
+	return []tc{
+		{"v1 basic", version.Number{7, 0, 0, ""}, Config{
+			Enable:             true,
+			RejectDefaultRoute: true,
+		}},
+		{"v1 timers with raw", version.Number{7, 0, 0, ""}, Config{
+			Enable:                        true,
+			AllowRedistributeDefaultRoute: true,
+			IntervalSeconds:               1,
+			UpdateIntervals:               30,
+			ExpireIntervals:               180,
+			DeleteIntervals:               120,
+			raw: map[string]string{
+				"ap":    "auth profiles",
+				"iface": "interfaces",
+				"er":    "export rules",
+			},
+		}},
+		{"v2 basic", version.Number{7, 1, 0, ""}, Config{
+			Enable:             true,
+			RejectDefaultRoute: true,
+		}},
+		{"v2 bfd and timers", version.Number{7, 1, 0, ""}, Config{
+			Enable:          true,
+			BfdProfile:      "my bfd profile",
+			IntervalSeconds: 1,
+			UpdateIntervals: 30,
+			ExpireIntervals: 180,
+			DeleteIntervals: 120,
+		}},
+		{"v2 with raw", version.Number{7, 1, 0, ""}, Config{
+			Enable:     true,
+			BfdProfile: "None",
+			raw: map[string]string{
+				"iface": "interfaces",
+			},
+		}},
+	}
+}
@@ -5,7 +5,8 @@ Only valid for PAN-OS 9.0+.
 
 GRE tunnels are not imported into the vsys.
 
-Panorama seems to have GRE tunnels in templates only, not template stacks.
+For Panorama, specify either the template ("tmpl") or the template stack
+("ts") that the GRE tunnels are in.
 
 Normalized object:  Entry
 */
@@ -6,7 +6,7 @@ import (
 	"github.com/PaloAltoNetworks/pango/util"
 )
 
-// Entry is a normalized, version independent representation of a peer.
+// Entry is a normalized, version independent representation of a GRE tunnel.
 type Entry struct {
 	Name               string
 	Interface          string
@@ -40,6 +40,16 @@ func (o *Entry) Copy(s Entry) {
 	o.Disabled = s.Disabled
 }
 
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
 /** Structs / functions for this namespace. **/
 
 type normalizer interface {
@@ -51,29 +61,46 @@ type container_v1 struct {
 }
 
 func (o *container_v1) Normalize() Entry {
+	return o.Answer.normalize()
+}
+
+type list_v1 struct {
+	Answer []entry_v1 `xml:"result>entry"`
+}
+
+func (o *list_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o entry_v1) normalize() Entry {
 	ans := Entry{
-		Name:            o.Answer.Name,
-		Interface:       o.Answer.Local.Interface,
-		PeerAddress:     o.Answer.Peer.PeerAddress,
-		TunnelInterface: o.Answer.TunnelInterface,
-		Ttl:             o.Answer.Ttl,
-		CopyTos:         util.AsBool(o.Answer.CopyTos),
-		Disabled:        util.AsBool(o.Answer.Disabled),
+		Name:            o.Name,
+		Interface:       o.Local.Interface,
+		PeerAddress:     o.Peer.PeerAddress,
+		TunnelInterface: o.TunnelInterface,
+		Ttl:             o.Ttl,
+		CopyTos:         util.AsBool(o.CopyTos),
+		Disabled:        util.AsBool(o.Disabled),
 	}
 
-	if o.Answer.Local.Ip != "" {
+	if o.Local.Ip != "" {
 		ans.LocalAddressType = LocalAddressTypeIp
-		ans.LocalAddressValue = o.Answer.Local.Ip
-	} else if o.Answer.Local.FloatingIp != "" {
+		ans.LocalAddressValue = o.Local.Ip
+	} else if o.Local.FloatingIp != "" {
 		ans.LocalAddressType = LocalAddressTypeFloatingIp
-		ans.LocalAddressValue = o.Answer.Local.FloatingIp
+		ans.LocalAddressValue = o.Local.FloatingIp
 	}
 
-	if o.Answer.KeepAlive != nil {
-		ans.EnableKeepAlive = util.AsBool(o.Answer.KeepAlive.EnableKeepAlive)
-		ans.KeepAliveInterval = o.Answer.KeepAlive.KeepAliveInterval
-		ans.KeepAliveRetry = o.Answer.KeepAlive.KeepAliveRetry
-		ans.KeepAliveHoldTimer = o.Answer.KeepAlive.KeepAliveHoldTimer
+	if o.KeepAlive != nil {
+		ans.EnableKeepAlive = util.AsBool(o.KeepAlive.EnableKeepAlive)
+		ans.KeepAliveInterval = o.KeepAlive.KeepAliveInterval
+		ans.KeepAliveRetry = o.KeepAlive.KeepAliveRetry
+		ans.KeepAliveHoldTimer = o.KeepAlive.KeepAliveHoldTimer
 	}
 
 	return ans
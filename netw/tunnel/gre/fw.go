@@ -43,6 +43,18 @@ func (c *FwGre) Show(name string) (Entry, error) {
 	return c.details(c.con.Show, name)
 }
 
+// GetAll performs GET to retrieve information for all objects.
+func (c *FwGre) GetAll() ([]Entry, error) {
+	c.con.LogQuery("(get) list of %s", plural)
+	return c.all(c.con.Get)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *FwGre) ShowAll() ([]Entry, error) {
+	c.con.LogQuery("(show) list of %s", plural)
+	return c.all(c.con.Show)
+}
+
 // Set performs SET to create / update one or more objects.
 func (c *FwGre) Set(e ...Entry) error {
 	var err error
@@ -138,6 +150,19 @@ func (c *FwGre) details(fn util.Retriever, name string) (Entry, error) {
 	return ans, nil
 }
 
+func (c *FwGre) all(fn util.Retriever) ([]Entry, error) {
+	path := c.xpath(nil)
+	obj := &list_v1{}
+	if _, err := fn(path, nil, obj); err != nil {
+		if err.Error() == "No such node" || err.Error() == "Object not found" {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return obj.Normalize(), nil
+}
+
 func (c *FwGre) xpath(vals []string) []string {
 	return []string{
 		"config",
@@ -35,3 +35,31 @@ func TestFwNormalization(t *testing.T) {
 		})
 	}
 }
+
+func TestFwGetAll(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &FwGre{}
+	ns.Initialize(mc)
+
+	var conf []Entry
+	var elms string
+	for _, tc := range getTests() {
+		mc.Reset()
+		mc.AddResp("")
+		if err := ns.Set(tc.conf); err != nil {
+			t.Fatalf("Error in set: %s", err)
+		}
+		conf = append(conf, tc.conf)
+		elms += mc.Elm
+	}
+
+	mc.Reset()
+	mc.AddResp(elms)
+	r, err := ns.GetAll()
+	if err != nil {
+		t.Fatalf("Error in get all: %s", err)
+	}
+	if !reflect.DeepEqual(conf, r) {
+		t.Errorf("%#v != %#v", conf, r)
+	}
+}
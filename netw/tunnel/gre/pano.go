@@ -43,6 +43,18 @@ func (c *PanoGre) Show(tmpl, ts, name string) (Entry, error) {
 	return c.details(c.con.Show, tmpl, ts, name)
 }
 
+// GetAll performs GET to retrieve information for all objects.
+func (c *PanoGre) GetAll(tmpl, ts string) ([]Entry, error) {
+	c.con.LogQuery("(get) list of %s", plural)
+	return c.all(c.con.Get, tmpl, ts)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *PanoGre) ShowAll(tmpl, ts string) ([]Entry, error) {
+	c.con.LogQuery("(show) list of %s", plural)
+	return c.all(c.con.Show, tmpl, ts)
+}
+
 // Set performs SET to create / update one or more objects.
 func (c *PanoGre) Set(tmpl, ts string, e ...Entry) error {
 	var err error
@@ -146,6 +158,19 @@ func (c *PanoGre) details(fn util.Retriever, tmpl, ts, name string) (Entry, erro
 	return ans, nil
 }
 
+func (c *PanoGre) all(fn util.Retriever, tmpl, ts string) ([]Entry, error) {
+	path := c.xpath(tmpl, ts, nil)
+	obj := &list_v1{}
+	if _, err := fn(path, nil, obj); err != nil {
+		if err.Error() == "No such node" || err.Error() == "Object not found" {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return obj.Normalize(), nil
+}
+
 func (c *PanoGre) xpath(tmpl, ts string, vals []string) []string {
 	ans := make([]string, 0, 12)
 	ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
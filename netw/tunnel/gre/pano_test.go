@@ -35,3 +35,19 @@ func TestPanoNormalization(t *testing.T) {
 		})
 	}
 }
+
+func TestPanoTemplateStackXpath(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &PanoGre{}
+	ns.Initialize(mc)
+
+	mc.AddResp("")
+	if _, err := ns.ShowAll("", "stack1"); err != nil {
+		t.Fatalf("Error in show all: %s", err)
+	}
+
+	expected := "/config/devices/entry[@name='localhost.localdomain']/template-stack/entry[@name='stack1']/config/devices/entry[@name='localhost.localdomain']/network/tunnel/gre/entry"
+	if mc.Path != expected {
+		t.Errorf("Path is %q, not %q", mc.Path, expected)
+	}
+}
@@ -30,13 +30,23 @@ func (o *Entry) Copy(s Entry) {
 	o.ExcludeAcls = s.ExcludeAcls
 }
 
-/** Structs / functions for this namespace. **/
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
 
-type normalizer interface {
-	Normalize() []Entry
-	Names() []string
+// EntryName returns the name of this zone.
+func (o Entry) EntryName() string {
+	return o.Name
 }
 
+/** Structs / functions for this namespace. **/
+
 type container_v1 struct {
 	Answer []entry_v1 `xml:"entry"`
 }
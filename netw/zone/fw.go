@@ -10,13 +10,13 @@ import (
 // FwZone is a namespace struct, included as part of pango.Client.
 type FwZone struct {
 	con util.XapiClient
-	ns  *namespace.Namespace
+	ns  *namespace.Standard[Entry]
 }
 
 // Initialize is invoked when Initialize on the pango.Client is called.
 func (c *FwZone) Initialize(con util.XapiClient) {
 	c.con = con
-	c.ns = namespace.New(singular, plural, con)
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
 }
 
 /*
@@ -73,106 +73,63 @@ func (c *FwZone) DeleteInterface(vsys string, zone interface{}, mode, iface stri
 
 // GetList performs GET to retrieve a list of values.
 func (c *FwZone) GetList(vsys string) ([]string, error) {
-	result, _ := c.versioning()
-	return c.ns.Listing(util.Get, c.xpath(vsys, nil), result)
+	return c.ns.List(util.Get, c.xpath(vsys, nil))
 }
 
 // ShowList performs SHOW to retrieve a list of values.
 func (c *FwZone) ShowList(vsys string) ([]string, error) {
-	result, _ := c.versioning()
-	return c.ns.Listing(util.Show, c.xpath(vsys, nil), result)
+	return c.ns.List(util.Show, c.xpath(vsys, nil))
 }
 
 // Get performs GET to retrieve information for the given uid.
 func (c *FwZone) Get(vsys, name string) (Entry, error) {
-	result, _ := c.versioning()
-	if err := c.ns.Object(util.Get, c.xpath(vsys, []string{name}), name, result); err != nil {
-		return Entry{}, err
-	}
-
-	return result.Normalize()[0], nil
+	return c.ns.One(util.Get, c.xpath(vsys, []string{name}), name)
 }
 
 // GetAll performs GET to retrieve information for all objects.
 func (c *FwZone) GetAll(vsys string) ([]Entry, error) {
-	result, _ := c.versioning()
-	if err := c.ns.Objects(util.Get, c.xpath(vsys, nil), result); err != nil {
-		return nil, err
-	}
-
-	return result.Normalize(), nil
+	return c.ns.All(util.Get, c.xpath(vsys, nil))
 }
 
-// Get performs SHOW to retrieve information for the given uid.
+// Show performs SHOW to retrieve information for the given uid.
 func (c *FwZone) Show(vsys, name string) (Entry, error) {
-	result, _ := c.versioning()
-	if err := c.ns.Object(util.Show, c.xpath(vsys, []string{name}), name, result); err != nil {
-		return Entry{}, err
-	}
-
-	return result.Normalize()[0], nil
+	return c.ns.One(util.Show, c.xpath(vsys, []string{name}), name)
 }
 
 // ShowAll performs SHOW to retrieve information for all objects.
 func (c *FwZone) ShowAll(vsys string) ([]Entry, error) {
-	result, _ := c.versioning()
-	if err := c.ns.Objects(util.Show, c.xpath(vsys, nil), result); err != nil {
-		return nil, err
-	}
-
-	return result.Normalize(), nil
+	return c.ns.All(util.Show, c.xpath(vsys, nil))
 }
 
 // Set performs SET to create / update one or more objects.
 func (c *FwZone) Set(vsys string, e ...Entry) error {
-	_, fn := c.versioning()
-	data := make([]interface{}, 0, len(e))
-	names := make([]string, 0, len(e))
-
-	for i := range e {
-		data = append(data, fn(e[i]))
-		names = append(names, e[i].Name)
-	}
-	path := c.xpath(vsys, names)
-
-	return c.ns.Set(names, path, data)
+	return c.ns.SetEntries(c.pather(vsys), e...)
 }
 
 // Edit performs EDIT to create / update one object.
 func (c *FwZone) Edit(vsys string, e Entry) error {
-	_, fn := c.versioning()
-	path := c.xpath(vsys, []string{e.Name})
-	data := fn(e)
-
-	return c.ns.Edit(e.Name, path, data)
+	return c.ns.EditEntry(c.pather(vsys), e)
 }
 
 // Delete removes the given objects.
 //
 // Objects can be either a string or an Entry object.
 func (c *FwZone) Delete(vsys string, e ...interface{}) error {
-	names := make([]string, len(e))
-	for i := range e {
-		switch v := e[i].(type) {
-		case string:
-			names[i] = v
-		case Entry:
-			names[i] = v.Name
-		default:
-			return fmt.Errorf("Unsupported type to delete: %s", v)
-		}
-	}
-
-	path := c.xpath(vsys, names)
-	return c.ns.Delete(names, path)
+	return c.ns.DeleteEntries(c.pather(vsys), e...)
 }
 
 /** Internal functions for this namespace struct **/
 
-func (c *FwZone) versioning() (normalizer, func(Entry) interface{}) {
+func (c *FwZone) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
 	return &container_v1{}, specify_v1
 }
 
+func (c *FwZone) pather(vsys string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(vsys, v)
+	}
+}
+
 func (c *FwZone) xpath(vsys string, vals []string) []string {
 	if vsys == "" {
 		vsys = "vsys1"
@@ -10,13 +10,13 @@ import (
 // PanoZone is a namespace struct, included as part of pango.Client.
 type PanoZone struct {
 	con util.XapiClient
-	ns  *namespace.Namespace
+	ns  *namespace.Standard[Entry]
 }
 
 // Initialize is invoked when Initialize on the pango.Client is called.
 func (c *PanoZone) Initialize(con util.XapiClient) {
 	c.con = con
-	c.ns = namespace.New(singular, plural, con)
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
 }
 
 /*
@@ -81,69 +81,37 @@ func (c *PanoZone) DeleteInterface(tmpl, ts, vsys string, zone interface{}, mode
 
 // GetList performs GET to retrieve a list of values.
 func (c *PanoZone) GetList(tmpl, ts, vsys string) ([]string, error) {
-	result, _ := c.versioning()
-	return c.ns.Listing(util.Get, c.xpath(tmpl, ts, vsys, nil), result)
+	return c.ns.List(util.Get, c.xpath(tmpl, ts, vsys, nil))
 }
 
 // ShowList performs SHOW to retrieve a list of values.
 func (c *PanoZone) ShowList(tmpl, ts, vsys string) ([]string, error) {
-	result, _ := c.versioning()
-	return c.ns.Listing(util.Show, c.xpath(tmpl, ts, vsys, nil), result)
+	return c.ns.List(util.Show, c.xpath(tmpl, ts, vsys, nil))
 }
 
 // Get performs GET to retrieve information for the given uid.
 func (c *PanoZone) Get(tmpl, ts, vsys, name string) (Entry, error) {
-	result, _ := c.versioning()
-	if err := c.ns.Object(util.Get, c.xpath(tmpl, ts, vsys, []string{name}), name, result); err != nil {
-		return Entry{}, err
-	}
-
-	return result.Normalize()[0], nil
+	return c.ns.One(util.Get, c.xpath(tmpl, ts, vsys, []string{name}), name)
 }
 
 // GetAll performs GET to retrieve information for all objects.
 func (c *PanoZone) GetAll(tmpl, ts, vsys string) ([]Entry, error) {
-	result, _ := c.versioning()
-	if err := c.ns.Objects(util.Get, c.xpath(tmpl, ts, vsys, nil), result); err != nil {
-		return nil, err
-	}
-
-	return result.Normalize(), nil
+	return c.ns.All(util.Get, c.xpath(tmpl, ts, vsys, nil))
 }
 
-// Get performs SHOW to retrieve information for the given uid.
+// Show performs SHOW to retrieve information for the given uid.
 func (c *PanoZone) Show(tmpl, ts, vsys, name string) (Entry, error) {
-	result, _ := c.versioning()
-	if err := c.ns.Object(util.Show, c.xpath(tmpl, ts, vsys, []string{name}), name, result); err != nil {
-		return Entry{}, err
-	}
-
-	return result.Normalize()[0], nil
+	return c.ns.One(util.Show, c.xpath(tmpl, ts, vsys, []string{name}), name)
 }
 
 // ShowAll performs SHOW to retrieve information for all objects.
 func (c *PanoZone) ShowAll(tmpl, ts, vsys string) ([]Entry, error) {
-	result, _ := c.versioning()
-	if err := c.ns.Objects(util.Show, c.xpath(tmpl, ts, vsys, nil), result); err != nil {
-		return nil, err
-	}
-
-	return result.Normalize(), nil
+	return c.ns.All(util.Show, c.xpath(tmpl, ts, vsys, nil))
 }
 
 // Set performs SET to create / update one or more objects.
 func (c *PanoZone) Set(tmpl, ts, vsys string, e ...Entry) error {
-	_, fn := c.versioning()
-	data := make([]interface{}, 0, len(e))
-	names := make([]string, 0, len(e))
-
-	for i := range e {
-		data = append(data, fn(e[i]))
-		names = append(names, e[i].Name)
-	}
-	path := c.xpath(tmpl, ts, vsys, names)
-
-	return c.ns.Set(names, path, data)
+	return c.ns.SetEntries(c.pather(tmpl, ts, vsys), e...)
 }
 
 // Edit performs EDIT to create / update one object.
@@ -152,11 +120,7 @@ func (c *PanoZone) Edit(tmpl, ts, vsys string, e Entry) error {
 		return fmt.Errorf("tmpl or ts must be specified")
 	}
 
-	_, fn := c.versioning()
-	path := c.xpath(tmpl, ts, vsys, []string{e.Name})
-	data := fn(e)
-
-	return c.ns.Edit(e.Name, path, data)
+	return c.ns.EditEntry(c.pather(tmpl, ts, vsys), e)
 }
 
 // Delete removes the given objects.
@@ -169,28 +133,21 @@ func (c *PanoZone) Delete(tmpl, ts, vsys string, e ...interface{}) error {
 		return fmt.Errorf("tmpl or ts must be specified")
 	}
 
-	names := make([]string, len(e))
-	for i := range e {
-		switch v := e[i].(type) {
-		case string:
-			names[i] = v
-		case Entry:
-			names[i] = v.Name
-		default:
-			return fmt.Errorf("Unsupported type to delete: %s", v)
-		}
-	}
-
-	path := c.xpath(tmpl, ts, vsys, names)
-	return c.ns.Delete(names, path)
+	return c.ns.DeleteEntries(c.pather(tmpl, ts, vsys), e...)
 }
 
 /** Internal functions for this namespace struct **/
 
-func (c *PanoZone) versioning() (normalizer, func(Entry) interface{}) {
+func (c *PanoZone) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
 	return &container_v1{}, specify_v1
 }
 
+func (c *PanoZone) pather(tmpl, ts, vsys string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(tmpl, ts, vsys, v)
+	}
+}
+
 func (c *PanoZone) xpath(tmpl, ts, vsys string, vals []string) []string {
 	if vsys == "" {
 		vsys = "vsys1"
@@ -0,0 +1,82 @@
+package pango
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// NtpStatus is the result of "show ntp".
+type NtpStatus struct {
+	// Synched is the NTP server the clock is synchronized to, or "LOCAL" if
+	// it is not synchronized to any server.
+	Synched string
+	Servers []NtpServer
+}
+
+// NtpServer is the status of a single configured NTP server.
+type NtpServer struct {
+	Name               string
+	Status             string
+	AuthenticationType string
+	Reachable          bool
+}
+
+// Synchronized returns true if the clock is synchronized to an NTP server.
+func (o NtpStatus) Synchronized() bool {
+	return o.Synched != "" && !strings.EqualFold(o.Synched, "LOCAL")
+}
+
+// Ntp returns the NTP synchronization status of the PAN-OS appliance.
+func (c *Client) Ntp() (NtpStatus, error) {
+	type req struct {
+		XMLName xml.Name `xml:"show"`
+		Cmd     string   `xml:"ntp"`
+	}
+
+	var resp ntpResp
+
+	c.LogOp("(op) getting ntp status")
+	if _, err := c.Op(req{}, "", nil, &resp); err != nil {
+		return NtpStatus{}, err
+	}
+
+	return resp.normalize(), nil
+}
+
+type ntpResp struct {
+	XMLName xml.Name  `xml:"response"`
+	Result  ntpResult `xml:"result"`
+}
+
+type ntpResult struct {
+	Synched string          `xml:"synched"`
+	Servers []ntpServerResp `xml:",any"`
+}
+
+type ntpServerResp struct {
+	XMLName            xml.Name
+	Name               string `xml:"name"`
+	Status             string `xml:"status"`
+	AuthenticationType string `xml:"authentication-type"`
+	Reachable          string `xml:"reachable"`
+}
+
+func (o ntpResp) normalize() NtpStatus {
+	ans := NtpStatus{
+		Synched: strings.TrimSpace(o.Result.Synched),
+	}
+
+	for _, s := range o.Result.Servers {
+		if !strings.HasPrefix(s.XMLName.Local, "ntp-server") {
+			continue
+		}
+		ans.Servers = append(ans.Servers, NtpServer{
+			Name:               s.Name,
+			Status:             s.Status,
+			AuthenticationType: s.AuthenticationType,
+			Reachable:          s.Reachable == "yes",
+		})
+	}
+
+	return ans
+}
@@ -0,0 +1,54 @@
+package pango
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNtp(t *testing.T) {
+	resp := `<response status="success"><result>
+<synched>10.1.1.1</synched>
+<ntp-server-1><status>synched</status><authentication-type>none</authentication-type><reachable>yes</reachable><name>10.1.1.1</name></ntp-server-1>
+<ntp-server-2><status>rejected</status><authentication-type>md5</authentication-type><reachable>no</reachable><name>pool.ntp.org</name></ntp-server-2>
+</result></response>`
+
+	c := &Client{rb: [][]byte{[]byte(resp)}}
+	c.Initialize()
+
+	ans, err := c.Ntp()
+	if err != nil {
+		t.Fatalf("Error getting ntp status: %s", err)
+	}
+
+	expected := NtpStatus{
+		Synched: "10.1.1.1",
+		Servers: []NtpServer{
+			{Name: "10.1.1.1", Status: "synched", AuthenticationType: "none", Reachable: true},
+			{Name: "pool.ntp.org", Status: "rejected", AuthenticationType: "md5"},
+		},
+	}
+	if !reflect.DeepEqual(ans, expected) {
+		t.Errorf("Got %#v", ans)
+	}
+	if !ans.Synchronized() {
+		t.Errorf("Not synchronized")
+	}
+	if c.rp[0].Get("cmd") != "<show><ntp></ntp></show>" {
+		t.Errorf("Command is %q", c.rp[0].Get("cmd"))
+	}
+}
+
+func TestNtpLocal(t *testing.T) {
+	resp := `<response status="success"><result><synched>LOCAL</synched></result></response>`
+
+	c := &Client{rb: [][]byte{[]byte(resp)}}
+	c.Initialize()
+
+	ans, err := c.Ntp()
+	if err != nil {
+		t.Fatalf("Error getting ntp status: %s", err)
+	}
+	if ans.Synchronized() || len(ans.Servers) != 0 {
+		t.Errorf("Got %#v", ans)
+	}
+}
@@ -0,0 +1,35 @@
+package addr
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestYAMLRoundTrip(t *testing.T) {
+	e := Entry{
+		Name:  "web",
+		Value: "10.1.1.1",
+		Type:  IpNetmask,
+		Tags:  []string{"prod", "web"},
+	}
+
+	v, err := e.MarshalYAML()
+	if err != nil {
+		t.Fatalf("Error marshaling: %s", err)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Error encoding: %s", err)
+	}
+
+	var got Entry
+	unmarshal := func(out interface{}) error { return json.Unmarshal(b, out) }
+	if err = got.UnmarshalYAML(unmarshal); err != nil {
+		t.Fatalf("Error unmarshaling %s: %s", b, err)
+	}
+
+	if !reflect.DeepEqual(got, e) {
+		t.Errorf("Expected %#v, got %#v", e, got)
+	}
+}
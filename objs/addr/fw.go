@@ -48,6 +48,47 @@ func (c *FwAddr) GetAll(vsys string) ([]Entry, error) {
 	return c.details(c.con.Get, vsys, "")
 }
 
+// GetAllByTag performs GET to retrieve all address objects with the given tag.
+//
+// The filtering is done by PAN-OS instead of client side, so only the
+// matching objects are returned.
+func (c *FwAddr) GetAllByTag(vsys, tag string) ([]Entry, error) {
+	c.con.LogQuery("(get) address objects with tag %q", tag)
+	path := c.xpath(vsys, nil)
+	path[len(path)-1] = util.AsTaggedEntryXpath(tag)
+	obj, _ := c.versioning()
+	if _, err := c.con.Get(path, nil, obj); err != nil {
+		return nil, err
+	}
+
+	return obj.Normalize(), nil
+}
+
+// GetListPage performs GET to retrieve a list of up to limit address objects,
+// starting at the given zero-based offset.
+func (c *FwAddr) GetListPage(vsys string, offset, limit int) ([]string, error) {
+	list, err := c.page(vsys, offset, limit, true)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := make([]string, 0, len(list))
+	for _, x := range list {
+		ans = append(ans, x.Name)
+	}
+
+	return ans, nil
+}
+
+// GetAllPage performs GET to retrieve up to limit address objects, starting at
+// the given zero-based offset.
+//
+// Paging through large numbers of objects this way avoids retrieving them
+// all in a single, very large response.
+func (c *FwAddr) GetAllPage(vsys string, offset, limit int) ([]Entry, error) {
+	return c.page(vsys, offset, limit, false)
+}
+
 // Show performs SHOW to retrieve information for the given address object.
 func (c *FwAddr) Show(vsys, name string) (Entry, error) {
 	c.con.LogQuery("(show) address object %q", name)
@@ -64,6 +105,33 @@ func (c *FwAddr) ShowAll(vsys string) ([]Entry, error) {
 	return c.details(c.con.Show, vsys, "")
 }
 
+// GetAllUsing retrieves all address objects using the given pango query type:
+// util.Get for the candidate config, util.Show for the running config, or
+// util.EffectiveRunning for the running config merged with any config pushed
+// from Panorama.
+func (c *FwAddr) GetAllUsing(qt, vsys string) ([]Entry, error) {
+	fn, err := util.RetrieverFor(c.con, qt)
+	if err != nil {
+		return nil, err
+	}
+
+	c.con.LogQuery("(%s) all address objects", qt)
+	return c.details(fn, vsys, "")
+}
+
+// GetAllPushed retrieves all address objects that Panorama has pushed to the firewall.
+//
+// Use "shared" as the vsys for objects pushed from the Panorama shared
+// location.
+func (c *FwAddr) GetAllPushed(vsys string) ([]Entry, error) {
+	c.con.LogQuery("(pushed) all address objects")
+	fn, err := util.RetrieverFor(c.con, util.PushedSharedPolicy)
+	if err != nil {
+		return nil, err
+	}
+	return c.details(fn, vsys, "")
+}
+
 // Set performs SET to create / update one or more address objects.
 func (c *FwAddr) Set(vsys string, e ...Entry) error {
 	var err error
@@ -112,6 +180,30 @@ func (c *FwAddr) Edit(vsys string, e Entry) error {
 	return err
 }
 
+// Clone retrieves the address object `name`, then creates a copy of it named
+// `newName`.  If `overrides` is not nil, it is called on the copy before it
+// is created so that fields can be changed.
+func (c *FwAddr) Clone(vsys string, name, newName string, overrides func(*Entry)) (Entry, error) {
+	if e, err := c.Get(vsys, newName); err == nil && e.Name == newName {
+		return Entry{}, fmt.Errorf("address object %q already exists", newName)
+	}
+
+	e, err := c.Get(vsys, name)
+	if err != nil {
+		return Entry{}, err
+	}
+	e.Name = newName
+	if overrides != nil {
+		overrides(&e)
+	}
+
+	if err = c.Set(vsys, e); err != nil {
+		return Entry{}, err
+	}
+
+	return e, nil
+}
+
 // Delete removes the given address objects from the firewall.
 //
 // Address objects can be either a string or an Entry object.
@@ -164,6 +256,22 @@ func (c *FwAddr) details(fn util.Retriever, vsys, name string) ([]Entry, error)
 	return ans, nil
 }
 
+func (c *FwAddr) page(vsys string, offset, limit int, namesOnly bool) ([]Entry, error) {
+	c.con.LogQuery("(get) address objects %d - %d", offset+1, offset+limit)
+	path := c.xpath(vsys, nil)
+	path[len(path)-1] = util.AsEntryXpathPage(offset, limit)
+	if namesOnly {
+		path = append(path, "@name")
+	}
+
+	obj, _ := c.versioning()
+	if _, err := c.con.Get(path, nil, obj); err != nil {
+		return nil, err
+	}
+
+	return obj.Normalize(), nil
+}
+
 func (c *FwAddr) xpath(vsys string, vals []string) []string {
 	if vsys == "" {
 		vsys = "vsys1"
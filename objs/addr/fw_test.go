@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/PaloAltoNetworks/pango/testdata"
+	"github.com/PaloAltoNetworks/pango/util"
 	"github.com/PaloAltoNetworks/pango/version"
 )
 
@@ -87,3 +88,109 @@ func TestFwNormalization(t *testing.T) {
 		})
 	}
 }
+
+func TestFwGetAllByTag(t *testing.T) {
+	mc := &testdata.MockClient{Version: version.Number{9, 0, 0, ""}}
+	ns := &FwAddr{}
+	ns.Initialize(mc)
+
+	mc.AddResp(`<entry name="one"><ip-netmask>10.1.1.1</ip-netmask><tag><member>web</member></tag></entry><entry name="two"><fqdn>example.com</fqdn><tag><member>web</member></tag></entry>`)
+	list, err := ns.GetAllByTag("", "web")
+	if err != nil {
+		t.Fatalf("Error in get: %s", err)
+	}
+
+	expectedPath := "/config/devices/entry[@name='localhost.localdomain']/vsys/entry[@name='vsys1']/address/entry[tag/member='web']"
+	if mc.Path != expectedPath {
+		t.Errorf("Path is %q, not %q", mc.Path, expectedPath)
+	}
+	if len(list) != 2 || list[0].Name != "one" || list[1].Name != "two" {
+		t.Errorf("Unexpected entries: %#v", list)
+	}
+}
+
+func TestFwGetAllUsing(t *testing.T) {
+	mc := &testdata.MockClient{Version: version.Number{9, 0, 0, ""}}
+	ns := &FwAddr{}
+	ns.Initialize(mc)
+
+	mc.AddResp(`<entry name="one"><ip-netmask>10.1.1.1</ip-netmask></entry>`)
+	for _, qt := range []string{util.Get, util.Show, util.EffectiveRunning} {
+		list, err := ns.GetAllUsing(qt, "")
+		if err != nil {
+			t.Fatalf("%s: error in retrieval: %s", qt, err)
+		} else if mc.Function != qt {
+			t.Errorf("%s: function is %q", qt, mc.Function)
+		} else if len(list) != 1 || list[0].Name != "one" {
+			t.Errorf("%s: unexpected entries: %#v", qt, list)
+		}
+	}
+
+	if _, err := ns.GetAllUsing("bogus", ""); err == nil {
+		t.Errorf("No error for invalid query type")
+	}
+}
+
+func TestFwGetPage(t *testing.T) {
+	mc := &testdata.MockClient{Version: version.Number{9, 0, 0, ""}}
+	ns := &FwAddr{}
+	ns.Initialize(mc)
+
+	mc.AddResp(`<entry name="three"><ip-netmask>10.1.1.3</ip-netmask></entry><entry name="four"><ip-netmask>10.1.1.4</ip-netmask></entry>`)
+	list, err := ns.GetAllPage("", 2, 2)
+	if err != nil {
+		t.Fatalf("Error in get: %s", err)
+	}
+	expectedPath := "/config/devices/entry[@name='localhost.localdomain']/vsys/entry[@name='vsys1']/address/entry[position() > 2 and position() <= 4]"
+	if mc.Path != expectedPath {
+		t.Errorf("Path is %q, not %q", mc.Path, expectedPath)
+	}
+	if len(list) != 2 || list[0].Value != "10.1.1.3" {
+		t.Errorf("Unexpected entries: %#v", list)
+	}
+
+	names, err := ns.GetListPage("", 2, 2)
+	if err != nil {
+		t.Fatalf("Error in get list: %s", err)
+	} else if !reflect.DeepEqual(names, []string{"three", "four"}) {
+		t.Errorf("Unexpected names: %#v", names)
+	}
+	if mc.Path != expectedPath+"/@name" {
+		t.Errorf("Path is %q", mc.Path)
+	}
+}
+
+func TestFwClone(t *testing.T) {
+	mc := &testdata.MockClient{Version: version.Number{9, 0, 0, ""}}
+	ns := &FwAddr{}
+	ns.Initialize(mc)
+
+	mc.AddResp("")
+	mc.AddResp(`<entry name="web1"><ip-netmask>10.1.1.1</ip-netmask><description>web</description></entry>`)
+	mc.AddResp("")
+	e, err := ns.Clone("", "web1", "web2", func(e *Entry) {
+		e.Value = "10.1.1.2"
+	})
+	if err != nil {
+		t.Fatalf("Error in clone: %s", err)
+	}
+
+	expected := Entry{Name: "web2", Type: IpNetmask, Value: "10.1.1.2", Description: "web"}
+	if !reflect.DeepEqual(e, expected) {
+		t.Errorf("%#v != %#v", e, expected)
+	}
+	if mc.Function != "set" || mc.Elm != `<entry name="web2"><ip-netmask>10.1.1.2</ip-netmask><description>web</description></entry>` {
+		t.Errorf("Last write was %s %s", mc.Function, mc.Elm)
+	}
+}
+
+func TestFwCloneExisting(t *testing.T) {
+	mc := &testdata.MockClient{Version: version.Number{9, 0, 0, ""}}
+	ns := &FwAddr{}
+	ns.Initialize(mc)
+
+	mc.AddResp(`<entry name="web2"><ip-netmask>10.1.1.2</ip-netmask></entry>`)
+	if _, err := ns.Clone("", "web1", "web2", nil); err == nil {
+		t.Errorf("Expected an error when the clone already exists")
+	}
+}
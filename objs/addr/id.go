@@ -0,0 +1,39 @@
+package addr
+
+import (
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwId returns the import ID of the address object `name` in
+// the given vsys.
+func FwId(vsys, name string) string {
+	return util.BuildId(vsys, name)
+}
+
+// ParseFwId parses an import ID built by FwId, returning the
+// vsys, name params given to it.
+func ParseFwId(id string) (string, string, error) {
+	parts, err := util.ParseId(id, 2)
+	if err != nil {
+		return "", "", err
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// PanoId returns the import ID of the address object `name` in
+// the given device group.
+func PanoId(dg, name string) string {
+	return util.BuildId(dg, name)
+}
+
+// ParsePanoId parses an import ID built by PanoId, returning the
+// dg, name params given to it.
+func ParsePanoId(id string) (string, string, error) {
+	parts, err := util.ParseId(id, 2)
+	if err != nil {
+		return "", "", err
+	}
+
+	return parts[0], parts[1], nil
+}
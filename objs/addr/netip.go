@@ -0,0 +1,149 @@
+package addr
+
+import (
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Range returns the inclusive range of IP addresses that this address object
+// refers to.
+//
+// Only IpNetmask and IpRange address objects can be converted.
+func (o Entry) Range() (netip.Addr, netip.Addr, error) {
+	switch o.Type {
+	case IpNetmask:
+		p, err := parsePrefix(o.Value)
+		if err != nil {
+			return netip.Addr{}, netip.Addr{}, err
+		}
+		first, last := util.PrefixRange(p)
+		return first, last, nil
+	case IpRange:
+		tokens := strings.Split(o.Value, "-")
+		if len(tokens) != 2 {
+			return netip.Addr{}, netip.Addr{}, fmt.Errorf("%s: invalid ip range %q", o.Name, o.Value)
+		}
+		first, err := netip.ParseAddr(strings.TrimSpace(tokens[0]))
+		if err != nil {
+			return netip.Addr{}, netip.Addr{}, err
+		}
+		last, err := netip.ParseAddr(strings.TrimSpace(tokens[1]))
+		if err != nil {
+			return netip.Addr{}, netip.Addr{}, err
+		}
+		if first.BitLen() != last.BitLen() || last.Less(first) {
+			return netip.Addr{}, netip.Addr{}, fmt.Errorf("%s: invalid ip range %q", o.Name, o.Value)
+		}
+		return first, last, nil
+	}
+
+	return netip.Addr{}, netip.Addr{}, fmt.Errorf("%s: cannot convert %q address object to an ip range", o.Name, o.Type)
+}
+
+// Prefixes returns the smallest list of prefixes that exactly covers the IP
+// addresses this address object refers to.
+//
+// Only IpNetmask and IpRange address objects can be converted.
+func (o Entry) Prefixes() ([]netip.Prefix, error) {
+	first, last, err := o.Range()
+	if err != nil {
+		return nil, err
+	}
+
+	return util.RangeToPrefixes(first, last), nil
+}
+
+func parsePrefix(v string) (netip.Prefix, error) {
+	if !strings.Contains(v, "/") {
+		a, err := netip.ParseAddr(v)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return netip.PrefixFrom(a, a.BitLen()), nil
+	}
+
+	p, err := netip.ParsePrefix(v)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return p.Masked(), nil
+}
+
+// Overlap is a pair of address objects whose IP addresses overlap.
+//
+// A and B are the address object names, and ALocation and BLocation are the
+// locations (such as "shared" or a device group name) they were found in.
+type Overlap struct {
+	ALocation string
+	A         string
+	BLocation string
+	B         string
+
+	// Duplicate is true if both address objects refer to exactly the same
+	// IP addresses.
+	Duplicate bool
+}
+
+// FindOverlaps returns address objects whose IP addresses overlap.
+//
+// The objects param is a map of location (such as "shared" or a device group
+// name) to the address objects in that location.  Address objects that are
+// not IpNetmask or IpRange are skipped.
+//
+// Results are ordered by the start of B's range.
+func FindOverlaps(objects map[string][]Entry) []Overlap {
+	type span struct {
+		loc   string
+		name  string
+		first netip.Addr
+		last  netip.Addr
+	}
+
+	locs := make([]string, 0, len(objects))
+	for loc := range objects {
+		locs = append(locs, loc)
+	}
+	sort.Strings(locs)
+
+	var spans []span
+	for _, loc := range locs {
+		for _, e := range objects[loc] {
+			first, last, err := e.Range()
+			if err != nil {
+				continue
+			}
+			spans = append(spans, span{loc, e.Name, first, last})
+		}
+	}
+	sort.SliceStable(spans, func(i, j int) bool {
+		return spans[i].first.Less(spans[j].first)
+	})
+
+	var ans []Overlap
+	var active []span
+	for _, s := range spans {
+		keep := active[:0]
+		for _, a := range active {
+			// IPv4 addresses sort before IPv6, so anything ending before
+			// this span starts can never overlap with a later span.
+			if a.first.BitLen() != s.first.BitLen() || a.last.Less(s.first) {
+				continue
+			}
+			ans = append(ans, Overlap{
+				ALocation: a.loc,
+				A:         a.name,
+				BLocation: s.loc,
+				B:         s.name,
+				Duplicate: a.first == s.first && a.last == s.last,
+			})
+			keep = append(keep, a)
+		}
+		active = append(keep, s)
+	}
+
+	return ans
+}
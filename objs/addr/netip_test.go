@@ -0,0 +1,84 @@
+package addr
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestPrefixes(t *testing.T) {
+	testCases := []struct {
+		e   Entry
+		r   []string
+		err bool
+	}{
+		{Entry{Name: "host", Type: IpNetmask, Value: "10.1.1.1"}, []string{"10.1.1.1/32"}, false},
+		{Entry{Name: "net", Type: IpNetmask, Value: "10.1.1.5/24"}, []string{"10.1.1.0/24"}, false},
+		{Entry{Name: "v6", Type: IpNetmask, Value: "fd00::1/64"}, []string{"fd00::/64"}, false},
+		{Entry{Name: "range", Type: IpRange, Value: "10.1.1.0-10.1.1.4"}, []string{"10.1.1.0/30", "10.1.1.4/32"}, false},
+		{Entry{Name: "backwards", Type: IpRange, Value: "10.1.1.4-10.1.1.0"}, nil, true},
+		{Entry{Name: "fqdn", Type: Fqdn, Value: "example.com"}, nil, true},
+		{Entry{Name: "bogus", Type: IpNetmask, Value: "bogus"}, nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.e.Name, func(t *testing.T) {
+			ans, err := tc.e.Prefixes()
+			if (err != nil) != tc.err {
+				t.Fatalf("Error is %v", err)
+			}
+			var v []string
+			for _, p := range ans {
+				v = append(v, p.String())
+			}
+			if !reflect.DeepEqual(v, tc.r) {
+				t.Errorf("%#v != %#v", v, tc.r)
+			}
+		})
+	}
+}
+
+func TestFindOverlaps(t *testing.T) {
+	objects := map[string][]Entry{
+		"shared": {
+			{Name: "net", Type: IpNetmask, Value: "10.1.1.0/24"},
+			{Name: "dns", Type: Fqdn, Value: "example.com"},
+			{Name: "v6", Type: IpNetmask, Value: "fd00::/64"},
+		},
+		"dg1": {
+			{Name: "web", Type: IpNetmask, Value: "10.1.1.10"},
+			{Name: "other", Type: IpNetmask, Value: "10.2.2.0/24"},
+			{Name: "net-copy", Type: IpRange, Value: "10.1.1.0-10.1.1.255"},
+			{Name: "v6-host", Type: IpNetmask, Value: "fd00::5"},
+		},
+	}
+
+	expected := []Overlap{
+		{ALocation: "dg1", A: "net-copy", BLocation: "shared", B: "net", Duplicate: true},
+		{ALocation: "dg1", A: "net-copy", BLocation: "dg1", B: "web"},
+		{ALocation: "shared", A: "net", BLocation: "dg1", B: "web"},
+		{ALocation: "shared", A: "v6", BLocation: "dg1", B: "v6-host"},
+	}
+
+	if v := FindOverlaps(objects); !reflect.DeepEqual(v, expected) {
+		t.Errorf("\n%#v\n!=\n%#v", v, expected)
+	}
+}
+
+func TestPanoGetAllLocations(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp(`<entry name="net"><ip-netmask>10.1.1.0/24</ip-netmask></entry>`)
+	mc.Resp = append(mc.Resp, testdata.Response{Raw: []byte(`<response status="error"/>`), Error: fmt.Errorf("No such node")})
+	ns := &PanoAddr{}
+	ns.Initialize(mc)
+
+	ans, err := ns.GetAllLocations("dg1")
+	if err != nil {
+		t.Fatalf("Error getting locations: %s", err)
+	}
+	if len(ans) != 2 || len(ans["shared"]) != 1 || ans["shared"][0].Value != "10.1.1.0/24" || len(ans["dg1"]) != 0 {
+		t.Errorf("Got %#v", ans)
+	}
+}
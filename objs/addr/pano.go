@@ -48,6 +48,65 @@ func (c *PanoAddr) GetAll(dg string) ([]Entry, error) {
 	return c.details(c.con.Get, dg, "")
 }
 
+// GetAllLocations performs GET to retrieve all address objects in shared and
+// in each of the given device groups, keyed by location.
+//
+// Locations without any address objects are included with an empty list.
+// The result can be passed to FindOverlaps.
+func (c *PanoAddr) GetAllLocations(dgs ...string) (map[string][]Entry, error) {
+	ans := make(map[string][]Entry, len(dgs)+1)
+	for _, dg := range append([]string{"shared"}, dgs...) {
+		list, err := c.GetAll(dg)
+		if err != nil && err.Error() != "No such node" && err.Error() != "Object not found" {
+			return nil, err
+		}
+		ans[dg] = list
+	}
+
+	return ans, nil
+}
+
+// GetAllByTag performs GET to retrieve all address objects with the given tag.
+//
+// The filtering is done by PAN-OS instead of client side, so only the
+// matching objects are returned.
+func (c *PanoAddr) GetAllByTag(dg, tag string) ([]Entry, error) {
+	c.con.LogQuery("(get) address objects with tag %q", tag)
+	path := c.xpath(dg, nil)
+	path[len(path)-1] = util.AsTaggedEntryXpath(tag)
+	obj, _ := c.versioning()
+	if _, err := c.con.Get(path, nil, obj); err != nil {
+		return nil, err
+	}
+
+	return obj.Normalize(), nil
+}
+
+// GetListPage performs GET to retrieve a list of up to limit address objects,
+// starting at the given zero-based offset.
+func (c *PanoAddr) GetListPage(dg string, offset, limit int) ([]string, error) {
+	list, err := c.page(dg, offset, limit, true)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := make([]string, 0, len(list))
+	for _, x := range list {
+		ans = append(ans, x.Name)
+	}
+
+	return ans, nil
+}
+
+// GetAllPage performs GET to retrieve up to limit address objects, starting at
+// the given zero-based offset.
+//
+// Paging through large numbers of objects this way avoids retrieving them
+// all in a single, very large response.
+func (c *PanoAddr) GetAllPage(dg string, offset, limit int) ([]Entry, error) {
+	return c.page(dg, offset, limit, false)
+}
+
 // Show performs SHOW to retrieve information for the given address object.
 func (c *PanoAddr) Show(dg, name string) (Entry, error) {
 	c.con.LogQuery("(show) address object %q", name)
@@ -64,6 +123,20 @@ func (c *PanoAddr) ShowAll(dg string) ([]Entry, error) {
 	return c.details(c.con.Show, dg, "")
 }
 
+// GetAllUsing retrieves all address objects using the given pango query type:
+// util.Get for the candidate config, util.Show for the running config, or
+// util.EffectiveRunning for the running config merged with any config pushed
+// from Panorama.
+func (c *PanoAddr) GetAllUsing(qt, dg string) ([]Entry, error) {
+	fn, err := util.RetrieverFor(c.con, qt)
+	if err != nil {
+		return nil, err
+	}
+
+	c.con.LogQuery("(%s) all address objects", qt)
+	return c.details(fn, dg, "")
+}
+
 // Set performs SET to create / update one or more address objects.
 func (c *PanoAddr) Set(dg string, e ...Entry) error {
 	var err error
@@ -112,6 +185,30 @@ func (c *PanoAddr) Edit(dg string, e Entry) error {
 	return err
 }
 
+// Clone retrieves the address object `name`, then creates a copy of it named
+// `newName`.  If `overrides` is not nil, it is called on the copy before it
+// is created so that fields can be changed.
+func (c *PanoAddr) Clone(dg string, name, newName string, overrides func(*Entry)) (Entry, error) {
+	if e, err := c.Get(dg, newName); err == nil && e.Name == newName {
+		return Entry{}, fmt.Errorf("address object %q already exists", newName)
+	}
+
+	e, err := c.Get(dg, name)
+	if err != nil {
+		return Entry{}, err
+	}
+	e.Name = newName
+	if overrides != nil {
+		overrides(&e)
+	}
+
+	if err = c.Set(dg, e); err != nil {
+		return Entry{}, err
+	}
+
+	return e, nil
+}
+
 // Delete removes the given address objects from the firewall.
 //
 // Address objects can be either a string or an Entry object.
@@ -164,6 +261,22 @@ func (c *PanoAddr) details(fn util.Retriever, dg, name string) ([]Entry, error)
 	return ans, nil
 }
 
+func (c *PanoAddr) page(dg string, offset, limit int, namesOnly bool) ([]Entry, error) {
+	c.con.LogQuery("(get) address objects %d - %d", offset+1, offset+limit)
+	path := c.xpath(dg, nil)
+	path[len(path)-1] = util.AsEntryXpathPage(offset, limit)
+	if namesOnly {
+		path = append(path, "@name")
+	}
+
+	obj, _ := c.versioning()
+	if _, err := c.con.Get(path, nil, obj); err != nil {
+		return nil, err
+	}
+
+	return obj.Normalize(), nil
+}
+
 func (c *PanoAddr) xpath(dg string, vals []string) []string {
 	if dg == "" {
 		dg = "shared"
@@ -31,6 +31,16 @@ func (o *Entry) Copy(s Entry) {
 	o.Tags = s.Tags
 }
 
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
 /** Structs / functions for normalization. **/
 
 type normalizer interface {
@@ -42,14 +52,31 @@ type container_v1 struct {
 }
 
 func (o *container_v1) Normalize() Entry {
+	return o.Answer.normalize()
+}
+
+type list_v1 struct {
+	Answer []entry_v1 `xml:"result>entry"`
+}
+
+func (o *list_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
 	ans := Entry{
-		Name:            o.Answer.Name,
-		Description:     o.Answer.Description,
-		StaticAddresses: util.MemToStr(o.Answer.StaticAddresses),
-		Tags:            util.MemToStr(o.Answer.Tags),
+		Name:            o.Name,
+		Description:     o.Description,
+		StaticAddresses: util.MemToStr(o.StaticAddresses),
+		Tags:            util.MemToStr(o.Tags),
 	}
-	if o.Answer.DynamicMatch != nil {
-		ans.DynamicMatch = *o.Answer.DynamicMatch
+	if o.DynamicMatch != nil {
+		ans.DynamicMatch = *o.DynamicMatch
 	}
 
 	return ans
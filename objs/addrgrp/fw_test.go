@@ -60,3 +60,23 @@ func TestFwNormalization(t *testing.T) {
 		})
 	}
 }
+
+func TestFwGetAllByTag(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &FwAddrGrp{}
+	ns.Initialize(mc)
+
+	mc.AddResp(`<entry name="g1"><static><member>a1</member></static><tag><member>web</member></tag></entry><entry name="g2"><dynamic><filter>'web'</filter></dynamic><tag><member>web</member></tag></entry>`)
+	list, err := ns.GetAllByTag("", "web")
+	if err != nil {
+		t.Fatalf("Error in get: %s", err)
+	}
+
+	expected := []Entry{
+		{Name: "g1", StaticAddresses: []string{"a1"}, Tags: []string{"web"}},
+		{Name: "g2", DynamicMatch: "'web'", Tags: []string{"web"}},
+	}
+	if !reflect.DeepEqual(list, expected) {
+		t.Errorf("%#v != %#v", list, expected)
+	}
+}
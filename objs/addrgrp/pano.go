@@ -37,6 +37,77 @@ func (c *PanoAddrGrp) Get(dg, name string) (Entry, error) {
 	return c.details(c.con.Get, dg, name)
 }
 
+// GetAll performs GET to retrieve all address groups.
+func (c *PanoAddrGrp) GetAll(dg string) ([]Entry, error) {
+	c.con.LogQuery("(get) all address groups")
+	obj := &list_v1{}
+	if _, err := c.con.Get(c.xpath(dg, nil), nil, obj); err != nil {
+		return nil, err
+	}
+
+	return obj.Normalize(), nil
+}
+
+// GetAllUsing retrieves all address groups using the given pango query type:
+// util.Get for the candidate config, util.Show for the running config, or
+// util.EffectiveRunning for the running config merged with any config pushed
+// from Panorama.
+func (c *PanoAddrGrp) GetAllUsing(qt, dg string) ([]Entry, error) {
+	fn, err := util.RetrieverFor(c.con, qt)
+	if err != nil {
+		return nil, err
+	}
+
+	c.con.LogQuery("(%s) all address groups", qt)
+	obj := &list_v1{}
+	if _, err = fn(c.xpath(dg, nil), nil, obj); err != nil {
+		return nil, err
+	}
+
+	return obj.Normalize(), nil
+}
+
+// GetAllByTag performs GET to retrieve all address groups with the given tag.
+//
+// The filtering is done by PAN-OS instead of client side, so only the
+// matching objects are returned.
+func (c *PanoAddrGrp) GetAllByTag(dg, tag string) ([]Entry, error) {
+	c.con.LogQuery("(get) address groups with tag %q", tag)
+	path := c.xpath(dg, nil)
+	path[len(path)-1] = util.AsTaggedEntryXpath(tag)
+	obj := &list_v1{}
+	if _, err := c.con.Get(path, nil, obj); err != nil {
+		return nil, err
+	}
+
+	return obj.Normalize(), nil
+}
+
+// GetListPage performs GET to retrieve a list of up to limit address groups,
+// starting at the given zero-based offset.
+func (c *PanoAddrGrp) GetListPage(dg string, offset, limit int) ([]string, error) {
+	list, err := c.page(dg, offset, limit, true)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := make([]string, 0, len(list))
+	for _, x := range list {
+		ans = append(ans, x.Name)
+	}
+
+	return ans, nil
+}
+
+// GetAllPage performs GET to retrieve up to limit address groups, starting at
+// the given zero-based offset.
+//
+// Paging through large numbers of objects this way avoids retrieving them
+// all in a single, very large response.
+func (c *PanoAddrGrp) GetAllPage(dg string, offset, limit int) ([]Entry, error) {
+	return c.page(dg, offset, limit, false)
+}
+
 // Get performs SHOW to retrieve information for the given address group.
 func (c *PanoAddrGrp) Show(dg, name string) (Entry, error) {
 	c.con.LogQuery("(show) address group %q", name)
@@ -91,6 +162,30 @@ func (c *PanoAddrGrp) Edit(dg string, e Entry) error {
 	return err
 }
 
+// Clone retrieves the address group `name`, then creates a copy of it named
+// `newName`.  If `overrides` is not nil, it is called on the copy before it
+// is created so that fields can be changed.
+func (c *PanoAddrGrp) Clone(dg string, name, newName string, overrides func(*Entry)) (Entry, error) {
+	if e, err := c.Get(dg, newName); err == nil && e.Name == newName {
+		return Entry{}, fmt.Errorf("address group %q already exists", newName)
+	}
+
+	e, err := c.Get(dg, name)
+	if err != nil {
+		return Entry{}, err
+	}
+	e.Name = newName
+	if overrides != nil {
+		overrides(&e)
+	}
+
+	if err = c.Set(dg, e); err != nil {
+		return Entry{}, err
+	}
+
+	return e, nil
+}
+
 // Delete removes the given address groups from the firewall.
 //
 // Address groups can be either a string or an Entry object.
@@ -137,6 +232,22 @@ func (c *PanoAddrGrp) details(fn util.Retriever, dg, name string) (Entry, error)
 	return ans, nil
 }
 
+func (c *PanoAddrGrp) page(dg string, offset, limit int, namesOnly bool) ([]Entry, error) {
+	c.con.LogQuery("(get) address groups %d - %d", offset+1, offset+limit)
+	path := c.xpath(dg, nil)
+	path[len(path)-1] = util.AsEntryXpathPage(offset, limit)
+	if namesOnly {
+		path = append(path, "@name")
+	}
+
+	obj := &list_v1{}
+	if _, err := c.con.Get(path, nil, obj); err != nil {
+		return nil, err
+	}
+
+	return obj.Normalize(), nil
+}
+
 func (c *PanoAddrGrp) xpath(dg string, vals []string) []string {
 	if dg == "" {
 		dg = "shared"
@@ -1,6 +1,43 @@
 /*
 Package app is the client.Objects.Application namespace.
 
+Custom applications are built as a tree:  the application itself (this
+namespace) holds the default ports, timeouts, category, and risk, while the
+pattern match signatures are configured beneath it using the
+client.Objects.AppSignature, client.Objects.AppSigAndCond, and
+client.Objects.AppSigOrCond namespaces.  A signature matches when all of its
+and-conditions match, and an and-condition matches when any of its
+or-conditions match.
+
+Create the tree from the top down, for example:
+
+	fw.Objects.Application.Set("vsys1", app.Entry{
+		Name: "my-app",
+		DefaultType: app.DefaultTypePort,
+		DefaultPorts: []string{"tcp/8443"},
+		Category: "business-systems",
+		Subcategory: "management",
+		Technology: "client-server",
+		Risk: 2,
+	})
+	fw.Objects.AppSignature.Set("vsys1", "my-app", signature.Entry{
+		Name: "sig1",
+		Scope: signature.ScopeSession,
+	})
+	fw.Objects.AppSigAndCond.Set("vsys1", "my-app", "sig1", andcond.Entry{
+		Name: "and1",
+	})
+	fw.Objects.AppSigOrCond.Set("vsys1", "my-app", "sig1", "and1", orcond.Entry{
+		Name: "or1",
+		Operator: orcond.OperatorPatternMatch,
+		Context: "http-req-host-header",
+		Pattern: "my-app\\.example\\.com",
+	})
+
+Set merges into the existing config, so updating an application with Set leaves
+its signatures in place.  Edit replaces the application, so only use Edit with
+an Entry retrieved using Get, as that Entry carries the signatures along.
+
 Normalized object:  Entry
 */
 package app
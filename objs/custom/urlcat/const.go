@@ -0,0 +1,12 @@
+package urlcat
+
+// Valid values for Type.
+const (
+	TypeUrlList       = "URL List"
+	TypeCategoryMatch = "Category Match"
+)
+
+const (
+	singular = "custom url category"
+	plural   = "custom url categories"
+)
@@ -0,0 +1,9 @@
+/*
+Package urlcat is the client.Objects.CustomUrlCategory namespace.
+
+Custom URL categories are either a list of URLs, or (as of PAN-OS 9.0) a match
+of URLs that are in all of the given URL categories.
+
+Normalized object:  Entry
+*/
+package urlcat
@@ -0,0 +1,148 @@
+package urlcat
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a custom URL
+// category.
+//
+// Sites is the list of URLs for TypeUrlList, or the list of URL categories
+// for TypeCategoryMatch.
+//
+// Type is PAN-OS 9.0+.  Prior to 9.0, all custom URL categories are URL lists.
+type Entry struct {
+	Name        string
+	Description string
+	Sites       []string // unordered
+	Type        string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Description = s.Description
+	o.Sites = s.Sites
+	o.Type = s.Type
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this custom URL category.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:        o.Name,
+		Description: o.Description,
+		Sites:       util.MemToStr(o.Sites),
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName     xml.Name         `xml:"entry"`
+	Name        string           `xml:"name,attr"`
+	Description string           `xml:"description,omitempty"`
+	Sites       *util.MemberType `xml:"list"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:        e.Name,
+		Description: e.Description,
+		Sites:       util.StrToMem(e.Sites),
+	}
+
+	return ans
+}
+
+// PAN-OS 9.0, adds type.
+type container_v2 struct {
+	Answer []entry_v2 `xml:"entry"`
+}
+
+func (o *container_v2) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v2) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v2) normalize() Entry {
+	ans := Entry{
+		Name:        o.Name,
+		Description: o.Description,
+		Sites:       util.MemToStr(o.Sites),
+		Type:        o.Type,
+	}
+
+	return ans
+}
+
+type entry_v2 struct {
+	XMLName     xml.Name         `xml:"entry"`
+	Name        string           `xml:"name,attr"`
+	Description string           `xml:"description,omitempty"`
+	Sites       *util.MemberType `xml:"list"`
+	Type        string           `xml:"type,omitempty"`
+}
+
+func specify_v2(e Entry) interface{} {
+	ans := entry_v2{
+		Name:        e.Name,
+		Description: e.Description,
+		Sites:       util.StrToMem(e.Sites),
+		Type:        e.Type,
+	}
+
+	return ans
+}
@@ -0,0 +1,100 @@
+package urlcat
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+// PanoUrlCat is a namespace struct, included as part of pango.Panorama.
+type PanoUrlCat struct {
+	con util.XapiClient
+	ns  *namespace.Standard[Entry]
+}
+
+// Initialize is invoked when Initialize on the pango.Panorama is called.
+func (c *PanoUrlCat) Initialize(con util.XapiClient) {
+	c.con = con
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *PanoUrlCat) GetList(dg string) ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(dg, nil))
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *PanoUrlCat) ShowList(dg string) ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(dg, nil))
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *PanoUrlCat) Get(dg, name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath(dg, []string{name}), name)
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *PanoUrlCat) GetAll(dg string) ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(dg, nil))
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *PanoUrlCat) Show(dg, name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath(dg, []string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *PanoUrlCat) ShowAll(dg string) ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(dg, nil))
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *PanoUrlCat) Set(dg string, e ...Entry) error {
+	return c.ns.SetEntries(c.pather(dg), e...)
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *PanoUrlCat) Edit(dg string, e Entry) error {
+	return c.ns.EditEntry(c.pather(dg), e)
+}
+
+// Delete removes the given objects.
+//
+// Objects can be either a string or an Entry object.
+func (c *PanoUrlCat) Delete(dg string, e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(dg), e...)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *PanoUrlCat) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	v := c.con.Versioning()
+
+	if v.Gte(version.Number{9, 0, 0, ""}) {
+		return &container_v2{}, specify_v2
+	} else {
+		return &container_v1{}, specify_v1
+	}
+}
+
+func (c *PanoUrlCat) pather(dg string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(dg, v)
+	}
+}
+
+func (c *PanoUrlCat) xpath(dg string, vals []string) []string {
+	if dg == "" {
+		dg = "shared"
+	}
+
+	ans := make([]string, 0, 8)
+	ans = append(ans, util.DeviceGroupXpathPrefix(dg)...)
+	ans = append(ans,
+		"profiles",
+		"custom-url-category",
+		util.AsEntryXpath(vals),
+	)
+
+	return ans
+}
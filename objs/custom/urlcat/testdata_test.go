@@ -0,0 +1,35 @@
+package urlcat
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type testCase struct {
+	desc    string
+	version version.Number
+	conf    Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"v1 basic", version.Number{8, 1, 0, ""}, Entry{
+			Name: "one",
+		}},
+		{"v1 sites", version.Number{8, 1, 0, ""}, Entry{
+			Name:        "two",
+			Description: "blocked",
+			Sites:       []string{"example.com", "*.example.org"},
+		}},
+		{"v2 url list", version.Number{9, 0, 0, ""}, Entry{
+			Name:        "three",
+			Description: "allowed",
+			Sites:       []string{"example.com/"},
+			Type:        TypeUrlList,
+		}},
+		{"v2 category match", version.Number{9, 0, 0, ""}, Entry{
+			Name:  "four",
+			Sites: []string{"high-risk", "newly-registered-domain"},
+			Type:  TypeCategoryMatch,
+		}},
+	}
+}
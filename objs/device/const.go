@@ -0,0 +1,6 @@
+package device
+
+const (
+	singular = "device object"
+	plural   = "device objects"
+)
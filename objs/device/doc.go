@@ -0,0 +1,12 @@
+/*
+Package device is the client.Objects.Device namespace.
+
+Device objects are entries in the device dictionary used by device-id (IoT)
+security policy, matching devices by category, profile, OS, model, and
+vendor.
+
+Only valid for PAN-OS 10.0+.
+
+Normalized object:  Entry
+*/
+package device
@@ -0,0 +1,121 @@
+package device
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a device
+// object.
+//
+// The match fields (Category, Profile, OsFamily, Os, Model, and Vendor) are
+// values from the device dictionary.
+type Entry struct {
+	Name        string
+	Description string
+	Tags        []string // ordered
+	Category    string
+	Profile     string
+	OsFamily    string
+	Os          string
+	Model       string
+	Vendor      string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Description = s.Description
+	o.Tags = s.Tags
+	o.Category = s.Category
+	o.Profile = s.Profile
+	o.OsFamily = s.OsFamily
+	o.Os = s.Os
+	o.Model = s.Model
+	o.Vendor = s.Vendor
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this device object.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:        o.Name,
+		Description: o.Description,
+		Tags:        util.MemToStr(o.Tags),
+		Category:    o.Category,
+		Profile:     o.Profile,
+		OsFamily:    o.OsFamily,
+		Os:          o.Os,
+		Model:       o.Model,
+		Vendor:      o.Vendor,
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName     xml.Name         `xml:"entry"`
+	Name        string           `xml:"name,attr"`
+	Description string           `xml:"description,omitempty"`
+	Tags        *util.MemberType `xml:"tag"`
+	Category    string           `xml:"category,omitempty"`
+	Profile     string           `xml:"profile,omitempty"`
+	OsFamily    string           `xml:"osfamily,omitempty"`
+	Os          string           `xml:"os,omitempty"`
+	Model       string           `xml:"model,omitempty"`
+	Vendor      string           `xml:"vendor,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:        e.Name,
+		Description: e.Description,
+		Tags:        util.StrToMem(e.Tags),
+		Category:    e.Category,
+		Profile:     e.Profile,
+		OsFamily:    e.OsFamily,
+		Os:          e.Os,
+		Model:       e.Model,
+		Vendor:      e.Vendor,
+	}
+
+	return ans
+}
@@ -0,0 +1,26 @@
+package device
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"category only", Entry{
+			Name:     "t1",
+			Category: "IP Phone",
+		}},
+		{"all fields", Entry{
+			Name:        "t2",
+			Description: "lobby cameras",
+			Tags:        []string{"iot", "building1"},
+			Category:    "IP Camera",
+			Profile:     "Axis Camera",
+			OsFamily:    "Linux",
+			Os:          "Linux 4.x",
+			Model:       "P3245",
+			Vendor:      "Axis Communications",
+		}},
+	}
+}
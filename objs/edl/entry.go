@@ -7,12 +7,14 @@ import (
 )
 
 // Constants for Entry.Type field.  Only TypeIp is valid for PAN-OS 7.0 and
-// earlier.  TypePredefined is valid for PAN-OS 8.0 and later.
+// earlier.  TypePredefined is valid for PAN-OS 8.0 and later, and
+// TypePredefinedUrl is valid for PAN-OS 9.0 and later.
 const (
-	TypeIp         string = "ip"
-	TypeDomain     string = "domain"
-	TypeUrl        string = "url"
-	TypePredefined string = "predefined"
+	TypeIp            string = "ip"
+	TypeDomain        string = "domain"
+	TypeUrl           string = "url"
+	TypePredefined    string = "predefined"
+	TypePredefinedUrl string = "predefined-url"
 )
 
 // Constants for the Repeat field.  Option "RepeatEveryFiveMinutes" is valid
@@ -27,6 +29,8 @@ const (
 
 // Entry is a normalized, version independent representation of an
 // external dynamic list.
+//
+// ExpandDomain is only used for TypeDomain lists, and is PAN-OS 9.0+.
 type Entry struct {
 	Name               string
 	Type               string
@@ -40,6 +44,7 @@ type Entry struct {
 	RepeatDayOfWeek    string
 	RepeatDayOfMonth   int
 	Exceptions         []string // ordered
+	ExpandDomain       bool
 }
 
 // Copy copies the information from source Entry `s` to this object.  As the
@@ -56,6 +61,17 @@ func (o *Entry) Copy(s Entry) {
 	o.RepeatDayOfWeek = s.RepeatDayOfWeek
 	o.RepeatDayOfMonth = s.RepeatDayOfMonth
 	o.Exceptions = s.Exceptions
+	o.ExpandDomain = s.ExpandDomain
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
 }
 
 /** Structs / functions for normalization. **/
@@ -154,6 +170,70 @@ func (o *container_v2) Normalize() Entry {
 	return ans
 }
 
+// PAN-OS 9.0, adds predefined-url and expand-domain.
+type container_v3 struct {
+	Answer entry_v3 `xml:"result>entry"`
+}
+
+func (o *container_v3) Normalize() Entry {
+	ans := Entry{
+		Name: o.Answer.Name,
+	}
+
+	var sp *typeSpec_v3
+
+	if o.Answer.PredefinedIp != nil {
+		ans.Type = TypePredefined
+		ans.Description = o.Answer.PredefinedIp.Description
+		ans.Source = o.Answer.PredefinedIp.Source
+		ans.Exceptions = util.MemToStr(o.Answer.PredefinedIp.Exceptions)
+	} else if o.Answer.PredefinedUrl != nil {
+		ans.Type = TypePredefinedUrl
+		ans.Description = o.Answer.PredefinedUrl.Description
+		ans.Source = o.Answer.PredefinedUrl.Source
+		ans.Exceptions = util.MemToStr(o.Answer.PredefinedUrl.Exceptions)
+	} else if o.Answer.Ip != nil {
+		ans.Type = TypeIp
+		sp = o.Answer.Ip
+	} else if o.Answer.Domain != nil {
+		ans.Type = TypeDomain
+		sp = o.Answer.Domain
+	} else if o.Answer.Url != nil {
+		ans.Type = TypeUrl
+		sp = o.Answer.Url
+	}
+
+	if sp != nil {
+		ans.Description = sp.Description
+		ans.Source = sp.Source
+		ans.CertificateProfile = sp.CertificateProfile
+		ans.Exceptions = util.MemToStr(sp.Exceptions)
+		ans.ExpandDomain = util.AsBool(sp.ExpandDomain)
+		if sp.Auth != nil {
+			ans.Username = sp.Auth.Username
+			ans.Password = sp.Auth.Password
+		}
+		if sp.Repeat.FiveMinute != nil {
+			ans.Repeat = RepeatEveryFiveMinutes
+		} else if sp.Repeat.Hourly != nil {
+			ans.Repeat = RepeatHourly
+		} else if sp.Repeat.Daily != nil {
+			ans.Repeat = RepeatDaily
+			ans.RepeatAt = sp.Repeat.Daily.At
+		} else if sp.Repeat.Weekly != nil {
+			ans.Repeat = RepeatWeekly
+			ans.RepeatAt = sp.Repeat.Weekly.At
+			ans.RepeatDayOfWeek = sp.Repeat.Weekly.DayOfWeek
+		} else if sp.Repeat.Monthly != nil {
+			ans.Repeat = RepeatMonthly
+			ans.RepeatAt = sp.Repeat.Monthly.At
+			ans.RepeatDayOfMonth = sp.Repeat.Monthly.DayOfMonth
+		}
+	}
+
+	return ans
+}
+
 // Ideally there would be one struct for PAN-OS 6.1 & 7.0 and another for
 // PAN-OS 7.1, but since the difference is minimal, I'm using the same struct.
 //
@@ -226,6 +306,26 @@ type rep_v2 struct {
 	Monthly    *timeMonth `xml:"monthly"`
 }
 
+type entry_v3 struct {
+	XMLName       xml.Name        `xml:"entry"`
+	Name          string          `xml:"name,attr"`
+	PredefinedIp  *typePredefined `xml:"type>predefined-ip"`
+	PredefinedUrl *typePredefined `xml:"type>predefined-url"`
+	Ip            *typeSpec_v3    `xml:"type>ip"`
+	Domain        *typeSpec_v3    `xml:"type>domain"`
+	Url           *typeSpec_v3    `xml:"type>url"`
+}
+
+type typeSpec_v3 struct {
+	Description        string           `xml:"description,omitempty"`
+	Source             string           `xml:"url"`
+	CertificateProfile string           `xml:"certificate-profile,omitempty"`
+	Auth               *authType        `xml:"auth"`
+	Repeat             rep_v2           `xml:"recurring"`
+	Exceptions         *util.MemberType `xml:"exception-list"`
+	ExpandDomain       string           `xml:"expand-domain,omitempty"`
+}
+
 func specify_v1(e Entry) interface{} {
 	ans := entry_v1{
 		Name:        e.Name,
@@ -301,3 +401,62 @@ func specify_v2(e Entry) interface{} {
 
 	return ans
 }
+
+func specify_v3(e Entry) interface{} {
+	ans := entry_v3{
+		Name: e.Name,
+	}
+
+	switch e.Type {
+	case TypePredefined, TypePredefinedUrl:
+		p := &typePredefined{
+			Description: e.Description,
+			Source:      e.Source,
+			Exceptions:  util.StrToMem(e.Exceptions),
+		}
+		if e.Type == TypePredefined {
+			ans.PredefinedIp = p
+		} else {
+			ans.PredefinedUrl = p
+		}
+	default:
+		spec := &typeSpec_v3{
+			Description:        e.Description,
+			Source:             e.Source,
+			CertificateProfile: e.CertificateProfile,
+			Exceptions:         util.StrToMem(e.Exceptions),
+		}
+
+		if e.Username != "" || e.Password != "" {
+			spec.Auth = &authType{e.Username, e.Password}
+		}
+
+		sp := ""
+		switch e.Repeat {
+		case RepeatEveryFiveMinutes:
+			spec.Repeat.FiveMinute = &sp
+		case RepeatHourly:
+			spec.Repeat.Hourly = &sp
+		case RepeatDaily:
+			spec.Repeat.Daily = &timeAt{e.RepeatAt}
+		case RepeatWeekly:
+			spec.Repeat.Weekly = &timeWeek{e.RepeatAt, e.RepeatDayOfWeek}
+		case RepeatMonthly:
+			spec.Repeat.Monthly = &timeMonth{e.RepeatAt, e.RepeatDayOfMonth}
+		}
+
+		switch e.Type {
+		case TypeIp:
+			ans.Ip = spec
+		case TypeDomain:
+			if e.ExpandDomain {
+				spec.ExpandDomain = util.YesNo(e.ExpandDomain)
+			}
+			ans.Domain = spec
+		case TypeUrl:
+			ans.Url = spec
+		}
+	}
+
+	return ans
+}
@@ -125,7 +125,9 @@ func (c *FwEdl) Delete(vsys string, e ...interface{}) error {
 func (c *FwEdl) versioning() (normalizer, func(Entry) interface{}) {
 	v := c.con.Versioning()
 
-	if v.Gte(version.Number{8, 0, 0, ""}) {
+	if v.Gte(version.Number{9, 0, 0, ""}) {
+		return &container_v3{}, specify_v3
+	} else if v.Gte(version.Number{8, 0, 0, ""}) {
 		return &container_v2{}, specify_v2
 	} else {
 		return &container_v1{}, specify_v1
@@ -104,6 +104,38 @@ func TestFwNormalization(t *testing.T) {
 			RepeatAt:         "also invalid",
 			RepeatDayOfMonth: 7,
 		}},
+		{"v3 predefined url", version.Number{9, 0, 0, ""}, "", Entry{
+			Name:        "six",
+			Type:        TypePredefinedUrl,
+			Description: "sixth",
+			Source:      "panw-auth-portal-exclude-list",
+			Exceptions:  []string{"example.com/"},
+		}},
+		{"v3 domain expand", version.Number{9, 0, 0, ""}, "", Entry{
+			Name:               "seven",
+			Type:               TypeDomain,
+			Description:        "seventh",
+			Source:             "https://example.com/domains.txt",
+			CertificateProfile: "cp",
+			Username:           "user",
+			Password:           "pass",
+			Repeat:             RepeatWeekly,
+			RepeatAt:           "02",
+			RepeatDayOfWeek:    "sunday",
+			ExpandDomain:       true,
+		}},
+		{"v3 predefined ip", version.Number{9, 0, 0, ""}, "", Entry{
+			Name:   "eight",
+			Type:   TypePredefined,
+			Source: "panw-bulletproof-ip-list",
+		}},
+		{"v3 ip hourly", version.Number{9, 0, 0, ""}, "", Entry{
+			Name:       "nine",
+			Type:       TypeIp,
+			Source:     "https://example.com/ips.txt",
+			Repeat:     RepeatHourly,
+			Exceptions: []string{"10.1.1.1"},
+		}},
 	}
 
 	mc := &testdata.MockClient{}
@@ -130,3 +162,55 @@ func TestFwNormalization(t *testing.T) {
 		})
 	}
 }
+
+func TestFwRefresh(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &FwEdl{}
+	ns.Initialize(mc)
+	mc.AddResp("")
+
+	if err := ns.Refresh("vsys2", TypeDomain, "dl1"); err != nil {
+		t.Fatalf("Error in refresh: %s", err)
+	}
+	if mc.Elm != "<request><system><external-list><refresh><type><domain><name>dl1</name></domain></type></refresh></external-list></system></request>" {
+		t.Errorf("Refresh op is %s", mc.Elm)
+	}
+	if mc.Vsys != "vsys2" {
+		t.Errorf("vsys is %q", mc.Vsys)
+	}
+
+	if err := ns.Refresh("", "imsi", "dl1"); err == nil {
+		t.Errorf("No error for unknown type")
+	}
+}
+
+func TestFwContents(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &FwEdl{}
+	ns.Initialize(mc)
+	mc.AddResp(`<external-list><name>ips</name><vsys>vsys1</vsys><source>https://example.com/ips.txt</source><next-update-at>2022/01/02 03:00:00</next-update-at><referenced>Yes</referenced><valid>Yes</valid><auth-valid>Yes</auth-valid><total-valid>2</total-valid><total-invalid>1</total-invalid><valid-members><member>10.1.1.1</member><member>10.1.1.0/24</member></valid-members></external-list>`)
+
+	ans, err := ns.Contents("", TypeIp, "ips")
+	if err != nil {
+		t.Fatalf("Error in contents: %s", err)
+	}
+
+	expected := Contents{
+		Name:         "ips",
+		Vsys:         "vsys1",
+		Source:       "https://example.com/ips.txt",
+		NextUpdate:   "2022/01/02 03:00:00",
+		Referenced:   true,
+		Valid:        true,
+		AuthValid:    true,
+		TotalValid:   2,
+		TotalInvalid: 1,
+		Members:      []string{"10.1.1.1", "10.1.1.0/24"},
+	}
+	if !reflect.DeepEqual(ans, expected) {
+		t.Errorf("%#v != %#v", ans, expected)
+	}
+	if mc.Elm != "<request><system><external-list><show><type><ip><name>ips</name></ip></type></show></external-list></system></request>" {
+		t.Errorf("Show op is %s", mc.Elm)
+	}
+}
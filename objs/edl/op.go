@@ -0,0 +1,130 @@
+package edl
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Contents is the state and contents of an external dynamic list, as fetched
+// by the firewall.
+//
+// Members are the valid entries of the list.
+type Contents struct {
+	Name         string
+	Vsys         string
+	Source       string
+	NextUpdate   string
+	Referenced   bool
+	Valid        bool
+	AuthValid    bool
+	TotalValid   int
+	TotalInvalid int
+	Members      []string
+}
+
+// Refresh triggers the firewall to fetch the given external dynamic list now
+// instead of waiting for its next scheduled update.
+//
+// The typ param is the Type of the list.
+func (c *FwEdl) Refresh(vsys, typ, name string) error {
+	t, err := opType(typ, name)
+	if err != nil {
+		return err
+	}
+
+	c.con.LogOp("(op) refreshing EDL %q", name)
+	_, err = c.con.Op(listReq{Refresh: &t}, vsys, nil, nil)
+	return err
+}
+
+// Contents returns the current state and contents of the given external
+// dynamic list.
+//
+// The typ param is the Type of the list.
+func (c *FwEdl) Contents(vsys, typ, name string) (Contents, error) {
+	t, err := opType(typ, name)
+	if err != nil {
+		return Contents{}, err
+	}
+
+	var resp contentsResp
+
+	c.con.LogOp("(op) getting contents of EDL %q", name)
+	if _, err = c.con.Op(listReq{Show: &t}, vsys, nil, &resp); err != nil {
+		return Contents{}, err
+	}
+
+	r := resp.Result
+	return Contents{
+		Name:         r.Name,
+		Vsys:         r.Vsys,
+		Source:       r.Source,
+		NextUpdate:   r.NextUpdate,
+		Referenced:   util.AsBool(strings.ToLower(r.Referenced)),
+		Valid:        util.AsBool(strings.ToLower(r.Valid)),
+		AuthValid:    util.AsBool(strings.ToLower(r.AuthValid)),
+		TotalValid:   r.TotalValid,
+		TotalInvalid: r.TotalInvalid,
+		Members:      util.MemToStr(r.Members),
+	}, nil
+}
+
+/** Structs for the op commands. **/
+
+type listReq struct {
+	XMLName xml.Name  `xml:"request"`
+	Refresh *listType `xml:"system>external-list>refresh>type"`
+	Show    *listType `xml:"system>external-list>show>type"`
+}
+
+type listType struct {
+	Ip            *listName `xml:"ip"`
+	Domain        *listName `xml:"domain"`
+	Url           *listName `xml:"url"`
+	PredefinedIp  *listName `xml:"predefined-ip"`
+	PredefinedUrl *listName `xml:"predefined-url"`
+}
+
+type listName struct {
+	Name string `xml:"name"`
+}
+
+func opType(typ, name string) (listType, error) {
+	n := &listName{Name: name}
+
+	switch typ {
+	case TypeIp:
+		return listType{Ip: n}, nil
+	case TypeDomain:
+		return listType{Domain: n}, nil
+	case TypeUrl:
+		return listType{Url: n}, nil
+	case TypePredefined:
+		return listType{PredefinedIp: n}, nil
+	case TypePredefinedUrl:
+		return listType{PredefinedUrl: n}, nil
+	}
+
+	return listType{}, fmt.Errorf("unknown EDL type: %q", typ)
+}
+
+type contentsResp struct {
+	XMLName xml.Name       `xml:"response"`
+	Result  contentsResult `xml:"result>external-list"`
+}
+
+type contentsResult struct {
+	Name         string           `xml:"name"`
+	Vsys         string           `xml:"vsys"`
+	Source       string           `xml:"source"`
+	NextUpdate   string           `xml:"next-update-at"`
+	Referenced   string           `xml:"referenced"`
+	Valid        string           `xml:"valid"`
+	AuthValid    string           `xml:"auth-valid"`
+	TotalValid   int              `xml:"total-valid"`
+	TotalInvalid int              `xml:"total-invalid"`
+	Members      *util.MemberType `xml:"valid-members"`
+}
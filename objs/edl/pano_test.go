@@ -104,6 +104,38 @@ func TestPanoNormalization(t *testing.T) {
 			RepeatAt:         "also invalid",
 			RepeatDayOfMonth: 7,
 		}},
+		{"v3 predefined url", version.Number{9, 0, 0, ""}, "", Entry{
+			Name:        "six",
+			Type:        TypePredefinedUrl,
+			Description: "sixth",
+			Source:      "panw-auth-portal-exclude-list",
+			Exceptions:  []string{"example.com/"},
+		}},
+		{"v3 domain expand", version.Number{9, 0, 0, ""}, "", Entry{
+			Name:               "seven",
+			Type:               TypeDomain,
+			Description:        "seventh",
+			Source:             "https://example.com/domains.txt",
+			CertificateProfile: "cp",
+			Username:           "user",
+			Password:           "pass",
+			Repeat:             RepeatWeekly,
+			RepeatAt:           "02",
+			RepeatDayOfWeek:    "sunday",
+			ExpandDomain:       true,
+		}},
+		{"v3 predefined ip", version.Number{9, 0, 0, ""}, "", Entry{
+			Name:   "eight",
+			Type:   TypePredefined,
+			Source: "panw-bulletproof-ip-list",
+		}},
+		{"v3 ip hourly", version.Number{9, 0, 0, ""}, "", Entry{
+			Name:       "nine",
+			Type:       TypeIp,
+			Source:     "https://example.com/ips.txt",
+			Repeat:     RepeatHourly,
+			Exceptions: []string{"10.1.1.1"},
+		}},
 	}
 
 	mc := &testdata.MockClient{}
@@ -10,10 +10,16 @@ import (
 	"github.com/PaloAltoNetworks/pango/objs/app/signature"
 	"github.com/PaloAltoNetworks/pango/objs/app/signature/andcond"
 	"github.com/PaloAltoNetworks/pango/objs/app/signature/orcond"
+	"github.com/PaloAltoNetworks/pango/objs/custom/urlcat"
+	"github.com/PaloAltoNetworks/pango/objs/device"
 	"github.com/PaloAltoNetworks/pango/objs/edl"
+	"github.com/PaloAltoNetworks/pango/objs/profile/decryption"
+	"github.com/PaloAltoNetworks/pango/objs/profile/dos"
 	"github.com/PaloAltoNetworks/pango/objs/profile/logfwd"
 	"github.com/PaloAltoNetworks/pango/objs/profile/logfwd/matchlist"
 	"github.com/PaloAltoNetworks/pango/objs/profile/logfwd/matchlist/action"
+	"github.com/PaloAltoNetworks/pango/objs/profile/sdwan/pathquality"
+	"github.com/PaloAltoNetworks/pango/objs/profile/sdwan/traffic"
 	"github.com/PaloAltoNetworks/pango/objs/srvc"
 	"github.com/PaloAltoNetworks/pango/objs/srvcgrp"
 	"github.com/PaloAltoNetworks/pango/objs/tags"
@@ -28,10 +34,16 @@ type FwObjs struct {
 	AppSignature                        *signature.FwSignature
 	AppSigAndCond                       *andcond.FwAndCond
 	AppSigOrCond                        *orcond.FwOrCond
+	CustomUrlCategory                   *urlcat.FwUrlCat
+	DecryptionProfile                   *decryption.FwDecryption
+	DosProtectionProfile                *dos.FwDos
+	Device                              *device.FwDevice
 	Edl                                 *edl.FwEdl
 	LogForwardingProfile                *logfwd.FwLogFwd
 	LogForwardingProfileMatchList       *matchlist.FwMatchList
 	LogForwardingProfileMatchListAction *action.FwAction
+	SdwanPathQualityProfile             *pathquality.FwPathQuality
+	SdwanTrafficDistributionProfile     *traffic.FwTraffic
 	Services                            *srvc.FwSrvc
 	ServiceGroup                        *srvcgrp.FwSrvcGrp
 	Tags                                *tags.FwTags
@@ -60,6 +72,18 @@ func (c *FwObjs) Initialize(i util.XapiClient) {
 	c.AppSigOrCond = &orcond.FwOrCond{}
 	c.AppSigOrCond.Initialize(i)
 
+	c.CustomUrlCategory = &urlcat.FwUrlCat{}
+	c.CustomUrlCategory.Initialize(i)
+
+	c.DecryptionProfile = &decryption.FwDecryption{}
+	c.DecryptionProfile.Initialize(i)
+
+	c.DosProtectionProfile = &dos.FwDos{}
+	c.DosProtectionProfile.Initialize(i)
+
+	c.Device = &device.FwDevice{}
+	c.Device.Initialize(i)
+
 	c.Edl = &edl.FwEdl{}
 	c.Edl.Initialize(i)
 
@@ -72,6 +96,12 @@ func (c *FwObjs) Initialize(i util.XapiClient) {
 	c.LogForwardingProfileMatchListAction = &action.FwAction{}
 	c.LogForwardingProfileMatchListAction.Initialize(i)
 
+	c.SdwanPathQualityProfile = &pathquality.FwPathQuality{}
+	c.SdwanPathQualityProfile.Initialize(i)
+
+	c.SdwanTrafficDistributionProfile = &traffic.FwTraffic{}
+	c.SdwanTrafficDistributionProfile.Initialize(i)
+
 	c.Services = &srvc.FwSrvc{}
 	c.Services.Initialize(i)
 
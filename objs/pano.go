@@ -10,10 +10,16 @@ import (
 	"github.com/PaloAltoNetworks/pango/objs/app/signature"
 	"github.com/PaloAltoNetworks/pango/objs/app/signature/andcond"
 	"github.com/PaloAltoNetworks/pango/objs/app/signature/orcond"
+	"github.com/PaloAltoNetworks/pango/objs/custom/urlcat"
+	"github.com/PaloAltoNetworks/pango/objs/device"
 	"github.com/PaloAltoNetworks/pango/objs/edl"
+	"github.com/PaloAltoNetworks/pango/objs/profile/decryption"
+	"github.com/PaloAltoNetworks/pango/objs/profile/dos"
 	"github.com/PaloAltoNetworks/pango/objs/profile/logfwd"
 	"github.com/PaloAltoNetworks/pango/objs/profile/logfwd/matchlist"
 	"github.com/PaloAltoNetworks/pango/objs/profile/logfwd/matchlist/action"
+	"github.com/PaloAltoNetworks/pango/objs/profile/sdwan/pathquality"
+	"github.com/PaloAltoNetworks/pango/objs/profile/sdwan/traffic"
 	"github.com/PaloAltoNetworks/pango/objs/srvc"
 	"github.com/PaloAltoNetworks/pango/objs/srvcgrp"
 	"github.com/PaloAltoNetworks/pango/objs/tags"
@@ -28,10 +34,16 @@ type PanoObjs struct {
 	AppSignature                        *signature.PanoSignature
 	AppSigAndCond                       *andcond.PanoAndCond
 	AppSigOrCond                        *orcond.PanoOrCond
+	CustomUrlCategory                   *urlcat.PanoUrlCat
+	DecryptionProfile                   *decryption.PanoDecryption
+	DosProtectionProfile                *dos.PanoDos
+	Device                              *device.PanoDevice
 	Edl                                 *edl.PanoEdl
 	LogForwardingProfile                *logfwd.PanoLogFwd
 	LogForwardingProfileMatchList       *matchlist.PanoMatchList
 	LogForwardingProfileMatchListAction *action.PanoAction
+	SdwanPathQualityProfile             *pathquality.PanoPathQuality
+	SdwanTrafficDistributionProfile     *traffic.PanoTraffic
 	Services                            *srvc.PanoSrvc
 	ServiceGroup                        *srvcgrp.PanoSrvcGrp
 	Tags                                *tags.PanoTags
@@ -60,6 +72,18 @@ func (c *PanoObjs) Initialize(i util.XapiClient) {
 	c.AppSigOrCond = &orcond.PanoOrCond{}
 	c.AppSigOrCond.Initialize(i)
 
+	c.CustomUrlCategory = &urlcat.PanoUrlCat{}
+	c.CustomUrlCategory.Initialize(i)
+
+	c.DecryptionProfile = &decryption.PanoDecryption{}
+	c.DecryptionProfile.Initialize(i)
+
+	c.DosProtectionProfile = &dos.PanoDos{}
+	c.DosProtectionProfile.Initialize(i)
+
+	c.Device = &device.PanoDevice{}
+	c.Device.Initialize(i)
+
 	c.Edl = &edl.PanoEdl{}
 	c.Edl.Initialize(i)
 
@@ -72,6 +96,12 @@ func (c *PanoObjs) Initialize(i util.XapiClient) {
 	c.LogForwardingProfileMatchListAction = &action.PanoAction{}
 	c.LogForwardingProfileMatchListAction.Initialize(i)
 
+	c.SdwanPathQualityProfile = &pathquality.PanoPathQuality{}
+	c.SdwanPathQualityProfile.Initialize(i)
+
+	c.SdwanTrafficDistributionProfile = &traffic.PanoTraffic{}
+	c.SdwanTrafficDistributionProfile.Initialize(i)
+
 	c.Services = &srvc.PanoSrvc{}
 	c.Services.Initialize(i)
 
@@ -0,0 +1,16 @@
+package decryption
+
+// Valid values for MinVersion and MaxVersion.
+const (
+	VersionSsl3  = "sslv3"
+	VersionTls10 = "tls1-0"
+	VersionTls11 = "tls1-1"
+	VersionTls12 = "tls1-2"
+	VersionTls13 = "tls1-3"
+	VersionMax   = "max"
+)
+
+const (
+	singular = "decryption profile"
+	plural   = "decryption profiles"
+)
@@ -0,0 +1,10 @@
+/*
+Package decryption is the client.Objects.DecryptionProfile namespace.
+
+Decryption profiles control the certificate checks, protocol versions, and
+algorithms allowed for SSL forward proxy, SSL inbound inspection, SSH proxy,
+and traffic that is not decrypted.
+
+Normalized object:  Entry
+*/
+package decryption
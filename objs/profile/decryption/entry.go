@@ -0,0 +1,499 @@
+package decryption
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a decryption
+// profile.
+//
+// The ForwardProxy*, InboundProxy*, NoProxy*, and SshProxy* fields are the
+// checks for the corresponding type of decryption.  The remaining fields are
+// the SSL protocol settings, which apply to both SSL forward proxy and SSL
+// inbound inspection.
+//
+// ForwardProxyBlockIfHsmUnavailable, InboundProxyBlockIfHsmUnavailable,
+// EncryptChacha20Poly1305, and AuthSha384 are PAN-OS 9.0+.
+type Entry struct {
+	Name string
+
+	ForwardProxyBlockExpiredCertificate bool
+	ForwardProxyBlockUntrustedIssuer    bool
+	ForwardProxyBlockUnknownCert        bool
+	ForwardProxyBlockTimeoutCert        bool
+	ForwardProxyRestrictCertExts        bool
+	ForwardProxyBlockUnsupportedVersion bool
+	ForwardProxyBlockUnsupportedCipher  bool
+	ForwardProxyBlockClientCert         bool
+	ForwardProxyBlockIfNoResource       bool
+	ForwardProxyBlockIfHsmUnavailable   bool
+	ForwardProxyStripAlpn               bool
+
+	InboundProxyBlockUnsupportedVersion bool
+	InboundProxyBlockUnsupportedCipher  bool
+	InboundProxyBlockIfNoResource       bool
+	InboundProxyBlockIfHsmUnavailable   bool
+
+	NoProxyBlockExpiredCertificate bool
+	NoProxyBlockUntrustedIssuer    bool
+
+	SshProxyBlockUnsupportedVersion bool
+	SshProxyBlockUnsupportedAlg     bool
+	SshProxyBlockSshErrors          bool
+	SshProxyBlockIfNoResource       bool
+
+	MinVersion              string
+	MaxVersion              string
+	KeyExchangeRsa          bool
+	KeyExchangeDhe          bool
+	KeyExchangeEcdhe        bool
+	Encrypt3des             bool
+	EncryptRc4              bool
+	EncryptAes128Cbc        bool
+	EncryptAes256Cbc        bool
+	EncryptAes128Gcm        bool
+	EncryptAes256Gcm        bool
+	EncryptChacha20Poly1305 bool
+	AuthMd5                 bool
+	AuthSha1                bool
+	AuthSha256              bool
+	AuthSha384              bool
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.ForwardProxyBlockExpiredCertificate = s.ForwardProxyBlockExpiredCertificate
+	o.ForwardProxyBlockUntrustedIssuer = s.ForwardProxyBlockUntrustedIssuer
+	o.ForwardProxyBlockUnknownCert = s.ForwardProxyBlockUnknownCert
+	o.ForwardProxyBlockTimeoutCert = s.ForwardProxyBlockTimeoutCert
+	o.ForwardProxyRestrictCertExts = s.ForwardProxyRestrictCertExts
+	o.ForwardProxyBlockUnsupportedVersion = s.ForwardProxyBlockUnsupportedVersion
+	o.ForwardProxyBlockUnsupportedCipher = s.ForwardProxyBlockUnsupportedCipher
+	o.ForwardProxyBlockClientCert = s.ForwardProxyBlockClientCert
+	o.ForwardProxyBlockIfNoResource = s.ForwardProxyBlockIfNoResource
+	o.ForwardProxyBlockIfHsmUnavailable = s.ForwardProxyBlockIfHsmUnavailable
+	o.ForwardProxyStripAlpn = s.ForwardProxyStripAlpn
+	o.InboundProxyBlockUnsupportedVersion = s.InboundProxyBlockUnsupportedVersion
+	o.InboundProxyBlockUnsupportedCipher = s.InboundProxyBlockUnsupportedCipher
+	o.InboundProxyBlockIfNoResource = s.InboundProxyBlockIfNoResource
+	o.InboundProxyBlockIfHsmUnavailable = s.InboundProxyBlockIfHsmUnavailable
+	o.NoProxyBlockExpiredCertificate = s.NoProxyBlockExpiredCertificate
+	o.NoProxyBlockUntrustedIssuer = s.NoProxyBlockUntrustedIssuer
+	o.SshProxyBlockUnsupportedVersion = s.SshProxyBlockUnsupportedVersion
+	o.SshProxyBlockUnsupportedAlg = s.SshProxyBlockUnsupportedAlg
+	o.SshProxyBlockSshErrors = s.SshProxyBlockSshErrors
+	o.SshProxyBlockIfNoResource = s.SshProxyBlockIfNoResource
+	o.MinVersion = s.MinVersion
+	o.MaxVersion = s.MaxVersion
+	o.KeyExchangeRsa = s.KeyExchangeRsa
+	o.KeyExchangeDhe = s.KeyExchangeDhe
+	o.KeyExchangeEcdhe = s.KeyExchangeEcdhe
+	o.Encrypt3des = s.Encrypt3des
+	o.EncryptRc4 = s.EncryptRc4
+	o.EncryptAes128Cbc = s.EncryptAes128Cbc
+	o.EncryptAes256Cbc = s.EncryptAes256Cbc
+	o.EncryptAes128Gcm = s.EncryptAes128Gcm
+	o.EncryptAes256Gcm = s.EncryptAes256Gcm
+	o.EncryptChacha20Poly1305 = s.EncryptChacha20Poly1305
+	o.AuthMd5 = s.AuthMd5
+	o.AuthSha1 = s.AuthSha1
+	o.AuthSha256 = s.AuthSha256
+	o.AuthSha384 = s.AuthSha384
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this decryption profile.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name: o.Name,
+	}
+
+	if o.ForwardProxy != nil {
+		ans.ForwardProxyBlockExpiredCertificate = util.AsBool(o.ForwardProxy.BlockExpiredCertificate)
+		ans.ForwardProxyBlockUntrustedIssuer = util.AsBool(o.ForwardProxy.BlockUntrustedIssuer)
+		ans.ForwardProxyBlockUnknownCert = util.AsBool(o.ForwardProxy.BlockUnknownCert)
+		ans.ForwardProxyBlockTimeoutCert = util.AsBool(o.ForwardProxy.BlockTimeoutCert)
+		ans.ForwardProxyRestrictCertExts = util.AsBool(o.ForwardProxy.RestrictCertExts)
+		ans.ForwardProxyBlockUnsupportedVersion = util.AsBool(o.ForwardProxy.BlockUnsupportedVersion)
+		ans.ForwardProxyBlockUnsupportedCipher = util.AsBool(o.ForwardProxy.BlockUnsupportedCipher)
+		ans.ForwardProxyBlockClientCert = util.AsBool(o.ForwardProxy.BlockClientCert)
+		ans.ForwardProxyBlockIfNoResource = util.AsBool(o.ForwardProxy.BlockIfNoResource)
+		ans.ForwardProxyStripAlpn = util.AsBool(o.ForwardProxy.StripAlpn)
+	}
+
+	if o.InboundProxy != nil {
+		ans.InboundProxyBlockUnsupportedVersion = util.AsBool(o.InboundProxy.BlockUnsupportedVersion)
+		ans.InboundProxyBlockUnsupportedCipher = util.AsBool(o.InboundProxy.BlockUnsupportedCipher)
+		ans.InboundProxyBlockIfNoResource = util.AsBool(o.InboundProxy.BlockIfNoResource)
+	}
+
+	o.NoProxy.normalize(&ans)
+	o.SshProxy.normalize(&ans)
+
+	if o.Protocol != nil {
+		ans.MinVersion = o.Protocol.MinVersion
+		ans.MaxVersion = o.Protocol.MaxVersion
+		ans.KeyExchangeRsa = util.AsBool(o.Protocol.KeyExchangeRsa)
+		ans.KeyExchangeDhe = util.AsBool(o.Protocol.KeyExchangeDhe)
+		ans.KeyExchangeEcdhe = util.AsBool(o.Protocol.KeyExchangeEcdhe)
+		ans.Encrypt3des = util.AsBool(o.Protocol.Encrypt3des)
+		ans.EncryptRc4 = util.AsBool(o.Protocol.EncryptRc4)
+		ans.EncryptAes128Cbc = util.AsBool(o.Protocol.EncryptAes128Cbc)
+		ans.EncryptAes256Cbc = util.AsBool(o.Protocol.EncryptAes256Cbc)
+		ans.EncryptAes128Gcm = util.AsBool(o.Protocol.EncryptAes128Gcm)
+		ans.EncryptAes256Gcm = util.AsBool(o.Protocol.EncryptAes256Gcm)
+		ans.AuthMd5 = util.AsBool(o.Protocol.AuthMd5)
+		ans.AuthSha1 = util.AsBool(o.Protocol.AuthSha1)
+		ans.AuthSha256 = util.AsBool(o.Protocol.AuthSha256)
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName      xml.Name         `xml:"entry"`
+	Name         string           `xml:"name,attr"`
+	ForwardProxy *forwardProxy_v1 `xml:"ssl-forward-proxy"`
+	InboundProxy *inboundProxy_v1 `xml:"ssl-inbound-proxy"`
+	NoProxy      *noProxy         `xml:"ssl-no-proxy"`
+	SshProxy     *sshProxy        `xml:"ssh-proxy"`
+	Protocol     *protocol_v1     `xml:"ssl-protocol-settings"`
+}
+
+type forwardProxy_v1 struct {
+	BlockExpiredCertificate string `xml:"block-expired-certificate"`
+	BlockUntrustedIssuer    string `xml:"block-untrusted-issuer"`
+	BlockUnknownCert        string `xml:"block-unknown-cert"`
+	BlockTimeoutCert        string `xml:"block-timeout-cert"`
+	RestrictCertExts        string `xml:"restrict-cert-exts"`
+	BlockUnsupportedVersion string `xml:"block-unsupported-version"`
+	BlockUnsupportedCipher  string `xml:"block-unsupported-cipher"`
+	BlockClientCert         string `xml:"block-client-cert"`
+	BlockIfNoResource       string `xml:"block-if-no-resource"`
+	StripAlpn               string `xml:"strip-alpn"`
+}
+
+type inboundProxy_v1 struct {
+	BlockUnsupportedVersion string `xml:"block-unsupported-version"`
+	BlockUnsupportedCipher  string `xml:"block-unsupported-cipher"`
+	BlockIfNoResource       string `xml:"block-if-no-resource"`
+}
+
+type noProxy struct {
+	BlockExpiredCertificate string `xml:"block-expired-certificate"`
+	BlockUntrustedIssuer    string `xml:"block-untrusted-issuer"`
+}
+
+func (o *noProxy) normalize(e *Entry) {
+	if o == nil {
+		return
+	}
+
+	e.NoProxyBlockExpiredCertificate = util.AsBool(o.BlockExpiredCertificate)
+	e.NoProxyBlockUntrustedIssuer = util.AsBool(o.BlockUntrustedIssuer)
+}
+
+func specifyNoProxy(e Entry) *noProxy {
+	return &noProxy{
+		BlockExpiredCertificate: util.YesNo(e.NoProxyBlockExpiredCertificate),
+		BlockUntrustedIssuer:    util.YesNo(e.NoProxyBlockUntrustedIssuer),
+	}
+}
+
+type sshProxy struct {
+	BlockUnsupportedVersion string `xml:"block-unsupported-version"`
+	BlockUnsupportedAlg     string `xml:"block-unsupported-alg"`
+	BlockSshErrors          string `xml:"block-ssh-errors"`
+	BlockIfNoResource       string `xml:"block-if-no-resource"`
+}
+
+func (o *sshProxy) normalize(e *Entry) {
+	if o == nil {
+		return
+	}
+
+	e.SshProxyBlockUnsupportedVersion = util.AsBool(o.BlockUnsupportedVersion)
+	e.SshProxyBlockUnsupportedAlg = util.AsBool(o.BlockUnsupportedAlg)
+	e.SshProxyBlockSshErrors = util.AsBool(o.BlockSshErrors)
+	e.SshProxyBlockIfNoResource = util.AsBool(o.BlockIfNoResource)
+}
+
+func specifySshProxy(e Entry) *sshProxy {
+	return &sshProxy{
+		BlockUnsupportedVersion: util.YesNo(e.SshProxyBlockUnsupportedVersion),
+		BlockUnsupportedAlg:     util.YesNo(e.SshProxyBlockUnsupportedAlg),
+		BlockSshErrors:          util.YesNo(e.SshProxyBlockSshErrors),
+		BlockIfNoResource:       util.YesNo(e.SshProxyBlockIfNoResource),
+	}
+}
+
+type protocol_v1 struct {
+	MinVersion       string `xml:"min-version,omitempty"`
+	MaxVersion       string `xml:"max-version,omitempty"`
+	KeyExchangeRsa   string `xml:"keyxchg-algo-rsa"`
+	KeyExchangeDhe   string `xml:"keyxchg-algo-dhe"`
+	KeyExchangeEcdhe string `xml:"keyxchg-algo-ecdhe"`
+	Encrypt3des      string `xml:"enc-algo-3des"`
+	EncryptRc4       string `xml:"enc-algo-rc4"`
+	EncryptAes128Cbc string `xml:"enc-algo-aes-128-cbc"`
+	EncryptAes256Cbc string `xml:"enc-algo-aes-256-cbc"`
+	EncryptAes128Gcm string `xml:"enc-algo-aes-128-gcm"`
+	EncryptAes256Gcm string `xml:"enc-algo-aes-256-gcm"`
+	AuthMd5          string `xml:"auth-algo-md5"`
+	AuthSha1         string `xml:"auth-algo-sha1"`
+	AuthSha256       string `xml:"auth-algo-sha256"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name: e.Name,
+		ForwardProxy: &forwardProxy_v1{
+			BlockExpiredCertificate: util.YesNo(e.ForwardProxyBlockExpiredCertificate),
+			BlockUntrustedIssuer:    util.YesNo(e.ForwardProxyBlockUntrustedIssuer),
+			BlockUnknownCert:        util.YesNo(e.ForwardProxyBlockUnknownCert),
+			BlockTimeoutCert:        util.YesNo(e.ForwardProxyBlockTimeoutCert),
+			RestrictCertExts:        util.YesNo(e.ForwardProxyRestrictCertExts),
+			BlockUnsupportedVersion: util.YesNo(e.ForwardProxyBlockUnsupportedVersion),
+			BlockUnsupportedCipher:  util.YesNo(e.ForwardProxyBlockUnsupportedCipher),
+			BlockClientCert:         util.YesNo(e.ForwardProxyBlockClientCert),
+			BlockIfNoResource:       util.YesNo(e.ForwardProxyBlockIfNoResource),
+			StripAlpn:               util.YesNo(e.ForwardProxyStripAlpn),
+		},
+		InboundProxy: &inboundProxy_v1{
+			BlockUnsupportedVersion: util.YesNo(e.InboundProxyBlockUnsupportedVersion),
+			BlockUnsupportedCipher:  util.YesNo(e.InboundProxyBlockUnsupportedCipher),
+			BlockIfNoResource:       util.YesNo(e.InboundProxyBlockIfNoResource),
+		},
+		NoProxy:  specifyNoProxy(e),
+		SshProxy: specifySshProxy(e),
+		Protocol: &protocol_v1{
+			MinVersion:       e.MinVersion,
+			MaxVersion:       e.MaxVersion,
+			KeyExchangeRsa:   util.YesNo(e.KeyExchangeRsa),
+			KeyExchangeDhe:   util.YesNo(e.KeyExchangeDhe),
+			KeyExchangeEcdhe: util.YesNo(e.KeyExchangeEcdhe),
+			Encrypt3des:      util.YesNo(e.Encrypt3des),
+			EncryptRc4:       util.YesNo(e.EncryptRc4),
+			EncryptAes128Cbc: util.YesNo(e.EncryptAes128Cbc),
+			EncryptAes256Cbc: util.YesNo(e.EncryptAes256Cbc),
+			EncryptAes128Gcm: util.YesNo(e.EncryptAes128Gcm),
+			EncryptAes256Gcm: util.YesNo(e.EncryptAes256Gcm),
+			AuthMd5:          util.YesNo(e.AuthMd5),
+			AuthSha1:         util.YesNo(e.AuthSha1),
+			AuthSha256:       util.YesNo(e.AuthSha256),
+		},
+	}
+
+	return ans
+}
+
+// PAN-OS 9.0, adds block-if-hsm-unavailable, chacha20-poly1305, and sha384.
+type container_v2 struct {
+	Answer []entry_v2 `xml:"entry"`
+}
+
+func (o *container_v2) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v2) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v2) normalize() Entry {
+	ans := Entry{
+		Name: o.Name,
+	}
+
+	if o.ForwardProxy != nil {
+		ans.ForwardProxyBlockExpiredCertificate = util.AsBool(o.ForwardProxy.BlockExpiredCertificate)
+		ans.ForwardProxyBlockUntrustedIssuer = util.AsBool(o.ForwardProxy.BlockUntrustedIssuer)
+		ans.ForwardProxyBlockUnknownCert = util.AsBool(o.ForwardProxy.BlockUnknownCert)
+		ans.ForwardProxyBlockTimeoutCert = util.AsBool(o.ForwardProxy.BlockTimeoutCert)
+		ans.ForwardProxyRestrictCertExts = util.AsBool(o.ForwardProxy.RestrictCertExts)
+		ans.ForwardProxyBlockUnsupportedVersion = util.AsBool(o.ForwardProxy.BlockUnsupportedVersion)
+		ans.ForwardProxyBlockUnsupportedCipher = util.AsBool(o.ForwardProxy.BlockUnsupportedCipher)
+		ans.ForwardProxyBlockClientCert = util.AsBool(o.ForwardProxy.BlockClientCert)
+		ans.ForwardProxyBlockIfNoResource = util.AsBool(o.ForwardProxy.BlockIfNoResource)
+		ans.ForwardProxyBlockIfHsmUnavailable = util.AsBool(o.ForwardProxy.BlockIfHsmUnavailable)
+		ans.ForwardProxyStripAlpn = util.AsBool(o.ForwardProxy.StripAlpn)
+	}
+
+	if o.InboundProxy != nil {
+		ans.InboundProxyBlockUnsupportedVersion = util.AsBool(o.InboundProxy.BlockUnsupportedVersion)
+		ans.InboundProxyBlockUnsupportedCipher = util.AsBool(o.InboundProxy.BlockUnsupportedCipher)
+		ans.InboundProxyBlockIfNoResource = util.AsBool(o.InboundProxy.BlockIfNoResource)
+		ans.InboundProxyBlockIfHsmUnavailable = util.AsBool(o.InboundProxy.BlockIfHsmUnavailable)
+	}
+
+	o.NoProxy.normalize(&ans)
+	o.SshProxy.normalize(&ans)
+
+	if o.Protocol != nil {
+		ans.MinVersion = o.Protocol.MinVersion
+		ans.MaxVersion = o.Protocol.MaxVersion
+		ans.KeyExchangeRsa = util.AsBool(o.Protocol.KeyExchangeRsa)
+		ans.KeyExchangeDhe = util.AsBool(o.Protocol.KeyExchangeDhe)
+		ans.KeyExchangeEcdhe = util.AsBool(o.Protocol.KeyExchangeEcdhe)
+		ans.Encrypt3des = util.AsBool(o.Protocol.Encrypt3des)
+		ans.EncryptRc4 = util.AsBool(o.Protocol.EncryptRc4)
+		ans.EncryptAes128Cbc = util.AsBool(o.Protocol.EncryptAes128Cbc)
+		ans.EncryptAes256Cbc = util.AsBool(o.Protocol.EncryptAes256Cbc)
+		ans.EncryptAes128Gcm = util.AsBool(o.Protocol.EncryptAes128Gcm)
+		ans.EncryptAes256Gcm = util.AsBool(o.Protocol.EncryptAes256Gcm)
+		ans.EncryptChacha20Poly1305 = util.AsBool(o.Protocol.EncryptChacha20Poly1305)
+		ans.AuthMd5 = util.AsBool(o.Protocol.AuthMd5)
+		ans.AuthSha1 = util.AsBool(o.Protocol.AuthSha1)
+		ans.AuthSha256 = util.AsBool(o.Protocol.AuthSha256)
+		ans.AuthSha384 = util.AsBool(o.Protocol.AuthSha384)
+	}
+
+	return ans
+}
+
+type entry_v2 struct {
+	XMLName      xml.Name         `xml:"entry"`
+	Name         string           `xml:"name,attr"`
+	ForwardProxy *forwardProxy_v2 `xml:"ssl-forward-proxy"`
+	InboundProxy *inboundProxy_v2 `xml:"ssl-inbound-proxy"`
+	NoProxy      *noProxy         `xml:"ssl-no-proxy"`
+	SshProxy     *sshProxy        `xml:"ssh-proxy"`
+	Protocol     *protocol_v2     `xml:"ssl-protocol-settings"`
+}
+
+type forwardProxy_v2 struct {
+	BlockExpiredCertificate string `xml:"block-expired-certificate"`
+	BlockUntrustedIssuer    string `xml:"block-untrusted-issuer"`
+	BlockUnknownCert        string `xml:"block-unknown-cert"`
+	BlockTimeoutCert        string `xml:"block-timeout-cert"`
+	RestrictCertExts        string `xml:"restrict-cert-exts"`
+	BlockUnsupportedVersion string `xml:"block-unsupported-version"`
+	BlockUnsupportedCipher  string `xml:"block-unsupported-cipher"`
+	BlockClientCert         string `xml:"block-client-cert"`
+	BlockIfNoResource       string `xml:"block-if-no-resource"`
+	BlockIfHsmUnavailable   string `xml:"block-if-hsm-unavailable"`
+	StripAlpn               string `xml:"strip-alpn"`
+}
+
+type inboundProxy_v2 struct {
+	BlockUnsupportedVersion string `xml:"block-unsupported-version"`
+	BlockUnsupportedCipher  string `xml:"block-unsupported-cipher"`
+	BlockIfNoResource       string `xml:"block-if-no-resource"`
+	BlockIfHsmUnavailable   string `xml:"block-if-hsm-unavailable"`
+}
+
+type protocol_v2 struct {
+	MinVersion              string `xml:"min-version,omitempty"`
+	MaxVersion              string `xml:"max-version,omitempty"`
+	KeyExchangeRsa          string `xml:"keyxchg-algo-rsa"`
+	KeyExchangeDhe          string `xml:"keyxchg-algo-dhe"`
+	KeyExchangeEcdhe        string `xml:"keyxchg-algo-ecdhe"`
+	Encrypt3des             string `xml:"enc-algo-3des"`
+	EncryptRc4              string `xml:"enc-algo-rc4"`
+	EncryptAes128Cbc        string `xml:"enc-algo-aes-128-cbc"`
+	EncryptAes256Cbc        string `xml:"enc-algo-aes-256-cbc"`
+	EncryptAes128Gcm        string `xml:"enc-algo-aes-128-gcm"`
+	EncryptAes256Gcm        string `xml:"enc-algo-aes-256-gcm"`
+	EncryptChacha20Poly1305 string `xml:"enc-algo-chacha20-poly1305"`
+	AuthMd5                 string `xml:"auth-algo-md5"`
+	AuthSha1                string `xml:"auth-algo-sha1"`
+	AuthSha256              string `xml:"auth-algo-sha256"`
+	AuthSha384              string `xml:"auth-algo-sha384"`
+}
+
+func specify_v2(e Entry) interface{} {
+	ans := entry_v2{
+		Name: e.Name,
+		ForwardProxy: &forwardProxy_v2{
+			BlockExpiredCertificate: util.YesNo(e.ForwardProxyBlockExpiredCertificate),
+			BlockUntrustedIssuer:    util.YesNo(e.ForwardProxyBlockUntrustedIssuer),
+			BlockUnknownCert:        util.YesNo(e.ForwardProxyBlockUnknownCert),
+			BlockTimeoutCert:        util.YesNo(e.ForwardProxyBlockTimeoutCert),
+			RestrictCertExts:        util.YesNo(e.ForwardProxyRestrictCertExts),
+			BlockUnsupportedVersion: util.YesNo(e.ForwardProxyBlockUnsupportedVersion),
+			BlockUnsupportedCipher:  util.YesNo(e.ForwardProxyBlockUnsupportedCipher),
+			BlockClientCert:         util.YesNo(e.ForwardProxyBlockClientCert),
+			BlockIfNoResource:       util.YesNo(e.ForwardProxyBlockIfNoResource),
+			BlockIfHsmUnavailable:   util.YesNo(e.ForwardProxyBlockIfHsmUnavailable),
+			StripAlpn:               util.YesNo(e.ForwardProxyStripAlpn),
+		},
+		InboundProxy: &inboundProxy_v2{
+			BlockUnsupportedVersion: util.YesNo(e.InboundProxyBlockUnsupportedVersion),
+			BlockUnsupportedCipher:  util.YesNo(e.InboundProxyBlockUnsupportedCipher),
+			BlockIfNoResource:       util.YesNo(e.InboundProxyBlockIfNoResource),
+			BlockIfHsmUnavailable:   util.YesNo(e.InboundProxyBlockIfHsmUnavailable),
+		},
+		NoProxy:  specifyNoProxy(e),
+		SshProxy: specifySshProxy(e),
+		Protocol: &protocol_v2{
+			MinVersion:              e.MinVersion,
+			MaxVersion:              e.MaxVersion,
+			KeyExchangeRsa:          util.YesNo(e.KeyExchangeRsa),
+			KeyExchangeDhe:          util.YesNo(e.KeyExchangeDhe),
+			KeyExchangeEcdhe:        util.YesNo(e.KeyExchangeEcdhe),
+			Encrypt3des:             util.YesNo(e.Encrypt3des),
+			EncryptRc4:              util.YesNo(e.EncryptRc4),
+			EncryptAes128Cbc:        util.YesNo(e.EncryptAes128Cbc),
+			EncryptAes256Cbc:        util.YesNo(e.EncryptAes256Cbc),
+			EncryptAes128Gcm:        util.YesNo(e.EncryptAes128Gcm),
+			EncryptAes256Gcm:        util.YesNo(e.EncryptAes256Gcm),
+			EncryptChacha20Poly1305: util.YesNo(e.EncryptChacha20Poly1305),
+			AuthMd5:                 util.YesNo(e.AuthMd5),
+			AuthSha1:                util.YesNo(e.AuthSha1),
+			AuthSha256:              util.YesNo(e.AuthSha256),
+			AuthSha384:              util.YesNo(e.AuthSha384),
+		},
+	}
+
+	return ans
+}
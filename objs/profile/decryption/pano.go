@@ -0,0 +1,100 @@
+package decryption
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+// PanoDecryption is a namespace struct, included as part of pango.Panorama.
+type PanoDecryption struct {
+	con util.XapiClient
+	ns  *namespace.Standard[Entry]
+}
+
+// Initialize is invoked when Initialize on the pango.Panorama is called.
+func (c *PanoDecryption) Initialize(con util.XapiClient) {
+	c.con = con
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *PanoDecryption) GetList(dg string) ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(dg, nil))
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *PanoDecryption) ShowList(dg string) ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(dg, nil))
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *PanoDecryption) Get(dg, name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath(dg, []string{name}), name)
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *PanoDecryption) GetAll(dg string) ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(dg, nil))
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *PanoDecryption) Show(dg, name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath(dg, []string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *PanoDecryption) ShowAll(dg string) ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(dg, nil))
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *PanoDecryption) Set(dg string, e ...Entry) error {
+	return c.ns.SetEntries(c.pather(dg), e...)
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *PanoDecryption) Edit(dg string, e Entry) error {
+	return c.ns.EditEntry(c.pather(dg), e)
+}
+
+// Delete removes the given objects.
+//
+// Objects can be either a string or an Entry object.
+func (c *PanoDecryption) Delete(dg string, e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(dg), e...)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *PanoDecryption) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	v := c.con.Versioning()
+
+	if v.Gte(version.Number{9, 0, 0, ""}) {
+		return &container_v2{}, specify_v2
+	} else {
+		return &container_v1{}, specify_v1
+	}
+}
+
+func (c *PanoDecryption) pather(dg string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(dg, v)
+	}
+}
+
+func (c *PanoDecryption) xpath(dg string, vals []string) []string {
+	if dg == "" {
+		dg = "shared"
+	}
+
+	ans := make([]string, 0, 8)
+	ans = append(ans, util.DeviceGroupXpathPrefix(dg)...)
+	ans = append(ans,
+		"profiles",
+		"decryption",
+		util.AsEntryXpath(vals),
+	)
+
+	return ans
+}
@@ -0,0 +1,70 @@
+package decryption
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type testCase struct {
+	desc    string
+	version version.Number
+	conf    Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"v1 basic", version.Number{8, 1, 0, ""}, Entry{
+			Name: "one",
+		}},
+		{"v1 full", version.Number{8, 1, 0, ""}, Entry{
+			Name:                                "two",
+			ForwardProxyBlockExpiredCertificate: true,
+			ForwardProxyBlockUntrustedIssuer:    true,
+			ForwardProxyBlockUnknownCert:        true,
+			ForwardProxyBlockTimeoutCert:        true,
+			ForwardProxyRestrictCertExts:        true,
+			ForwardProxyBlockUnsupportedVersion: true,
+			ForwardProxyBlockUnsupportedCipher:  true,
+			ForwardProxyBlockClientCert:         true,
+			ForwardProxyBlockIfNoResource:       true,
+			ForwardProxyStripAlpn:               true,
+			InboundProxyBlockUnsupportedVersion: true,
+			InboundProxyBlockUnsupportedCipher:  true,
+			InboundProxyBlockIfNoResource:       true,
+			NoProxyBlockExpiredCertificate:      true,
+			NoProxyBlockUntrustedIssuer:         true,
+			SshProxyBlockUnsupportedVersion:     true,
+			SshProxyBlockUnsupportedAlg:         true,
+			SshProxyBlockSshErrors:              true,
+			SshProxyBlockIfNoResource:           true,
+			MinVersion:                          VersionTls10,
+			MaxVersion:                          VersionMax,
+			KeyExchangeRsa:                      true,
+			KeyExchangeDhe:                      true,
+			KeyExchangeEcdhe:                    true,
+			Encrypt3des:                         true,
+			EncryptRc4:                          true,
+			EncryptAes128Cbc:                    true,
+			EncryptAes256Cbc:                    true,
+			EncryptAes128Gcm:                    true,
+			EncryptAes256Gcm:                    true,
+			AuthMd5:                             true,
+			AuthSha1:                            true,
+			AuthSha256:                          true,
+		}},
+		{"v2 basic", version.Number{9, 0, 0, ""}, Entry{
+			Name: "one",
+		}},
+		{"v2 tls settings", version.Number{9, 0, 0, ""}, Entry{
+			Name:                              "two",
+			ForwardProxyBlockIfHsmUnavailable: true,
+			InboundProxyBlockIfHsmUnavailable: true,
+			MinVersion:                        VersionTls12,
+			MaxVersion:                        VersionTls13,
+			KeyExchangeEcdhe:                  true,
+			EncryptAes256Gcm:                  true,
+			EncryptChacha20Poly1305:           true,
+			AuthSha256:                        true,
+			AuthSha384:                        true,
+		}},
+	}
+}
@@ -0,0 +1,18 @@
+package dos
+
+// Valid values for Type.
+const (
+	TypeAggregate  = "aggregate"
+	TypeClassified = "classified"
+)
+
+// Valid values for the SynFlood Action.
+const (
+	ActionRed        = "red"
+	ActionSynCookies = "syn-cookies"
+)
+
+const (
+	singular = "dos protection profile"
+	plural   = "dos protection profiles"
+)
@@ -0,0 +1,11 @@
+/*
+Package dos is the client.Objects.DosProtectionProfile namespace.
+
+DoS protection profiles set the flood thresholds for SYN, UDP, ICMP, ICMPv6,
+and other IP traffic, as well as the session resource limit.  Aggregate
+profiles apply the thresholds to all matching traffic combined, while
+classified profiles apply them per source and / or destination IP.
+
+Normalized object:  Entry
+*/
+package dos
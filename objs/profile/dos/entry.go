@@ -0,0 +1,283 @@
+package dos
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a DoS
+// protection profile.
+//
+// Each flood param is nil if that flood type has no config.
+//
+// Sessions enables the session resource limit of MaxConcurrentSessions.
+type Entry struct {
+	Name                  string
+	Type                  string
+	Description           string
+	SynFlood              *Flood
+	UdpFlood              *Flood
+	IcmpFlood             *Flood
+	Icmpv6Flood           *Flood
+	OtherIpFlood          *Flood
+	Sessions              bool
+	MaxConcurrentSessions int
+}
+
+// Flood is the protection config for one type of flood.
+//
+// Rates are in packets per second.  BlockDuration is in seconds.
+//
+// Action is only used by SynFlood, and is either random early drop (the
+// default) or SYN cookies.  All other flood types always use random early
+// drop.
+type Flood struct {
+	Enabled       bool
+	Action        string
+	AlarmRate     int
+	ActivateRate  int
+	MaxRate       int
+	BlockDuration int
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Type = s.Type
+	o.Description = s.Description
+	o.SynFlood = s.SynFlood
+	o.UdpFlood = s.UdpFlood
+	o.IcmpFlood = s.IcmpFlood
+	o.Icmpv6Flood = s.Icmpv6Flood
+	o.OtherIpFlood = s.OtherIpFlood
+	o.Sessions = s.Sessions
+	o.MaxConcurrentSessions = s.MaxConcurrentSessions
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this DoS protection profile.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:        o.Name,
+		Type:        o.Type,
+		Description: o.Description,
+	}
+
+	if o.Flood != nil {
+		if o.Flood.Syn != nil {
+			ans.SynFlood = o.Flood.Syn.normalize()
+		}
+		ans.UdpFlood = o.Flood.Udp.normalize()
+		ans.IcmpFlood = o.Flood.Icmp.normalize()
+		ans.Icmpv6Flood = o.Flood.Icmpv6.normalize()
+		ans.OtherIpFlood = o.Flood.OtherIp.normalize()
+	}
+
+	if o.Resource != nil && o.Resource.Sessions != nil {
+		ans.Sessions = util.AsBool(o.Resource.Sessions.Enabled)
+		ans.MaxConcurrentSessions = o.Resource.Sessions.MaxConcurrentSessions
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName     xml.Name  `xml:"entry"`
+	Name        string    `xml:"name,attr"`
+	Type        string    `xml:"type"`
+	Description string    `xml:"description,omitempty"`
+	Flood       *flood    `xml:"flood"`
+	Resource    *resource `xml:"resource"`
+}
+
+type flood struct {
+	Syn     *synFlood `xml:"tcp-syn"`
+	Udp     *redFlood `xml:"udp"`
+	Icmp    *redFlood `xml:"icmp"`
+	Icmpv6  *redFlood `xml:"icmpv6"`
+	OtherIp *redFlood `xml:"other-ip"`
+}
+
+type synFlood struct {
+	Enabled    string     `xml:"enable"`
+	Red        *floodRate `xml:"red"`
+	SynCookies *floodRate `xml:"syn-cookies"`
+}
+
+func (o *synFlood) normalize() *Flood {
+	ans := &Flood{
+		Enabled: util.AsBool(o.Enabled),
+	}
+
+	switch {
+	case o.Red != nil:
+		ans.Action = ActionRed
+		o.Red.normalize(ans)
+	case o.SynCookies != nil:
+		ans.Action = ActionSynCookies
+		o.SynCookies.normalize(ans)
+	}
+
+	return ans
+}
+
+type redFlood struct {
+	Enabled string     `xml:"enable"`
+	Red     *floodRate `xml:"red"`
+}
+
+func (o *redFlood) normalize() *Flood {
+	if o == nil {
+		return nil
+	}
+
+	ans := &Flood{
+		Enabled: util.AsBool(o.Enabled),
+	}
+	o.Red.normalize(ans)
+
+	return ans
+}
+
+type floodRate struct {
+	AlarmRate    int         `xml:"alarm-rate,omitempty"`
+	ActivateRate int         `xml:"activate-rate,omitempty"`
+	MaxRate      int         `xml:"maximal-rate,omitempty"`
+	Block        *floodBlock `xml:"block"`
+}
+
+type floodBlock struct {
+	Duration int `xml:"duration,omitempty"`
+}
+
+func (o *floodRate) normalize(f *Flood) {
+	if o == nil {
+		return
+	}
+
+	f.AlarmRate = o.AlarmRate
+	f.ActivateRate = o.ActivateRate
+	f.MaxRate = o.MaxRate
+	if o.Block != nil {
+		f.BlockDuration = o.Block.Duration
+	}
+}
+
+func specifyRate(f *Flood) *floodRate {
+	ans := &floodRate{
+		AlarmRate:    f.AlarmRate,
+		ActivateRate: f.ActivateRate,
+		MaxRate:      f.MaxRate,
+	}
+
+	if f.BlockDuration != 0 {
+		ans.Block = &floodBlock{Duration: f.BlockDuration}
+	}
+
+	return ans
+}
+
+func specifySynFlood(f *Flood) *synFlood {
+	if f == nil {
+		return nil
+	}
+
+	ans := &synFlood{
+		Enabled: util.YesNo(f.Enabled),
+	}
+
+	if f.Action == ActionSynCookies {
+		ans.SynCookies = specifyRate(f)
+	} else {
+		ans.Red = specifyRate(f)
+	}
+
+	return ans
+}
+
+func specifyRedFlood(f *Flood) *redFlood {
+	if f == nil {
+		return nil
+	}
+
+	return &redFlood{
+		Enabled: util.YesNo(f.Enabled),
+		Red:     specifyRate(f),
+	}
+}
+
+type resource struct {
+	Sessions *sessions `xml:"sessions"`
+}
+
+type sessions struct {
+	Enabled               string `xml:"enabled"`
+	MaxConcurrentSessions int    `xml:"max-concurrent-limit,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:        e.Name,
+		Type:        e.Type,
+		Description: e.Description,
+	}
+
+	if e.SynFlood != nil || e.UdpFlood != nil || e.IcmpFlood != nil || e.Icmpv6Flood != nil || e.OtherIpFlood != nil {
+		ans.Flood = &flood{
+			Syn:     specifySynFlood(e.SynFlood),
+			Udp:     specifyRedFlood(e.UdpFlood),
+			Icmp:    specifyRedFlood(e.IcmpFlood),
+			Icmpv6:  specifyRedFlood(e.Icmpv6Flood),
+			OtherIp: specifyRedFlood(e.OtherIpFlood),
+		}
+	}
+
+	if e.Sessions || e.MaxConcurrentSessions != 0 {
+		ans.Resource = &resource{
+			Sessions: &sessions{
+				Enabled:               util.YesNo(e.Sessions),
+				MaxConcurrentSessions: e.MaxConcurrentSessions,
+			},
+		}
+	}
+
+	return ans
+}
@@ -0,0 +1,91 @@
+package dos
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// PanoDos is a namespace struct, included as part of pango.Panorama.
+type PanoDos struct {
+	ns *namespace.Standard[Entry]
+}
+
+// Initialize is invoked when Initialize on the pango.Panorama is called.
+func (c *PanoDos) Initialize(con util.XapiClient) {
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *PanoDos) GetList(dg string) ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(dg, nil))
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *PanoDos) ShowList(dg string) ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(dg, nil))
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *PanoDos) Get(dg, name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath(dg, []string{name}), name)
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *PanoDos) GetAll(dg string) ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(dg, nil))
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *PanoDos) Show(dg, name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath(dg, []string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *PanoDos) ShowAll(dg string) ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(dg, nil))
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *PanoDos) Set(dg string, e ...Entry) error {
+	return c.ns.SetEntries(c.pather(dg), e...)
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *PanoDos) Edit(dg string, e Entry) error {
+	return c.ns.EditEntry(c.pather(dg), e)
+}
+
+// Delete removes the given objects.
+//
+// Objects can be either a string or an Entry object.
+func (c *PanoDos) Delete(dg string, e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(dg), e...)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *PanoDos) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *PanoDos) pather(dg string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(dg, v)
+	}
+}
+
+func (c *PanoDos) xpath(dg string, vals []string) []string {
+	if dg == "" {
+		dg = "shared"
+	}
+
+	ans := make([]string, 0, 8)
+	ans = append(ans, util.DeviceGroupXpathPrefix(dg)...)
+	ans = append(ans,
+		"profiles",
+		"dos-protection",
+		util.AsEntryXpath(vals),
+	)
+
+	return ans
+}
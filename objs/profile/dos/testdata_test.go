@@ -0,0 +1,61 @@
+package dos
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"basic", Entry{
+			Name: "one",
+			Type: TypeAggregate,
+		}},
+		{"syn red with sessions", Entry{
+			Name:        "two",
+			Type:        TypeAggregate,
+			Description: "edge",
+			SynFlood: &Flood{
+				Enabled:       true,
+				Action:        ActionRed,
+				AlarmRate:     10000,
+				ActivateRate:  10000,
+				MaxRate:       40000,
+				BlockDuration: 300,
+			},
+			Sessions:              true,
+			MaxConcurrentSessions: 32768,
+		}},
+		{"syn cookies", Entry{
+			Name: "three",
+			Type: TypeClassified,
+			SynFlood: &Flood{
+				Action:    ActionSynCookies,
+				AlarmRate: 5000,
+				MaxRate:   20000,
+			},
+		}},
+		{"all floods", Entry{
+			Name: "four",
+			Type: TypeClassified,
+			UdpFlood: &Flood{
+				Enabled:       true,
+				AlarmRate:     1000,
+				ActivateRate:  2000,
+				MaxRate:       3000,
+				BlockDuration: 60,
+			},
+			IcmpFlood: &Flood{
+				Enabled: true,
+				MaxRate: 100,
+			},
+			Icmpv6Flood: &Flood{
+				AlarmRate: 10,
+			},
+			OtherIpFlood: &Flood{
+				Enabled:      true,
+				ActivateRate: 500,
+			},
+		}},
+	}
+}
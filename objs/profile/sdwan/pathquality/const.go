@@ -0,0 +1,13 @@
+package pathquality
+
+// Valid values for the sensitivity params.
+const (
+	SensitivityLow    = "low"
+	SensitivityMedium = "medium"
+	SensitivityHigh   = "high"
+)
+
+const (
+	singular = "sdwan path quality profile"
+	plural   = "sdwan path quality profiles"
+)
@@ -0,0 +1,11 @@
+/*
+Package pathquality is the client.Objects.SdwanPathQualityProfile namespace.
+
+Path quality profiles set the latency, jitter, and packet loss thresholds that
+SD-WAN uses to decide when a path has degraded.
+
+Only valid for PAN-OS 9.1+.
+
+Normalized object:  Entry
+*/
+package pathquality
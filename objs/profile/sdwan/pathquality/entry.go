@@ -0,0 +1,137 @@
+package pathquality
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an SD-WAN
+// path quality profile.
+//
+// Latency and jitter thresholds are in milliseconds, and the packet loss
+// threshold is a percentage.
+type Entry struct {
+	Name                  string
+	LatencyThreshold      int
+	LatencySensitivity    string
+	PacketLossThreshold   int
+	PacketLossSensitivity string
+	JitterThreshold       int
+	JitterSensitivity     string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.LatencyThreshold = s.LatencyThreshold
+	o.LatencySensitivity = s.LatencySensitivity
+	o.PacketLossThreshold = s.PacketLossThreshold
+	o.PacketLossSensitivity = s.PacketLossSensitivity
+	o.JitterThreshold = s.JitterThreshold
+	o.JitterSensitivity = s.JitterSensitivity
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this path quality profile.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name: o.Name,
+	}
+
+	if o.Metric != nil {
+		if o.Metric.Latency != nil {
+			ans.LatencyThreshold = o.Metric.Latency.Threshold
+			ans.LatencySensitivity = o.Metric.Latency.Sensitivity
+		}
+		if o.Metric.PacketLoss != nil {
+			ans.PacketLossThreshold = o.Metric.PacketLoss.Threshold
+			ans.PacketLossSensitivity = o.Metric.PacketLoss.Sensitivity
+		}
+		if o.Metric.Jitter != nil {
+			ans.JitterThreshold = o.Metric.Jitter.Threshold
+			ans.JitterSensitivity = o.Metric.Jitter.Sensitivity
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName xml.Name `xml:"entry"`
+	Name    string   `xml:"name,attr"`
+	Metric  *metric  `xml:"metric"`
+}
+
+type metric struct {
+	Latency    *threshold `xml:"latency"`
+	PacketLoss *threshold `xml:"pkt-loss"`
+	Jitter     *threshold `xml:"jitter"`
+}
+
+type threshold struct {
+	Threshold   int    `xml:"threshold,omitempty"`
+	Sensitivity string `xml:"sensitivity,omitempty"`
+}
+
+func specifyThreshold(t int, s string) *threshold {
+	if t == 0 && s == "" {
+		return nil
+	}
+
+	return &threshold{Threshold: t, Sensitivity: s}
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name: e.Name,
+	}
+
+	m := metric{
+		Latency:    specifyThreshold(e.LatencyThreshold, e.LatencySensitivity),
+		PacketLoss: specifyThreshold(e.PacketLossThreshold, e.PacketLossSensitivity),
+		Jitter:     specifyThreshold(e.JitterThreshold, e.JitterSensitivity),
+	}
+	if m.Latency != nil || m.PacketLoss != nil || m.Jitter != nil {
+		ans.Metric = &m
+	}
+
+	return ans
+}
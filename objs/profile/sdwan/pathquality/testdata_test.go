@@ -0,0 +1,28 @@
+package pathquality
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"no metrics", Entry{
+			Name: "one",
+		}},
+		{"latency only", Entry{
+			Name:               "two",
+			LatencyThreshold:   100,
+			LatencySensitivity: SensitivityHigh,
+		}},
+		{"all metrics", Entry{
+			Name:                  "three",
+			LatencyThreshold:      150,
+			LatencySensitivity:    SensitivityMedium,
+			PacketLossThreshold:   2,
+			PacketLossSensitivity: SensitivityLow,
+			JitterThreshold:       30,
+			JitterSensitivity:     SensitivityMedium,
+		}},
+	}
+}
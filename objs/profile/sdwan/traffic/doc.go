@@ -0,0 +1,12 @@
+/*
+Package traffic is the client.Objects.SdwanTrafficDistributionProfile
+namespace.
+
+Traffic distribution profiles control how SD-WAN distributes sessions across
+the links with the given link tags.
+
+Only valid for PAN-OS 9.1+.
+
+Normalized object:  Entry
+*/
+package traffic
@@ -0,0 +1,130 @@
+package traffic
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an SD-WAN
+// traffic distribution profile.
+type Entry struct {
+	Name         string
+	Distribution string
+	LinkTags     []LinkTag // ordered
+}
+
+// LinkTag is a link tag in a traffic distribution profile.
+//
+// The Weight is only used for weighted session distribution.
+type LinkTag struct {
+	Name   string
+	Weight int
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Distribution = s.Distribution
+	if s.LinkTags == nil {
+		o.LinkTags = nil
+	} else {
+		o.LinkTags = make([]LinkTag, len(s.LinkTags))
+		copy(o.LinkTags, s.LinkTags)
+	}
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this traffic distribution profile.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:         o.Name,
+		Distribution: o.Distribution,
+	}
+
+	if o.LinkTags != nil {
+		list := make([]LinkTag, 0, len(o.LinkTags.Entries))
+		for _, x := range o.LinkTags.Entries {
+			list = append(list, LinkTag{
+				Name:   x.Name,
+				Weight: x.Weight,
+			})
+		}
+		ans.LinkTags = list
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName      xml.Name  `xml:"entry"`
+	Name         string    `xml:"name,attr"`
+	Distribution string    `xml:"traffic-distribution,omitempty"`
+	LinkTags     *linkTags `xml:"link-tags"`
+}
+
+type linkTags struct {
+	Entries []linkTag `xml:"entry"`
+}
+
+type linkTag struct {
+	Name   string `xml:"name,attr"`
+	Weight int    `xml:"weight,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:         e.Name,
+		Distribution: e.Distribution,
+	}
+
+	if len(e.LinkTags) > 0 {
+		list := make([]linkTag, 0, len(e.LinkTags))
+		for _, x := range e.LinkTags {
+			list = append(list, linkTag{
+				Name:   x.Name,
+				Weight: x.Weight,
+			})
+		}
+		ans.LinkTags = &linkTags{Entries: list}
+	}
+
+	return ans
+}
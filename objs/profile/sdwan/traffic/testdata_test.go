@@ -0,0 +1,35 @@
+package traffic
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"best available", Entry{
+			Name:         "one",
+			Distribution: DistributionBestAvailablePath,
+			LinkTags: []LinkTag{
+				{Name: "broadband"},
+			},
+		}},
+		{"top down", Entry{
+			Name:         "two",
+			Distribution: DistributionTopDownPriority,
+			LinkTags: []LinkTag{
+				{Name: "mpls"},
+				{Name: "broadband"},
+				{Name: "lte"},
+			},
+		}},
+		{"weighted", Entry{
+			Name:         "three",
+			Distribution: DistributionWeightedSessionDistribution,
+			LinkTags: []LinkTag{
+				{Name: "mpls", Weight: 70},
+				{Name: "broadband", Weight: 30},
+			},
+		}},
+	}
+}
@@ -37,6 +37,16 @@ func (o *Entry) Copy(s Entry) {
 	o.OverrideTimeWaitTimeout = s.OverrideTimeWaitTimeout
 }
 
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
 /** Structs / functions for normalization. **/
 
 type normalizer interface {
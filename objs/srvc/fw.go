@@ -48,6 +48,59 @@ func (c *FwSrvc) GetAll(vsys string) ([]Entry, error) {
 	return c.details(c.con.Get, vsys, "")
 }
 
+// Conflicts performs GET to retrieve all services, then returns the names of
+// the services that match the same traffic as the given service but have a
+// different name.
+func (c *FwSrvc) Conflicts(vsys string, e Entry) ([]string, error) {
+	list, err := c.GetAll(vsys)
+	if err != nil && err.Error() != "No such node" && err.Error() != "Object not found" {
+		return nil, err
+	}
+
+	return Conflicts(e, list)
+}
+
+// GetAllByTag performs GET to retrieve all service objects with the given tag.
+//
+// The filtering is done by PAN-OS instead of client side, so only the
+// matching objects are returned.
+func (c *FwSrvc) GetAllByTag(vsys, tag string) ([]Entry, error) {
+	c.con.LogQuery("(get) service objects with tag %q", tag)
+	path := c.xpath(vsys, nil)
+	path[len(path)-1] = util.AsTaggedEntryXpath(tag)
+	obj, _ := c.versioning()
+	if _, err := c.con.Get(path, nil, obj); err != nil {
+		return nil, err
+	}
+
+	return obj.Normalize(), nil
+}
+
+// GetListPage performs GET to retrieve a list of up to limit service objects,
+// starting at the given zero-based offset.
+func (c *FwSrvc) GetListPage(vsys string, offset, limit int) ([]string, error) {
+	list, err := c.page(vsys, offset, limit, true)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := make([]string, 0, len(list))
+	for _, x := range list {
+		ans = append(ans, x.Name)
+	}
+
+	return ans, nil
+}
+
+// GetAllPage performs GET to retrieve up to limit service objects, starting at
+// the given zero-based offset.
+//
+// Paging through large numbers of objects this way avoids retrieving them
+// all in a single, very large response.
+func (c *FwSrvc) GetAllPage(vsys string, offset, limit int) ([]Entry, error) {
+	return c.page(vsys, offset, limit, false)
+}
+
 // Get performs SHOW to retrieve information for the given service object.
 func (c *FwSrvc) Show(vsys, name string) (Entry, error) {
 	c.con.LogQuery("(show) service object %q", name)
@@ -64,6 +117,33 @@ func (c *FwSrvc) ShowAll(vsys string) ([]Entry, error) {
 	return c.details(c.con.Show, vsys, "")
 }
 
+// GetAllUsing retrieves all services using the given pango query type:
+// util.Get for the candidate config, util.Show for the running config, or
+// util.EffectiveRunning for the running config merged with any config pushed
+// from Panorama.
+func (c *FwSrvc) GetAllUsing(qt, vsys string) ([]Entry, error) {
+	fn, err := util.RetrieverFor(c.con, qt)
+	if err != nil {
+		return nil, err
+	}
+
+	c.con.LogQuery("(%s) all services", qt)
+	return c.details(fn, vsys, "")
+}
+
+// GetAllPushed retrieves all services that Panorama has pushed to the firewall.
+//
+// Use "shared" as the vsys for objects pushed from the Panorama shared
+// location.
+func (c *FwSrvc) GetAllPushed(vsys string) ([]Entry, error) {
+	c.con.LogQuery("(pushed) all services")
+	fn, err := util.RetrieverFor(c.con, util.PushedSharedPolicy)
+	if err != nil {
+		return nil, err
+	}
+	return c.details(fn, vsys, "")
+}
+
 // Set performs SET to create / update one or more service objects.
 func (c *FwSrvc) Set(vsys string, e ...Entry) error {
 	var err error
@@ -112,6 +192,30 @@ func (c *FwSrvc) Edit(vsys string, e Entry) error {
 	return err
 }
 
+// Clone retrieves the service object `name`, then creates a copy of it named
+// `newName`.  If `overrides` is not nil, it is called on the copy before it
+// is created so that fields can be changed.
+func (c *FwSrvc) Clone(vsys string, name, newName string, overrides func(*Entry)) (Entry, error) {
+	if e, err := c.Get(vsys, newName); err == nil && e.Name == newName {
+		return Entry{}, fmt.Errorf("service object %q already exists", newName)
+	}
+
+	e, err := c.Get(vsys, name)
+	if err != nil {
+		return Entry{}, err
+	}
+	e.Name = newName
+	if overrides != nil {
+		overrides(&e)
+	}
+
+	if err = c.Set(vsys, e); err != nil {
+		return Entry{}, err
+	}
+
+	return e, nil
+}
+
 // Delete removes the given service objects from the firewall.
 //
 // Service objects can be either a string or an Entry object.
@@ -164,6 +268,22 @@ func (c *FwSrvc) details(fn util.Retriever, vsys, name string) ([]Entry, error)
 	return ans, nil
 }
 
+func (c *FwSrvc) page(vsys string, offset, limit int, namesOnly bool) ([]Entry, error) {
+	c.con.LogQuery("(get) service objects %d - %d", offset+1, offset+limit)
+	path := c.xpath(vsys, nil)
+	path[len(path)-1] = util.AsEntryXpathPage(offset, limit)
+	if namesOnly {
+		path = append(path, "@name")
+	}
+
+	obj, _ := c.versioning()
+	if _, err := c.con.Get(path, nil, obj); err != nil {
+		return nil, err
+	}
+
+	return obj.Normalize(), nil
+}
+
 func (c *FwSrvc) xpath(vsys string, vals []string) []string {
 	if vsys == "" {
 		vsys = "vsys1"
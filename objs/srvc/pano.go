@@ -48,6 +48,59 @@ func (c *PanoSrvc) GetAll(dg string) ([]Entry, error) {
 	return c.details(c.con.Get, dg, "")
 }
 
+// Conflicts performs GET to retrieve all services, then returns the names of
+// the services that match the same traffic as the given service but have a
+// different name.
+func (c *PanoSrvc) Conflicts(dg string, e Entry) ([]string, error) {
+	list, err := c.GetAll(dg)
+	if err != nil && err.Error() != "No such node" && err.Error() != "Object not found" {
+		return nil, err
+	}
+
+	return Conflicts(e, list)
+}
+
+// GetAllByTag performs GET to retrieve all service objects with the given tag.
+//
+// The filtering is done by PAN-OS instead of client side, so only the
+// matching objects are returned.
+func (c *PanoSrvc) GetAllByTag(dg, tag string) ([]Entry, error) {
+	c.con.LogQuery("(get) service objects with tag %q", tag)
+	path := c.xpath(dg, nil)
+	path[len(path)-1] = util.AsTaggedEntryXpath(tag)
+	obj, _ := c.versioning()
+	if _, err := c.con.Get(path, nil, obj); err != nil {
+		return nil, err
+	}
+
+	return obj.Normalize(), nil
+}
+
+// GetListPage performs GET to retrieve a list of up to limit service objects,
+// starting at the given zero-based offset.
+func (c *PanoSrvc) GetListPage(dg string, offset, limit int) ([]string, error) {
+	list, err := c.page(dg, offset, limit, true)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := make([]string, 0, len(list))
+	for _, x := range list {
+		ans = append(ans, x.Name)
+	}
+
+	return ans, nil
+}
+
+// GetAllPage performs GET to retrieve up to limit service objects, starting at
+// the given zero-based offset.
+//
+// Paging through large numbers of objects this way avoids retrieving them
+// all in a single, very large response.
+func (c *PanoSrvc) GetAllPage(dg string, offset, limit int) ([]Entry, error) {
+	return c.page(dg, offset, limit, false)
+}
+
 // Get performs SHOW to retrieve information for the given service object.
 func (c *PanoSrvc) Show(dg, name string) (Entry, error) {
 	c.con.LogQuery("(show) service object %q", name)
@@ -64,6 +117,20 @@ func (c *PanoSrvc) ShowAll(dg string) ([]Entry, error) {
 	return c.details(c.con.Show, dg, "")
 }
 
+// GetAllUsing retrieves all services using the given pango query type:
+// util.Get for the candidate config, util.Show for the running config, or
+// util.EffectiveRunning for the running config merged with any config pushed
+// from Panorama.
+func (c *PanoSrvc) GetAllUsing(qt, dg string) ([]Entry, error) {
+	fn, err := util.RetrieverFor(c.con, qt)
+	if err != nil {
+		return nil, err
+	}
+
+	c.con.LogQuery("(%s) all services", qt)
+	return c.details(fn, dg, "")
+}
+
 // Set performs SET to create / update one or more service objects.
 func (c *PanoSrvc) Set(dg string, e ...Entry) error {
 	var err error
@@ -112,6 +179,30 @@ func (c *PanoSrvc) Edit(dg string, e Entry) error {
 	return err
 }
 
+// Clone retrieves the service object `name`, then creates a copy of it named
+// `newName`.  If `overrides` is not nil, it is called on the copy before it
+// is created so that fields can be changed.
+func (c *PanoSrvc) Clone(dg string, name, newName string, overrides func(*Entry)) (Entry, error) {
+	if e, err := c.Get(dg, newName); err == nil && e.Name == newName {
+		return Entry{}, fmt.Errorf("service object %q already exists", newName)
+	}
+
+	e, err := c.Get(dg, name)
+	if err != nil {
+		return Entry{}, err
+	}
+	e.Name = newName
+	if overrides != nil {
+		overrides(&e)
+	}
+
+	if err = c.Set(dg, e); err != nil {
+		return Entry{}, err
+	}
+
+	return e, nil
+}
+
 // Delete removes the given service objects from the firewall.
 //
 // Service objects can be either a string or an Entry object.
@@ -164,6 +255,22 @@ func (c *PanoSrvc) details(fn util.Retriever, dg, name string) ([]Entry, error)
 	return ans, nil
 }
 
+func (c *PanoSrvc) page(dg string, offset, limit int, namesOnly bool) ([]Entry, error) {
+	c.con.LogQuery("(get) service objects %d - %d", offset+1, offset+limit)
+	path := c.xpath(dg, nil)
+	path[len(path)-1] = util.AsEntryXpathPage(offset, limit)
+	if namesOnly {
+		path = append(path, "@name")
+	}
+
+	obj, _ := c.versioning()
+	if _, err := c.con.Get(path, nil, obj); err != nil {
+		return nil, err
+	}
+
+	return obj.Normalize(), nil
+}
+
 func (c *PanoSrvc) xpath(dg string, vals []string) []string {
 	if dg == "" {
 		dg = "shared"
@@ -0,0 +1,89 @@
+package srvc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PortRange is an inclusive range of ports.
+type PortRange struct {
+	Low  int
+	High int
+}
+
+// String returns the port range in PAN-OS format.
+func (o PortRange) String() string {
+	if o.Low == o.High {
+		return strconv.Itoa(o.Low)
+	}
+	return fmt.Sprintf("%d-%d", o.Low, o.High)
+}
+
+// Contains returns true if the given port is in this range.
+func (o PortRange) Contains(port int) bool {
+	return port >= o.Low && port <= o.High
+}
+
+// ParsePorts parses a PAN-OS port specification, such as "80,443,8000-8080",
+// into a list of port ranges.
+func ParsePorts(v string) ([]PortRange, error) {
+	var ans []PortRange
+
+	for _, tok := range strings.Split(v, ",") {
+		tok = strings.TrimSpace(tok)
+		if tok == "" {
+			continue
+		}
+
+		var r PortRange
+		var err error
+		if i := strings.Index(tok, "-"); i >= 0 {
+			if r.Low, err = parsePort(tok[:i]); err != nil {
+				return nil, err
+			}
+			if r.High, err = parsePort(tok[i+1:]); err != nil {
+				return nil, err
+			}
+			if r.High < r.Low {
+				return nil, fmt.Errorf("invalid port range %q", tok)
+			}
+		} else {
+			if r.Low, err = parsePort(tok); err != nil {
+				return nil, err
+			}
+			r.High = r.Low
+		}
+		ans = append(ans, r)
+	}
+
+	return ans, nil
+}
+
+func parsePort(v string) (int, error) {
+	p, err := strconv.Atoi(strings.TrimSpace(v))
+	if err != nil || p < 0 || p > 65535 {
+		return 0, fmt.Errorf("invalid port %q", v)
+	}
+	return p, nil
+}
+
+// DestinationPorts returns the parsed destination ports of this service.
+func (o Entry) DestinationPorts() ([]PortRange, error) {
+	return ParsePorts(o.DestinationPort)
+}
+
+// SourcePorts returns the parsed source ports of this service.  An empty list
+// means any source port.
+func (o Entry) SourcePorts() ([]PortRange, error) {
+	return ParsePorts(o.SourcePort)
+}
+
+// Predefined returns the service objects that are predefined on PAN-OS and so
+// may be referenced without being configured.
+func Predefined() []Entry {
+	return []Entry{
+		{Name: "service-http", Protocol: ProtocolTcp, DestinationPort: "80,8080"},
+		{Name: "service-https", Protocol: ProtocolTcp, DestinationPort: "443"},
+	}
+}
@@ -0,0 +1,42 @@
+package srvc
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePorts(t *testing.T) {
+	testCases := []struct {
+		v   string
+		r   []PortRange
+		err bool
+	}{
+		{"", nil, false},
+		{"80", []PortRange{{80, 80}}, false},
+		{"80, 443,8000-8080", []PortRange{{80, 80}, {443, 443}, {8000, 8080}}, false},
+		{"8080-80", nil, true},
+		{"70000", nil, true},
+		{"http", nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.v, func(t *testing.T) {
+			v, err := ParsePorts(tc.v)
+			if (err != nil) != tc.err {
+				t.Fatalf("Error is %v", err)
+			}
+			if !reflect.DeepEqual(v, tc.r) {
+				t.Errorf("%#v != %#v", v, tc.r)
+			}
+		})
+	}
+}
+
+func TestPortRangeString(t *testing.T) {
+	if v := (PortRange{80, 80}).String(); v != "80" {
+		t.Errorf("Got %q", v)
+	}
+	if v := (PortRange{8000, 8080}).String(); v != "8000-8080" {
+		t.Errorf("Got %q", v)
+	}
+}
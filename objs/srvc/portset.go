@@ -0,0 +1,182 @@
+package srvc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// PortSet is a normalized set of ports per protocol.
+//
+// The zero value is an empty port set.
+type PortSet struct {
+	ports map[string][]PortRange
+}
+
+// Add adds the given port ranges for the given protocol.
+func (o *PortSet) Add(protocol string, ranges ...PortRange) {
+	if o.ports == nil {
+		o.ports = make(map[string][]PortRange)
+	}
+	protocol = strings.ToLower(protocol)
+	o.ports[protocol] = mergePortRanges(append(o.ports[protocol], ranges...))
+}
+
+// AddSet adds all ports in the given port set to this one.
+func (o *PortSet) AddSet(s PortSet) {
+	for proto, ranges := range s.ports {
+		o.Add(proto, ranges...)
+	}
+}
+
+// Protocols returns the protocols in this port set, sorted.
+func (o PortSet) Protocols() []string {
+	ans := make([]string, 0, len(o.ports))
+	for proto := range o.ports {
+		ans = append(ans, proto)
+	}
+	sort.Strings(ans)
+
+	return ans
+}
+
+// Ports returns the merged port ranges for the given protocol.
+func (o PortSet) Ports(protocol string) []PortRange {
+	return o.ports[strings.ToLower(protocol)]
+}
+
+// Contains returns true if the given protocol and port is in this set.
+func (o PortSet) Contains(protocol string, port int) bool {
+	for _, r := range o.Ports(protocol) {
+		if r.Contains(port) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Equal returns true if both port sets contain exactly the same ports.
+func (o PortSet) Equal(s PortSet) bool {
+	return o.String() == s.String()
+}
+
+// String returns the port set in a canonical form, such as
+// "tcp/80,443;udp/53".
+func (o PortSet) String() string {
+	parts := make([]string, 0, len(o.ports))
+	for _, proto := range o.Protocols() {
+		ranges := o.ports[proto]
+		ports := make([]string, 0, len(ranges))
+		for _, r := range ranges {
+			ports = append(ports, r.String())
+		}
+		parts = append(parts, fmt.Sprintf("%s/%s", proto, strings.Join(ports, ",")))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// PortSet returns the destination ports of this service as a port set.
+func (o Entry) PortSet() (PortSet, error) {
+	var ans PortSet
+
+	ports, err := o.DestinationPorts()
+	if err != nil {
+		return ans, fmt.Errorf("%s: %s", o.Name, err)
+	}
+	ans.Add(o.Protocol, ports...)
+
+	return ans, nil
+}
+
+// key returns a string that is the same for services that match the same
+// traffic.
+func (o Entry) key() (string, error) {
+	ps, err := o.PortSet()
+	if err != nil {
+		return "", err
+	}
+
+	src, err := o.SourcePorts()
+	if err != nil {
+		return "", fmt.Errorf("%s: %s", o.Name, err)
+	}
+	var sps PortSet
+	sps.Add(o.Protocol, src...)
+
+	return ps.String() + " src " + sps.String(), nil
+}
+
+// FindDuplicates returns groups of service names that match exactly the same
+// protocol, destination ports, and source ports.
+//
+// Services whose ports cannot be parsed are skipped.  Groups are returned in
+// the order their first member appears in the given list.
+func FindDuplicates(list []Entry) [][]string {
+	idx := make(map[string]int)
+	var groups [][]string
+
+	for _, e := range list {
+		key, err := e.key()
+		if err != nil {
+			continue
+		}
+		if i, ok := idx[key]; ok {
+			groups[i] = append(groups[i], e.Name)
+		} else {
+			idx[key] = len(groups)
+			groups = append(groups, []string{e.Name})
+		}
+	}
+
+	ans := make([][]string, 0, len(groups))
+	for _, g := range groups {
+		if len(g) > 1 {
+			ans = append(ans, g)
+		}
+	}
+
+	return ans
+}
+
+// Conflicts returns the names of services in existing that match the same
+// traffic as e but have a different name.  This is useful to check before
+// creating a new service object.
+func Conflicts(e Entry, existing []Entry) ([]string, error) {
+	key, err := e.key()
+	if err != nil {
+		return nil, err
+	}
+
+	var ans []string
+	for _, x := range existing {
+		if x.Name == e.Name {
+			continue
+		}
+		if k, err := x.key(); err == nil && k == key {
+			ans = append(ans, x.Name)
+		}
+	}
+
+	return ans, nil
+}
+
+func mergePortRanges(list []PortRange) []PortRange {
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Low < list[j].Low
+	})
+
+	ans := make([]PortRange, 0, len(list))
+	for _, r := range list {
+		if n := len(ans); n > 0 && r.Low <= ans[n-1].High+1 {
+			if r.High > ans[n-1].High {
+				ans[n-1].High = r.High
+			}
+			continue
+		}
+		ans = append(ans, r)
+	}
+
+	return ans
+}
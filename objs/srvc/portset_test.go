@@ -0,0 +1,55 @@
+package srvc
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPortSet(t *testing.T) {
+	var ps PortSet
+	ps.Add("TCP", PortRange{443, 443}, PortRange{80, 80})
+	ps.Add("tcp", PortRange{81, 90}, PortRange{85, 100})
+	ps.Add("udp", PortRange{53, 53})
+
+	if v := ps.String(); v != "tcp/80-100,443;udp/53" {
+		t.Errorf("String is %q", v)
+	}
+	if !ps.Contains("tcp", 95) || ps.Contains("udp", 95) {
+		t.Errorf("Contains is wrong")
+	}
+
+	var ps2 PortSet
+	ps2.Add("udp", PortRange{53, 53})
+	ps2.Add("tcp", PortRange{80, 100}, PortRange{443, 443})
+	if !ps.Equal(ps2) {
+		t.Errorf("%s != %s", ps, ps2)
+	}
+}
+
+func TestFindDuplicates(t *testing.T) {
+	list := []Entry{
+		{Name: "web", Protocol: ProtocolTcp, DestinationPort: "80,443"},
+		{Name: "dns", Protocol: ProtocolUdp, DestinationPort: "53"},
+		{Name: "https-and-http", Protocol: ProtocolTcp, DestinationPort: "443, 80"},
+		{Name: "dns-tcp", Protocol: ProtocolTcp, DestinationPort: "53"},
+		{Name: "web-from-high", Protocol: ProtocolTcp, DestinationPort: "80,443", SourcePort: "1024-65535"},
+		{Name: "broken", Protocol: ProtocolTcp, DestinationPort: "http"},
+	}
+
+	expected := [][]string{{"web", "https-and-http"}}
+	if v := FindDuplicates(list); !reflect.DeepEqual(v, expected) {
+		t.Errorf("%#v != %#v", v, expected)
+	}
+
+	v, err := Conflicts(Entry{Name: "new-web", Protocol: ProtocolTcp, DestinationPort: "443,80"}, list)
+	if err != nil {
+		t.Fatalf("Error in conflicts: %s", err)
+	}
+	if !reflect.DeepEqual(v, []string{"web", "https-and-http"}) {
+		t.Errorf("Conflicts: %#v", v)
+	}
+
+	if _, err = Conflicts(Entry{Name: "bad", DestinationPort: "x"}, list); err == nil {
+		t.Errorf("Expected an error for bad ports")
+	}
+}
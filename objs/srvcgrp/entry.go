@@ -21,6 +21,16 @@ func (o *Entry) Copy(s Entry) {
 	o.Tags = s.Tags
 }
 
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
 /** Structs / functions for normalization. **/
 
 type normalizer interface {
@@ -32,10 +42,27 @@ type container_v1 struct {
 }
 
 func (o *container_v1) Normalize() Entry {
+	return o.Answer.normalize()
+}
+
+type list_v1 struct {
+	Answer []entry_v1 `xml:"result>entry"`
+}
+
+func (o *list_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
 	ans := Entry{
-		Name:     o.Answer.Name,
-		Services: util.MemToStr(o.Answer.Services),
-		Tags:     util.MemToStr(o.Answer.Tags),
+		Name:     o.Name,
+		Services: util.MemToStr(o.Services),
+		Tags:     util.MemToStr(o.Tags),
 	}
 
 	return ans
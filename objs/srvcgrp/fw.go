@@ -37,6 +37,85 @@ func (c *FwSrvcGrp) Get(vsys, name string) (Entry, error) {
 	return c.details(c.con.Get, vsys, name)
 }
 
+// GetAll performs GET to retrieve all service groups.
+func (c *FwSrvcGrp) GetAll(vsys string) ([]Entry, error) {
+	c.con.LogQuery("(get) all service groups")
+	obj := &list_v1{}
+	if _, err := c.con.Get(c.xpath(vsys, nil), nil, obj); err != nil {
+		return nil, err
+	}
+
+	return obj.Normalize(), nil
+}
+
+// GetAllUsing retrieves all service groups using the given pango query type:
+// util.Get for the candidate config, util.Show for the running config, or
+// util.EffectiveRunning for the running config merged with any config pushed
+// from Panorama.
+func (c *FwSrvcGrp) GetAllUsing(qt, vsys string) ([]Entry, error) {
+	fn, err := util.RetrieverFor(c.con, qt)
+	if err != nil {
+		return nil, err
+	}
+
+	c.con.LogQuery("(%s) all service groups", qt)
+	obj := &list_v1{}
+	if _, err = fn(c.xpath(vsys, nil), nil, obj); err != nil {
+		return nil, err
+	}
+
+	return obj.Normalize(), nil
+}
+
+// GetAllPushed retrieves all service groups that Panorama has pushed to the firewall.
+//
+// Use "shared" as the vsys for objects pushed from the Panorama shared
+// location.
+func (c *FwSrvcGrp) GetAllPushed(vsys string) ([]Entry, error) {
+	return c.GetAllUsing(util.PushedSharedPolicy, vsys)
+}
+
+// GetAllByTag performs GET to retrieve all service groups with the given tag.
+//
+// The filtering is done by PAN-OS instead of client side, so only the
+// matching objects are returned.
+func (c *FwSrvcGrp) GetAllByTag(vsys, tag string) ([]Entry, error) {
+	c.con.LogQuery("(get) service groups with tag %q", tag)
+	path := c.xpath(vsys, nil)
+	path[len(path)-1] = util.AsTaggedEntryXpath(tag)
+	obj := &list_v1{}
+	if _, err := c.con.Get(path, nil, obj); err != nil {
+		return nil, err
+	}
+
+	return obj.Normalize(), nil
+}
+
+// GetListPage performs GET to retrieve a list of up to limit service groups,
+// starting at the given zero-based offset.
+func (c *FwSrvcGrp) GetListPage(vsys string, offset, limit int) ([]string, error) {
+	list, err := c.page(vsys, offset, limit, true)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := make([]string, 0, len(list))
+	for _, x := range list {
+		ans = append(ans, x.Name)
+	}
+
+	return ans, nil
+}
+
+// GetAllPage performs GET to retrieve up to limit service groups, starting at
+// the given zero-based offset.
+//
+// Paging through large numbers of objects this way avoids retrieving them
+// all in a single, very large response.
+func (c *FwSrvcGrp) GetAllPage(vsys string, offset, limit int) ([]Entry, error) {
+	return c.page(vsys, offset, limit, false)
+}
+
 // Get performs SHOW to retrieve information for the given service group.
 func (c *FwSrvcGrp) Show(vsys, name string) (Entry, error) {
 	c.con.LogQuery("(show) service group %q", name)
@@ -91,6 +170,30 @@ func (c *FwSrvcGrp) Edit(vsys string, e Entry) error {
 	return err
 }
 
+// Clone retrieves the service group `name`, then creates a copy of it named
+// `newName`.  If `overrides` is not nil, it is called on the copy before it
+// is created so that fields can be changed.
+func (c *FwSrvcGrp) Clone(vsys string, name, newName string, overrides func(*Entry)) (Entry, error) {
+	if e, err := c.Get(vsys, newName); err == nil && e.Name == newName {
+		return Entry{}, fmt.Errorf("service group %q already exists", newName)
+	}
+
+	e, err := c.Get(vsys, name)
+	if err != nil {
+		return Entry{}, err
+	}
+	e.Name = newName
+	if overrides != nil {
+		overrides(&e)
+	}
+
+	if err = c.Set(vsys, e); err != nil {
+		return Entry{}, err
+	}
+
+	return e, nil
+}
+
 // Delete removes the given service groups from the firewall.
 //
 // Service groups can be either a string or an Entry object.
@@ -137,6 +240,22 @@ func (c *FwSrvcGrp) details(fn util.Retriever, vsys, name string) (Entry, error)
 	return ans, nil
 }
 
+func (c *FwSrvcGrp) page(vsys string, offset, limit int, namesOnly bool) ([]Entry, error) {
+	c.con.LogQuery("(get) service groups %d - %d", offset+1, offset+limit)
+	path := c.xpath(vsys, nil)
+	path[len(path)-1] = util.AsEntryXpathPage(offset, limit)
+	if namesOnly {
+		path = append(path, "@name")
+	}
+
+	obj := &list_v1{}
+	if _, err := c.con.Get(path, nil, obj); err != nil {
+		return nil, err
+	}
+
+	return obj.Normalize(), nil
+}
+
 func (c *FwSrvcGrp) xpath(vsys string, vals []string) []string {
 	if vsys == "" {
 		vsys = "vsys1"
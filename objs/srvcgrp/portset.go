@@ -0,0 +1,102 @@
+package srvcgrp
+
+import (
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/objs/srvc"
+)
+
+// PortSets resolves each of the given service groups into the combined port
+// set of its members, keyed by group name.
+//
+// Members may be service objects from services (predefined services are
+// included automatically) or other service groups from groups.
+func PortSets(groups []Entry, services []srvc.Entry) (map[string]srvc.PortSet, error) {
+	svcs := make(map[string]srvc.Entry, len(services)+2)
+	for _, e := range srvc.Predefined() {
+		svcs[e.Name] = e
+	}
+	for _, e := range services {
+		svcs[e.Name] = e
+	}
+	grps := make(map[string]Entry, len(groups))
+	for _, g := range groups {
+		grps[g.Name] = g
+	}
+
+	ans := make(map[string]srvc.PortSet, len(groups))
+	var resolve func(string, []string) (srvc.PortSet, error)
+	resolve = func(name string, stack []string) (srvc.PortSet, error) {
+		if ps, ok := ans[name]; ok {
+			return ps, nil
+		}
+		for _, x := range stack {
+			if x == name {
+				return srvc.PortSet{}, fmt.Errorf("service group %q contains itself", name)
+			}
+		}
+
+		var ps srvc.PortSet
+		for _, m := range grps[name].Services {
+			if e, ok := svcs[m]; ok {
+				eps, err := e.PortSet()
+				if err != nil {
+					return ps, err
+				}
+				ps.AddSet(eps)
+			} else if _, ok := grps[m]; ok {
+				gps, err := resolve(m, append(stack, name))
+				if err != nil {
+					return ps, err
+				}
+				ps.AddSet(gps)
+			} else {
+				return ps, fmt.Errorf("service group %q: unknown member %q", name, m)
+			}
+		}
+		ans[name] = ps
+
+		return ps, nil
+	}
+
+	for _, g := range groups {
+		if _, err := resolve(g.Name, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return ans, nil
+}
+
+// FindDuplicates returns groups of service group names that resolve to
+// exactly the same port set.
+//
+// Groups are returned in the order their first member appears in the given
+// list.
+func FindDuplicates(groups []Entry, services []srvc.Entry) ([][]string, error) {
+	sets, err := PortSets(groups, services)
+	if err != nil {
+		return nil, err
+	}
+
+	idx := make(map[string]int)
+	var dups [][]string
+	for _, g := range groups {
+		key := sets[g.Name].String()
+		if i, ok := idx[key]; ok {
+			dups[i] = append(dups[i], g.Name)
+		} else {
+			idx[key] = len(dups)
+			dups = append(dups, []string{g.Name})
+		}
+	}
+
+	ans := make([][]string, 0, len(dups))
+	for _, d := range dups {
+		if len(d) > 1 {
+			ans = append(ans, d)
+		}
+	}
+
+	return ans, nil
+}
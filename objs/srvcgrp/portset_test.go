@@ -0,0 +1,57 @@
+package srvcgrp
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/objs/srvc"
+)
+
+func TestPortSets(t *testing.T) {
+	services := []srvc.Entry{
+		{Name: "dns", Protocol: srvc.ProtocolUdp, DestinationPort: "53"},
+		{Name: "web", Protocol: srvc.ProtocolTcp, DestinationPort: "80,8080,443"},
+	}
+	groups := []Entry{
+		{Name: "all", Services: []string{"web-grp", "dns"}},
+		{Name: "web-grp", Services: []string{"service-http", "service-https"}},
+		{Name: "web-copy", Services: []string{"web"}},
+	}
+
+	sets, err := PortSets(groups, services)
+	if err != nil {
+		t.Fatalf("Error resolving: %s", err)
+	}
+	if v := sets["all"].String(); v != "tcp/80,443,8080;udp/53" {
+		t.Errorf("all is %q", v)
+	}
+
+	dups, err := FindDuplicates(groups, services)
+	if err != nil {
+		t.Fatalf("Error finding duplicates: %s", err)
+	}
+	if !reflect.DeepEqual(dups, [][]string{{"web-grp", "web-copy"}}) {
+		t.Errorf("Duplicates: %#v", dups)
+	}
+}
+
+func TestPortSetsErrors(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		groups []Entry
+	}{
+		{"unknown member", []Entry{{Name: "g", Services: []string{"missing"}}}},
+		{"loop", []Entry{
+			{Name: "a", Services: []string{"b"}},
+			{Name: "b", Services: []string{"a"}},
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if _, err := PortSets(tc.groups, nil); err == nil {
+				t.Errorf("Expected an error")
+			}
+		})
+	}
+}
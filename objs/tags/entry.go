@@ -3,6 +3,8 @@ package tags
 import (
 	"encoding/xml"
 	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
 )
 
 // These are the color constants you can use in Entry.SetColor().  Note that
@@ -71,6 +73,16 @@ func (o *Entry) Copy(s Entry) {
 	o.Comment = s.Comment
 }
 
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
 // SetColor takes a color constant (e.g. - Olive) and converts it to a color
 // enum (e.g. - "color17").
 //
@@ -0,0 +1,276 @@
+package pango
+
+import (
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var errOffline = fmt.Errorf("client is in offline mode")
+
+// RenderConfig returns the XML config document that results from all of the
+// config changes that have been recorded in the multi-configure request.
+//
+// This is typically used with a client in offline mode, allowing namespaces
+// to generate config snippets (such as for a bootstrap.xml) without a PAN-OS
+// connection.  Set, edit, and delete actions are applied in the order that
+// they were performed, starting from an empty config.
+func (c *Client) RenderConfig() ([]byte, error) {
+	root := &configNode{XMLName: xml.Name{Local: "config"}}
+
+	for _, r := range c.queuedMultiConfigure() {
+		if err := root.apply(r); err != nil {
+			return nil, err
+		}
+	}
+
+	return xml.MarshalIndent(root, "", "    ")
+}
+
+/** Internal functions **/
+
+func (c *Client) initOffline() {
+	if c.Hostname == "" {
+		c.Hostname = "offline"
+	}
+	if c.MultiConfigure == nil {
+		c.PrepareMultiConfigure(0)
+	}
+}
+
+// configNode is a generic XML node used for rendering config.
+type configNode struct {
+	XMLName xml.Name
+	Attrs   []xml.Attr    `xml:",any,attr"`
+	Text    string        `xml:",chardata"`
+	Nodes   []*configNode `xml:",any"`
+}
+
+func (n *configNode) name() string {
+	for _, a := range n.Attrs {
+		if a.Name.Local == "name" {
+			return a.Value
+		}
+	}
+
+	return ""
+}
+
+func (n *configNode) matches(o *configNode) bool {
+	if n.XMLName.Local != o.XMLName.Local || n.name() != o.name() {
+		return false
+	}
+
+	if n.XMLName.Local == "member" {
+		return strings.TrimSpace(n.Text) == strings.TrimSpace(o.Text)
+	}
+
+	return true
+}
+
+// merge merges the given node underneath this node, mimicking the PAN-OS
+// "set" action.
+func (n *configNode) merge(o *configNode) {
+	for _, x := range n.Nodes {
+		if x.matches(o) {
+			if len(o.Nodes) == 0 {
+				x.Text = o.Text
+			}
+			for _, child := range o.Nodes {
+				x.merge(child)
+			}
+			return
+		}
+	}
+
+	n.Text = ""
+	n.Nodes = append(n.Nodes, o)
+}
+
+// apply performs the given request against this root node.
+func (n *configNode) apply(r MultiConfigureRequest) error {
+	segs, err := splitXpath(r.Xpath)
+	if err != nil {
+		return err
+	} else if len(segs) == 0 || segs[0].tag != n.XMLName.Local {
+		return fmt.Errorf("xpath %q does not start with %q", r.Xpath, n.XMLName.Local)
+	}
+	segs = segs[1:]
+
+	var elm *configNode
+	if r.Data != nil {
+		s, err := asString(r.Data, true)
+		if err != nil {
+			return err
+		}
+		elm = &configNode{}
+		if err = xml.Unmarshal([]byte(s), elm); err != nil {
+			return err
+		}
+	}
+
+	switch r.XMLName.Local {
+	case "set":
+		node, err := n.walk(segs, true)
+		if err != nil {
+			return err
+		} else if elm != nil {
+			node.merge(elm)
+		}
+	case "edit":
+		if len(segs) == 0 {
+			return fmt.Errorf("cannot edit the root node")
+		}
+		parent, err := n.walk(segs[:len(segs)-1], true)
+		if err != nil {
+			return err
+		}
+		parent.remove(segs[len(segs)-1])
+		if elm != nil {
+			parent.Text = ""
+			parent.Nodes = append(parent.Nodes, elm)
+		}
+	case "delete":
+		if len(segs) == 0 {
+			return fmt.Errorf("cannot delete the root node")
+		}
+		parent, err := n.walk(segs[:len(segs)-1], false)
+		if err != nil {
+			return err
+		} else if parent != nil {
+			parent.remove(segs[len(segs)-1])
+		}
+	default:
+		return fmt.Errorf("unsupported action: %s", r.XMLName.Local)
+	}
+
+	return nil
+}
+
+// walk returns the node at the given xpath segments, optionally creating
+// any missing nodes along the way.  If create is false and the node does not
+// exist, then nil is returned.
+func (n *configNode) walk(segs []xpathSeg, create bool) (*configNode, error) {
+	cur := n
+	for _, seg := range segs {
+		if len(seg.values) > 1 {
+			return nil, fmt.Errorf("xpath segment %q matches multiple nodes", seg.tag)
+		}
+
+		var next *configNode
+		for _, x := range cur.Nodes {
+			if seg.matches(x) {
+				next = x
+				break
+			}
+		}
+
+		if next == nil {
+			if !create {
+				return nil, nil
+			}
+			next = seg.node()
+			cur.Text = ""
+			cur.Nodes = append(cur.Nodes, next)
+		}
+		cur = next
+	}
+
+	return cur, nil
+}
+
+// remove removes all children matching the given xpath segment.
+func (n *configNode) remove(seg xpathSeg) {
+	list := make([]*configNode, 0, len(n.Nodes))
+	for _, x := range n.Nodes {
+		if !seg.matches(x) {
+			list = append(list, x)
+		}
+	}
+	n.Nodes = list
+}
+
+// xpathSeg is a single segment of an xpath, such as "entry[@name='foo']".
+type xpathSeg struct {
+	tag    string
+	byText bool
+	values []string
+}
+
+func (s xpathSeg) matches(n *configNode) bool {
+	if n.XMLName.Local != s.tag {
+		return false
+	} else if len(s.values) == 0 {
+		return true
+	}
+
+	v := n.name()
+	if s.byText {
+		v = strings.TrimSpace(n.Text)
+	}
+	for _, x := range s.values {
+		if x == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s xpathSeg) node() *configNode {
+	ans := &configNode{XMLName: xml.Name{Local: s.tag}}
+	if len(s.values) == 1 {
+		if s.byText {
+			ans.Text = s.values[0]
+		} else {
+			ans.Attrs = []xml.Attr{{Name: xml.Name{Local: "name"}, Value: s.values[0]}}
+		}
+	}
+
+	return ans
+}
+
+var (
+	xpathSegRe   = regexp.MustCompile(`^([^\[]+)(?:\[(.*)\])?$`)
+	xpathValueRe = regexp.MustCompile(`(@name|text\(\))='([^']*)'`)
+)
+
+func splitXpath(xp string) ([]xpathSeg, error) {
+	parts := make([]string, 0, 20)
+	var depth, start int
+	xp = strings.TrimPrefix(xp, "/")
+	for i, ch := range xp {
+		switch ch {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case '/':
+			if depth == 0 {
+				parts = append(parts, xp[start:i])
+				start = i + 1
+			}
+		}
+	}
+	parts = append(parts, xp[start:])
+
+	ans := make([]xpathSeg, 0, len(parts))
+	for _, p := range parts {
+		m := xpathSegRe.FindStringSubmatch(p)
+		if m == nil {
+			return nil, fmt.Errorf("unsupported xpath segment: %q", p)
+		}
+		seg := xpathSeg{tag: m[1]}
+		for _, v := range xpathValueRe.FindAllStringSubmatch(m[2], -1) {
+			seg.byText = v[1] == "text()"
+			seg.values = append(seg.values, v[2])
+		}
+		if m[2] != "" && len(seg.values) == 0 {
+			return nil, fmt.Errorf("unsupported xpath predicate: %q", p)
+		}
+		ans = append(ans, seg)
+	}
+
+	return ans, nil
+}
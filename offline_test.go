@@ -0,0 +1,83 @@
+package pango
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/netw/interface/eth"
+	"github.com/PaloAltoNetworks/pango/objs/addr"
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+func TestOfflineRenderConfig(t *testing.T) {
+	fw := &Firewall{Client: Client{
+		Offline: true,
+		Version: version.Number{9, 0, 0, ""},
+	}}
+	if err := fw.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	if err := fw.Objects.Address.Set("vsys1",
+		addr.Entry{Name: "a1", Value: "10.1.1.1", Type: addr.IpNetmask},
+		addr.Entry{Name: "a2", Value: "10.1.1.2", Type: addr.IpNetmask},
+	); err != nil {
+		t.Fatalf("Failed to set addresses: %s", err)
+	}
+	if err := fw.Objects.Address.Edit("vsys1", addr.Entry{Name: "a1", Value: "10.9.9.9", Type: addr.IpNetmask}); err != nil {
+		t.Fatalf("Failed to edit address: %s", err)
+	}
+	if err := fw.Objects.Address.Delete("vsys1", "a2"); err != nil {
+		t.Fatalf("Failed to delete address: %s", err)
+	}
+	if err := fw.Network.EthernetInterface.Set("vsys1", eth.Entry{Name: "ethernet1/1", Mode: "layer3"}); err != nil {
+		t.Fatalf("Failed to set interface: %s", err)
+	}
+
+	b, err := fw.RenderConfig()
+	if err != nil {
+		t.Fatalf("Failed to render: %s", err)
+	}
+	s := string(b)
+
+	for _, chk := range []string{
+		`<entry name="a1">`,
+		`<ip-netmask>10.9.9.9</ip-netmask>`,
+		`<entry name="ethernet1/1">`,
+		`<member>ethernet1/1</member>`,
+	} {
+		if !strings.Contains(s, chk) {
+			t.Errorf("Missing %q in:\n%s", chk, s)
+		}
+	}
+	for _, chk := range []string{`a2`, `10.1.1.1`} {
+		if strings.Contains(s, chk) {
+			t.Errorf("Found %q in:\n%s", chk, s)
+		}
+	}
+	if strings.Count(s, `<entry name="localhost.localdomain">`) != 1 {
+		t.Errorf("Device entry not merged:\n%s", s)
+	}
+}
+
+func TestOfflineReadsFail(t *testing.T) {
+	fw := &Firewall{Client: Client{Offline: true}}
+	if err := fw.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	if _, err := fw.Objects.Address.GetList("vsys1"); err != errOffline {
+		t.Errorf("Expected offline error, got %v", err)
+	}
+}
+
+func TestSplitXpath(t *testing.T) {
+	segs, err := splitXpath("/config/devices/entry[@name='localhost.localdomain']/network/interface/ethernet/entry[@name='ethernet1/1' or @name='ethernet1/2']")
+	if err != nil {
+		t.Fatalf("Failed: %s", err)
+	} else if len(segs) != 7 {
+		t.Fatalf("Got %d segments, not 7", len(segs))
+	} else if segs[6].tag != "entry" || len(segs[6].values) != 2 || segs[6].values[1] != "ethernet1/2" {
+		t.Errorf("Bad last segment: %#v", segs[6])
+	}
+}
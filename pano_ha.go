@@ -0,0 +1,104 @@
+package pango
+
+import (
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/dev"
+	"github.com/PaloAltoNetworks/pango/licen"
+	"github.com/PaloAltoNetworks/pango/netw"
+	"github.com/PaloAltoNetworks/pango/objs"
+	"github.com/PaloAltoNetworks/pango/pnrm"
+	"github.com/PaloAltoNetworks/pango/poli"
+	"github.com/PaloAltoNetworks/pango/userid"
+)
+
+// PanoramaHaPair is a Panorama client for both members of an HA pair.
+//
+// Requests are sent to the active member, and are retried on failover the
+// same way as with an HaPair.
+//
+// A PanoramaHaPair satisfies util.XapiClient, and it has the namespaces of the
+// Panorama, which send their requests to the active member.
+//
+// Each peer should be initialized prior to invoking Initialize().  The peers
+// should also be running the same PAN-OS version.
+type PanoramaHaPair struct {
+	haPair[*Panorama]
+
+	Peers [2]*Panorama
+
+	// Namespaces
+	Device    *dev.PanoDev
+	Licensing *licen.Licen
+	UserId    *userid.UserId
+	Panorama  *pnrm.Pnrm
+	Objects   *objs.PanoObjs
+	Policies  *poli.PanoPoli
+	Network   *netw.PanoNetw
+}
+
+// NewPanoramaHaPair returns a PanoramaHaPair for the given peers.
+//
+// If either peer is nil, Initialize() will return an error.
+func NewPanoramaHaPair(a, b *Panorama) *PanoramaHaPair {
+	h := &PanoramaHaPair{
+		Peers: [2]*Panorama{a, b},
+	}
+
+	var clients [2]*Client
+	if a != nil {
+		clients[0] = &a.Client
+	}
+	if b != nil {
+		clients[1] = &b.Client
+	}
+	h.setup("PanoramaHaPair", h.Peers, clients)
+
+	return h
+}
+
+// Initialize detects the active member and sets up the namespaces.
+func (h *PanoramaHaPair) Initialize() error {
+	if h.Peers[0] == nil || h.Peers[1] == nil {
+		return fmt.Errorf("both HA peers must be specified")
+	}
+
+	h.setup("PanoramaHaPair", h.Peers, [2]*Client{&h.Peers[0].Client, &h.Peers[1].Client})
+	if _, err := h.Detect(); err != nil {
+		return err
+	}
+
+	h.Device = &dev.PanoDev{}
+	h.Device.Initialize(h)
+
+	h.Licensing = &licen.Licen{}
+	h.Licensing.Initialize(h)
+
+	h.UserId = &userid.UserId{}
+	h.UserId.Initialize(h)
+
+	h.Panorama = &pnrm.Pnrm{}
+	h.Panorama.Initialize(h)
+
+	h.Objects = &objs.PanoObjs{}
+	h.Objects.Initialize(h)
+
+	h.Policies = &poli.PanoPoli{}
+	h.Policies.Initialize(h)
+
+	h.Network = &netw.PanoNetw{}
+	h.Network.Initialize(h)
+
+	return nil
+}
+
+// Detect queries both peers for their HA state, and saves and returns the
+// active member.
+func (h *PanoramaHaPair) Detect() (*Panorama, error) {
+	return h.detectPeer()
+}
+
+// Active returns the active member, detecting it first if it is not known.
+func (h *PanoramaHaPair) Active() (*Panorama, error) {
+	return h.activePeer()
+}
@@ -0,0 +1,177 @@
+package pango
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func newPanoHaPeer(state string) *haPeer {
+	p := &haPeer{}
+	p.state.Store(state)
+	p.srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.FormValue("cmd"), "high-availability") {
+			fmt.Fprintf(w, `<response status="success"><result><enabled>yes</enabled><local-info><state>%s</state></local-info></result></response>`, p.state.Load())
+			return
+		}
+
+		atomic.AddInt32(&p.gets, 1)
+		w.Write([]byte(`<response status="success"><result><hostname>pano</hostname></result></response>`))
+	}))
+
+	return p
+}
+
+func (p *haPeer) panorama(name string) *Panorama {
+	pano := &Panorama{}
+	pano.Hostname = name
+	pano.Logging = LogQuiet
+	pano.con = p.srv.Client()
+	pano.api_url = p.srv.URL
+
+	return pano
+}
+
+func TestPanoramaHaInfo(t *testing.T) {
+	c := &Client{}
+	c.rb = [][]byte{[]byte(`<response status="success"><result><enabled>yes</enabled><local-info><state>secondary-active</state></local-info><peer-info><state>primary-passive</state></peer-info></result></response>`)}
+	c.Initialize()
+
+	info, err := c.HaInfo()
+	if err != nil {
+		t.Fatalf("Error in HaInfo: %s", err)
+	}
+
+	if !info.Enabled || info.State != "secondary-active" || info.PeerState != "primary-passive" {
+		t.Errorf("Got %#v", info)
+	}
+	if !info.Active() {
+		t.Errorf("Active Panorama is not active")
+	}
+}
+
+func TestPanoramaHaPairDetectsActive(t *testing.T) {
+	a := newPanoHaPeer("primary-passive")
+	defer a.srv.Close()
+	b := newPanoHaPeer("secondary-active")
+	defer b.srv.Close()
+
+	h := NewPanoramaHaPair(a.panorama("a"), b.panorama("b"))
+	if err := h.Initialize(); err != nil {
+		t.Fatalf("Error in initialize: %s", err)
+	}
+
+	pano, err := h.Active()
+	if err != nil {
+		t.Fatalf("Error in active: %s", err)
+	} else if pano.Hostname != "b" {
+		t.Errorf("Active is %q", pano.Hostname)
+	}
+
+	if _, err = h.Get("/config/devices", nil, nil); err != nil {
+		t.Fatalf("Error in get: %s", err)
+	}
+	if a.gets != 0 || b.gets != 1 {
+		t.Errorf("Gets: a=%d b=%d", a.gets, b.gets)
+	}
+}
+
+func TestPanoramaHaPairFailover(t *testing.T) {
+	a := newPanoHaPeer("primary-active")
+	b := newPanoHaPeer("secondary-passive")
+	defer b.srv.Close()
+
+	h := NewPanoramaHaPair(a.panorama("a"), b.panorama("b"))
+	if err := h.Initialize(); err != nil {
+		t.Fatalf("Error in initialize: %s", err)
+	}
+
+	a.srv.Close()
+	b.state.Store("secondary-active")
+
+	if _, err := h.Get("/config/devices", nil, nil); err != nil {
+		t.Fatalf("Error in get: %s", err)
+	}
+	if b.gets != 1 {
+		t.Errorf("Gets on b: %d", b.gets)
+	}
+	if pano, _ := h.Active(); pano.Hostname != "b" {
+		t.Errorf("Active is %q", pano.Hostname)
+	}
+}
+
+func TestPanoramaHaPairNoActivePeer(t *testing.T) {
+	a := newPanoHaPeer("primary-passive")
+	defer a.srv.Close()
+	b := newPanoHaPeer("secondary-suspended")
+	defer b.srv.Close()
+
+	h := NewPanoramaHaPair(a.panorama("a"), b.panorama("b"))
+	if _, ok := h.Initialize().(NoActivePeerError); !ok {
+		t.Errorf("Expected NoActivePeerError")
+	}
+}
+
+func TestPanoramaHaPairFailoverNoReplay(t *testing.T) {
+	testCases := []struct {
+		desc string
+		fn   func(*PanoramaHaPair) error
+	}{
+		{"op", func(h *PanoramaHaPair) error {
+			_, err := h.Op("<request><restart><system/></restart></request>", "", nil, nil)
+			return err
+		}},
+		{"move", func(h *PanoramaHaPair) error {
+			_, err := h.Move("/config/devices/entry/x", "top", "", nil, nil)
+			return err
+		}},
+		{"vsys import", func(h *PanoramaHaPair) error {
+			return h.VsysImport("interface", "tmpl", "", "vsys1", []string{"ethernet1/1"})
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			a := newPanoHaPeer("primary-active")
+			b := newPanoHaPeer("secondary-passive")
+			defer b.srv.Close()
+
+			h := NewPanoramaHaPair(a.panorama("a"), b.panorama("b"))
+			if err := h.Initialize(); err != nil {
+				t.Fatalf("Error in initialize: %s", err)
+			}
+
+			a.srv.Close()
+			b.state.Store("secondary-active")
+
+			if err := tc.fn(h); err == nil {
+				t.Errorf("Request was retried")
+			}
+			if b.gets != 0 {
+				t.Errorf("Requests on b: %d", b.gets)
+			}
+			if pano, _ := h.Active(); pano.Hostname != "b" {
+				t.Errorf("Active is %q", pano.Hostname)
+			}
+		})
+	}
+}
+
+func TestPanoramaHaPairNilPeer(t *testing.T) {
+	b := newPanoHaPeer("secondary-active")
+	defer b.srv.Close()
+
+	h := NewPanoramaHaPair(nil, b.panorama("b"))
+	if err := h.Initialize(); err == nil {
+		t.Errorf("Initialize succeeded with a nil peer")
+	}
+	if _, err := h.Get("/config/devices", nil, nil); err == nil {
+		t.Errorf("Get succeeded with a nil peer")
+	}
+	if b.gets != 0 {
+		t.Errorf("Gets on b: %d", b.gets)
+	}
+}
@@ -0,0 +1,86 @@
+package pango
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// PermissionError is returned from Communicate and CommunicateFile when
+// PAN-OS denies a request because the admin role of the API key in use does
+// not allow it.
+//
+// This is most commonly encountered when using a restricted API key, such as
+// the key of a vsys admin set up for least-privilege automation.
+//
+// Permission is the admin role XML API permission required for the request,
+// such as "xmlapi/config".  Action, Xpath, and Cmd are the details of the
+// request that was denied, if applicable.
+type PermissionError struct {
+	PanosError
+	Permission string
+	Action     string
+	Xpath      string
+	Cmd        string
+}
+
+// Error returns the error message.
+func (e PermissionError) Error() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s (requires %s", e.Msg, e.Permission)
+	if e.Action != "" {
+		fmt.Fprintf(&b, ", action %s", e.Action)
+	}
+	if e.Xpath != "" {
+		fmt.Fprintf(&b, ", xpath %s", e.Xpath)
+	}
+	if e.Cmd != "" {
+		fmt.Fprintf(&b, ", cmd %s", e.Cmd)
+	}
+	b.WriteString(")")
+
+	return b.String()
+}
+
+// Unwrap returns the underlying PanosError.
+func (e PermissionError) Unwrap() error {
+	return e.PanosError
+}
+
+// PermissionDenied returns true if the error is due to the admin role of the
+// API key not allowing the request.
+func (e PanosError) PermissionDenied() bool {
+	switch e.Code {
+	case 16:
+		return true
+	case 403:
+		return !strings.Contains(strings.ToLower(e.Msg), "credential")
+	}
+
+	return strings.Contains(strings.ToLower(e.Msg), "not authorized")
+}
+
+/** Internal functions **/
+
+// permissionError converts the given error into a PermissionError if it is a
+// PanosError due to a permission problem.
+func permissionError(data url.Values, err error) error {
+	e, ok := err.(PanosError)
+	if !ok || !e.PermissionDenied() {
+		return err
+	}
+
+	t := data.Get("type")
+	ans := PermissionError{
+		PanosError: e,
+		Permission: "xmlapi/" + t,
+		Action:     data.Get("action"),
+		Xpath:      data.Get("xpath"),
+	}
+	if t == "op" {
+		ans.Cmd = data.Get("cmd")
+	}
+
+	return ans
+}
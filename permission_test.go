@@ -0,0 +1,65 @@
+package pango
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+func TestPermissionError(t *testing.T) {
+	c := &Client{rb: [][]byte{
+		[]byte(`<response status="error" code="403"><result><msg>Type [config] not authorized for user role.</msg></result></response>`),
+	}}
+	c.Initialize()
+
+	_, err := c.Get("/config/devices/entry[@name='localhost.localdomain']/vsys/entry[@name='vsys1']/address", nil, nil)
+	if err == nil {
+		t.Fatalf("Expected an error")
+	}
+
+	e, ok := err.(PermissionError)
+	if !ok {
+		t.Fatalf("Error is %T, not PermissionError", err)
+	}
+	if e.Permission != "xmlapi/config" {
+		t.Errorf("Permission is %q", e.Permission)
+	}
+	if e.Action != "get" {
+		t.Errorf("Action is %q", e.Action)
+	}
+
+	var pe PanosError
+	if !errors.As(err, &pe) || pe.Code != 403 {
+		t.Errorf("Unable to unwrap PanosError")
+	}
+}
+
+func TestPermissionErrorInvalidCredentials(t *testing.T) {
+	c := &Client{rb: [][]byte{
+		[]byte(`<response status="error" code="403"><result><msg>Invalid Credential</msg></result></response>`),
+	}}
+	c.Initialize()
+
+	_, err := c.Op("<show><clock></clock></show>", "", nil, nil)
+	if _, ok := err.(PanosError); !ok {
+		t.Errorf("Error is %T, not PanosError", err)
+	}
+}
+
+func TestSystemInfoDeniedWithVersion(t *testing.T) {
+	c := &Client{rb: [][]byte{
+		[]byte(`<response status="error" code="16"><msg><line>Unauthorized request</line></msg></response>`),
+	}}
+	c.Initialize()
+	c.Version = version.Number{10, 1, 0, ""}
+
+	if err := c.initSystemInfo(); err != nil {
+		t.Errorf("Error with version set: %s", err)
+	}
+
+	c.Version = version.Number{}
+	if err := c.initSystemInfo(); err == nil {
+		t.Errorf("Expected an error without version set")
+	}
+}
@@ -0,0 +1,32 @@
+package collector
+
+// Valid values for Entry.DiskPairs.
+const (
+	DiskPairA = "A"
+	DiskPairB = "B"
+	DiskPairC = "C"
+	DiskPairD = "D"
+	DiskPairE = "E"
+	DiskPairF = "F"
+	DiskPairG = "G"
+	DiskPairH = "H"
+	DiskPairI = "I"
+	DiskPairJ = "J"
+	DiskPairK = "K"
+	DiskPairL = "L"
+)
+
+const (
+	singular = "log collector"
+	plural   = "log collectors"
+)
+
+// diskPairs maps appliance model to the number of disk pairs it has.
+var diskPairs = map[string]int{
+	"M-100": 4,
+	"M-200": 2,
+	"M-300": 2,
+	"M-500": 12,
+	"M-600": 6,
+	"M-700": 6,
+}
@@ -0,0 +1,11 @@
+/*
+Package collector is the client.Panorama.LogCollector namespace.
+
+Each log collector is keyed by its serial number.  Disk pairs must be
+enabled on the collector before the logging disks can be used, and the disk
+pairs available depend on the appliance model, which can be checked with
+Entry.ValidateDiskPairs().
+
+Normalized object: Entry
+*/
+package collector
@@ -0,0 +1,127 @@
+package collector
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a log
+// collector.
+//
+// Name is the serial number of the log collector.
+type Entry struct {
+	Name      string
+	DiskPairs []string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.DiskPairs = s.DiskPairs
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the serial number of this log collector.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+// ValidateDiskPairs checks that the disk pairs are supported on the given
+// appliance model, such as "M-600".
+//
+// Virtual appliances do not have disk pairs, so any model that is not a
+// hardware M-series appliance returns an error if disk pairs are specified.
+func (o Entry) ValidateDiskPairs(model string) error {
+	if len(o.DiskPairs) == 0 {
+		return nil
+	}
+
+	num, ok := diskPairs[model]
+	if !ok {
+		return fmt.Errorf("model %q does not support disk pairs", model)
+	}
+
+	seen := make(map[string]bool, len(o.DiskPairs))
+	for _, p := range o.DiskPairs {
+		if len(p) != 1 || p[0] < 'A' || p[0] >= 'A'+byte(num) {
+			return fmt.Errorf("disk pair %q is not valid for model %q", p, model)
+		}
+		if seen[p] {
+			return fmt.Errorf("disk pair %q specified more than once", p)
+		}
+		seen[p] = true
+	}
+
+	return nil
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name: o.Name,
+	}
+
+	if o.Disk != nil {
+		ans.DiskPairs = util.EntToStr(o.Disk.DiskPairs)
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName xml.Name `xml:"entry"`
+	Name    string   `xml:"name,attr"`
+	Disk    *disk    `xml:"disk-settings"`
+}
+
+type disk struct {
+	DiskPairs *util.EntryType `xml:"disk-pair"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name: e.Name,
+	}
+
+	if len(e.DiskPairs) > 0 {
+		ans.Disk = &disk{
+			DiskPairs: util.StrToEnt(e.DiskPairs),
+		}
+	}
+
+	return ans
+}
@@ -0,0 +1,6 @@
+package group
+
+const (
+	singular = "log collector group"
+	plural   = "log collector groups"
+)
@@ -0,0 +1,10 @@
+/*
+Package group is the client.Panorama.LogCollectorGroup namespace.
+
+Log redundancy keeps two copies of each log across the collectors in the
+group, so it requires at least two collectors; use
+Entry.ValidateLogRedundancy() to check this before configuring the group.
+
+Normalized object: Entry
+*/
+package group
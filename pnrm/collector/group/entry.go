@@ -0,0 +1,119 @@
+package group
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a log
+// collector group.
+//
+// Collectors is the list of log collector serial numbers in this group.
+type Entry struct {
+	Name          string
+	Collectors    []string
+	LogRedundancy bool
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Collectors = s.Collectors
+	o.LogRedundancy = s.LogRedundancy
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this log collector group.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+// ValidateLogRedundancy checks that there are enough collectors in the group
+// for log redundancy to be enabled.
+func (o Entry) ValidateLogRedundancy() error {
+	if o.LogRedundancy && len(o.Collectors) < 2 {
+		return fmt.Errorf("log redundancy requires at least two collectors, have %d", len(o.Collectors))
+	}
+
+	return nil
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:       o.Name,
+		Collectors: util.EntToStr(o.Collectors),
+	}
+
+	if o.General != nil && o.General.Management != nil {
+		ans.LogRedundancy = util.AsBool(o.General.Management.LogRedundancy)
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName    xml.Name        `xml:"entry"`
+	Name       string          `xml:"name,attr"`
+	Collectors *util.EntryType `xml:"logfwd-setting>collectors"`
+	General    *general        `xml:"general-setting"`
+}
+
+type general struct {
+	Management *management `xml:"management"`
+}
+
+type management struct {
+	LogRedundancy string `xml:"enable-log-redundancy,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:       e.Name,
+		Collectors: util.StrToEnt(e.Collectors),
+	}
+
+	if e.LogRedundancy {
+		ans.General = &general{
+			Management: &management{
+				LogRedundancy: util.YesNo(e.LogRedundancy),
+			},
+		}
+	}
+
+	return ans
+}
@@ -0,0 +1,85 @@
+package group
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Group is the client.Panorama.LogCollectorGroup namespace.
+type Group struct {
+	ns *namespace.Standard[Entry]
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *Group) Initialize(con util.XapiClient) {
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of all log collector groups.
+func (c *Group) GetList() ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(nil))
+}
+
+// ShowList performs SHOW to retrieve a list of all log collector groups.
+func (c *Group) ShowList() ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(nil))
+}
+
+// Get performs GET to retrieve information for the given log collector group.
+func (c *Group) Get(name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath([]string{name}), name)
+}
+
+// GetAll performs GET to retrieve all log collector groups configured.
+func (c *Group) GetAll() ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(nil))
+}
+
+// Show performs SHOW to retrieve information for the given log collector group.
+func (c *Group) Show(name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath([]string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve all log collector groups configured.
+func (c *Group) ShowAll() ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(nil))
+}
+
+// Set performs SET to create / update one or more log collector groups.
+func (c *Group) Set(e ...Entry) error {
+	return c.ns.SetEntries(c.pather(), e...)
+}
+
+// Edit performs EDIT to create / update one log collector group.
+func (c *Group) Edit(e Entry) error {
+	return c.ns.EditEntry(c.pather(), e)
+}
+
+// Delete removes the given log collector groups.
+//
+// Objects can be either a string or an Entry object.
+func (c *Group) Delete(e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(), e...)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *Group) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *Group) pather() namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(v)
+	}
+}
+
+func (c *Group) xpath(vals []string) []string {
+	return []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"log-collector-group",
+		util.AsEntryXpath(vals),
+	}
+}
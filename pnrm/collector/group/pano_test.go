@@ -0,0 +1,47 @@
+package group
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestPanoNormalization(t *testing.T) {
+	testCases := getTests()
+
+	mc := &testdata.MockClient{}
+	ns := &Group{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.AddResp("")
+			err := ns.Set(tc.conf)
+			if err != nil {
+				t.Errorf("Error in set: %s", err)
+			} else {
+				mc.AddResp(mc.Elm)
+				r, err := ns.Get(tc.conf.Name)
+				if err != nil {
+					t.Errorf("Error in get: %s", err)
+				} else if !reflect.DeepEqual(tc.conf, r) {
+					t.Errorf("%#v != %#v", tc.conf, r)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateLogRedundancy(t *testing.T) {
+	e := Entry{Name: "cg", Collectors: []string{"0001"}, LogRedundancy: true}
+	if err := e.ValidateLogRedundancy(); err == nil {
+		t.Errorf("No error with a single collector")
+	}
+
+	e.Collectors = append(e.Collectors, "0002")
+	if err := e.ValidateLogRedundancy(); err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+}
@@ -0,0 +1,20 @@
+package group
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"single collector", Entry{
+			Name:       "cg1",
+			Collectors: []string{"0001"},
+		}},
+		{"log redundancy", Entry{
+			Name:          "cg2",
+			Collectors:    []string{"0001", "0002"},
+			LogRedundancy: true,
+		}},
+	}
+}
@@ -0,0 +1,64 @@
+package collector
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestPanoNormalization(t *testing.T) {
+	testCases := getTests()
+
+	mc := &testdata.MockClient{}
+	ns := &Collector{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.AddResp("")
+			err := ns.Set(tc.conf)
+			if err != nil {
+				t.Errorf("Error in set: %s", err)
+			} else {
+				mc.AddResp(mc.Elm)
+				r, err := ns.Get(tc.conf.Name)
+				if err != nil {
+					t.Errorf("Error in get: %s", err)
+				} else if !reflect.DeepEqual(tc.conf, r) {
+					t.Errorf("%#v != %#v", tc.conf, r)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateDiskPairs(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		model string
+		pairs []string
+		ok    bool
+	}{
+		{"no pairs on virtual", "Panorama", nil, true},
+		{"pairs on virtual", "Panorama", []string{DiskPairA}, false},
+		{"m-200 in range", "M-200", []string{DiskPairA, DiskPairB}, true},
+		{"m-200 out of range", "M-200", []string{DiskPairC}, false},
+		{"m-500 last pair", "M-500", []string{DiskPairL}, true},
+		{"duplicate pair", "M-600", []string{DiskPairA, DiskPairA}, false},
+		{"bogus pair", "M-600", []string{"AA"}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			e := Entry{Name: "0001", DiskPairs: tc.pairs}
+			err := e.ValidateDiskPairs(tc.model)
+			if tc.ok && err != nil {
+				t.Errorf("Unexpected error: %s", err)
+			} else if !tc.ok && err == nil {
+				t.Errorf("Expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package collector
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"no disk pairs", Entry{
+			Name: "0001",
+		}},
+		{"with disk pairs", Entry{
+			Name:      "0002",
+			DiskPairs: []string{DiskPairA, DiskPairB},
+		}},
+	}
+}
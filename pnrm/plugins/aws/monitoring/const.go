@@ -0,0 +1,6 @@
+package monitoring
+
+const (
+	singular = "aws monitoring definition"
+	plural   = "aws monitoring definitions"
+)
@@ -0,0 +1,10 @@
+/*
+Package monitoring is the client.Panorama.AwsMonitoringDefinition namespace.
+
+A monitoring definition uses an IAM role to retrieve EC2 instance tags from a
+VPC, which are then pushed as IP-to-tag mappings to the firewalls of the
+notify group (a device group) for use in dynamic address groups.
+
+Normalized object:  Entry
+*/
+package monitoring
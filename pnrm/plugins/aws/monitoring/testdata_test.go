@@ -0,0 +1,25 @@
+package monitoring
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"enabled definition", Entry{
+			Name:        "aws1",
+			IamRole:     "arn:aws:iam::123456789012:role/panorama",
+			VpcId:       "vpc-12345678",
+			NotifyGroup: "dg1",
+			Enable:      true,
+		}},
+		{"disabled definition", Entry{
+			Name:        "aws2",
+			Description: "staging",
+			IamRole:     "arn:aws:iam::123456789012:role/staging",
+			VpcId:       "vpc-87654321",
+			NotifyGroup: "dg2",
+		}},
+	}
+}
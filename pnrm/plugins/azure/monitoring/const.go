@@ -0,0 +1,6 @@
+package monitoring
+
+const (
+	singular = "azure monitoring definition"
+	plural   = "azure monitoring definitions"
+)
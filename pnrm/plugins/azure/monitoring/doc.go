@@ -0,0 +1,11 @@
+/*
+Package monitoring is the client.Panorama.AzureMonitoringDefinition namespace.
+
+A monitoring definition uses a service principal to retrieve VM tags from an
+Azure subscription, which are then pushed as IP-to-tag mappings to the
+firewalls of the notify group (a device group) for use in dynamic address
+groups.
+
+Normalized object:  Entry
+*/
+package monitoring
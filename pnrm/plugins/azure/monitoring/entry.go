@@ -0,0 +1,98 @@
+package monitoring
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a Azure
+// plugin monitoring definition.
+type Entry struct {
+	Name             string
+	Description      string
+	ServicePrincipal string
+	NotifyGroup      string
+	Enable           bool
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Description = s.Description
+	o.ServicePrincipal = s.ServicePrincipal
+	o.NotifyGroup = s.NotifyGroup
+	o.Enable = s.Enable
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this monitoring definition.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:             o.Name,
+		Description:      o.Description,
+		ServicePrincipal: o.ServicePrincipal,
+		NotifyGroup:      o.NotifyGroup,
+		Enable:           util.AsBool(o.Enable),
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName          xml.Name `xml:"entry"`
+	Name             string   `xml:"name,attr"`
+	Description      string   `xml:"description,omitempty"`
+	ServicePrincipal string   `xml:"service-principal,omitempty"`
+	NotifyGroup      string   `xml:"notify-group,omitempty"`
+	Enable           string   `xml:"enable"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:             e.Name,
+		Description:      e.Description,
+		ServicePrincipal: e.ServicePrincipal,
+		NotifyGroup:      e.NotifyGroup,
+		Enable:           util.YesNo(e.Enable),
+	}
+
+	return ans
+}
@@ -0,0 +1,23 @@
+package monitoring
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"enabled definition", Entry{
+			Name:             "azure1",
+			ServicePrincipal: "sp1",
+			NotifyGroup:      "dg1",
+			Enable:           true,
+		}},
+		{"disabled definition", Entry{
+			Name:             "azure2",
+			Description:      "staging",
+			ServicePrincipal: "sp2",
+			NotifyGroup:      "dg2",
+		}},
+	}
+}
@@ -0,0 +1,6 @@
+package bundle
+
+const (
+	singular = "kubernetes license bundle"
+	plural   = "kubernetes license bundles"
+)
@@ -0,0 +1,10 @@
+/*
+Package bundle is the client.Panorama.KubernetesLicenseBundle namespace.
+
+A license bundle ties CN-Series firewall licensing (an auth code and the number
+of vCPUs to license) to the device group and template stack that CN-Series
+firewalls deployed with the bundle will be associated with.
+
+Normalized object:  Entry
+*/
+package bundle
@@ -0,0 +1,108 @@
+package bundle
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a CN-Series
+// license bundle.
+type Entry struct {
+	Name           string
+	Description    string
+	AuthCode       string
+	Vcpus          int
+	DeviceGroup    string
+	TemplateStack  string
+	CollectorGroup string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Description = s.Description
+	o.AuthCode = s.AuthCode
+	o.Vcpus = s.Vcpus
+	o.DeviceGroup = s.DeviceGroup
+	o.TemplateStack = s.TemplateStack
+	o.CollectorGroup = s.CollectorGroup
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this license bundle.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:           o.Name,
+		Description:    o.Description,
+		AuthCode:       o.AuthCode,
+		Vcpus:          o.Vcpus,
+		DeviceGroup:    o.DeviceGroup,
+		TemplateStack:  o.TemplateStack,
+		CollectorGroup: o.CollectorGroup,
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName        xml.Name `xml:"entry"`
+	Name           string   `xml:"name,attr"`
+	Description    string   `xml:"description,omitempty"`
+	AuthCode       string   `xml:"auth-code,omitempty"`
+	Vcpus          int      `xml:"vcpu-count,omitempty"`
+	DeviceGroup    string   `xml:"device-group,omitempty"`
+	TemplateStack  string   `xml:"template-stack,omitempty"`
+	CollectorGroup string   `xml:"collector-group,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:           e.Name,
+		Description:    e.Description,
+		AuthCode:       e.AuthCode,
+		Vcpus:          e.Vcpus,
+		DeviceGroup:    e.DeviceGroup,
+		TemplateStack:  e.TemplateStack,
+		CollectorGroup: e.CollectorGroup,
+	}
+
+	return ans
+}
@@ -0,0 +1,27 @@
+package bundle
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"basic bundle", Entry{
+			Name:          "bundle1",
+			AuthCode:      "D1234567",
+			Vcpus:         8,
+			DeviceGroup:   "cn-dg",
+			TemplateStack: "cn-ts",
+		}},
+		{"bundle with collector group", Entry{
+			Name:           "bundle2",
+			Description:    "prod",
+			AuthCode:       "D7654321",
+			Vcpus:          32,
+			DeviceGroup:    "cn-dg",
+			TemplateStack:  "cn-ts",
+			CollectorGroup: "cg1",
+		}},
+	}
+}
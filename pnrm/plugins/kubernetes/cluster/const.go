@@ -0,0 +1,15 @@
+package cluster
+
+// Valid values for Entry.Type.
+const (
+	TypeNative    = "native"
+	TypeGke       = "gke"
+	TypeEks       = "eks"
+	TypeAks       = "aks"
+	TypeOpenShift = "openshift"
+)
+
+const (
+	singular = "kubernetes cluster"
+	plural   = "kubernetes clusters"
+)
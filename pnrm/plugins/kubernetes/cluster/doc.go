@@ -0,0 +1,9 @@
+/*
+Package cluster is the client.Panorama.KubernetesCluster namespace.
+
+This namespace manages the Kubernetes clusters that the Panorama Kubernetes
+plugin monitors, for use with CN-Series firewalls.
+
+Normalized object:  Entry
+*/
+package cluster
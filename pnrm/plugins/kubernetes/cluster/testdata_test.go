@@ -0,0 +1,24 @@
+package cluster
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"native cluster", Entry{
+			Name:             "k8s1",
+			Description:      "on prem",
+			Type:             TypeNative,
+			ApiServerAddress: "https://10.1.1.1:6443",
+			CredentialFile:   "encrypted",
+		}},
+		{"eks with label filters", Entry{
+			Name:             "k8s2",
+			Type:             TypeEks,
+			ApiServerAddress: "https://example.eks.amazonaws.com",
+			LabelFilters:     []string{"app", "tier"},
+		}},
+	}
+}
@@ -0,0 +1,12 @@
+package device
+
+// Valid values for Entry.Type.
+const (
+	TypeBranch = "branch"
+	TypeHub    = "hub"
+)
+
+const (
+	singular = "sdwan device"
+	plural   = "sdwan devices"
+)
@@ -0,0 +1,10 @@
+/*
+Package device is the client.Panorama.SdwanDevice namespace.
+
+This namespace manages the devices of the Panorama SD-WAN plugin, which
+defines each managed firewall as either a branch or a hub.  Devices are then
+grouped into a VPN topology with the vpncluster namespace.
+
+Normalized object:  Entry
+*/
+package device
@@ -0,0 +1,105 @@
+package device
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a SD-WAN
+// device.
+//
+// Name is the serial number of the firewall.
+type Entry struct {
+	Name                   string
+	Type                   string
+	Site                   string
+	VirtualRouter          string
+	RouterName             string
+	PrefixesToRedistribute []string // ordered
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Type = s.Type
+	o.Site = s.Site
+	o.VirtualRouter = s.VirtualRouter
+	o.RouterName = s.RouterName
+	o.PrefixesToRedistribute = s.PrefixesToRedistribute
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the serial number of this device.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:                   o.Name,
+		Type:                   o.Type,
+		Site:                   o.Site,
+		VirtualRouter:          o.VirtualRouter,
+		RouterName:             o.RouterName,
+		PrefixesToRedistribute: util.MemToStr(o.PrefixesToRedistribute),
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName                xml.Name         `xml:"entry"`
+	Name                   string           `xml:"name,attr"`
+	Type                   string           `xml:"type,omitempty"`
+	Site                   string           `xml:"site,omitempty"`
+	VirtualRouter          string           `xml:"vr-name,omitempty"`
+	RouterName             string           `xml:"router-name,omitempty"`
+	PrefixesToRedistribute *util.MemberType `xml:"prefix-redistribute"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:                   e.Name,
+		Type:                   e.Type,
+		Site:                   e.Site,
+		VirtualRouter:          e.VirtualRouter,
+		RouterName:             e.RouterName,
+		PrefixesToRedistribute: util.StrToMem(e.PrefixesToRedistribute),
+	}
+
+	return ans
+}
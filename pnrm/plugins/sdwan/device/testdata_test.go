@@ -0,0 +1,25 @@
+package device
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"branch", Entry{
+			Name:          "0001",
+			Type:          TypeBranch,
+			Site:          "branch1",
+			VirtualRouter: "default",
+			RouterName:    "br1",
+		}},
+		{"hub with prefixes", Entry{
+			Name:                   "0010",
+			Type:                   TypeHub,
+			Site:                   "dc1",
+			VirtualRouter:          "vr1",
+			PrefixesToRedistribute: []string{"10.1.0.0/16", "10.2.0.0/16"},
+		}},
+	}
+}
@@ -0,0 +1,12 @@
+package vpncluster
+
+// Valid values for Entry.Type.
+const (
+	TypeHubSpoke = "hub-spoke"
+	TypeMesh     = "mesh"
+)
+
+const (
+	singular = "sdwan vpn cluster"
+	plural   = "sdwan vpn clusters"
+)
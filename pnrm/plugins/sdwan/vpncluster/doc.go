@@ -0,0 +1,9 @@
+/*
+Package vpncluster is the client.Panorama.SdwanVpnCluster namespace.
+
+This namespace manages the VPN clusters of the Panorama SD-WAN plugin, which
+group SD-WAN branch and hub devices into a VPN topology.
+
+Normalized object:  Entry
+*/
+package vpncluster
@@ -0,0 +1,157 @@
+package vpncluster
+
+import (
+	"encoding/xml"
+	"sort"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a SD-WAN
+// VPN cluster.
+//
+// Branches and Hubs are device serial numbers.  Hubs are ordered by priority,
+// with the first hub having the highest priority.
+type Entry struct {
+	Name     string
+	Type     string
+	Branches []string // unordered
+	Hubs     []string // ordered
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Type = s.Type
+	o.Branches = s.Branches
+	o.Hubs = s.Hubs
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this VPN cluster.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name: o.Name,
+		Type: o.Type,
+	}
+
+	if o.Branches != nil {
+		ans.Branches = make([]string, 0, len(o.Branches.Entries))
+		for _, x := range o.Branches.Entries {
+			ans.Branches = append(ans.Branches, x.Name)
+		}
+	}
+
+	if o.Hubs != nil {
+		list := make([]hubEntry, len(o.Hubs.Entries))
+		copy(list, o.Hubs.Entries)
+		sort.SliceStable(list, func(i, j int) bool {
+			return list[i].Priority < list[j].Priority
+		})
+		ans.Hubs = make([]string, 0, len(list))
+		for _, x := range list {
+			ans.Hubs = append(ans.Hubs, x.Name)
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName  xml.Name `xml:"entry"`
+	Name     string   `xml:"name,attr"`
+	Type     string   `xml:"type,omitempty"`
+	Branches *devList `xml:"branches"`
+	Hubs     *hubList `xml:"hubs"`
+}
+
+type devList struct {
+	XMLName xml.Name   `xml:"branches"`
+	Entries []devEntry `xml:"entry"`
+}
+
+type devEntry struct {
+	Name string `xml:"name,attr"`
+}
+
+type hubList struct {
+	XMLName xml.Name   `xml:"hubs"`
+	Entries []hubEntry `xml:"entry"`
+}
+
+type hubEntry struct {
+	Name     string `xml:"name,attr"`
+	Priority int    `xml:"priority,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name: e.Name,
+		Type: e.Type,
+	}
+
+	if len(e.Branches) > 0 {
+		ans.Branches = branchList(e.Branches)
+	}
+
+	if len(e.Hubs) > 0 {
+		ans.Hubs = hubPriorities(e.Hubs)
+	}
+
+	return ans
+}
+
+func branchList(v []string) *devList {
+	ans := &devList{Entries: make([]devEntry, 0, len(v))}
+	for _, name := range v {
+		ans.Entries = append(ans.Entries, devEntry{Name: name})
+	}
+
+	return ans
+}
+
+func hubPriorities(v []string) *hubList {
+	ans := &hubList{Entries: make([]hubEntry, 0, len(v))}
+	for i, name := range v {
+		ans.Entries = append(ans.Entries, hubEntry{Name: name, Priority: i + 1})
+	}
+
+	return ans
+}
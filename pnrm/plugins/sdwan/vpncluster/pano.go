@@ -0,0 +1,130 @@
+package vpncluster
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// VpnCluster is the client.Panorama.SdwanVpnCluster namespace.
+type VpnCluster struct {
+	con util.XapiClient
+	ns  *namespace.Standard[Entry]
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *VpnCluster) Initialize(con util.XapiClient) {
+	c.con = con
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of all VPN clusters.
+func (c *VpnCluster) GetList() ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(nil))
+}
+
+// ShowList performs SHOW to retrieve a list of all VPN clusters.
+func (c *VpnCluster) ShowList() ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(nil))
+}
+
+// Get performs GET to retrieve information for the given VPN cluster.
+func (c *VpnCluster) Get(name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath([]string{name}), name)
+}
+
+// GetAll performs GET to retrieve all VPN clusters configured.
+func (c *VpnCluster) GetAll() ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(nil))
+}
+
+// Show performs SHOW to retrieve information for the given VPN cluster.
+func (c *VpnCluster) Show(name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath([]string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve all VPN clusters configured.
+func (c *VpnCluster) ShowAll() ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(nil))
+}
+
+// Set performs SET to create / update one or more VPN clusters.
+func (c *VpnCluster) Set(e ...Entry) error {
+	return c.ns.SetEntries(c.pather(), e...)
+}
+
+// Edit performs EDIT to create / update one VPN cluster.
+func (c *VpnCluster) Edit(e Entry) error {
+	return c.ns.EditEntry(c.pather(), e)
+}
+
+// Delete removes the given VPN clusters.
+//
+// Objects can be either a string or an Entry object.
+func (c *VpnCluster) Delete(e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(), e...)
+}
+
+// AssignBranches adds the given branch devices to the VPN cluster.
+func (c *VpnCluster) AssignBranches(cluster string, serials ...string) error {
+	if len(serials) == 0 {
+		return nil
+	}
+
+	c.con.LogAction("(set) %s %q branches: %v", singular, cluster, serials)
+	path := c.xpath([]string{cluster})
+	_, err := c.con.Set(path, branchList(serials), nil, nil)
+	return err
+}
+
+// SetHubs replaces the hubs of the VPN cluster with the given hub devices,
+// in priority order.
+func (c *VpnCluster) SetHubs(cluster string, serials ...string) error {
+	c.con.LogAction("(edit) %s %q hubs: %v", singular, cluster, serials)
+	path := c.xpath([]string{cluster})
+	path = append(path, "hubs")
+	_, err := c.con.Edit(path, hubPriorities(serials), nil, nil)
+	return err
+}
+
+// UnassignDevices removes the given devices from the VPN cluster, whether
+// they are branches or hubs.
+func (c *VpnCluster) UnassignDevices(cluster string, serials ...string) error {
+	if len(serials) == 0 {
+		return nil
+	}
+
+	c.con.LogAction("(delete) %s %q devices: %v", singular, cluster, serials)
+	for _, loc := range []string{"branches", "hubs"} {
+		path := c.xpath([]string{cluster})
+		path = append(path, loc, util.AsEntryXpath(serials))
+		if _, err := c.con.Delete(path, nil, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *VpnCluster) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *VpnCluster) pather() namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(v)
+	}
+}
+
+func (c *VpnCluster) xpath(vals []string) []string {
+	return []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"plugins",
+		"sd_wan",
+		"vpn-cluster",
+		util.AsEntryXpath(vals),
+	}
+}
@@ -0,0 +1,68 @@
+package vpncluster
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestPanoNormalization(t *testing.T) {
+	testCases := getTests()
+
+	mc := &testdata.MockClient{}
+	ns := &VpnCluster{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.AddResp("")
+			err := ns.Set(tc.conf)
+			if err != nil {
+				t.Errorf("Error in set: %s", err)
+			} else {
+				mc.AddResp(mc.Elm)
+				r, err := ns.Get(tc.conf.Name)
+				if err != nil {
+					t.Errorf("Error in get: %s", err)
+				} else if !reflect.DeepEqual(tc.conf, r) {
+					t.Errorf("%#v != %#v", tc.conf, r)
+				}
+			}
+		})
+	}
+}
+
+func TestSetHubs(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp("")
+	ns := &VpnCluster{}
+	ns.Initialize(mc)
+
+	if err := ns.SetHubs("one", "0011", "0010"); err != nil {
+		t.Fatalf("Error in set hubs: %s", err)
+	}
+
+	if mc.Path != "/config/devices/entry[@name='localhost.localdomain']/plugins/sd_wan/vpn-cluster/entry[@name='one']/hubs" {
+		t.Errorf("Path is %q", mc.Path)
+	}
+	if mc.Elm != `<hubs><entry name="0011"><priority>1</priority></entry><entry name="0010"><priority>2</priority></entry></hubs>` {
+		t.Errorf("Elm is %q", mc.Elm)
+	}
+}
+
+func TestUnassignDevices(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp("")
+	ns := &VpnCluster{}
+	ns.Initialize(mc)
+
+	if err := ns.UnassignDevices("one", "0001"); err != nil {
+		t.Fatalf("Error in unassign: %s", err)
+	}
+
+	if mc.Function != "delete" || mc.Called != 2 {
+		t.Errorf("Function %q called %d times", mc.Function, mc.Called)
+	}
+}
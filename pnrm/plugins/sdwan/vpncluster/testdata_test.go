@@ -0,0 +1,26 @@
+package vpncluster
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"empty cluster", Entry{
+			Name: "one",
+			Type: TypeHubSpoke,
+		}},
+		{"hub and spoke", Entry{
+			Name:     "two",
+			Type:     TypeHubSpoke,
+			Branches: []string{"0001", "0002"},
+			Hubs:     []string{"0011", "0010"},
+		}},
+		{"mesh", Entry{
+			Name:     "three",
+			Type:     TypeMesh,
+			Branches: []string{"0003", "0004", "0005"},
+		}},
+	}
+}
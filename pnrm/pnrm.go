@@ -6,10 +6,18 @@ package pnrm
 import (
 	"github.com/PaloAltoNetworks/pango/util"
 
+	"github.com/PaloAltoNetworks/pango/pnrm/collector"
+	collectorgroup "github.com/PaloAltoNetworks/pango/pnrm/collector/group"
 	"github.com/PaloAltoNetworks/pango/pnrm/dg"
+	awsmonitoring "github.com/PaloAltoNetworks/pango/pnrm/plugins/aws/monitoring"
+	azuremonitoring "github.com/PaloAltoNetworks/pango/pnrm/plugins/azure/monitoring"
 	"github.com/PaloAltoNetworks/pango/pnrm/plugins/gcp/account"
 	"github.com/PaloAltoNetworks/pango/pnrm/plugins/gcp/gke/cluster"
 	"github.com/PaloAltoNetworks/pango/pnrm/plugins/gcp/gke/cluster/group"
+	"github.com/PaloAltoNetworks/pango/pnrm/plugins/kubernetes/bundle"
+	k8scluster "github.com/PaloAltoNetworks/pango/pnrm/plugins/kubernetes/cluster"
+	"github.com/PaloAltoNetworks/pango/pnrm/plugins/sdwan/device"
+	"github.com/PaloAltoNetworks/pango/pnrm/plugins/sdwan/vpncluster"
 	"github.com/PaloAltoNetworks/pango/pnrm/template"
 	"github.com/PaloAltoNetworks/pango/pnrm/template/stack"
 	"github.com/PaloAltoNetworks/pango/pnrm/template/variable"
@@ -17,17 +25,31 @@ import (
 
 // Pnrm is the panorama.DeviceGroup namespace.
 type Pnrm struct {
-	DeviceGroup      *dg.Dg
-	GcpAccount       *account.Account
-	GkeCluster       *cluster.Cluster
-	GkeClusterGroup  *group.Group
-	Template         *template.Template
-	TemplateStack    *stack.Stack
-	TemplateVariable *variable.Variable
+	AwsMonitoringDefinition   *awsmonitoring.Monitoring
+	AzureMonitoringDefinition *azuremonitoring.Monitoring
+	DeviceGroup               *dg.Dg
+	GcpAccount                *account.Account
+	GkeCluster                *cluster.Cluster
+	GkeClusterGroup           *group.Group
+	KubernetesCluster         *k8scluster.Cluster
+	KubernetesLicenseBundle   *bundle.Bundle
+	LogCollector              *collector.Collector
+	LogCollectorGroup         *collectorgroup.Group
+	SdwanDevice               *device.Device
+	SdwanVpnCluster           *vpncluster.VpnCluster
+	Template                  *template.Template
+	TemplateStack             *stack.Stack
+	TemplateVariable          *variable.Variable
 }
 
 // Initialize is invoked on panorama.Initialize().
 func (c *Pnrm) Initialize(i util.XapiClient) {
+	c.AwsMonitoringDefinition = &awsmonitoring.Monitoring{}
+	c.AwsMonitoringDefinition.Initialize(i)
+
+	c.AzureMonitoringDefinition = &azuremonitoring.Monitoring{}
+	c.AzureMonitoringDefinition.Initialize(i)
+
 	c.DeviceGroup = &dg.Dg{}
 	c.DeviceGroup.Initialize(i)
 
@@ -40,6 +62,24 @@ func (c *Pnrm) Initialize(i util.XapiClient) {
 	c.GkeClusterGroup = &group.Group{}
 	c.GkeClusterGroup.Initialize(i)
 
+	c.KubernetesCluster = &k8scluster.Cluster{}
+	c.KubernetesCluster.Initialize(i)
+
+	c.KubernetesLicenseBundle = &bundle.Bundle{}
+	c.KubernetesLicenseBundle.Initialize(i)
+
+	c.LogCollector = &collector.Collector{}
+	c.LogCollector.Initialize(i)
+
+	c.LogCollectorGroup = &collectorgroup.Group{}
+	c.LogCollectorGroup.Initialize(i)
+
+	c.SdwanDevice = &device.Device{}
+	c.SdwanDevice.Initialize(i)
+
+	c.SdwanVpnCluster = &vpncluster.VpnCluster{}
+	c.SdwanVpnCluster.Initialize(i)
+
 	c.Template = &template.Template{}
 	c.Template.Initialize(i)
 
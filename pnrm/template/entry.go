@@ -35,6 +35,16 @@ func (o *Entry) Copy(s Entry) {
 	o.Devices = s.Devices
 }
 
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
 // SetConfTree sets the conf internal variable such that the XML contains
 // the mandatory "/config" subelement tree.
 //
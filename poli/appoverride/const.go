@@ -0,0 +1,12 @@
+package appoverride
+
+// Valid values for Protocol.
+const (
+	ProtocolTcp = "tcp"
+	ProtocolUdp = "udp"
+)
+
+const (
+	singular = "application override rule"
+	plural   = "application override rules"
+)
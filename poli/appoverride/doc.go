@@ -0,0 +1,9 @@
+/*
+Package appoverride is the client.Policies.AppOverrideRule namespace.
+
+Application override rules match traffic by zone, address, protocol, and port
+and assign it the given application, bypassing App-ID.
+
+Normalized object:  Entry
+*/
+package appoverride
@@ -0,0 +1,167 @@
+package appoverride
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an
+// application override rule.
+//
+// Port is a comma separated list of ports and / or port ranges.
+//
+// Targets is a map where the key is the serial number of the target device and
+// the value is a list of specific vsys on that device.  The list of vsys is
+// nil if all vsys on that device should be included or if the device is a
+// virtual firewall (and thus only has vsys1).
+type Entry struct {
+	Name                 string
+	Description          string
+	Tags                 []string // ordered
+	SourceZones          []string // unordered
+	SourceAddresses      []string // unordered
+	NegateSource         bool
+	DestinationZones     []string // unordered
+	DestinationAddresses []string // unordered
+	NegateDestination    bool
+	Protocol             string
+	Port                 string
+	Application          string
+	Disabled             bool
+	Targets              map[string][]string
+	NegateTarget         bool
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Description = s.Description
+	o.Tags = s.Tags
+	o.SourceZones = s.SourceZones
+	o.SourceAddresses = s.SourceAddresses
+	o.NegateSource = s.NegateSource
+	o.DestinationZones = s.DestinationZones
+	o.DestinationAddresses = s.DestinationAddresses
+	o.NegateDestination = s.NegateDestination
+	o.Protocol = s.Protocol
+	o.Port = s.Port
+	o.Application = s.Application
+	o.Disabled = s.Disabled
+	o.Targets = s.Targets
+	o.NegateTarget = s.NegateTarget
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this application override rule.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:                 o.Name,
+		Description:          o.Description,
+		Tags:                 util.MemToStr(o.Tags),
+		SourceZones:          util.MemToStr(o.SourceZones),
+		SourceAddresses:      util.MemToStr(o.SourceAddresses),
+		NegateSource:         util.AsBool(o.NegateSource),
+		DestinationZones:     util.MemToStr(o.DestinationZones),
+		DestinationAddresses: util.MemToStr(o.DestinationAddresses),
+		NegateDestination:    util.AsBool(o.NegateDestination),
+		Protocol:             o.Protocol,
+		Port:                 o.Port,
+		Application:          o.Application,
+		Disabled:             util.AsBool(o.Disabled),
+	}
+
+	if o.TargetInfo != nil {
+		ans.NegateTarget = util.AsBool(o.TargetInfo.NegateTarget)
+		ans.Targets = util.VsysEntToMap(o.TargetInfo.Targets)
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName              xml.Name         `xml:"entry"`
+	Name                 string           `xml:"name,attr"`
+	Description          string           `xml:"description,omitempty"`
+	Tags                 *util.MemberType `xml:"tag"`
+	SourceZones          *util.MemberType `xml:"from"`
+	DestinationZones     *util.MemberType `xml:"to"`
+	SourceAddresses      *util.MemberType `xml:"source"`
+	NegateSource         string           `xml:"negate-source"`
+	DestinationAddresses *util.MemberType `xml:"destination"`
+	NegateDestination    string           `xml:"negate-destination"`
+	Protocol             string           `xml:"protocol,omitempty"`
+	Port                 string           `xml:"port,omitempty"`
+	Application          string           `xml:"application,omitempty"`
+	Disabled             string           `xml:"disabled"`
+	TargetInfo           *targetInfo      `xml:"target"`
+}
+
+type targetInfo struct {
+	Targets      *util.VsysEntryType `xml:"devices"`
+	NegateTarget string              `xml:"negate,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:                 e.Name,
+		Description:          e.Description,
+		Tags:                 util.StrToMem(e.Tags),
+		SourceZones:          util.StrToMem(e.SourceZones),
+		DestinationZones:     util.StrToMem(e.DestinationZones),
+		SourceAddresses:      util.StrToMem(e.SourceAddresses),
+		NegateSource:         util.YesNo(e.NegateSource),
+		DestinationAddresses: util.StrToMem(e.DestinationAddresses),
+		NegateDestination:    util.YesNo(e.NegateDestination),
+		Protocol:             e.Protocol,
+		Port:                 e.Port,
+		Application:          e.Application,
+		Disabled:             util.YesNo(e.Disabled),
+	}
+
+	if e.Targets != nil || e.NegateTarget {
+		ans.TargetInfo = &targetInfo{
+			Targets:      util.MapToVsysEnt(e.Targets),
+			NegateTarget: util.YesNo(e.NegateTarget),
+		}
+	}
+
+	return ans
+}
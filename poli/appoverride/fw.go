@@ -0,0 +1,138 @@
+package appoverride
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwAppOverride is a namespace struct, included as part of pango.Firewall.
+type FwAppOverride struct {
+	ns *namespace.Standard[Entry]
+}
+
+// Initialize is invoked when Initialize on the pango.Firewall is called.
+func (c *FwAppOverride) Initialize(con util.XapiClient) {
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *FwAppOverride) GetList(vsys string) ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(vsys, nil))
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *FwAppOverride) ShowList(vsys string) ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(vsys, nil))
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *FwAppOverride) Get(vsys, name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath(vsys, []string{name}), name)
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *FwAppOverride) GetAll(vsys string) ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(vsys, nil))
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *FwAppOverride) Show(vsys, name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath(vsys, []string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *FwAppOverride) ShowAll(vsys string) ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(vsys, nil))
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *FwAppOverride) Set(vsys string, e ...Entry) error {
+	return c.ns.SetEntries(c.pather(vsys), e...)
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *FwAppOverride) Edit(vsys string, e Entry) error {
+	return c.ns.EditEntry(c.pather(vsys), e)
+}
+
+// Delete removes the given objects.
+//
+// Objects can be either a string or an Entry object.
+func (c *FwAppOverride) Delete(vsys string, e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(vsys), e...)
+}
+
+// MoveGroup moves a logical group of application override rules somewhere in relation
+// to another policy.
+//
+// The `movement` param should be one of the Move constants in the util
+// package.
+//
+// The `rule` param is the other rule the `movement` param is referencing.  If
+// this is an empty string, then the first policy in the group isn't moved
+// anywhere, but all other policies will still be moved to be grouped with the
+// first one.
+func (c *FwAppOverride) MoveGroup(vsys string, movement int, rule string, e ...Entry) error {
+	names := make([]string, 0, len(e))
+	for i := range e {
+		names = append(names, e[i].Name)
+	}
+
+	return c.Move(vsys, movement, rule, names...)
+}
+
+// Move moves the named application override rules somewhere in relation to another rule,
+// keeping them grouped together in the order given.
+func (c *FwAppOverride) Move(vsys string, movement int, rule string, names ...string) error {
+	return c.ns.MoveGroup(c.movePather(vsys), c.moveLister(vsys), movement, rule, names)
+}
+
+// Reorder moves application override rules so that the given rules are at the top of the
+// rulebase in the given order.
+//
+// Rules not named are left after the named rules, in their current relative
+// order.  Only rules that are out of place are moved.
+func (c *FwAppOverride) Reorder(vsys string, names ...string) error {
+	return c.ns.Reorder(c.movePather(vsys), c.moveLister(vsys), names)
+}
+
+func (c *FwAppOverride) movePather(vsys string) namespace.MovePather {
+	return func(v string) []string {
+		return c.xpath(vsys, []string{v})
+	}
+}
+
+func (c *FwAppOverride) moveLister(vsys string) namespace.MoveLister {
+	return func() ([]string, error) {
+		return c.GetList(vsys)
+	}
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *FwAppOverride) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *FwAppOverride) pather(vsys string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(vsys, v)
+	}
+}
+
+func (c *FwAppOverride) xpath(vsys string, vals []string) []string {
+	if vsys == "" {
+		vsys = "vsys1"
+	}
+
+	ans := make([]string, 0, 9)
+	ans = append(ans, util.VsysXpathPrefix(vsys)...)
+	ans = append(ans,
+		"rulebase",
+		"application-override",
+		"rules",
+		util.AsEntryXpath(vals),
+	)
+
+	return ans
+}
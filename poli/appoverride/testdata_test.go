@@ -0,0 +1,47 @@
+package appoverride
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"basic", Entry{
+			Name:                 "r1",
+			SourceZones:          []string{"trust"},
+			SourceAddresses:      []string{"any"},
+			DestinationZones:     []string{"untrust"},
+			DestinationAddresses: []string{"any"},
+			Protocol:             ProtocolTcp,
+			Port:                 "8080",
+			Application:          "web-browsing",
+		}},
+		{"full", Entry{
+			Name:                 "r2",
+			Description:          "custom app",
+			Tags:                 []string{"t1", "t2"},
+			SourceZones:          []string{"trust"},
+			SourceAddresses:      []string{"10.1.1.0/24"},
+			NegateSource:         true,
+			DestinationZones:     []string{"dmz"},
+			DestinationAddresses: []string{"10.2.1.5"},
+			NegateDestination:    true,
+			Protocol:             ProtocolUdp,
+			Port:                 "5000-5010,6000",
+			Application:          "my-app",
+			Disabled:             true,
+		}},
+		{"targets", Entry{
+			Name:        "r3",
+			Protocol:    ProtocolTcp,
+			Port:        "443",
+			Application: "ssl",
+			Targets: map[string][]string{
+				"fw1": nil,
+				"fw2": {"vsys2"},
+			},
+			NegateTarget: true,
+		}},
+	}
+}
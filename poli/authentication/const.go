@@ -0,0 +1,6 @@
+package authentication
+
+const (
+	singular = "authentication rule"
+	plural   = "authentication rules"
+)
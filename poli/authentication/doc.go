@@ -0,0 +1,10 @@
+/*
+Package authentication is the client.Policies.AuthenticationRule namespace.
+
+Authentication rules match traffic and require the user to authenticate
+using the given authentication enforcement object before the traffic is
+allowed.
+
+Normalized object:  Entry
+*/
+package authentication
@@ -0,0 +1,312 @@
+package authentication
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an
+// authentication policy rule.
+//
+// Timeout is the number of minutes before the user must authenticate again.
+//
+// Targets is a map where the key is the serial number of the target device and
+// the value is a list of specific vsys on that device.  The list of vsys is
+// nil if all vsys on that device should be included or if the device is a
+// virtual firewall (and thus only has vsys1).
+//
+// SourceDevices and DestinationDevices are the device objects (device-id)
+// that the rule matches, and are only used on PAN-OS 10.0+.
+type Entry struct {
+	Name                      string
+	Description               string
+	Tags                      []string // ordered
+	SourceZones               []string // unordered
+	SourceAddresses           []string // unordered
+	NegateSource              bool
+	SourceUsers               []string // unordered
+	HipProfiles               []string // unordered
+	DestinationZones          []string // unordered
+	DestinationAddresses      []string // unordered
+	NegateDestination         bool
+	Services                  []string // unordered
+	Categories                []string // unordered
+	AuthenticationEnforcement string
+	Timeout                   int
+	LogAuthenticationTimeout  bool
+	LogSetting                string
+	Disabled                  bool
+	Targets                   map[string][]string
+	NegateTarget              bool
+	SourceDevices             []string // unordered, 10.0+
+	DestinationDevices        []string // unordered, 10.0+
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Description = s.Description
+	o.Tags = s.Tags
+	o.SourceZones = s.SourceZones
+	o.SourceAddresses = s.SourceAddresses
+	o.NegateSource = s.NegateSource
+	o.SourceUsers = s.SourceUsers
+	o.HipProfiles = s.HipProfiles
+	o.DestinationZones = s.DestinationZones
+	o.DestinationAddresses = s.DestinationAddresses
+	o.NegateDestination = s.NegateDestination
+	o.Services = s.Services
+	o.Categories = s.Categories
+	o.AuthenticationEnforcement = s.AuthenticationEnforcement
+	o.Timeout = s.Timeout
+	o.LogAuthenticationTimeout = s.LogAuthenticationTimeout
+	o.LogSetting = s.LogSetting
+	o.Disabled = s.Disabled
+	o.Targets = s.Targets
+	o.NegateTarget = s.NegateTarget
+	o.SourceDevices = s.SourceDevices
+	o.DestinationDevices = s.DestinationDevices
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this authentication rule.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:                      o.Name,
+		Description:               o.Description,
+		Tags:                      util.MemToStr(o.Tags),
+		SourceZones:               util.MemToStr(o.SourceZones),
+		SourceAddresses:           util.MemToStr(o.SourceAddresses),
+		NegateSource:              util.AsBool(o.NegateSource),
+		SourceUsers:               util.MemToStr(o.SourceUsers),
+		HipProfiles:               util.MemToStr(o.HipProfiles),
+		DestinationZones:          util.MemToStr(o.DestinationZones),
+		DestinationAddresses:      util.MemToStr(o.DestinationAddresses),
+		NegateDestination:         util.AsBool(o.NegateDestination),
+		Services:                  util.MemToStr(o.Services),
+		Categories:                util.MemToStr(o.Categories),
+		AuthenticationEnforcement: o.AuthenticationEnforcement,
+		Timeout:                   o.Timeout,
+		LogAuthenticationTimeout:  util.AsBool(o.LogAuthenticationTimeout),
+		LogSetting:                o.LogSetting,
+		Disabled:                  util.AsBool(o.Disabled),
+	}
+
+	if o.TargetInfo != nil {
+		ans.NegateTarget = util.AsBool(o.TargetInfo.NegateTarget)
+		ans.Targets = util.VsysEntToMap(o.TargetInfo.Targets)
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName                   xml.Name         `xml:"entry"`
+	Name                      string           `xml:"name,attr"`
+	Description               string           `xml:"description,omitempty"`
+	Tags                      *util.MemberType `xml:"tag"`
+	SourceZones               *util.MemberType `xml:"from"`
+	DestinationZones          *util.MemberType `xml:"to"`
+	SourceAddresses           *util.MemberType `xml:"source"`
+	NegateSource              string           `xml:"negate-source"`
+	SourceUsers               *util.MemberType `xml:"source-user"`
+	HipProfiles               *util.MemberType `xml:"hip-profiles"`
+	DestinationAddresses      *util.MemberType `xml:"destination"`
+	NegateDestination         string           `xml:"negate-destination"`
+	Services                  *util.MemberType `xml:"service"`
+	Categories                *util.MemberType `xml:"category"`
+	AuthenticationEnforcement string           `xml:"authentication-enforcement,omitempty"`
+	Timeout                   int              `xml:"timeout,omitempty"`
+	LogAuthenticationTimeout  string           `xml:"log-authentication-timeout"`
+	LogSetting                string           `xml:"log-setting,omitempty"`
+	Disabled                  string           `xml:"disabled"`
+	TargetInfo                *targetInfo      `xml:"target"`
+}
+
+type targetInfo struct {
+	Targets      *util.VsysEntryType `xml:"devices"`
+	NegateTarget string              `xml:"negate,omitempty"`
+}
+
+func specifyTarget(e Entry) *targetInfo {
+	if e.Targets == nil && !e.NegateTarget {
+		return nil
+	}
+
+	return &targetInfo{
+		Targets:      util.MapToVsysEnt(e.Targets),
+		NegateTarget: util.YesNo(e.NegateTarget),
+	}
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:                      e.Name,
+		Description:               e.Description,
+		Tags:                      util.StrToMem(e.Tags),
+		SourceZones:               util.StrToMem(e.SourceZones),
+		DestinationZones:          util.StrToMem(e.DestinationZones),
+		SourceAddresses:           util.StrToMem(e.SourceAddresses),
+		NegateSource:              util.YesNo(e.NegateSource),
+		SourceUsers:               util.StrToMem(e.SourceUsers),
+		HipProfiles:               util.StrToMem(e.HipProfiles),
+		DestinationAddresses:      util.StrToMem(e.DestinationAddresses),
+		NegateDestination:         util.YesNo(e.NegateDestination),
+		Services:                  util.StrToMem(e.Services),
+		Categories:                util.StrToMem(e.Categories),
+		AuthenticationEnforcement: e.AuthenticationEnforcement,
+		Timeout:                   e.Timeout,
+		LogAuthenticationTimeout:  util.YesNo(e.LogAuthenticationTimeout),
+		LogSetting:                e.LogSetting,
+		Disabled:                  util.YesNo(e.Disabled),
+		TargetInfo:                specifyTarget(e),
+	}
+
+	return ans
+}
+
+// PAN-OS 10.0, adds source-hip and destination-hip.
+type container_v2 struct {
+	Answer []entry_v2 `xml:"entry"`
+}
+
+func (o *container_v2) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v2) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v2) normalize() Entry {
+	ans := Entry{
+		Name:                      o.Name,
+		Description:               o.Description,
+		Tags:                      util.MemToStr(o.Tags),
+		SourceZones:               util.MemToStr(o.SourceZones),
+		SourceAddresses:           util.MemToStr(o.SourceAddresses),
+		NegateSource:              util.AsBool(o.NegateSource),
+		SourceUsers:               util.MemToStr(o.SourceUsers),
+		HipProfiles:               util.MemToStr(o.HipProfiles),
+		DestinationZones:          util.MemToStr(o.DestinationZones),
+		DestinationAddresses:      util.MemToStr(o.DestinationAddresses),
+		NegateDestination:         util.AsBool(o.NegateDestination),
+		Services:                  util.MemToStr(o.Services),
+		Categories:                util.MemToStr(o.Categories),
+		AuthenticationEnforcement: o.AuthenticationEnforcement,
+		Timeout:                   o.Timeout,
+		LogAuthenticationTimeout:  util.AsBool(o.LogAuthenticationTimeout),
+		LogSetting:                o.LogSetting,
+		Disabled:                  util.AsBool(o.Disabled),
+		SourceDevices:             util.MemToStr(o.SourceDevices),
+		DestinationDevices:        util.MemToStr(o.DestinationDevices),
+	}
+
+	if o.TargetInfo != nil {
+		ans.NegateTarget = util.AsBool(o.TargetInfo.NegateTarget)
+		ans.Targets = util.VsysEntToMap(o.TargetInfo.Targets)
+	}
+
+	return ans
+}
+
+type entry_v2 struct {
+	XMLName                   xml.Name         `xml:"entry"`
+	Name                      string           `xml:"name,attr"`
+	Description               string           `xml:"description,omitempty"`
+	Tags                      *util.MemberType `xml:"tag"`
+	SourceZones               *util.MemberType `xml:"from"`
+	DestinationZones          *util.MemberType `xml:"to"`
+	SourceAddresses           *util.MemberType `xml:"source"`
+	NegateSource              string           `xml:"negate-source"`
+	SourceUsers               *util.MemberType `xml:"source-user"`
+	HipProfiles               *util.MemberType `xml:"hip-profiles"`
+	SourceDevices             *util.MemberType `xml:"source-hip"`
+	DestinationAddresses      *util.MemberType `xml:"destination"`
+	DestinationDevices        *util.MemberType `xml:"destination-hip"`
+	NegateDestination         string           `xml:"negate-destination"`
+	Services                  *util.MemberType `xml:"service"`
+	Categories                *util.MemberType `xml:"category"`
+	AuthenticationEnforcement string           `xml:"authentication-enforcement,omitempty"`
+	Timeout                   int              `xml:"timeout,omitempty"`
+	LogAuthenticationTimeout  string           `xml:"log-authentication-timeout"`
+	LogSetting                string           `xml:"log-setting,omitempty"`
+	Disabled                  string           `xml:"disabled"`
+	TargetInfo                *targetInfo      `xml:"target"`
+}
+
+func specify_v2(e Entry) interface{} {
+	ans := entry_v2{
+		Name:                      e.Name,
+		Description:               e.Description,
+		Tags:                      util.StrToMem(e.Tags),
+		SourceZones:               util.StrToMem(e.SourceZones),
+		DestinationZones:          util.StrToMem(e.DestinationZones),
+		SourceAddresses:           util.StrToMem(e.SourceAddresses),
+		NegateSource:              util.YesNo(e.NegateSource),
+		SourceUsers:               util.StrToMem(e.SourceUsers),
+		HipProfiles:               util.StrToMem(e.HipProfiles),
+		SourceDevices:             util.StrToMem(e.SourceDevices),
+		DestinationAddresses:      util.StrToMem(e.DestinationAddresses),
+		DestinationDevices:        util.StrToMem(e.DestinationDevices),
+		NegateDestination:         util.YesNo(e.NegateDestination),
+		Services:                  util.StrToMem(e.Services),
+		Categories:                util.StrToMem(e.Categories),
+		AuthenticationEnforcement: e.AuthenticationEnforcement,
+		Timeout:                   e.Timeout,
+		LogAuthenticationTimeout:  util.YesNo(e.LogAuthenticationTimeout),
+		LogSetting:                e.LogSetting,
+		Disabled:                  util.YesNo(e.Disabled),
+		TargetInfo:                specifyTarget(e),
+	}
+
+	return ans
+}
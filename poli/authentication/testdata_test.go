@@ -0,0 +1,62 @@
+package authentication
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type testCase struct {
+	desc    string
+	version version.Number
+	conf    Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"v1 basic", version.Number{9, 1, 0, ""}, Entry{
+			Name:                      "r1",
+			SourceZones:               []string{"trust"},
+			SourceAddresses:           []string{"any"},
+			SourceUsers:               []string{"unknown"},
+			HipProfiles:               []string{"any"},
+			DestinationZones:          []string{"untrust"},
+			DestinationAddresses:      []string{"any"},
+			Services:                  []string{"service-http", "service-https"},
+			Categories:                []string{"any"},
+			AuthenticationEnforcement: "default-web-form",
+			Timeout:                   60,
+		}},
+		{"v1 full", version.Number{9, 1, 0, ""}, Entry{
+			Name:                      "r2",
+			Description:               "mfa for servers",
+			Tags:                      []string{"t1", "t2"},
+			SourceZones:               []string{"trust"},
+			SourceAddresses:           []string{"10.1.1.0/24"},
+			NegateSource:              true,
+			SourceUsers:               []string{"any"},
+			DestinationZones:          []string{"dmz"},
+			DestinationAddresses:      []string{"10.2.1.0/24"},
+			NegateDestination:         true,
+			Services:                  []string{"service-https"},
+			AuthenticationEnforcement: "mfa",
+			Timeout:                   30,
+			LogAuthenticationTimeout:  true,
+			LogSetting:                "default",
+			Disabled:                  true,
+		}},
+		{"v1 targets", version.Number{9, 1, 0, ""}, Entry{
+			Name: "r3",
+			Targets: map[string][]string{
+				"fw1": nil,
+				"fw2": {"vsys2"},
+			},
+			NegateTarget: true,
+		}},
+		{"v2 devices", version.Number{10, 0, 0, ""}, Entry{
+			Name:                      "r1",
+			SourceZones:               []string{"trust"},
+			SourceDevices:             []string{"laptops"},
+			DestinationDevices:        []string{"any"},
+			AuthenticationEnforcement: "default-browser-challenge",
+		}},
+	}
+}
@@ -0,0 +1,19 @@
+package decryption
+
+// Valid values for Action.
+const (
+	ActionDecrypt   = "decrypt"
+	ActionNoDecrypt = "no-decrypt"
+)
+
+// Valid values for DecryptionType.
+const (
+	DecryptionTypeSslForwardProxy      = "ssl-forward-proxy"
+	DecryptionTypeSslInboundInspection = "ssl-inbound-inspection"
+	DecryptionTypeSshProxy             = "ssh-proxy"
+)
+
+const (
+	singular = "decryption rule"
+	plural   = "decryption rules"
+)
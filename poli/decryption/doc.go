@@ -0,0 +1,9 @@
+/*
+Package decryption is the client.Policies.DecryptionRule namespace.
+
+Decryption rules match traffic and decide whether it is decrypted, and if
+so, whether by SSL forward proxy, SSL inbound inspection, or SSH proxy.
+
+Normalized object:  Entry
+*/
+package decryption
@@ -0,0 +1,354 @@
+package decryption
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a decryption
+// policy rule.
+//
+// SslCertificate is the server certificate, and is only used when the
+// DecryptionType is SSL inbound inspection.
+//
+// Targets is a map where the key is the serial number of the target device and
+// the value is a list of specific vsys on that device.  The list of vsys is
+// nil if all vsys on that device should be included or if the device is a
+// virtual firewall (and thus only has vsys1).
+//
+// SourceDevices, DestinationDevices, the TLS handshake logging params, and
+// LogSetting are only used on PAN-OS 10.0+.
+type Entry struct {
+	Name                       string
+	Description                string
+	Tags                       []string // ordered
+	SourceZones                []string // unordered
+	SourceAddresses            []string // unordered
+	NegateSource               bool
+	SourceUsers                []string // unordered
+	DestinationZones           []string // unordered
+	DestinationAddresses       []string // unordered
+	NegateDestination          bool
+	Services                   []string // unordered
+	Categories                 []string // unordered
+	Action                     string
+	DecryptionType             string
+	SslCertificate             string
+	DecryptionProfile          string
+	Disabled                   bool
+	Targets                    map[string][]string
+	NegateTarget               bool
+	SourceDevices              []string // unordered, 10.0+
+	DestinationDevices         []string // unordered, 10.0+
+	LogSuccessfulTlsHandshakes bool
+	LogFailedTlsHandshakes     bool
+	LogSetting                 string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Description = s.Description
+	o.Tags = s.Tags
+	o.SourceZones = s.SourceZones
+	o.SourceAddresses = s.SourceAddresses
+	o.NegateSource = s.NegateSource
+	o.SourceUsers = s.SourceUsers
+	o.DestinationZones = s.DestinationZones
+	o.DestinationAddresses = s.DestinationAddresses
+	o.NegateDestination = s.NegateDestination
+	o.Services = s.Services
+	o.Categories = s.Categories
+	o.Action = s.Action
+	o.DecryptionType = s.DecryptionType
+	o.SslCertificate = s.SslCertificate
+	o.DecryptionProfile = s.DecryptionProfile
+	o.Disabled = s.Disabled
+	o.Targets = s.Targets
+	o.NegateTarget = s.NegateTarget
+	o.SourceDevices = s.SourceDevices
+	o.DestinationDevices = s.DestinationDevices
+	o.LogSuccessfulTlsHandshakes = s.LogSuccessfulTlsHandshakes
+	o.LogFailedTlsHandshakes = s.LogFailedTlsHandshakes
+	o.LogSetting = s.LogSetting
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this decryption rule.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:                 o.Name,
+		Description:          o.Description,
+		Tags:                 util.MemToStr(o.Tags),
+		SourceZones:          util.MemToStr(o.SourceZones),
+		SourceAddresses:      util.MemToStr(o.SourceAddresses),
+		NegateSource:         util.AsBool(o.NegateSource),
+		SourceUsers:          util.MemToStr(o.SourceUsers),
+		DestinationZones:     util.MemToStr(o.DestinationZones),
+		DestinationAddresses: util.MemToStr(o.DestinationAddresses),
+		NegateDestination:    util.AsBool(o.NegateDestination),
+		Services:             util.MemToStr(o.Services),
+		Categories:           util.MemToStr(o.Categories),
+		Action:               o.Action,
+		DecryptionProfile:    o.DecryptionProfile,
+		Disabled:             util.AsBool(o.Disabled),
+	}
+
+	o.Type.normalize(&ans)
+
+	if o.TargetInfo != nil {
+		ans.NegateTarget = util.AsBool(o.TargetInfo.NegateTarget)
+		ans.Targets = util.VsysEntToMap(o.TargetInfo.Targets)
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName              xml.Name         `xml:"entry"`
+	Name                 string           `xml:"name,attr"`
+	Description          string           `xml:"description,omitempty"`
+	Tags                 *util.MemberType `xml:"tag"`
+	SourceZones          *util.MemberType `xml:"from"`
+	DestinationZones     *util.MemberType `xml:"to"`
+	SourceAddresses      *util.MemberType `xml:"source"`
+	NegateSource         string           `xml:"negate-source"`
+	SourceUsers          *util.MemberType `xml:"source-user"`
+	DestinationAddresses *util.MemberType `xml:"destination"`
+	NegateDestination    string           `xml:"negate-destination"`
+	Services             *util.MemberType `xml:"service"`
+	Categories           *util.MemberType `xml:"category"`
+	Action               string           `xml:"action,omitempty"`
+	Type                 *decryptType     `xml:"type"`
+	DecryptionProfile    string           `xml:"profile,omitempty"`
+	Disabled             string           `xml:"disabled"`
+	TargetInfo           *targetInfo      `xml:"target"`
+}
+
+type decryptType struct {
+	SslForwardProxy      *string `xml:"ssl-forward-proxy"`
+	SslInboundInspection *string `xml:"ssl-inbound-inspection"`
+	SshProxy             *string `xml:"ssh-proxy"`
+}
+
+func (o *decryptType) normalize(e *Entry) {
+	if o == nil {
+		return
+	}
+
+	switch {
+	case o.SslForwardProxy != nil:
+		e.DecryptionType = DecryptionTypeSslForwardProxy
+	case o.SslInboundInspection != nil:
+		e.DecryptionType = DecryptionTypeSslInboundInspection
+		e.SslCertificate = *o.SslInboundInspection
+	case o.SshProxy != nil:
+		e.DecryptionType = DecryptionTypeSshProxy
+	}
+}
+
+func specifyType(e Entry) *decryptType {
+	s := ""
+
+	switch e.DecryptionType {
+	case DecryptionTypeSslForwardProxy:
+		return &decryptType{SslForwardProxy: &s}
+	case DecryptionTypeSslInboundInspection:
+		s = e.SslCertificate
+		return &decryptType{SslInboundInspection: &s}
+	case DecryptionTypeSshProxy:
+		return &decryptType{SshProxy: &s}
+	}
+
+	return nil
+}
+
+type targetInfo struct {
+	Targets      *util.VsysEntryType `xml:"devices"`
+	NegateTarget string              `xml:"negate,omitempty"`
+}
+
+func specifyTarget(e Entry) *targetInfo {
+	if e.Targets == nil && !e.NegateTarget {
+		return nil
+	}
+
+	return &targetInfo{
+		Targets:      util.MapToVsysEnt(e.Targets),
+		NegateTarget: util.YesNo(e.NegateTarget),
+	}
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:                 e.Name,
+		Description:          e.Description,
+		Tags:                 util.StrToMem(e.Tags),
+		SourceZones:          util.StrToMem(e.SourceZones),
+		DestinationZones:     util.StrToMem(e.DestinationZones),
+		SourceAddresses:      util.StrToMem(e.SourceAddresses),
+		NegateSource:         util.YesNo(e.NegateSource),
+		SourceUsers:          util.StrToMem(e.SourceUsers),
+		DestinationAddresses: util.StrToMem(e.DestinationAddresses),
+		NegateDestination:    util.YesNo(e.NegateDestination),
+		Services:             util.StrToMem(e.Services),
+		Categories:           util.StrToMem(e.Categories),
+		Action:               e.Action,
+		Type:                 specifyType(e),
+		DecryptionProfile:    e.DecryptionProfile,
+		Disabled:             util.YesNo(e.Disabled),
+		TargetInfo:           specifyTarget(e),
+	}
+
+	return ans
+}
+
+// PAN-OS 10.0, adds source-hip, destination-hip, and logging.
+type container_v2 struct {
+	Answer []entry_v2 `xml:"entry"`
+}
+
+func (o *container_v2) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v2) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v2) normalize() Entry {
+	ans := Entry{
+		Name:                       o.Name,
+		Description:                o.Description,
+		Tags:                       util.MemToStr(o.Tags),
+		SourceZones:                util.MemToStr(o.SourceZones),
+		SourceAddresses:            util.MemToStr(o.SourceAddresses),
+		NegateSource:               util.AsBool(o.NegateSource),
+		SourceUsers:                util.MemToStr(o.SourceUsers),
+		DestinationZones:           util.MemToStr(o.DestinationZones),
+		DestinationAddresses:       util.MemToStr(o.DestinationAddresses),
+		NegateDestination:          util.AsBool(o.NegateDestination),
+		Services:                   util.MemToStr(o.Services),
+		Categories:                 util.MemToStr(o.Categories),
+		Action:                     o.Action,
+		DecryptionProfile:          o.DecryptionProfile,
+		Disabled:                   util.AsBool(o.Disabled),
+		SourceDevices:              util.MemToStr(o.SourceDevices),
+		DestinationDevices:         util.MemToStr(o.DestinationDevices),
+		LogSuccessfulTlsHandshakes: util.AsBool(o.LogSuccessfulTlsHandshakes),
+		LogFailedTlsHandshakes:     util.AsBool(o.LogFailedTlsHandshakes),
+		LogSetting:                 o.LogSetting,
+	}
+
+	o.Type.normalize(&ans)
+
+	if o.TargetInfo != nil {
+		ans.NegateTarget = util.AsBool(o.TargetInfo.NegateTarget)
+		ans.Targets = util.VsysEntToMap(o.TargetInfo.Targets)
+	}
+
+	return ans
+}
+
+type entry_v2 struct {
+	XMLName                    xml.Name         `xml:"entry"`
+	Name                       string           `xml:"name,attr"`
+	Description                string           `xml:"description,omitempty"`
+	Tags                       *util.MemberType `xml:"tag"`
+	SourceZones                *util.MemberType `xml:"from"`
+	DestinationZones           *util.MemberType `xml:"to"`
+	SourceAddresses            *util.MemberType `xml:"source"`
+	NegateSource               string           `xml:"negate-source"`
+	SourceUsers                *util.MemberType `xml:"source-user"`
+	SourceDevices              *util.MemberType `xml:"source-hip"`
+	DestinationAddresses       *util.MemberType `xml:"destination"`
+	DestinationDevices         *util.MemberType `xml:"destination-hip"`
+	NegateDestination          string           `xml:"negate-destination"`
+	Services                   *util.MemberType `xml:"service"`
+	Categories                 *util.MemberType `xml:"category"`
+	Action                     string           `xml:"action,omitempty"`
+	Type                       *decryptType     `xml:"type"`
+	DecryptionProfile          string           `xml:"profile,omitempty"`
+	Disabled                   string           `xml:"disabled"`
+	LogSuccessfulTlsHandshakes string           `xml:"log-successful-tls-handshakes"`
+	LogFailedTlsHandshakes     string           `xml:"log-failed-tls-handshakes"`
+	LogSetting                 string           `xml:"log-setting,omitempty"`
+	TargetInfo                 *targetInfo      `xml:"target"`
+}
+
+func specify_v2(e Entry) interface{} {
+	ans := entry_v2{
+		Name:                       e.Name,
+		Description:                e.Description,
+		Tags:                       util.StrToMem(e.Tags),
+		SourceZones:                util.StrToMem(e.SourceZones),
+		DestinationZones:           util.StrToMem(e.DestinationZones),
+		SourceAddresses:            util.StrToMem(e.SourceAddresses),
+		NegateSource:               util.YesNo(e.NegateSource),
+		SourceUsers:                util.StrToMem(e.SourceUsers),
+		SourceDevices:              util.StrToMem(e.SourceDevices),
+		DestinationAddresses:       util.StrToMem(e.DestinationAddresses),
+		DestinationDevices:         util.StrToMem(e.DestinationDevices),
+		NegateDestination:          util.YesNo(e.NegateDestination),
+		Services:                   util.StrToMem(e.Services),
+		Categories:                 util.StrToMem(e.Categories),
+		Action:                     e.Action,
+		Type:                       specifyType(e),
+		DecryptionProfile:          e.DecryptionProfile,
+		Disabled:                   util.YesNo(e.Disabled),
+		LogSuccessfulTlsHandshakes: util.YesNo(e.LogSuccessfulTlsHandshakes),
+		LogFailedTlsHandshakes:     util.YesNo(e.LogFailedTlsHandshakes),
+		LogSetting:                 e.LogSetting,
+		TargetInfo:                 specifyTarget(e),
+	}
+
+	return ans
+}
@@ -0,0 +1,150 @@
+package decryption
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+// PanoDecryption is a namespace struct, included as part of pango.Panorama.
+type PanoDecryption struct {
+	con util.XapiClient
+	ns  *namespace.Standard[Entry]
+}
+
+// Initialize is invoked when Initialize on the pango.Panorama is called.
+func (c *PanoDecryption) Initialize(con util.XapiClient) {
+	c.con = con
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *PanoDecryption) GetList(dg, base string) ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(dg, base, nil))
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *PanoDecryption) ShowList(dg, base string) ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(dg, base, nil))
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *PanoDecryption) Get(dg, base, name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath(dg, base, []string{name}), name)
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *PanoDecryption) GetAll(dg, base string) ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(dg, base, nil))
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *PanoDecryption) Show(dg, base, name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath(dg, base, []string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *PanoDecryption) ShowAll(dg, base string) ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(dg, base, nil))
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *PanoDecryption) Set(dg, base string, e ...Entry) error {
+	return c.ns.SetEntries(c.pather(dg, base), e...)
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *PanoDecryption) Edit(dg, base string, e Entry) error {
+	return c.ns.EditEntry(c.pather(dg, base), e)
+}
+
+// Delete removes the given objects.
+//
+// Objects can be either a string or an Entry object.
+func (c *PanoDecryption) Delete(dg, base string, e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(dg, base), e...)
+}
+
+// MoveGroup moves a logical group of decryption rules somewhere in relation
+// to another policy.
+//
+// The `movement` param should be one of the Move constants in the util
+// package.
+//
+// The `rule` param is the other rule the `movement` param is referencing.  If
+// this is an empty string, then the first policy in the group isn't moved
+// anywhere, but all other policies will still be moved to be grouped with the
+// first one.
+func (c *PanoDecryption) MoveGroup(dg, base string, movement int, rule string, e ...Entry) error {
+	names := make([]string, 0, len(e))
+	for i := range e {
+		names = append(names, e[i].Name)
+	}
+
+	return c.Move(dg, base, movement, rule, names...)
+}
+
+// Move moves the named decryption rules somewhere in relation to another rule,
+// keeping them grouped together in the order given.
+func (c *PanoDecryption) Move(dg, base string, movement int, rule string, names ...string) error {
+	return c.ns.MoveGroup(c.movePather(dg, base), c.moveLister(dg, base), movement, rule, names)
+}
+
+// Reorder moves decryption rules so that the given rules are at the top of the
+// rulebase in the given order.
+//
+// Rules not named are left after the named rules, in their current relative
+// order.  Only rules that are out of place are moved.
+func (c *PanoDecryption) Reorder(dg, base string, names ...string) error {
+	return c.ns.Reorder(c.movePather(dg, base), c.moveLister(dg, base), names)
+}
+
+func (c *PanoDecryption) movePather(dg, base string) namespace.MovePather {
+	return func(v string) []string {
+		return c.xpath(dg, base, []string{v})
+	}
+}
+
+func (c *PanoDecryption) moveLister(dg, base string) namespace.MoveLister {
+	return func() ([]string, error) {
+		return c.GetList(dg, base)
+	}
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *PanoDecryption) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	v := c.con.Versioning()
+
+	if v.Gte(version.Number{10, 0, 0, ""}) {
+		return &container_v2{}, specify_v2
+	} else {
+		return &container_v1{}, specify_v1
+	}
+}
+
+func (c *PanoDecryption) pather(dg, base string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(dg, base, v)
+	}
+}
+
+func (c *PanoDecryption) xpath(dg, base string, vals []string) []string {
+	if dg == "" {
+		dg = "shared"
+	}
+	if base == "" {
+		base = util.PreRulebase
+	}
+
+	ans := make([]string, 0, 9)
+	ans = append(ans, util.DeviceGroupXpathPrefix(dg)...)
+	ans = append(ans,
+		base,
+		"decryption",
+		"rules",
+		util.AsEntryXpath(vals),
+	)
+
+	return ans
+}
@@ -0,0 +1,71 @@
+package decryption
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type testCase struct {
+	desc    string
+	version version.Number
+	conf    Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"v1 forward proxy", version.Number{9, 1, 0, ""}, Entry{
+			Name:                 "r1",
+			SourceZones:          []string{"trust"},
+			SourceAddresses:      []string{"any"},
+			SourceUsers:          []string{"any"},
+			DestinationZones:     []string{"untrust"},
+			DestinationAddresses: []string{"any"},
+			Services:             []string{"service-https"},
+			Categories:           []string{"any"},
+			Action:               ActionDecrypt,
+			DecryptionType:       DecryptionTypeSslForwardProxy,
+			DecryptionProfile:    "default",
+		}},
+		{"v1 inbound inspection", version.Number{9, 1, 0, ""}, Entry{
+			Name:                 "r2",
+			Description:          "web servers",
+			Tags:                 []string{"t1", "t2"},
+			SourceZones:          []string{"untrust"},
+			SourceAddresses:      []string{"any"},
+			NegateSource:         true,
+			DestinationZones:     []string{"dmz"},
+			DestinationAddresses: []string{"10.1.1.0/24"},
+			NegateDestination:    true,
+			Action:               ActionDecrypt,
+			DecryptionType:       DecryptionTypeSslInboundInspection,
+			SslCertificate:       "web-cert",
+			Disabled:             true,
+		}},
+		{"v1 no decrypt with targets", version.Number{9, 1, 0, ""}, Entry{
+			Name:           "r3",
+			Categories:     []string{"financial-services", "health-and-medicine"},
+			Action:         ActionNoDecrypt,
+			DecryptionType: DecryptionTypeSslForwardProxy,
+			Targets: map[string][]string{
+				"fw1": nil,
+				"fw2": {"vsys2"},
+			},
+			NegateTarget: true,
+		}},
+		{"v2 ssh proxy", version.Number{10, 0, 0, ""}, Entry{
+			Name:           "r1",
+			Action:         ActionDecrypt,
+			DecryptionType: DecryptionTypeSshProxy,
+		}},
+		{"v2 devices and logging", version.Number{10, 0, 0, ""}, Entry{
+			Name:                       "r2",
+			SourceZones:                []string{"trust"},
+			SourceDevices:              []string{"laptops"},
+			DestinationDevices:         []string{"any"},
+			Action:                     ActionDecrypt,
+			DecryptionType:             DecryptionTypeSslForwardProxy,
+			LogSuccessfulTlsHandshakes: true,
+			LogFailedTlsHandshakes:     true,
+			LogSetting:                 "default",
+		}},
+	}
+}
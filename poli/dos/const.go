@@ -0,0 +1,20 @@
+package dos
+
+// Valid values for Action.
+const (
+	ActionDeny    = "deny"
+	ActionAllow   = "allow"
+	ActionProtect = "protect"
+)
+
+// Valid values for ClassificationCriteria.
+const (
+	CriteriaSourceIpOnly      = "source-ip-only"
+	CriteriaDestinationIpOnly = "destination-ip-only"
+	CriteriaSourceDestination = "src-dest-ip-both"
+)
+
+const (
+	singular = "dos rule"
+	plural   = "dos rules"
+)
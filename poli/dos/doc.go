@@ -0,0 +1,9 @@
+/*
+Package dos is the client.Policies.DosRule namespace.
+
+DoS protection rules match traffic by zone or interface and apply aggregate
+and / or classified DoS protection profiles to it.
+
+Normalized object:  Entry
+*/
+package dos
@@ -0,0 +1,305 @@
+package dos
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a DoS
+// protection rule.
+//
+// The source and destination of a DoS rule is either a zone or an interface,
+// so only one of SourceZones / SourceInterfaces and DestinationZones /
+// DestinationInterfaces should be specified.
+//
+// AggregateProfile and ClassifiedProfile are only used when Action is
+// "protect".  ClassifiedProfile requires ClassificationCriteria.
+//
+// Targets is a map where the key is the serial number of the target device and
+// the value is a list of specific vsys on that device.  The list of vsys is
+// nil if all vsys on that device should be included or if the device is a
+// virtual firewall (and thus only has vsys1).
+type Entry struct {
+	Name                   string
+	Description            string
+	Tags                   []string // ordered
+	SourceZones            []string // unordered
+	SourceInterfaces       []string // unordered
+	SourceAddresses        []string // unordered
+	NegateSource           bool
+	SourceUsers            []string // unordered
+	DestinationZones       []string // unordered
+	DestinationInterfaces  []string // unordered
+	DestinationAddresses   []string // unordered
+	NegateDestination      bool
+	Services               []string // unordered
+	Action                 string
+	Schedule               string
+	LogSetting             string
+	AggregateProfile       string
+	ClassifiedProfile      string
+	ClassificationCriteria string
+	Disabled               bool
+	Targets                map[string][]string
+	NegateTarget           bool
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Description = s.Description
+	o.Tags = s.Tags
+	o.SourceZones = s.SourceZones
+	o.SourceInterfaces = s.SourceInterfaces
+	o.SourceAddresses = s.SourceAddresses
+	o.NegateSource = s.NegateSource
+	o.SourceUsers = s.SourceUsers
+	o.DestinationZones = s.DestinationZones
+	o.DestinationInterfaces = s.DestinationInterfaces
+	o.DestinationAddresses = s.DestinationAddresses
+	o.NegateDestination = s.NegateDestination
+	o.Services = s.Services
+	o.Action = s.Action
+	o.Schedule = s.Schedule
+	o.LogSetting = s.LogSetting
+	o.AggregateProfile = s.AggregateProfile
+	o.ClassifiedProfile = s.ClassifiedProfile
+	o.ClassificationCriteria = s.ClassificationCriteria
+	o.Disabled = s.Disabled
+	o.Targets = s.Targets
+	o.NegateTarget = s.NegateTarget
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this DoS rule.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:                 o.Name,
+		Description:          o.Description,
+		Tags:                 util.MemToStr(o.Tags),
+		SourceAddresses:      util.MemToStr(o.SourceAddresses),
+		NegateSource:         util.AsBool(o.NegateSource),
+		SourceUsers:          util.MemToStr(o.SourceUsers),
+		DestinationAddresses: util.MemToStr(o.DestinationAddresses),
+		NegateDestination:    util.AsBool(o.NegateDestination),
+		Services:             util.MemToStr(o.Services),
+		Schedule:             o.Schedule,
+		LogSetting:           o.LogSetting,
+		Disabled:             util.AsBool(o.Disabled),
+	}
+
+	if o.From != nil {
+		ans.SourceZones = util.MemToStr(o.From.Zones)
+		ans.SourceInterfaces = util.MemToStr(o.From.Interfaces)
+	}
+
+	if o.To != nil {
+		ans.DestinationZones = util.MemToStr(o.To.Zones)
+		ans.DestinationInterfaces = util.MemToStr(o.To.Interfaces)
+	}
+
+	if o.Action != nil {
+		switch {
+		case o.Action.Deny != nil:
+			ans.Action = ActionDeny
+		case o.Action.Allow != nil:
+			ans.Action = ActionAllow
+		case o.Action.Protect != nil:
+			ans.Action = ActionProtect
+		}
+	}
+
+	if o.Protection != nil {
+		if o.Protection.Aggregate != nil {
+			ans.AggregateProfile = o.Protection.Aggregate.Profile
+		}
+		if o.Protection.Classified != nil {
+			ans.ClassifiedProfile = o.Protection.Classified.Profile
+			if o.Protection.Classified.Criteria != nil {
+				ans.ClassificationCriteria = o.Protection.Classified.Criteria.Address
+			}
+		}
+	}
+
+	if o.TargetInfo != nil {
+		ans.NegateTarget = util.AsBool(o.TargetInfo.NegateTarget)
+		ans.Targets = util.VsysEntToMap(o.TargetInfo.Targets)
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName              xml.Name         `xml:"entry"`
+	Name                 string           `xml:"name,attr"`
+	Description          string           `xml:"description,omitempty"`
+	Tags                 *util.MemberType `xml:"tag"`
+	From                 *zoneOrIface     `xml:"from"`
+	To                   *zoneOrIface     `xml:"to"`
+	SourceAddresses      *util.MemberType `xml:"source"`
+	NegateSource         string           `xml:"negate-source"`
+	SourceUsers          *util.MemberType `xml:"source-user"`
+	DestinationAddresses *util.MemberType `xml:"destination"`
+	NegateDestination    string           `xml:"negate-destination"`
+	Services             *util.MemberType `xml:"service"`
+	Action               *action          `xml:"action"`
+	Schedule             string           `xml:"schedule,omitempty"`
+	LogSetting           string           `xml:"log-setting,omitempty"`
+	Protection           *protection      `xml:"protection"`
+	Disabled             string           `xml:"disabled"`
+	TargetInfo           *targetInfo      `xml:"target"`
+}
+
+type zoneOrIface struct {
+	Zones      *util.MemberType `xml:"zone"`
+	Interfaces *util.MemberType `xml:"interface"`
+}
+
+func specifyZoneOrIface(zones, ifaces []string) *zoneOrIface {
+	if len(zones) == 0 && len(ifaces) == 0 {
+		return nil
+	}
+
+	return &zoneOrIface{
+		Zones:      util.StrToMem(zones),
+		Interfaces: util.StrToMem(ifaces),
+	}
+}
+
+type action struct {
+	Deny    *string `xml:"deny"`
+	Allow   *string `xml:"allow"`
+	Protect *string `xml:"protect"`
+}
+
+func specifyAction(e Entry) *action {
+	s := ""
+
+	switch e.Action {
+	case ActionDeny:
+		return &action{Deny: &s}
+	case ActionAllow:
+		return &action{Allow: &s}
+	case ActionProtect:
+		return &action{Protect: &s}
+	}
+
+	return nil
+}
+
+type protection struct {
+	Aggregate  *aggregate  `xml:"aggregate"`
+	Classified *classified `xml:"classified"`
+}
+
+type aggregate struct {
+	Profile string `xml:"profile"`
+}
+
+type classified struct {
+	Profile  string    `xml:"profile"`
+	Criteria *criteria `xml:"classification-criteria"`
+}
+
+type criteria struct {
+	Address string `xml:"address"`
+}
+
+func specifyProtection(e Entry) *protection {
+	if e.AggregateProfile == "" && e.ClassifiedProfile == "" {
+		return nil
+	}
+
+	ans := &protection{}
+
+	if e.AggregateProfile != "" {
+		ans.Aggregate = &aggregate{Profile: e.AggregateProfile}
+	}
+
+	if e.ClassifiedProfile != "" {
+		ans.Classified = &classified{Profile: e.ClassifiedProfile}
+		if e.ClassificationCriteria != "" {
+			ans.Classified.Criteria = &criteria{Address: e.ClassificationCriteria}
+		}
+	}
+
+	return ans
+}
+
+type targetInfo struct {
+	Targets      *util.VsysEntryType `xml:"devices"`
+	NegateTarget string              `xml:"negate,omitempty"`
+}
+
+func specifyTarget(e Entry) *targetInfo {
+	if e.Targets == nil && !e.NegateTarget {
+		return nil
+	}
+
+	return &targetInfo{
+		Targets:      util.MapToVsysEnt(e.Targets),
+		NegateTarget: util.YesNo(e.NegateTarget),
+	}
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:                 e.Name,
+		Description:          e.Description,
+		Tags:                 util.StrToMem(e.Tags),
+		From:                 specifyZoneOrIface(e.SourceZones, e.SourceInterfaces),
+		To:                   specifyZoneOrIface(e.DestinationZones, e.DestinationInterfaces),
+		SourceAddresses:      util.StrToMem(e.SourceAddresses),
+		NegateSource:         util.YesNo(e.NegateSource),
+		SourceUsers:          util.StrToMem(e.SourceUsers),
+		DestinationAddresses: util.StrToMem(e.DestinationAddresses),
+		NegateDestination:    util.YesNo(e.NegateDestination),
+		Services:             util.StrToMem(e.Services),
+		Action:               specifyAction(e),
+		Schedule:             e.Schedule,
+		LogSetting:           e.LogSetting,
+		Protection:           specifyProtection(e),
+		Disabled:             util.YesNo(e.Disabled),
+		TargetInfo:           specifyTarget(e),
+	}
+
+	return ans
+}
@@ -0,0 +1,52 @@
+package dos
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"basic", Entry{
+			Name:                 "r1",
+			SourceZones:          []string{"untrust"},
+			SourceAddresses:      []string{"any"},
+			SourceUsers:          []string{"any"},
+			DestinationZones:     []string{"trust"},
+			DestinationAddresses: []string{"any"},
+			Services:             []string{"any"},
+			Action:               ActionDeny,
+		}},
+		{"interfaces protect", Entry{
+			Name:                   "r2",
+			Description:            "protect web servers",
+			Tags:                   []string{"t1", "t2"},
+			SourceInterfaces:       []string{"ethernet1/1"},
+			SourceAddresses:        []string{"10.1.1.0/24"},
+			NegateSource:           true,
+			DestinationInterfaces:  []string{"ethernet1/2"},
+			DestinationAddresses:   []string{"10.2.1.0/24"},
+			NegateDestination:      true,
+			Services:               []string{"service-http"},
+			Action:                 ActionProtect,
+			Schedule:               "weekdays",
+			LogSetting:             "default",
+			AggregateProfile:       "agg",
+			ClassifiedProfile:      "cls",
+			ClassificationCriteria: CriteriaSourceDestination,
+			Disabled:               true,
+		}},
+		{"targets", Entry{
+			Name:             "r3",
+			SourceZones:      []string{"untrust"},
+			DestinationZones: []string{"trust"},
+			Action:           ActionProtect,
+			AggregateProfile: "agg",
+			Targets: map[string][]string{
+				"fw1": nil,
+				"fw2": {"vsys2"},
+			},
+			NegateTarget: true,
+		}},
+	}
+}
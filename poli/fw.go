@@ -3,26 +3,71 @@ package poli
 import (
 	"github.com/PaloAltoNetworks/pango/util"
 
+	"github.com/PaloAltoNetworks/pango/poli/appoverride"
+	"github.com/PaloAltoNetworks/pango/poli/authentication"
+	"github.com/PaloAltoNetworks/pango/poli/decryption"
+	"github.com/PaloAltoNetworks/pango/poli/dos"
+	"github.com/PaloAltoNetworks/pango/poli/hitcount"
 	"github.com/PaloAltoNetworks/pango/poli/nat"
 	"github.com/PaloAltoNetworks/pango/poli/pbf"
+	"github.com/PaloAltoNetworks/pango/poli/qos"
+	"github.com/PaloAltoNetworks/pango/poli/sdwan"
 	"github.com/PaloAltoNetworks/pango/poli/security"
+	"github.com/PaloAltoNetworks/pango/poli/security/defaultrule"
+	"github.com/PaloAltoNetworks/pango/poli/tunnelinspect"
 )
 
 // Poli is the client.Policies namespace.
 type FwPoli struct {
+	AppOverrideRule       *appoverride.FwAppOverride
+	AuthenticationRule    *authentication.FwAuth
+	DecryptionRule        *decryption.FwDecryption
+	DosRule               *dos.FwDos
+	DefaultSecurityRule   *defaultrule.FwDefaultRule
+	HitCount              *hitcount.FwHitCount
 	Nat                   *nat.FwNat
 	PolicyBasedForwarding *pbf.FwPbf
+	QosRule               *qos.FwQos
+	SdwanRule             *sdwan.FwSdwan
 	Security              *security.FwSecurity
+	TunnelInspectionRule  *tunnelinspect.FwTunnelInspect
 }
 
 // Initialize is invoked on client.Initialize().
 func (c *FwPoli) Initialize(i util.XapiClient) {
+	c.AppOverrideRule = &appoverride.FwAppOverride{}
+	c.AppOverrideRule.Initialize(i)
+
+	c.AuthenticationRule = &authentication.FwAuth{}
+	c.AuthenticationRule.Initialize(i)
+
+	c.DecryptionRule = &decryption.FwDecryption{}
+	c.DecryptionRule.Initialize(i)
+
+	c.DosRule = &dos.FwDos{}
+	c.DosRule.Initialize(i)
+
+	c.DefaultSecurityRule = &defaultrule.FwDefaultRule{}
+	c.DefaultSecurityRule.Initialize(i)
+
+	c.HitCount = &hitcount.FwHitCount{}
+	c.HitCount.Initialize(i)
+
 	c.Nat = &nat.FwNat{}
 	c.Nat.Initialize(i)
 
 	c.PolicyBasedForwarding = &pbf.FwPbf{}
 	c.PolicyBasedForwarding.Initialize(i)
 
+	c.QosRule = &qos.FwQos{}
+	c.QosRule.Initialize(i)
+
+	c.SdwanRule = &sdwan.FwSdwan{}
+	c.SdwanRule.Initialize(i)
+
 	c.Security = &security.FwSecurity{}
 	c.Security.Initialize(i)
+
+	c.TunnelInspectionRule = &tunnelinspect.FwTunnelInspect{}
+	c.TunnelInspectionRule.Initialize(i)
 }
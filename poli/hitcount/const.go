@@ -0,0 +1,15 @@
+package hitcount
+
+// Valid rulebase values.
+const (
+	Security              = "security"
+	Nat                   = "nat"
+	Qos                   = "qos"
+	PolicyBasedForwarding = "pbf"
+	Decryption            = "decryption"
+	TunnelInspection      = "tunnel-inspect"
+	ApplicationOverride   = "application-override"
+	Authentication        = "authentication"
+	Dos                   = "dos"
+	Sdwan                 = "sdwan"
+)
@@ -0,0 +1,8 @@
+/*
+Package hitcount is the client.Policies.HitCount namespace.
+
+Rule hit counters track how many times each policy rule has been matched,
+which is useful for finding unused rules during policy reviews.  Hit counters are maintained by the firewall itself, so
+this namespace is only present on firewall clients.
+*/
+package hitcount
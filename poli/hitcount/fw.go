@@ -0,0 +1,73 @@
+package hitcount
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwHitCount is the client.Policies.HitCount namespace.
+type FwHitCount struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *FwHitCount) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// Reset clears the hit counters of the given rules in the specified vsys and
+// rulebase.
+//
+// If no rules are specified, then the hit counters of all rules in the
+// rulebase are cleared.
+func (c *FwHitCount) Reset(vsys, rulebase string, rules ...string) error {
+	if rulebase == "" {
+		return fmt.Errorf("rulebase must be specified")
+	}
+	if vsys == "" {
+		vsys = "vsys1"
+	}
+
+	req := resetReq{
+		Vsys: vsysName{
+			Name: vsys,
+			Rulebase: rulebaseEntry{
+				Name: rulebase,
+			},
+		},
+	}
+	if len(rules) == 0 {
+		c.con.LogOp("(op) clearing all %s rule hit counts in %q", rulebase, vsys)
+		req.Vsys.Rulebase.Rules.All = &struct{}{}
+	} else {
+		c.con.LogOp("(op) clearing %s rule hit counts in %q: %v", rulebase, vsys, rules)
+		req.Vsys.Rulebase.Rules.List = util.StrToMem(rules)
+	}
+
+	_, err := c.con.Op(req, "", nil, nil)
+	return err
+}
+
+/** Structs for the op commands. **/
+
+type resetReq struct {
+	XMLName xml.Name `xml:"clear"`
+	Vsys    vsysName `xml:"rule-hit-count>vsys>vsys-name>entry"`
+}
+
+type vsysName struct {
+	Name     string        `xml:"name,attr"`
+	Rulebase rulebaseEntry `xml:"rule-base>entry"`
+}
+
+type rulebaseEntry struct {
+	Name  string    `xml:"name,attr"`
+	Rules ruleNames `xml:"rules"`
+}
+
+type ruleNames struct {
+	All  *struct{}        `xml:"all"`
+	List *util.MemberType `xml:"list"`
+}
@@ -0,0 +1,47 @@
+package hitcount
+
+import (
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestFwReset(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		vsys     string
+		rules    []string
+		expected string
+	}{
+		{"all rules", "", nil, "<clear><rule-hit-count><vsys><vsys-name><entry name=\"vsys1\"><rule-base><entry name=\"security\"><rules><all></all></rules></entry></rule-base></entry></vsys-name></vsys></rule-hit-count></clear>"},
+		{"some rules", "vsys2", []string{"r1", "r2"}, "<clear><rule-hit-count><vsys><vsys-name><entry name=\"vsys2\"><rule-base><entry name=\"security\"><rules><list><member>r1</member><member>r2</member></list></rules></entry></rule-base></entry></vsys-name></vsys></rule-hit-count></clear>"},
+	}
+
+	mc := &testdata.MockClient{}
+	ns := &FwHitCount{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.AddResp("")
+			if err := ns.Reset(tc.vsys, Security, tc.rules...); err != nil {
+				t.Errorf("Error in reset: %s", err)
+			} else if mc.Function != "op" {
+				t.Errorf("Function is %q, not op", mc.Function)
+			} else if mc.Elm != tc.expected {
+				t.Errorf("Sent %s, expected %s", mc.Elm, tc.expected)
+			}
+		})
+	}
+}
+
+func TestFwResetNoRulebase(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &FwHitCount{}
+	ns.Initialize(mc)
+
+	if err := ns.Reset("vsys1", ""); err == nil {
+		t.Errorf("Expected an error when no rulebase is given")
+	}
+}
@@ -159,6 +159,16 @@ func (o *Entry) Copy(s Entry) {
 	o.DatDynamicDistribution = s.DatDynamicDistribution
 }
 
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
 /** Structs / functions for normalization. **/
 
 type normalizer interface {
@@ -180,27 +190,28 @@ func (o *container_v1) Names() []string {
 }
 
 func (o *container_v1) Normalize() []Entry {
+	var buf util.StrBuffer
 	ans := make([]Entry, 0, len(o.Answer))
 	for i := range o.Answer {
-		ans = append(ans, o.Answer[i].normalize())
+		ans = append(ans, o.Answer[i].normalize(&buf))
 	}
 
 	return ans
 }
 
-func (o *entry_v1) normalize() Entry {
+func (o *entry_v1) normalize(buf *util.StrBuffer) Entry {
 	ans := Entry{
 		Name:                 o.Name,
 		Description:          o.Description,
 		Type:                 o.Type,
-		SourceZones:          util.MemToStr(o.SourceZones),
+		SourceZones:          buf.MemToStr(o.SourceZones),
 		DestinationZone:      o.DestinationZone,
 		ToInterface:          o.ToInterface,
 		Service:              o.Service,
-		SourceAddresses:      util.MemToStr(o.SourceAddresses),
-		DestinationAddresses: util.MemToStr(o.DestinationAddresses),
+		SourceAddresses:      buf.MemToStr(o.SourceAddresses),
+		DestinationAddresses: buf.MemToStr(o.DestinationAddresses),
 		Disabled:             util.AsBool(o.Disabled),
-		Tags:                 util.MemToStr(o.Tags),
+		Tags:                 buf.MemToStr(o.Tags),
 	}
 
 	if o.Sat == nil {
@@ -215,16 +226,16 @@ func (o *entry_v1) normalize() Entry {
 				ans.SatIpAddress = o.Sat.Diap.InterfaceAddress.Ip
 			} else {
 				ans.SatAddressType = TranslatedAddress
-				ans.SatTranslatedAddresses = util.MemToStr(o.Sat.Diap.TranslatedAddress)
+				ans.SatTranslatedAddresses = buf.MemToStr(o.Sat.Diap.TranslatedAddress)
 			}
 		case o.Sat.Di != nil:
 			ans.SatType = DynamicIp
-			ans.SatTranslatedAddresses = util.MemToStr(o.Sat.Di.TranslatedAddress)
+			ans.SatTranslatedAddresses = buf.MemToStr(o.Sat.Di.TranslatedAddress)
 			if o.Sat.Di.Fallback == nil {
 				ans.SatFallbackType = None
 			} else if o.Sat.Di.Fallback.TranslatedAddress != nil {
 				ans.SatFallbackType = TranslatedAddress
-				ans.SatFallbackTranslatedAddresses = util.MemToStr(o.Sat.Di.Fallback.TranslatedAddress)
+				ans.SatFallbackTranslatedAddresses = buf.MemToStr(o.Sat.Di.Fallback.TranslatedAddress)
 			} else if o.Sat.Di.Fallback.InterfaceAddress != nil {
 				ans.SatFallbackType = InterfaceAddress
 				ans.SatFallbackInterface = o.Sat.Di.Fallback.InterfaceAddress.Interface
@@ -419,27 +430,28 @@ func (o *container_v2) Names() []string {
 }
 
 func (o *container_v2) Normalize() []Entry {
+	var buf util.StrBuffer
 	ans := make([]Entry, 0, len(o.Answer))
 	for i := range o.Answer {
-		ans = append(ans, o.Answer[i].normalize())
+		ans = append(ans, o.Answer[i].normalize(&buf))
 	}
 
 	return ans
 }
 
-func (o *entry_v2) normalize() Entry {
+func (o *entry_v2) normalize(buf *util.StrBuffer) Entry {
 	ans := Entry{
 		Name:                 o.Name,
 		Description:          o.Description,
 		Type:                 o.Type,
-		SourceZones:          util.MemToStr(o.SourceZones),
+		SourceZones:          buf.MemToStr(o.SourceZones),
 		DestinationZone:      o.DestinationZone,
 		ToInterface:          o.ToInterface,
 		Service:              o.Service,
-		SourceAddresses:      util.MemToStr(o.SourceAddresses),
-		DestinationAddresses: util.MemToStr(o.DestinationAddresses),
+		SourceAddresses:      buf.MemToStr(o.SourceAddresses),
+		DestinationAddresses: buf.MemToStr(o.DestinationAddresses),
 		Disabled:             util.AsBool(o.Disabled),
-		Tags:                 util.MemToStr(o.Tags),
+		Tags:                 buf.MemToStr(o.Tags),
 	}
 
 	if o.Sat == nil {
@@ -454,16 +466,16 @@ func (o *entry_v2) normalize() Entry {
 				ans.SatIpAddress = o.Sat.Diap.InterfaceAddress.Ip
 			} else {
 				ans.SatAddressType = TranslatedAddress
-				ans.SatTranslatedAddresses = util.MemToStr(o.Sat.Diap.TranslatedAddress)
+				ans.SatTranslatedAddresses = buf.MemToStr(o.Sat.Diap.TranslatedAddress)
 			}
 		case o.Sat.Di != nil:
 			ans.SatType = DynamicIp
-			ans.SatTranslatedAddresses = util.MemToStr(o.Sat.Di.TranslatedAddress)
+			ans.SatTranslatedAddresses = buf.MemToStr(o.Sat.Di.TranslatedAddress)
 			if o.Sat.Di.Fallback == nil {
 				ans.SatFallbackType = None
 			} else if o.Sat.Di.Fallback.TranslatedAddress != nil {
 				ans.SatFallbackType = TranslatedAddress
-				ans.SatFallbackTranslatedAddresses = util.MemToStr(o.Sat.Di.Fallback.TranslatedAddress)
+				ans.SatFallbackTranslatedAddresses = buf.MemToStr(o.Sat.Di.Fallback.TranslatedAddress)
 			} else if o.Sat.Di.Fallback.InterfaceAddress != nil {
 				ans.SatFallbackType = InterfaceAddress
 				ans.SatFallbackInterface = o.Sat.Di.Fallback.InterfaceAddress.Interface
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/PaloAltoNetworks/pango/testdata"
+	"github.com/PaloAltoNetworks/pango/util"
 )
 
 func TestFwNormalization(t *testing.T) {
@@ -34,3 +35,26 @@ func TestFwNormalization(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkNormalize(b *testing.B) {
+	e := entry_v2{
+		Name:                 "rule",
+		Type:                 "ipv4",
+		SourceZones:          util.StrToMem([]string{"trust", "dmz"}),
+		DestinationZone:      "untrust",
+		Service:              "any",
+		SourceAddresses:      util.StrToMem([]string{"10.1.1.0/24", "10.1.2.0/24"}),
+		DestinationAddresses: util.StrToMem([]string{"any"}),
+		Tags:                 util.StrToMem([]string{"tag1"}),
+	}
+	o := &container_v2{Answer: make([]entry_v2, 1000)}
+	for i := range o.Answer {
+		o.Answer[i] = e
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = o.Normalize()
+	}
+}
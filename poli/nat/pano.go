@@ -73,6 +73,19 @@ func (c *PanoNat) ShowAll(dg, base string) ([]Entry, error) {
 	return result.Normalize(), nil
 }
 
+// GetAllUsing retrieves all NAT rules using the given pango query type:
+// util.Get for the candidate config, util.Show for the running config, or
+// util.EffectiveRunning for the running config merged with any config pushed
+// from Panorama.
+func (c *PanoNat) GetAllUsing(qt, dg, base string) ([]Entry, error) {
+	result, _ := c.versioning()
+	if err := c.ns.Objects(qt, c.xpath(dg, base, nil), result); err != nil {
+		return nil, err
+	}
+
+	return result.Normalize(), nil
+}
+
 // Set performs SET to create / update one or more NAT policies.
 func (c *PanoNat) Set(dg, base string, e ...Entry) error {
 	var err error
@@ -136,6 +149,39 @@ func (c *PanoNat) Delete(dg, base string, e ...interface{}) error {
 	return c.ns.Delete(names, path)
 }
 
+// Clone retrieves the NAT policy `name`, then creates a copy of it named
+// `newName`.  If `overrides` is not nil, it is called on the copy before it
+// is created so that fields can be changed.
+//
+// The `movement` param positions the copy relative to the original, and
+// should be one of the Move constants in the util package.  Use
+// util.MoveSkip to leave the copy wherever PAN-OS puts it.
+func (c *PanoNat) Clone(dg, base string, name, newName string, movement int, overrides func(*Entry)) (Entry, error) {
+	if e, err := c.Get(dg, base, newName); err == nil && e.Name == newName {
+		return Entry{}, fmt.Errorf("NAT policy %q already exists", newName)
+	}
+
+	e, err := c.Get(dg, base, name)
+	if err != nil {
+		return Entry{}, err
+	}
+	e.Name = newName
+	if overrides != nil {
+		overrides(&e)
+	}
+
+	if err = c.Set(dg, base, e); err != nil {
+		return Entry{}, err
+	}
+	if movement != util.MoveSkip {
+		if err = c.MoveGroup(dg, base, movement, name, e); err != nil {
+			return e, err
+		}
+	}
+
+	return e, nil
+}
+
 // MoveGroup moves a logical group of rules somewhere in relation
 // to another rule.
 //
@@ -163,6 +209,42 @@ func (c *PanoNat) MoveGroup(dg, base string, movement int, rule string, e ...Ent
 	return c.ns.MoveGroup(pather, lister, movement, rule, names)
 }
 
+// Move moves the named NAT rules somewhere in relation to another rule,
+// keeping them grouped together in the order given.
+//
+// This is the same as MoveGroup, but takes rule names instead of Entry
+// objects.  All named rules, as well as the `rule` being referenced, must
+// already exist.
+func (c *PanoNat) Move(dg, base string, movement int, rule string, names ...string) error {
+	pather := func(v string) []string {
+		return c.xpath(dg, base, []string{v})
+	}
+
+	lister := func() ([]string, error) {
+		return c.GetList(dg, base)
+	}
+
+	return c.ns.MoveGroup(pather, lister, movement, rule, names)
+}
+
+// Reorder moves NAT rules so that the given rules are at the top of the
+// rulebase in the given order.
+//
+// Rules not named are left after the named rules, in their current relative
+// order, so passing in the names of every rule reorders the entire rulebase.
+// Only rules that are out of place are moved.
+func (c *PanoNat) Reorder(dg, base string, names ...string) error {
+	pather := func(v string) []string {
+		return c.xpath(dg, base, []string{v})
+	}
+
+	lister := func() ([]string, error) {
+		return c.GetList(dg, base)
+	}
+
+	return c.ns.Reorder(pather, lister, names)
+}
+
 /** Internal functions **/
 
 func (c *PanoNat) versioning() (normalizer, func(Entry) interface{}) {
@@ -3,26 +3,66 @@ package poli
 import (
 	"github.com/PaloAltoNetworks/pango/util"
 
+	"github.com/PaloAltoNetworks/pango/poli/appoverride"
+	"github.com/PaloAltoNetworks/pango/poli/authentication"
+	"github.com/PaloAltoNetworks/pango/poli/decryption"
+	"github.com/PaloAltoNetworks/pango/poli/dos"
 	"github.com/PaloAltoNetworks/pango/poli/nat"
 	"github.com/PaloAltoNetworks/pango/poli/pbf"
+	"github.com/PaloAltoNetworks/pango/poli/qos"
+	"github.com/PaloAltoNetworks/pango/poli/sdwan"
 	"github.com/PaloAltoNetworks/pango/poli/security"
+	"github.com/PaloAltoNetworks/pango/poli/security/defaultrule"
+	"github.com/PaloAltoNetworks/pango/poli/tunnelinspect"
 )
 
 // Poli is the client.Policies namespace.
 type PanoPoli struct {
+	AppOverrideRule       *appoverride.PanoAppOverride
+	AuthenticationRule    *authentication.PanoAuth
+	DecryptionRule        *decryption.PanoDecryption
+	DosRule               *dos.PanoDos
+	DefaultSecurityRule   *defaultrule.PanoDefaultRule
 	Nat                   *nat.PanoNat
 	PolicyBasedForwarding *pbf.PanoPbf
+	QosRule               *qos.PanoQos
+	SdwanRule             *sdwan.PanoSdwan
 	Security              *security.PanoSecurity
+	TunnelInspectionRule  *tunnelinspect.PanoTunnelInspect
 }
 
 // Initialize is invoked on client.Initialize().
 func (c *PanoPoli) Initialize(i util.XapiClient) {
+	c.AppOverrideRule = &appoverride.PanoAppOverride{}
+	c.AppOverrideRule.Initialize(i)
+
+	c.AuthenticationRule = &authentication.PanoAuth{}
+	c.AuthenticationRule.Initialize(i)
+
+	c.DecryptionRule = &decryption.PanoDecryption{}
+	c.DecryptionRule.Initialize(i)
+
+	c.DosRule = &dos.PanoDos{}
+	c.DosRule.Initialize(i)
+
+	c.DefaultSecurityRule = &defaultrule.PanoDefaultRule{}
+	c.DefaultSecurityRule.Initialize(i)
+
 	c.Nat = &nat.PanoNat{}
 	c.Nat.Initialize(i)
 
 	c.PolicyBasedForwarding = &pbf.PanoPbf{}
 	c.PolicyBasedForwarding.Initialize(i)
 
+	c.QosRule = &qos.PanoQos{}
+	c.QosRule.Initialize(i)
+
+	c.SdwanRule = &sdwan.PanoSdwan{}
+	c.SdwanRule.Initialize(i)
+
 	c.Security = &security.PanoSecurity{}
 	c.Security.Initialize(i)
+
+	c.TunnelInspectionRule = &tunnelinspect.PanoTunnelInspect{}
+	c.TunnelInspectionRule.Initialize(i)
 }
@@ -167,6 +167,42 @@ func (c *PanoPbf) MoveGroup(dg, base string, movement int, rule string, e ...Ent
 	return c.ns.MoveGroup(pather, lister, movement, rule, names)
 }
 
+// Move moves the named policy based forwarding rules somewhere in relation to another rule,
+// keeping them grouped together in the order given.
+//
+// This is the same as MoveGroup, but takes rule names instead of Entry
+// objects.  All named rules, as well as the `rule` being referenced, must
+// already exist.
+func (c *PanoPbf) Move(dg, base string, movement int, rule string, names ...string) error {
+	pather := func(v string) []string {
+		return c.xpath(dg, base, []string{v})
+	}
+
+	lister := func() ([]string, error) {
+		return c.GetList(dg, base)
+	}
+
+	return c.ns.MoveGroup(pather, lister, movement, rule, names)
+}
+
+// Reorder moves policy based forwarding rules so that the given rules are at the top of the
+// rulebase in the given order.
+//
+// Rules not named are left after the named rules, in their current relative
+// order, so passing in the names of every rule reorders the entire rulebase.
+// Only rules that are out of place are moved.
+func (c *PanoPbf) Reorder(dg, base string, names ...string) error {
+	pather := func(v string) []string {
+		return c.xpath(dg, base, []string{v})
+	}
+
+	lister := func() ([]string, error) {
+		return c.GetList(dg, base)
+	}
+
+	return c.ns.Reorder(pather, lister, names)
+}
+
 /** Internal functions for this namespace struct **/
 
 func (c *PanoPbf) versioning() (normalizer, func(Entry) interface{}) {
@@ -0,0 +1,6 @@
+package qos
+
+const (
+	singular = "qos rule"
+	plural   = "qos rules"
+)
@@ -0,0 +1,9 @@
+/*
+Package qos is the client.Policies.QosRule namespace.
+
+QoS policy rules match traffic and assign it one of the traffic classes
+defined in the QoS profile of the egress interface.
+
+Normalized object:  Entry
+*/
+package qos
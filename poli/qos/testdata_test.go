@@ -0,0 +1,53 @@
+package qos
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"basic", Entry{
+			Name:                 "r1",
+			SourceZones:          []string{"trust"},
+			SourceAddresses:      []string{"any"},
+			SourceUsers:          []string{"any"},
+			DestinationZones:     []string{"untrust"},
+			DestinationAddresses: []string{"any"},
+			Applications:         []string{"any"},
+			Services:             []string{"application-default"},
+			Categories:           []string{"any"},
+			Class:                "4",
+		}},
+		{"full", Entry{
+			Name:                 "r2",
+			Description:          "voice",
+			Tags:                 []string{"t1", "t2"},
+			SourceZones:          []string{"trust"},
+			SourceAddresses:      []string{"10.1.1.0/24"},
+			SourceUsers:          []string{"any"},
+			NegateSource:         true,
+			DestinationZones:     []string{"untrust"},
+			DestinationAddresses: []string{"10.2.1.0/24"},
+			NegateDestination:    true,
+			Applications:         []string{"sip", "rtp"},
+			Services:             []string{"any"},
+			Categories:           []string{"streaming-media"},
+			Class:                "1",
+			Schedule:             "business-hours",
+			Disabled:             true,
+		}},
+		{"targets", Entry{
+			Name:                 "r3",
+			SourceZones:          []string{"any"},
+			DestinationZones:     []string{"any"},
+			SourceAddresses:      []string{"any"},
+			DestinationAddresses: []string{"any"},
+			Targets: map[string][]string{
+				"fw1": nil,
+				"fw2": {"vsys2"},
+			},
+			NegateTarget: true,
+		}},
+	}
+}
@@ -0,0 +1,6 @@
+package sdwan
+
+const (
+	singular = "sdwan policy"
+	plural   = "sdwan policies"
+)
@@ -0,0 +1,11 @@
+/*
+Package sdwan is the client.Policies.SdwanRule namespace.
+
+SD-WAN policy rules match traffic and select the path quality, SaaS quality,
+error correction, and traffic distribution profiles used to steer it.
+
+Only valid for PAN-OS 9.1+.
+
+Normalized object:  Entry
+*/
+package sdwan
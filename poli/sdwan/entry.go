@@ -0,0 +1,197 @@
+package sdwan
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an SD-WAN
+// policy rule.
+//
+// Targets is a map where the key is the serial number of the target device and
+// the value is a list of specific vsys on that device.  The list of vsys is
+// nil if all vsys on that device should be included or if the device is a
+// virtual firewall (and thus only has vsys1).
+type Entry struct {
+	Name                       string
+	Description                string
+	Tags                       []string // ordered
+	SourceZones                []string // unordered
+	SourceAddresses            []string // unordered
+	SourceUsers                []string // unordered
+	NegateSource               bool
+	DestinationZones           []string // unordered
+	DestinationAddresses       []string // unordered
+	NegateDestination          bool
+	Applications               []string // unordered
+	Services                   []string // unordered
+	PathQualityProfile         string
+	SaasQualityProfile         string
+	ErrorCorrectionProfile     string
+	TrafficDistributionProfile string
+	Disabled                   bool
+	Targets                    map[string][]string
+	NegateTarget               bool
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Description = s.Description
+	o.Tags = s.Tags
+	o.SourceZones = s.SourceZones
+	o.SourceAddresses = s.SourceAddresses
+	o.SourceUsers = s.SourceUsers
+	o.NegateSource = s.NegateSource
+	o.DestinationZones = s.DestinationZones
+	o.DestinationAddresses = s.DestinationAddresses
+	o.NegateDestination = s.NegateDestination
+	o.Applications = s.Applications
+	o.Services = s.Services
+	o.PathQualityProfile = s.PathQualityProfile
+	o.SaasQualityProfile = s.SaasQualityProfile
+	o.ErrorCorrectionProfile = s.ErrorCorrectionProfile
+	o.TrafficDistributionProfile = s.TrafficDistributionProfile
+	o.Disabled = s.Disabled
+	o.Targets = s.Targets
+	o.NegateTarget = s.NegateTarget
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this SD-WAN policy rule.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:                   o.Name,
+		Description:            o.Description,
+		Tags:                   util.MemToStr(o.Tags),
+		SourceZones:            util.MemToStr(o.SourceZones),
+		SourceAddresses:        util.MemToStr(o.SourceAddresses),
+		SourceUsers:            util.MemToStr(o.SourceUsers),
+		NegateSource:           util.AsBool(o.NegateSource),
+		DestinationZones:       util.MemToStr(o.DestinationZones),
+		DestinationAddresses:   util.MemToStr(o.DestinationAddresses),
+		NegateDestination:      util.AsBool(o.NegateDestination),
+		Applications:           util.MemToStr(o.Applications),
+		Services:               util.MemToStr(o.Services),
+		PathQualityProfile:     o.PathQualityProfile,
+		SaasQualityProfile:     o.SaasQualityProfile,
+		ErrorCorrectionProfile: o.ErrorCorrectionProfile,
+		Disabled:               util.AsBool(o.Disabled),
+	}
+
+	if o.Action != nil {
+		ans.TrafficDistributionProfile = o.Action.TrafficDistributionProfile
+	}
+
+	if o.TargetInfo != nil {
+		ans.NegateTarget = util.AsBool(o.TargetInfo.NegateTarget)
+		ans.Targets = util.VsysEntToMap(o.TargetInfo.Targets)
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName                xml.Name         `xml:"entry"`
+	Name                   string           `xml:"name,attr"`
+	Description            string           `xml:"description,omitempty"`
+	Tags                   *util.MemberType `xml:"tag"`
+	SourceZones            *util.MemberType `xml:"from"`
+	SourceAddresses        *util.MemberType `xml:"source"`
+	SourceUsers            *util.MemberType `xml:"source-user"`
+	NegateSource           string           `xml:"negate-source"`
+	DestinationZones       *util.MemberType `xml:"to"`
+	DestinationAddresses   *util.MemberType `xml:"destination"`
+	NegateDestination      string           `xml:"negate-destination"`
+	Applications           *util.MemberType `xml:"application"`
+	Services               *util.MemberType `xml:"service"`
+	PathQualityProfile     string           `xml:"path-quality-profile,omitempty"`
+	SaasQualityProfile     string           `xml:"saas-quality-profile,omitempty"`
+	ErrorCorrectionProfile string           `xml:"error-correction-profile,omitempty"`
+	Action                 *action          `xml:"action"`
+	Disabled               string           `xml:"disabled"`
+	TargetInfo             *targetInfo      `xml:"target"`
+}
+
+type action struct {
+	TrafficDistributionProfile string `xml:"traffic-distribution-profile"`
+}
+
+type targetInfo struct {
+	Targets      *util.VsysEntryType `xml:"devices"`
+	NegateTarget string              `xml:"negate,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:                   e.Name,
+		Description:            e.Description,
+		Tags:                   util.StrToMem(e.Tags),
+		SourceZones:            util.StrToMem(e.SourceZones),
+		SourceAddresses:        util.StrToMem(e.SourceAddresses),
+		SourceUsers:            util.StrToMem(e.SourceUsers),
+		NegateSource:           util.YesNo(e.NegateSource),
+		DestinationZones:       util.StrToMem(e.DestinationZones),
+		DestinationAddresses:   util.StrToMem(e.DestinationAddresses),
+		NegateDestination:      util.YesNo(e.NegateDestination),
+		Applications:           util.StrToMem(e.Applications),
+		Services:               util.StrToMem(e.Services),
+		PathQualityProfile:     e.PathQualityProfile,
+		SaasQualityProfile:     e.SaasQualityProfile,
+		ErrorCorrectionProfile: e.ErrorCorrectionProfile,
+		Disabled:               util.YesNo(e.Disabled),
+	}
+
+	if e.TrafficDistributionProfile != "" {
+		ans.Action = &action{
+			TrafficDistributionProfile: e.TrafficDistributionProfile,
+		}
+	}
+
+	if e.Targets != nil || e.NegateTarget {
+		ans.TargetInfo = &targetInfo{
+			Targets:      util.MapToVsysEnt(e.Targets),
+			NegateTarget: util.YesNo(e.NegateTarget),
+		}
+	}
+
+	return ans
+}
@@ -0,0 +1,54 @@
+package sdwan
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"basic", Entry{
+			Name:                       "r1",
+			SourceZones:                []string{"trust"},
+			SourceAddresses:            []string{"any"},
+			SourceUsers:                []string{"any"},
+			DestinationZones:           []string{"untrust"},
+			DestinationAddresses:       []string{"any"},
+			Applications:               []string{"any"},
+			Services:                   []string{"application-default"},
+			PathQualityProfile:         "general-business",
+			TrafficDistributionProfile: "td1",
+		}},
+		{"full", Entry{
+			Name:                       "r2",
+			Description:                "voice",
+			Tags:                       []string{"t1", "t2"},
+			SourceZones:                []string{"trust"},
+			SourceAddresses:            []string{"10.1.1.0/24"},
+			SourceUsers:                []string{"any"},
+			NegateSource:               true,
+			DestinationZones:           []string{"untrust"},
+			DestinationAddresses:       []string{"10.2.1.0/24"},
+			NegateDestination:          true,
+			Applications:               []string{"sip", "rtp"},
+			Services:                   []string{"any"},
+			PathQualityProfile:         "voip",
+			SaasQualityProfile:         "saas1",
+			ErrorCorrectionProfile:     "fec1",
+			TrafficDistributionProfile: "td2",
+			Disabled:                   true,
+		}},
+		{"targets", Entry{
+			Name:                 "r3",
+			SourceZones:          []string{"any"},
+			DestinationZones:     []string{"any"},
+			SourceAddresses:      []string{"any"},
+			DestinationAddresses: []string{"any"},
+			Targets: map[string][]string{
+				"fw1": nil,
+				"fw2": {"vsys2"},
+			},
+			NegateTarget: true,
+		}},
+	}
+}
@@ -0,0 +1,12 @@
+package defaultrule
+
+// Names of the default security rules.
+const (
+	IntrazoneDefault = "intrazone-default"
+	InterzoneDefault = "interzone-default"
+)
+
+const (
+	singular = "default security rule"
+	plural   = "default security rules"
+)
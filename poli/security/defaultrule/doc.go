@@ -0,0 +1,11 @@
+/*
+Package defaultrule is the client.Policies.DefaultSecurityRule namespace.
+
+The default security rules are the "intrazone-default" and
+"interzone-default" rules that are evaluated after all other security rules.
+They always exist, so they are only ever updated, and deleting one reverts it
+to its predefined settings.
+
+Normalized object:  Entry
+*/
+package defaultrule
@@ -0,0 +1,162 @@
+package defaultrule
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a default
+// security rule.
+//
+// The Name must be either IntrazoneDefault or InterzoneDefault.
+type Entry struct {
+	Name             string
+	Action           string
+	LogSetting       string
+	LogStart         bool
+	LogEnd           bool
+	Group            string
+	Virus            string
+	Spyware          string
+	Vulnerability    string
+	UrlFiltering     string
+	FileBlocking     string
+	WildFireAnalysis string
+	DataFiltering    string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Action = s.Action
+	o.LogSetting = s.LogSetting
+	o.LogStart = s.LogStart
+	o.LogEnd = s.LogEnd
+	o.Group = s.Group
+	o.Virus = s.Virus
+	o.Spyware = s.Spyware
+	o.Vulnerability = s.Vulnerability
+	o.UrlFiltering = s.UrlFiltering
+	o.FileBlocking = s.FileBlocking
+	o.WildFireAnalysis = s.WildFireAnalysis
+	o.DataFiltering = s.DataFiltering
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this default security rule.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:       o.Name,
+		Action:     o.Action,
+		LogSetting: o.LogSetting,
+		LogStart:   util.AsBool(o.LogStart),
+		LogEnd:     util.AsBool(o.LogEnd),
+	}
+	if o.ProfileSettings != nil {
+		ans.Group = util.MemToOneStr(o.ProfileSettings.Group)
+		if o.ProfileSettings.Profiles != nil {
+			ans.Virus = util.MemToOneStr(o.ProfileSettings.Profiles.Virus)
+			ans.Spyware = util.MemToOneStr(o.ProfileSettings.Profiles.Spyware)
+			ans.Vulnerability = util.MemToOneStr(o.ProfileSettings.Profiles.Vulnerability)
+			ans.UrlFiltering = util.MemToOneStr(o.ProfileSettings.Profiles.UrlFiltering)
+			ans.FileBlocking = util.MemToOneStr(o.ProfileSettings.Profiles.FileBlocking)
+			ans.WildFireAnalysis = util.MemToOneStr(o.ProfileSettings.Profiles.WildFireAnalysis)
+			ans.DataFiltering = util.MemToOneStr(o.ProfileSettings.Profiles.DataFiltering)
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName         xml.Name         `xml:"entry"`
+	Name            string           `xml:"name,attr"`
+	Action          string           `xml:"action,omitempty"`
+	LogSetting      string           `xml:"log-setting,omitempty"`
+	LogStart        string           `xml:"log-start,omitempty"`
+	LogEnd          string           `xml:"log-end,omitempty"`
+	ProfileSettings *profileSettings `xml:"profile-setting"`
+}
+
+type profileSettings struct {
+	Group    *util.MemberType        `xml:"group"`
+	Profiles *profileSettingsProfile `xml:"profiles"`
+}
+
+type profileSettingsProfile struct {
+	Virus            *util.MemberType `xml:"virus"`
+	Spyware          *util.MemberType `xml:"spyware"`
+	Vulnerability    *util.MemberType `xml:"vulnerability"`
+	UrlFiltering     *util.MemberType `xml:"url-filtering"`
+	FileBlocking     *util.MemberType `xml:"file-blocking"`
+	WildFireAnalysis *util.MemberType `xml:"wildfire-analysis"`
+	DataFiltering    *util.MemberType `xml:"data-filtering"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:       e.Name,
+		Action:     e.Action,
+		LogSetting: e.LogSetting,
+		LogStart:   util.YesNo(e.LogStart),
+		LogEnd:     util.YesNo(e.LogEnd),
+	}
+	gs := e.Virus != "" || e.Spyware != "" || e.Vulnerability != "" || e.UrlFiltering != "" || e.FileBlocking != "" || e.WildFireAnalysis != "" || e.DataFiltering != ""
+	if e.Group != "" || gs {
+		ps := &profileSettings{
+			Group: util.OneStrToMem(e.Group),
+		}
+		if gs {
+			ps.Profiles = &profileSettingsProfile{
+				util.OneStrToMem(e.Virus),
+				util.OneStrToMem(e.Spyware),
+				util.OneStrToMem(e.Vulnerability),
+				util.OneStrToMem(e.UrlFiltering),
+				util.OneStrToMem(e.FileBlocking),
+				util.OneStrToMem(e.WildFireAnalysis),
+				util.OneStrToMem(e.DataFiltering),
+			}
+		}
+		ans.ProfileSettings = ps
+	}
+
+	return ans
+}
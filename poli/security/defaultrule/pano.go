@@ -0,0 +1,109 @@
+package defaultrule
+
+import (
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// PanoDefaultRule is the client.Policies.DefaultSecurityRule namespace.
+//
+// Default security rules are always part of the post-rulebase.
+type PanoDefaultRule struct {
+	con util.XapiClient
+	ns  *namespace.Standard[Entry]
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *PanoDefaultRule) Initialize(con util.XapiClient) {
+	c.con = con
+	c.ns = namespace.NewStandard(singular, plural, con, c.versioning)
+}
+
+// GetList performs GET to retrieve a list of all default security rules.
+func (c *PanoDefaultRule) GetList(dg string) ([]string, error) {
+	return c.ns.List(util.Get, c.xpath(dg, nil))
+}
+
+// ShowList performs SHOW to retrieve a list of all default security rules.
+func (c *PanoDefaultRule) ShowList(dg string) ([]string, error) {
+	return c.ns.List(util.Show, c.xpath(dg, nil))
+}
+
+// Get performs GET to retrieve information for the given default security rule.
+func (c *PanoDefaultRule) Get(dg, name string) (Entry, error) {
+	return c.ns.One(util.Get, c.xpath(dg, []string{name}), name)
+}
+
+// GetAll performs GET to retrieve all default security rules configured.
+func (c *PanoDefaultRule) GetAll(dg string) ([]Entry, error) {
+	return c.ns.All(util.Get, c.xpath(dg, nil))
+}
+
+// Show performs SHOW to retrieve information for the given default security rule.
+func (c *PanoDefaultRule) Show(dg, name string) (Entry, error) {
+	return c.ns.One(util.Show, c.xpath(dg, []string{name}), name)
+}
+
+// ShowAll performs SHOW to retrieve all default security rules configured.
+func (c *PanoDefaultRule) ShowAll(dg string) ([]Entry, error) {
+	return c.ns.All(util.Show, c.xpath(dg, nil))
+}
+
+// Set performs SET to update one or more default security rules.
+func (c *PanoDefaultRule) Set(dg string, e ...Entry) error {
+	return c.ns.SetEntries(c.pather(dg), e...)
+}
+
+// Edit performs EDIT to update one default security rule.
+func (c *PanoDefaultRule) Edit(dg string, e Entry) error {
+	return c.ns.EditEntry(c.pather(dg), e)
+}
+
+// Delete reverts the given default security rules to their predefined
+// settings.
+//
+// Objects can be either a string or an Entry object.
+func (c *PanoDefaultRule) Delete(dg string, e ...interface{}) error {
+	return c.ns.DeleteEntries(c.pather(dg), e...)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *PanoDefaultRule) versioning() (namespace.Normalizer[Entry], func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *PanoDefaultRule) pather(dg string) namespace.Pather {
+	return func(v []string) []string {
+		return c.xpath(dg, v)
+	}
+}
+
+func (c *PanoDefaultRule) xpath(dg string, vals []string) []string {
+	if dg == "" {
+		dg = "shared"
+	}
+
+	if dg == "shared" {
+		return []string{
+			"config",
+			"shared",
+			util.PostRulebase,
+			"default-security-rules",
+			"rules",
+			util.AsEntryXpath(vals),
+		}
+	}
+
+	return []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"device-group",
+		util.AsEntryXpath([]string{dg}),
+		util.PostRulebase,
+		"default-security-rules",
+		"rules",
+		util.AsEntryXpath(vals),
+	}
+}
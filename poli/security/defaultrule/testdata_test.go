@@ -0,0 +1,35 @@
+package defaultrule
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"intrazone with logging", Entry{
+			Name:       IntrazoneDefault,
+			Action:     "allow",
+			LogSetting: "default",
+			LogEnd:     true,
+		}},
+		{"interzone with group", Entry{
+			Name:     InterzoneDefault,
+			Action:   "deny",
+			LogStart: true,
+			LogEnd:   true,
+			Group:    "best-practice",
+		}},
+		{"interzone with profiles", Entry{
+			Name:             InterzoneDefault,
+			Action:           "allow",
+			Virus:            "default",
+			Spyware:          "strict",
+			Vulnerability:    "strict",
+			UrlFiltering:     "default",
+			FileBlocking:     "basic file blocking",
+			WildFireAnalysis: "default",
+			DataFiltering:    "dfp",
+		}},
+	}
+}
@@ -13,6 +13,9 @@ import (
 // the value is a list of specific vsys on that device.  The list of vsys is
 // nil if all vsys on that device should be included or if the device is a
 // virtual firewall (and thus only has vsys1).
+//
+// SourceDevices and DestinationDevices are the device objects (device-id)
+// that the rule matches, and are only used on PAN-OS 10.0+.
 type Entry struct {
 	Name                            string
 	Type                            string
@@ -47,6 +50,8 @@ type Entry struct {
 	FileBlocking                    string
 	WildFireAnalysis                string
 	DataFiltering                   string
+	SourceDevices                   []string // unordered, 10.0+
+	DestinationDevices              []string // unordered, 10.0+
 }
 
 // Defaults sets params with uninitialized values to their GUI default setting.
@@ -149,6 +154,18 @@ func (o *Entry) Copy(s Entry) {
 	o.FileBlocking = s.FileBlocking
 	o.WildFireAnalysis = s.WildFireAnalysis
 	o.DataFiltering = s.DataFiltering
+	o.SourceDevices = s.SourceDevices
+	o.DestinationDevices = s.DestinationDevices
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
 }
 
 /** Structs / functions for normalization. **/
@@ -172,30 +189,31 @@ func (o *container_v1) Names() []string {
 }
 
 func (o *container_v1) Normalize() []Entry {
+	var buf util.StrBuffer
 	arr := make([]Entry, 0, len(o.Answer))
 	for i := range o.Answer {
-		arr = append(arr, o.Answer[i].normalize())
+		arr = append(arr, o.Answer[i].normalize(&buf))
 	}
 	return arr
 }
 
-func (o *entry_v1) normalize() Entry {
+func (o *entry_v1) normalize(buf *util.StrBuffer) Entry {
 	ans := Entry{
 		Name:                 o.Name,
 		Type:                 o.Type,
 		Description:          o.Description,
-		Tags:                 util.MemToStr(o.Tags),
-		SourceZones:          util.MemToStr(o.SourceZones),
-		DestinationZones:     util.MemToStr(o.DestinationZones),
-		SourceAddresses:      util.MemToStr(o.SourceAddresses),
+		Tags:                 buf.MemToStr(o.Tags),
+		SourceZones:          buf.MemToStr(o.SourceZones),
+		DestinationZones:     buf.MemToStr(o.DestinationZones),
+		SourceAddresses:      buf.MemToStr(o.SourceAddresses),
 		NegateSource:         util.AsBool(o.NegateSource),
-		SourceUsers:          util.MemToStr(o.SourceUsers),
-		HipProfiles:          util.MemToStr(o.HipProfiles),
-		DestinationAddresses: util.MemToStr(o.DestinationAddresses),
+		SourceUsers:          buf.MemToStr(o.SourceUsers),
+		HipProfiles:          buf.MemToStr(o.HipProfiles),
+		DestinationAddresses: buf.MemToStr(o.DestinationAddresses),
 		NegateDestination:    util.AsBool(o.NegateDestination),
-		Applications:         util.MemToStr(o.Applications),
-		Services:             util.MemToStr(o.Services),
-		Categories:           util.MemToStr(o.Categories),
+		Applications:         buf.MemToStr(o.Applications),
+		Services:             buf.MemToStr(o.Services),
+		Categories:           buf.MemToStr(o.Categories),
 		Action:               o.Action,
 		LogSetting:           o.LogSetting,
 		LogStart:             util.AsBool(o.LogStart),
@@ -334,3 +352,164 @@ func specify_v1(e Entry) interface{} {
 
 	return ans
 }
+
+// PAN-OS 10.0, adds SourceDevices and DestinationDevices
+type container_v2 struct {
+	Answer []entry_v2 `xml:"entry"`
+}
+
+func (o *container_v2) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *container_v2) Normalize() []Entry {
+	var buf util.StrBuffer
+	arr := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		arr = append(arr, o.Answer[i].normalize(&buf))
+	}
+	return arr
+}
+
+func (o *entry_v2) normalize(buf *util.StrBuffer) Entry {
+	ans := Entry{
+		Name:                 o.Name,
+		Type:                 o.Type,
+		Description:          o.Description,
+		Tags:                 buf.MemToStr(o.Tags),
+		SourceZones:          buf.MemToStr(o.SourceZones),
+		DestinationZones:     buf.MemToStr(o.DestinationZones),
+		SourceAddresses:      buf.MemToStr(o.SourceAddresses),
+		NegateSource:         util.AsBool(o.NegateSource),
+		SourceUsers:          buf.MemToStr(o.SourceUsers),
+		HipProfiles:          buf.MemToStr(o.HipProfiles),
+		DestinationAddresses: buf.MemToStr(o.DestinationAddresses),
+		NegateDestination:    util.AsBool(o.NegateDestination),
+		Applications:         buf.MemToStr(o.Applications),
+		Services:             buf.MemToStr(o.Services),
+		Categories:           buf.MemToStr(o.Categories),
+		Action:               o.Action,
+		LogSetting:           o.LogSetting,
+		LogStart:             util.AsBool(o.LogStart),
+		LogEnd:               util.AsBool(o.LogEnd),
+		Disabled:             util.AsBool(o.Disabled),
+		Schedule:             o.Schedule,
+		IcmpUnreachable:      util.AsBool(o.IcmpUnreachable),
+		SourceDevices:        buf.MemToStr(o.SourceDevices),
+		DestinationDevices:   buf.MemToStr(o.DestinationDevices),
+	}
+	if o.Options != nil {
+		ans.DisableServerResponseInspection = util.AsBool(o.Options.DisableServerResponseInspection)
+	}
+	if o.TargetInfo != nil {
+		ans.NegateTarget = util.AsBool(o.TargetInfo.NegateTarget)
+		ans.Targets = util.VsysEntToMap(o.TargetInfo.Targets)
+	}
+	if o.ProfileSettings != nil {
+		ans.Group = util.MemToOneStr(o.ProfileSettings.Group)
+		if o.ProfileSettings.Profiles != nil {
+			ans.Virus = util.MemToOneStr(o.ProfileSettings.Profiles.Virus)
+			ans.Spyware = util.MemToOneStr(o.ProfileSettings.Profiles.Spyware)
+			ans.Vulnerability = util.MemToOneStr(o.ProfileSettings.Profiles.Vulnerability)
+			ans.UrlFiltering = util.MemToOneStr(o.ProfileSettings.Profiles.UrlFiltering)
+			ans.FileBlocking = util.MemToOneStr(o.ProfileSettings.Profiles.FileBlocking)
+			ans.WildFireAnalysis = util.MemToOneStr(o.ProfileSettings.Profiles.WildFireAnalysis)
+			ans.DataFiltering = util.MemToOneStr(o.ProfileSettings.Profiles.DataFiltering)
+		}
+	}
+
+	return ans
+}
+
+type entry_v2 struct {
+	XMLName              xml.Name         `xml:"entry"`
+	Name                 string           `xml:"name,attr"`
+	Type                 string           `xml:"rule-type"`
+	Description          string           `xml:"description"`
+	Tags                 *util.MemberType `xml:"tag"`
+	SourceZones          *util.MemberType `xml:"from"`
+	DestinationZones     *util.MemberType `xml:"to"`
+	SourceAddresses      *util.MemberType `xml:"source"`
+	NegateSource         string           `xml:"negate-source"`
+	SourceUsers          *util.MemberType `xml:"source-user"`
+	HipProfiles          *util.MemberType `xml:"hip-profiles"`
+	SourceDevices        *util.MemberType `xml:"source-hip"`
+	DestinationAddresses *util.MemberType `xml:"destination"`
+	DestinationDevices   *util.MemberType `xml:"destination-hip"`
+	NegateDestination    string           `xml:"negate-destination"`
+	Applications         *util.MemberType `xml:"application"`
+	Services             *util.MemberType `xml:"service"`
+	Categories           *util.MemberType `xml:"category"`
+	Action               string           `xml:"action"`
+	LogSetting           string           `xml:"log-setting,omitempty"`
+	LogStart             string           `xml:"log-start"`
+	LogEnd               string           `xml:"log-end"`
+	Disabled             string           `xml:"disabled"`
+	Schedule             string           `xml:"schedule,omitempty"`
+	IcmpUnreachable      string           `xml:"icmp-unreachable"`
+	Options              *secOptions      `xml:"option"`
+	TargetInfo           *targetInfo      `xml:"target"`
+	ProfileSettings      *profileSettings `xml:"profile-setting"`
+}
+
+func specify_v2(e Entry) interface{} {
+	ans := entry_v2{
+		Name:                 e.Name,
+		Type:                 e.Type,
+		Description:          e.Description,
+		Tags:                 util.StrToMem(e.Tags),
+		SourceZones:          util.StrToMem(e.SourceZones),
+		DestinationZones:     util.StrToMem(e.DestinationZones),
+		SourceAddresses:      util.StrToMem(e.SourceAddresses),
+		NegateSource:         util.YesNo(e.NegateSource),
+		SourceUsers:          util.StrToMem(e.SourceUsers),
+		HipProfiles:          util.StrToMem(e.HipProfiles),
+		DestinationAddresses: util.StrToMem(e.DestinationAddresses),
+		NegateDestination:    util.YesNo(e.NegateDestination),
+		Applications:         util.StrToMem(e.Applications),
+		Services:             util.StrToMem(e.Services),
+		Categories:           util.StrToMem(e.Categories),
+		Action:               e.Action,
+		LogSetting:           e.LogSetting,
+		LogStart:             util.YesNo(e.LogStart),
+		LogEnd:               util.YesNo(e.LogEnd),
+		Disabled:             util.YesNo(e.Disabled),
+		Schedule:             e.Schedule,
+		IcmpUnreachable:      util.YesNo(e.IcmpUnreachable),
+		Options:              &secOptions{util.YesNo(e.DisableServerResponseInspection)},
+		SourceDevices:        util.StrToMem(e.SourceDevices),
+		DestinationDevices:   util.StrToMem(e.DestinationDevices),
+	}
+	if e.Targets != nil || e.NegateTarget {
+		nfo := &targetInfo{
+			Targets:      util.MapToVsysEnt(e.Targets),
+			NegateTarget: util.YesNo(e.NegateTarget),
+		}
+		ans.TargetInfo = nfo
+	}
+	gs := e.Virus != "" || e.Spyware != "" || e.Vulnerability != "" || e.UrlFiltering != "" || e.FileBlocking != "" || e.WildFireAnalysis != "" || e.DataFiltering != ""
+	if e.Group != "" || gs {
+		ps := &profileSettings{
+			Group: util.OneStrToMem(e.Group),
+		}
+		if gs {
+			ps.Profiles = &profileSettingsProfile{
+				util.OneStrToMem(e.Virus),
+				util.OneStrToMem(e.Spyware),
+				util.OneStrToMem(e.Vulnerability),
+				util.OneStrToMem(e.UrlFiltering),
+				util.OneStrToMem(e.FileBlocking),
+				util.OneStrToMem(e.WildFireAnalysis),
+				util.OneStrToMem(e.DataFiltering),
+			}
+		}
+		ans.ProfileSettings = ps
+	}
+
+	return ans
+}
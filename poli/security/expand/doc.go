@@ -0,0 +1,16 @@
+/*
+Package expand resolves security rules into the effective network tuples that
+they match.
+
+A Resolver is built from the address and service objects (and groups) that a
+rule may reference.  Expanding a rule resolves every referenced name down to
+IP prefixes and protocol / port ranges, producing one Tuple for each
+combination of source, destination, service, and application.  This allows
+connectivity questions ("is 10.1.1.5 allowed to reach 10.2.2.2 on tcp/443?")
+to be answered without a live firewall.
+
+Dynamic address groups, FQDN and wildcard address objects, regions, and
+external dynamic lists cannot be resolved client side.  These are reported as
+unresolved instead of causing an error.
+*/
+package expand
@@ -0,0 +1,333 @@
+package expand
+
+import (
+	"net/netip"
+	"strings"
+
+	"github.com/PaloAltoNetworks/pango/objs/addr"
+	"github.com/PaloAltoNetworks/pango/objs/addrgrp"
+	"github.com/PaloAltoNetworks/pango/objs/srvc"
+	"github.com/PaloAltoNetworks/pango/objs/srvcgrp"
+	"github.com/PaloAltoNetworks/pango/poli/security"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Special Tuple.Protocol values.
+const (
+	ProtocolAny                = "any"
+	ProtocolApplicationDefault = "application-default"
+)
+
+// Objects are the objects that rules are resolved against.
+//
+// For Panorama, objects from shared should be listed before objects from the
+// device group, as later objects with the same name take precedence.
+type Objects struct {
+	Addresses     []addr.Entry
+	AddressGroups []addrgrp.Entry
+	Services      []srvc.Entry
+	ServiceGroups []srvcgrp.Entry
+}
+
+// Service is a resolved service:  a protocol and a destination port range.
+//
+// If Protocol is ProtocolAny or ProtocolApplicationDefault, then Ports is the
+// zero value.
+type Service struct {
+	Protocol string
+	Ports    srvc.PortRange
+}
+
+// Tuple is a single effective match of a security rule.
+type Tuple struct {
+	Source      netip.Prefix
+	Destination netip.Prefix
+	Service     Service
+	Application string
+}
+
+// Expansion is the result of expanding a security rule.
+//
+// SourceDevices and DestinationDevices are the device objects that the rule
+// is limited to, which are not resolved.  An empty list means any device.
+type Expansion struct {
+	Rule               string
+	Action             string
+	Tuples             []Tuple
+	SourceDevices      []string
+	DestinationDevices []string
+
+	// Unresolved are the names referenced by the rule (directly or through a
+	// group) that could not be resolved.  Tuples only reflect the parts of
+	// the rule that could be resolved.
+	Unresolved []string
+}
+
+// Resolver resolves object names to their values.
+type Resolver struct {
+	addrs    map[string]addr.Entry
+	addrGrps map[string]addrgrp.Entry
+	srvcs    map[string]srvc.Entry
+	srvcGrps map[string]srvcgrp.Entry
+}
+
+// NewResolver returns a resolver for the given objects.
+//
+// The predefined service objects are included automatically.
+func NewResolver(o Objects) *Resolver {
+	ans := &Resolver{
+		addrs:    make(map[string]addr.Entry, len(o.Addresses)),
+		addrGrps: make(map[string]addrgrp.Entry, len(o.AddressGroups)),
+		srvcs:    make(map[string]srvc.Entry, len(o.Services)+2),
+		srvcGrps: make(map[string]srvcgrp.Entry, len(o.ServiceGroups)),
+	}
+
+	for _, e := range o.Addresses {
+		ans.addrs[e.Name] = e
+	}
+	for _, e := range o.AddressGroups {
+		ans.addrGrps[e.Name] = e
+	}
+	for _, e := range srvc.Predefined() {
+		ans.srvcs[e.Name] = e
+	}
+	for _, e := range o.Services {
+		ans.srvcs[e.Name] = e
+	}
+	for _, e := range o.ServiceGroups {
+		ans.srvcGrps[e.Name] = e
+	}
+
+	return ans
+}
+
+// Addresses resolves the given address names into a merged list of prefixes,
+// along with any names that could not be resolved.
+//
+// Names may be address objects, static address groups, "any", or literal IP
+// addresses, netmasks, or ranges.
+func (r *Resolver) Addresses(names []string) ([]netip.Prefix, []string) {
+	var ans []netip.Prefix
+	var unresolved []string
+
+	seen := make(map[string]bool)
+	var walk func(string)
+	walk = func(name string) {
+		if seen[name] {
+			return
+		}
+		seen[name] = true
+
+		if name == "any" {
+			ans = append(ans, util.ComplementPrefixes(nil)...)
+		} else if e, ok := r.addrs[name]; ok {
+			if list, err := e.Prefixes(); err == nil {
+				ans = append(ans, list...)
+			} else {
+				unresolved = append(unresolved, name)
+			}
+		} else if g, ok := r.addrGrps[name]; ok {
+			if g.DynamicMatch != "" {
+				unresolved = append(unresolved, name)
+				return
+			}
+			for _, m := range g.StaticAddresses {
+				walk(m)
+			}
+		} else if list, ok := literalPrefixes(name); ok {
+			ans = append(ans, list...)
+		} else {
+			unresolved = append(unresolved, name)
+		}
+	}
+
+	if len(names) == 0 {
+		walk("any")
+	}
+	for _, name := range names {
+		walk(name)
+	}
+
+	return util.MergePrefixes(ans), unresolved
+}
+
+// Services resolves the given service names into a list of services, along
+// with any names that could not be resolved.
+//
+// Names may be service objects, service groups, "any", or
+// "application-default".
+func (r *Resolver) Services(names []string) ([]Service, []string) {
+	var ans []Service
+	var unresolved []string
+
+	seen := make(map[string]bool)
+	var walk func(string)
+	walk = func(name string) {
+		if seen[name] {
+			return
+		}
+		seen[name] = true
+
+		if name == ProtocolAny || name == ProtocolApplicationDefault {
+			ans = append(ans, Service{Protocol: name})
+		} else if e, ok := r.srvcs[name]; ok {
+			ports, err := e.DestinationPorts()
+			if err != nil || len(ports) == 0 {
+				unresolved = append(unresolved, name)
+				return
+			}
+			for _, p := range ports {
+				ans = append(ans, Service{Protocol: e.Protocol, Ports: p})
+			}
+		} else if g, ok := r.srvcGrps[name]; ok {
+			for _, m := range g.Services {
+				walk(m)
+			}
+		} else {
+			unresolved = append(unresolved, name)
+		}
+	}
+
+	if len(names) == 0 {
+		walk(ProtocolAny)
+	}
+	for _, name := range names {
+		walk(name)
+	}
+
+	return dedupServices(ans), unresolved
+}
+
+// Rule expands the given security rule into its effective tuples.
+//
+// Negated source or destination addresses are expanded to the complement of
+// the referenced addresses.  The number of tuples is the product of the
+// number of source prefixes, destination prefixes, services, and
+// applications, so expanding broad rules can produce a large result.
+func (r *Resolver) Rule(e security.Entry) Expansion {
+	ans := Expansion{
+		Rule:               e.Name,
+		Action:             e.Action,
+		SourceDevices:      devices(e.SourceDevices),
+		DestinationDevices: devices(e.DestinationDevices),
+	}
+	if ans.Action == "" {
+		ans.Action = "allow"
+	}
+
+	src, u1 := r.Addresses(e.SourceAddresses)
+	if e.NegateSource {
+		src = util.ComplementPrefixes(src)
+	}
+	dst, u2 := r.Addresses(e.DestinationAddresses)
+	if e.NegateDestination {
+		dst = util.ComplementPrefixes(dst)
+	}
+	svcs, u3 := r.Services(e.Services)
+
+	apps := e.Applications
+	if len(apps) == 0 {
+		apps = []string{"any"}
+	}
+
+	ans.Unresolved = append(append(u1, u2...), u3...)
+	for _, s := range src {
+		for _, d := range dst {
+			for _, svc := range svcs {
+				for _, app := range apps {
+					ans.Tuples = append(ans.Tuples, Tuple{
+						Source:      s,
+						Destination: d,
+						Service:     svc,
+						Application: app,
+					})
+				}
+			}
+		}
+	}
+
+	return ans
+}
+
+// Matches returns the tuples that match the given connection.
+//
+// The protocol and port are only compared against tuples with an explicit
+// service; tuples with a service of "any" or "application-default" always
+// match.  An empty app matches any application.
+func (o Expansion) Matches(src, dst netip.Addr, protocol string, port int, app string) []Tuple {
+	var ans []Tuple
+	for _, t := range o.Tuples {
+		if !t.Source.Contains(src) || !t.Destination.Contains(dst) {
+			continue
+		}
+		switch t.Service.Protocol {
+		case ProtocolAny, ProtocolApplicationDefault:
+		default:
+			if !strings.EqualFold(t.Service.Protocol, protocol) || !t.Service.Ports.Contains(port) {
+				continue
+			}
+		}
+		if app != "" && t.Application != "any" && t.Application != app {
+			continue
+		}
+		ans = append(ans, t)
+	}
+
+	return ans
+}
+
+// MatchesDevices returns true if the rule applies to traffic between the given
+// device objects.  An empty device matches any device.
+func (o Expansion) MatchesDevices(src, dst string) bool {
+	return hasDevice(o.SourceDevices, src) && hasDevice(o.DestinationDevices, dst)
+}
+
+// devices returns the given device list, or nil if it means any device.
+func devices(list []string) []string {
+	for _, v := range list {
+		if v == "any" {
+			return nil
+		}
+	}
+
+	return list
+}
+
+func hasDevice(list []string, v string) bool {
+	if len(list) == 0 {
+		return true
+	} else if v == "" {
+		return false
+	}
+
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func literalPrefixes(v string) ([]netip.Prefix, bool) {
+	e := addr.Entry{Name: v, Value: v, Type: addr.IpNetmask}
+	if strings.Contains(v, "-") {
+		e.Type = addr.IpRange
+	}
+
+	list, err := e.Prefixes()
+	return list, err == nil
+}
+
+func dedupServices(list []Service) []Service {
+	seen := make(map[Service]bool, len(list))
+	ans := make([]Service, 0, len(list))
+	for _, s := range list {
+		if !seen[s] {
+			seen[s] = true
+			ans = append(ans, s)
+		}
+	}
+
+	return ans
+}
@@ -0,0 +1,171 @@
+package expand
+
+import (
+	"net/netip"
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/objs/addr"
+	"github.com/PaloAltoNetworks/pango/objs/addrgrp"
+	"github.com/PaloAltoNetworks/pango/objs/srvc"
+	"github.com/PaloAltoNetworks/pango/objs/srvcgrp"
+	"github.com/PaloAltoNetworks/pango/poli/security"
+)
+
+func testResolver() *Resolver {
+	return NewResolver(Objects{
+		Addresses: []addr.Entry{
+			{Name: "web1", Type: addr.IpNetmask, Value: "10.2.2.10"},
+			{Name: "web2", Type: addr.IpNetmask, Value: "10.2.2.11"},
+			{Name: "users", Type: addr.IpRange, Value: "10.1.1.0-10.1.1.127"},
+			{Name: "site", Type: addr.Fqdn, Value: "example.com"},
+		},
+		AddressGroups: []addrgrp.Entry{
+			{Name: "web", StaticAddresses: []string{"web1", "web2", "web"}},
+			{Name: "tagged", DynamicMatch: "'web'"},
+		},
+		Services: []srvc.Entry{
+			{Name: "alt-https", Protocol: srvc.ProtocolTcp, DestinationPort: "8443"},
+			{Name: "dns", Protocol: srvc.ProtocolUdp, DestinationPort: "53"},
+		},
+		ServiceGroups: []srvcgrp.Entry{
+			{Name: "https", Services: []string{"service-https", "alt-https"}},
+		},
+	})
+}
+
+func prefixes(v ...string) []netip.Prefix {
+	var ans []netip.Prefix
+	for _, s := range v {
+		ans = append(ans, netip.MustParsePrefix(s))
+	}
+	return ans
+}
+
+func TestAddresses(t *testing.T) {
+	r := testResolver()
+
+	testCases := []struct {
+		desc       string
+		names      []string
+		prefixes   []netip.Prefix
+		unresolved []string
+	}{
+		{"static group", []string{"web"}, prefixes("10.2.2.10/31"), nil},
+		{"range", []string{"users"}, prefixes("10.1.1.0/25"), nil},
+		{"literals", []string{"192.168.1.0/24", "192.168.2.1-192.168.2.2"}, prefixes("192.168.1.0/24", "192.168.2.1/32", "192.168.2.2/32"), nil},
+		{"any", []string{"any"}, prefixes("0.0.0.0/0", "::/0"), nil},
+		{"unresolved", []string{"web1", "site", "tagged", "missing"}, prefixes("10.2.2.10/32"), []string{"site", "tagged", "missing"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			p, u := r.Addresses(tc.names)
+			if !reflect.DeepEqual(p, tc.prefixes) {
+				t.Errorf("Prefixes: %v != %v", p, tc.prefixes)
+			}
+			if !reflect.DeepEqual(u, tc.unresolved) {
+				t.Errorf("Unresolved: %#v != %#v", u, tc.unresolved)
+			}
+		})
+	}
+}
+
+func TestServices(t *testing.T) {
+	r := testResolver()
+
+	s, u := r.Services([]string{"https", "service-http", "dns", "bogus"})
+	expected := []Service{
+		{Protocol: "tcp", Ports: srvc.PortRange{443, 443}},
+		{Protocol: "tcp", Ports: srvc.PortRange{8443, 8443}},
+		{Protocol: "tcp", Ports: srvc.PortRange{80, 80}},
+		{Protocol: "tcp", Ports: srvc.PortRange{8080, 8080}},
+		{Protocol: "udp", Ports: srvc.PortRange{53, 53}},
+	}
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("Services: %#v", s)
+	}
+	if !reflect.DeepEqual(u, []string{"bogus"}) {
+		t.Errorf("Unresolved: %#v", u)
+	}
+}
+
+func TestRule(t *testing.T) {
+	r := testResolver()
+
+	e := security.Entry{
+		Name:                 "users-to-web",
+		SourceAddresses:      []string{"users"},
+		DestinationAddresses: []string{"web", "tagged"},
+		Services:             []string{"https"},
+		Applications:         []string{"ssl", "web-browsing"},
+	}
+	ans := r.Rule(e)
+
+	if ans.Action != "allow" {
+		t.Errorf("Action is %q", ans.Action)
+	}
+	if len(ans.Tuples) != 4 {
+		t.Fatalf("Got %d tuples", len(ans.Tuples))
+	}
+	if !reflect.DeepEqual(ans.Unresolved, []string{"tagged"}) {
+		t.Errorf("Unresolved: %#v", ans.Unresolved)
+	}
+
+	src := netip.MustParseAddr("10.1.1.5")
+	dst := netip.MustParseAddr("10.2.2.11")
+	if m := ans.Matches(src, dst, "tcp", 443, "ssl"); len(m) != 1 {
+		t.Errorf("Expected one match, got %#v", m)
+	}
+	if m := ans.Matches(src, dst, "tcp", 22, ""); len(m) != 0 {
+		t.Errorf("Expected no matches, got %#v", m)
+	}
+	if m := ans.Matches(netip.MustParseAddr("10.1.1.200"), dst, "tcp", 443, ""); len(m) != 0 {
+		t.Errorf("Expected no matches, got %#v", m)
+	}
+}
+
+func TestRuleDevices(t *testing.T) {
+	r := testResolver()
+
+	ans := r.Rule(security.Entry{
+		Name:               "printers",
+		SourceDevices:      []string{"printers"},
+		DestinationDevices: []string{"any"},
+	})
+
+	if !reflect.DeepEqual(ans.SourceDevices, []string{"printers"}) || ans.DestinationDevices != nil {
+		t.Errorf("Devices: %#v / %#v", ans.SourceDevices, ans.DestinationDevices)
+	}
+	if !ans.MatchesDevices("printers", "cameras") {
+		t.Errorf("Printers do not match")
+	}
+	if ans.MatchesDevices("cameras", "") {
+		t.Errorf("Cameras match")
+	}
+	if ans.MatchesDevices("", "") {
+		t.Errorf("Unknown device matches")
+	}
+}
+
+func TestRuleNegated(t *testing.T) {
+	r := testResolver()
+
+	ans := r.Rule(security.Entry{
+		Name:            "not-users",
+		SourceAddresses: []string{"users"},
+		NegateSource:    true,
+		Action:          "deny",
+	})
+
+	src := map[string]bool{}
+	for _, x := range ans.Tuples {
+		src[x.Source.String()] = true
+	}
+	if src["10.1.1.0/25"] || !src["10.1.1.128/25"] || !src["::/0"] {
+		t.Errorf("Sources: %v", src)
+	}
+	if m := ans.Matches(netip.MustParseAddr("10.1.1.5"), netip.MustParseAddr("8.8.8.8"), "udp", 53, ""); len(m) != 0 {
+		t.Errorf("Negated source matched: %#v", m)
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/PaloAltoNetworks/pango/namespace"
 	"github.com/PaloAltoNetworks/pango/util"
+	"github.com/PaloAltoNetworks/pango/version"
 )
 
 // FwSecurity is the client.Policies.Security namespace.
@@ -72,6 +73,44 @@ func (c *FwSecurity) ShowAll(vsys string) ([]Entry, error) {
 	return result.Normalize(), nil
 }
 
+// GetAllUsing retrieves all security policies using the given pango query type:
+// util.Get for the candidate config, util.Show for the running config, or
+// util.EffectiveRunning for the running config merged with any config pushed
+// from Panorama.
+func (c *FwSecurity) GetAllUsing(qt, vsys string) ([]Entry, error) {
+	result, _ := c.versioning()
+	if err := c.ns.Objects(qt, c.xpath(vsys, nil), result); err != nil {
+		return nil, err
+	}
+
+	return result.Normalize(), nil
+}
+
+// GetAllPushed retrieves all security policies in the given rulebase that Panorama has
+// pushed to the firewall.
+//
+// The base param should be util.PreRulebase or util.PostRulebase, and
+// defaults to util.PreRulebase if unspecified.
+func (c *FwSecurity) GetAllPushed(vsys, base string) ([]Entry, error) {
+	if base == "" {
+		base = util.PreRulebase
+	}
+
+	path := c.xpath(vsys, nil)
+	for i := range path {
+		if path[i] == util.Rulebase {
+			path[i] = base
+		}
+	}
+
+	result, _ := c.versioning()
+	if err := c.ns.Objects(util.PushedSharedPolicy, path, result); err != nil {
+		return nil, err
+	}
+
+	return result.Normalize(), nil
+}
+
 // Set performs SET to create / update one or more security policies.
 func (c *FwSecurity) Set(vsys string, e ...Entry) error {
 	var err error
@@ -213,6 +252,39 @@ func (c *FwSecurity) DeleteAll(vsys string) error {
 	return c.Delete(vsys, li...)
 }
 
+// Clone retrieves the security policy `name`, then creates a copy of it named
+// `newName`.  If `overrides` is not nil, it is called on the copy before it
+// is created so that fields can be changed.
+//
+// The `movement` param positions the copy relative to the original, and
+// should be one of the Move constants in the util package.  Use
+// util.MoveSkip to leave the copy wherever PAN-OS puts it.
+func (c *FwSecurity) Clone(vsys string, name, newName string, movement int, overrides func(*Entry)) (Entry, error) {
+	if e, err := c.Get(vsys, newName); err == nil && e.Name == newName {
+		return Entry{}, fmt.Errorf("security policy %q already exists", newName)
+	}
+
+	e, err := c.Get(vsys, name)
+	if err != nil {
+		return Entry{}, err
+	}
+	e.Name = newName
+	if overrides != nil {
+		overrides(&e)
+	}
+
+	if err = c.Set(vsys, e); err != nil {
+		return Entry{}, err
+	}
+	if movement != util.MoveSkip {
+		if err = c.MoveGroup(vsys, movement, name, e); err != nil {
+			return e, err
+		}
+	}
+
+	return e, nil
+}
+
 // MoveGroup moves a logical group of security policies somewhere in relation
 // to another security policy.
 //
@@ -240,10 +312,52 @@ func (c *FwSecurity) MoveGroup(vsys string, movement int, rule string, e ...Entr
 	return c.ns.MoveGroup(pather, lister, movement, rule, names)
 }
 
+// Move moves the named security policies somewhere in relation to another rule,
+// keeping them grouped together in the order given.
+//
+// This is the same as MoveGroup, but takes rule names instead of Entry
+// objects.  All named rules, as well as the `rule` being referenced, must
+// already exist.
+func (c *FwSecurity) Move(vsys string, movement int, rule string, names ...string) error {
+	pather := func(v string) []string {
+		return c.xpath(vsys, []string{v})
+	}
+
+	lister := func() ([]string, error) {
+		return c.GetList(vsys)
+	}
+
+	return c.ns.MoveGroup(pather, lister, movement, rule, names)
+}
+
+// Reorder moves security policies so that the given rules are at the top of the
+// rulebase in the given order.
+//
+// Rules not named are left after the named rules, in their current relative
+// order, so passing in the names of every rule reorders the entire rulebase.
+// Only rules that are out of place are moved.
+func (c *FwSecurity) Reorder(vsys string, names ...string) error {
+	pather := func(v string) []string {
+		return c.xpath(vsys, []string{v})
+	}
+
+	lister := func() ([]string, error) {
+		return c.GetList(vsys)
+	}
+
+	return c.ns.Reorder(pather, lister, names)
+}
+
 /** Internal functions for the FwSecurity struct **/
 
 func (c *FwSecurity) versioning() (normalizer, func(Entry) interface{}) {
-	return &container_v1{}, specify_v1
+	v := c.con.Versioning()
+
+	if v.Gte(version.Number{10, 0, 0, ""}) {
+		return &container_v2{}, specify_v2
+	} else {
+		return &container_v1{}, specify_v1
+	}
 }
 
 func (c *FwSecurity) xpath(vsys string, vals []string) []string {
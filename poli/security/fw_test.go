@@ -1,10 +1,12 @@
 package security
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 
 	"github.com/PaloAltoNetworks/pango/testdata"
+	"github.com/PaloAltoNetworks/pango/util"
 )
 
 func TestFwNormalization(t *testing.T) {
@@ -37,3 +39,95 @@ func TestFwNormalization(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkNormalize(b *testing.B) {
+	e := entry_v1{
+		Name:                 "rule",
+		Type:                 "universal",
+		Tags:                 util.StrToMem([]string{"tag1", "tag2"}),
+		SourceZones:          util.StrToMem([]string{"trust"}),
+		DestinationZones:     util.StrToMem([]string{"untrust"}),
+		SourceAddresses:      util.StrToMem([]string{"10.1.1.0/24", "10.1.2.0/24"}),
+		SourceUsers:          util.StrToMem([]string{"any"}),
+		HipProfiles:          util.StrToMem([]string{"any"}),
+		DestinationAddresses: util.StrToMem([]string{"any"}),
+		Applications:         util.StrToMem([]string{"web-browsing", "ssl"}),
+		Services:             util.StrToMem([]string{"application-default"}),
+		Categories:           util.StrToMem([]string{"any"}),
+		Action:               "allow",
+	}
+	o := &container_v1{Answer: make([]entry_v1, 1000)}
+	for i := range o.Answer {
+		o.Answer[i] = e
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = o.Normalize()
+	}
+}
+
+func TestFwClone(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &FwSecurity{}
+	ns.Initialize(mc)
+
+	mc.Resp = []testdata.Response{{Raw: []byte(`<response status="error"/>`), Error: fmt.Errorf("No such node")}}
+	mc.AddResp(`<entry name="web"><action>allow</action><application><member>ssl</member></application></entry>`)
+	mc.AddResp("")
+	e, err := ns.Clone("", "web", "web-deny", util.MoveSkip, func(e *Entry) {
+		e.Action = "deny"
+	})
+	if err != nil {
+		t.Fatalf("Error in clone: %s", err)
+	}
+
+	if e.Name != "web-deny" || e.Action != "deny" || !reflect.DeepEqual(e.Applications, []string{"ssl"}) {
+		t.Errorf("Clone is %#v", e)
+	}
+	if mc.Function != "set" || mc.Called != 3 {
+		t.Errorf("Last call was %s after %d calls", mc.Function, mc.Called)
+	}
+}
+
+func TestFwGetAllPushed(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &FwSecurity{}
+	ns.Initialize(mc)
+
+	mc.AddResp(`<rules><entry name="pano-web"><action>allow</action></entry></rules>`)
+	list, err := ns.GetAllPushed("", util.PostRulebase)
+	if err != nil {
+		t.Fatalf("Error in retrieval: %s", err)
+	}
+
+	if mc.Function != util.PushedSharedPolicy {
+		t.Errorf("Function is %q", mc.Function)
+	}
+	expectedPath := "/config/devices/entry[@name='localhost.localdomain']/vsys/entry[@name='vsys1']/post-rulebase/security/rules"
+	if mc.Path != expectedPath {
+		t.Errorf("Path is %q, not %q", mc.Path, expectedPath)
+	}
+	if len(list) != 1 || list[0].Name != "pano-web" || list[0].Action != "allow" {
+		t.Errorf("Unexpected entries: %#v", list)
+	}
+}
+
+func TestIds(t *testing.T) {
+	id := PanoId("dg1", util.PostRulebase, "allow: web")
+	if id != "dg1:post-rulebase:allow%3A web" {
+		t.Errorf("Pano ID is %q", id)
+	}
+
+	dg, base, name, err := ParsePanoId(id)
+	if err != nil {
+		t.Fatalf("Error parsing %q: %s", id, err)
+	} else if dg != "dg1" || base != util.PostRulebase || name != "allow: web" {
+		t.Errorf("Parsed %q, %q, %q", dg, base, name)
+	}
+
+	if _, _, err = ParseFwId(id); err == nil {
+		t.Errorf("No error parsing pano ID as fw ID")
+	}
+}
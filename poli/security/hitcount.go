@@ -0,0 +1,222 @@
+package security
+
+import (
+	"encoding/xml"
+	"strings"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/poli/hitcount"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// HitCount is the hit counter data for a single security rule.
+//
+// Timestamps that PAN-OS reports as zero (such as the last hit of a rule that
+// has never been matched) are left as the zero time.
+type HitCount struct {
+	// Name is the rule name.
+	Name string
+
+	// Device is the serial number of the firewall that reported the hit
+	// counts.  This is only set for Panorama, which reports hit counts for
+	// each device that the rule was pushed to.
+	Device string
+
+	// Vsys is the vsys that the rule is in.
+	Vsys string
+
+	HitCount  uint64
+	LastHit   time.Time
+	FirstHit  time.Time
+	LastReset time.Time
+	Created   time.Time
+	Modified  time.Time
+}
+
+// Unused returns true if this rule has not been hit since it was created or
+// since its hit count was last reset.
+func (o HitCount) Unused() bool {
+	return o.HitCount == 0
+}
+
+// HitCounts runs "show rule-hit-count" and returns the hit counter data for
+// the given rules in the specified vsys.
+//
+// If no rules are specified, then the hit counters of all rules are returned.
+func (c *FwSecurity) HitCounts(vsys string, rules ...string) ([]HitCount, error) {
+	if vsys == "" {
+		vsys = "vsys1"
+	}
+
+	req := fwHitCountReq{
+		Vsys: fwHitCountVsys{
+			Name:     vsys,
+			Rulebase: hitCountRulebase{Name: "security", Rules: hitCountRules(rules)},
+		},
+	}
+
+	c.con.LogOp("(op) retrieving %s hit counts in %q", singular, vsys)
+	var resp fwHitCountResp
+	if _, err := c.con.Op(req, "", nil, &resp); err != nil {
+		return nil, err
+	}
+
+	ans := make([]HitCount, 0, len(resp.Rules))
+	for _, x := range resp.Rules {
+		ans = append(ans, x.normalize(x.Name, "", vsys))
+	}
+
+	return ans, nil
+}
+
+// ResetHitCounts clears the hit counters of the given rules in the specified
+// vsys.
+//
+// If no rules are specified, then the hit counters of all rules are cleared.
+//
+// This is the same as the client.Policies.HitCount namespace's Reset() for
+// the security rulebase.
+func (c *FwSecurity) ResetHitCounts(vsys string, rules ...string) error {
+	hc := &hitcount.FwHitCount{}
+	hc.Initialize(c.con)
+
+	return hc.Reset(vsys, hitcount.Security, rules...)
+}
+
+// HitCounts runs "show rule-hit-count" and returns the hit counter data for
+// the given rules in the specified device group and rulebase.
+//
+// Panorama reports the hit counts for each device that the rules are pushed
+// to, so there is one HitCount returned per rule per device / vsys.
+//
+// If no rules are specified, then the hit counters of all rules are returned.
+//
+// Hit counters are maintained by the firewalls themselves, so they can only
+// be reset by FwSecurity.ResetHitCounts on each firewall.
+func (c *PanoSecurity) HitCounts(dg, base string, rules ...string) ([]HitCount, error) {
+	if dg == "" {
+		dg = "shared"
+	}
+	if base == "" {
+		base = util.PreRulebase
+	}
+
+	req := panoHitCountReq{
+		Dg: panoHitCountDg{
+			Name: dg,
+			Rulebase: panoHitCountBase{
+				XMLName: xml.Name{Local: base},
+				Entry:   hitCountRulebase{Name: "security", Rules: hitCountRules(rules)},
+			},
+		},
+	}
+
+	c.con.LogOp("(op) retrieving %s %s hit counts in %q", base, singular, dg)
+	var resp panoHitCountResp
+	if _, err := c.con.Op(req, "", nil, &resp); err != nil {
+		return nil, err
+	}
+
+	ans := make([]HitCount, 0, len(resp.Rules))
+	for _, rule := range resp.Rules {
+		for _, x := range rule.Devices {
+			device, vsys := x.Name, ""
+			if idx := strings.LastIndex(x.Name, "/"); idx != -1 {
+				device, vsys = x.Name[:idx], x.Name[idx+1:]
+			}
+			ans = append(ans, x.normalize(rule.Name, device, vsys))
+		}
+	}
+
+	return ans, nil
+}
+
+/** Structs for the hit count op commands. **/
+
+func hitCountRules(rules []string) hitCountRuleList {
+	if len(rules) == 0 {
+		return hitCountRuleList{All: &struct{}{}}
+	}
+
+	return hitCountRuleList{List: util.StrToMem(rules)}
+}
+
+type hitCountRuleList struct {
+	All  *struct{}        `xml:"all"`
+	List *util.MemberType `xml:"list"`
+}
+
+type hitCountRulebase struct {
+	Name  string           `xml:"name,attr"`
+	Rules hitCountRuleList `xml:"rules"`
+}
+
+type fwHitCountVsys struct {
+	Name     string           `xml:"name,attr"`
+	Rulebase hitCountRulebase `xml:"rule-base>entry"`
+}
+
+type fwHitCountReq struct {
+	XMLName xml.Name       `xml:"show"`
+	Vsys    fwHitCountVsys `xml:"rule-hit-count>vsys>vsys-name>entry"`
+}
+
+type fwHitCountResp struct {
+	Rules []hitCountEntry `xml:"result>rule-hit-count>vsys>entry>rule-base>entry>rules>entry"`
+}
+
+type panoHitCountBase struct {
+	XMLName xml.Name
+	Entry   hitCountRulebase `xml:"entry"`
+}
+
+type panoHitCountDg struct {
+	Name     string           `xml:"name,attr"`
+	Rulebase panoHitCountBase `xml:",any"`
+}
+
+type panoHitCountReq struct {
+	XMLName xml.Name       `xml:"show"`
+	Dg      panoHitCountDg `xml:"rule-hit-count>device-group>entry"`
+}
+
+type panoHitCountResp struct {
+	Rules []panoHitCountRule `xml:"result>rule-hit-count>device-group>entry>rule-base>entry>rules>entry"`
+}
+
+type panoHitCountRule struct {
+	Name    string          `xml:"name,attr"`
+	Devices []hitCountEntry `xml:"device-vsys>entry"`
+}
+
+type hitCountEntry struct {
+	Name      string `xml:"name,attr"`
+	HitCount  uint64 `xml:"hit-count"`
+	LastHit   int64  `xml:"last-hit-timestamp"`
+	FirstHit  int64  `xml:"first-hit-timestamp"`
+	LastReset int64  `xml:"last-reset-timestamp"`
+	Created   int64  `xml:"rule-creation-timestamp"`
+	Modified  int64  `xml:"rule-modification-timestamp"`
+}
+
+func (o hitCountEntry) normalize(name, device, vsys string) HitCount {
+	return HitCount{
+		Name:      name,
+		Device:    device,
+		Vsys:      vsys,
+		HitCount:  o.HitCount,
+		LastHit:   asTimestamp(o.LastHit),
+		FirstHit:  asTimestamp(o.FirstHit),
+		LastReset: asTimestamp(o.LastReset),
+		Created:   asTimestamp(o.Created),
+		Modified:  asTimestamp(o.Modified),
+	}
+}
+
+func asTimestamp(v int64) time.Time {
+	if v == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(v, 0)
+}
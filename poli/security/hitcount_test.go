@@ -0,0 +1,104 @@
+package security
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestFwHitCounts(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &FwSecurity{}
+	ns.Initialize(mc)
+
+	mc.AddResp(`<rule-hit-count><vsys><entry name="vsys2"><rule-base><entry name="security"><rules><entry name="r1"><latest>yes</latest><hit-count>42</hit-count><last-hit-timestamp>1600000100</last-hit-timestamp><last-reset-timestamp>0</last-reset-timestamp><first-hit-timestamp>1600000000</first-hit-timestamp><rule-creation-timestamp>1500000000</rule-creation-timestamp><rule-modification-timestamp>1550000000</rule-modification-timestamp></entry><entry name="r2"><latest>yes</latest><hit-count>0</hit-count><last-hit-timestamp>0</last-hit-timestamp><last-reset-timestamp>0</last-reset-timestamp><first-hit-timestamp>0</first-hit-timestamp><rule-creation-timestamp>1500000000</rule-creation-timestamp><rule-modification-timestamp>1500000000</rule-modification-timestamp></entry></rules></entry></rule-base></entry></vsys></rule-hit-count>`)
+
+	ans, err := ns.HitCounts("vsys2", "r1", "r2")
+	if err != nil {
+		t.Fatalf("Error in hit counts: %s", err)
+	}
+
+	req := `<show><rule-hit-count><vsys><vsys-name><entry name="vsys2"><rule-base><entry name="security"><rules><list><member>r1</member><member>r2</member></list></rules></entry></rule-base></entry></vsys-name></vsys></rule-hit-count></show>`
+	if mc.Elm != req {
+		t.Errorf("Sent %s, expected %s", mc.Elm, req)
+	}
+
+	expected := []HitCount{
+		{
+			Name:     "r1",
+			Vsys:     "vsys2",
+			HitCount: 42,
+			LastHit:  time.Unix(1600000100, 0),
+			FirstHit: time.Unix(1600000000, 0),
+			Created:  time.Unix(1500000000, 0),
+			Modified: time.Unix(1550000000, 0),
+		},
+		{
+			Name:     "r2",
+			Vsys:     "vsys2",
+			Created:  time.Unix(1500000000, 0),
+			Modified: time.Unix(1500000000, 0),
+		},
+	}
+	if !reflect.DeepEqual(ans, expected) {
+		t.Errorf("Got %#v, expected %#v", ans, expected)
+	}
+	if ans[0].Unused() || !ans[1].Unused() {
+		t.Errorf("Unused is incorrect")
+	}
+}
+
+func TestFwResetHitCounts(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &FwSecurity{}
+	ns.Initialize(mc)
+
+	mc.AddResp("")
+	if err := ns.ResetHitCounts(""); err != nil {
+		t.Fatalf("Error in reset: %s", err)
+	}
+
+	req := `<clear><rule-hit-count><vsys><vsys-name><entry name="vsys1"><rule-base><entry name="security"><rules><all></all></rules></entry></rule-base></entry></vsys-name></vsys></rule-hit-count></clear>`
+	if mc.Elm != req {
+		t.Errorf("Sent %s, expected %s", mc.Elm, req)
+	}
+}
+
+func TestPanoHitCounts(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &PanoSecurity{}
+	ns.Initialize(mc)
+
+	mc.AddResp(`<rule-hit-count><device-group><entry name="dg1"><rule-base><entry name="security"><rules><entry name="r1"><device-vsys><entry name="0001/vsys1"><hit-count>5</hit-count><last-hit-timestamp>1600000100</last-hit-timestamp><last-reset-timestamp>0</last-reset-timestamp><first-hit-timestamp>1600000000</first-hit-timestamp><rule-creation-timestamp>0</rule-creation-timestamp><rule-modification-timestamp>0</rule-modification-timestamp></entry><entry name="0002/vsys3"><hit-count>0</hit-count></entry></device-vsys></entry></rules></entry></rule-base></entry></device-group></rule-hit-count>`)
+
+	ans, err := ns.HitCounts("dg1", "post-rulebase")
+	if err != nil {
+		t.Fatalf("Error in hit counts: %s", err)
+	}
+
+	req := `<show><rule-hit-count><device-group><entry name="dg1"><post-rulebase><entry name="security"><rules><all></all></rules></entry></post-rulebase></entry></device-group></rule-hit-count></show>`
+	if mc.Elm != req {
+		t.Errorf("Sent %s, expected %s", mc.Elm, req)
+	}
+
+	expected := []HitCount{
+		{
+			Name:     "r1",
+			Device:   "0001",
+			Vsys:     "vsys1",
+			HitCount: 5,
+			LastHit:  time.Unix(1600000100, 0),
+			FirstHit: time.Unix(1600000000, 0),
+		},
+		{
+			Name:   "r1",
+			Device: "0002",
+			Vsys:   "vsys3",
+		},
+	}
+	if !reflect.DeepEqual(ans, expected) {
+		t.Errorf("Got %#v, expected %#v", ans, expected)
+	}
+}
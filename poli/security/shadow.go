@@ -0,0 +1,157 @@
+package security
+
+// Shadow describes a security rule that can never match traffic because an
+// earlier rule matches everything that it would.
+type Shadow struct {
+	// Rule is the name of the rule that is shadowed.
+	Rule string
+
+	// By is the name of the earlier rule that shadows Rule.
+	By string
+
+	// Redundant is true if both rules have the same action, meaning that
+	// Rule can be removed without changing the behavior of the rulebase.
+	// If this is false, then Rule is a conflict:  it was likely intended to
+	// take a different action on a subset of the earlier rule's traffic.
+	Redundant bool
+}
+
+// FindShadowedRules analyzes the given rules, which should be in evaluation
+// order, and returns the rules that are shadowed by an earlier rule.
+//
+// Analysis is done on the names referenced by each rule; address objects,
+// groups, and so on are not resolved to their values.  A rule is considered
+// to be shadowed only if an earlier rule references "any" or all of the same
+// names for every match criterion.  Disabled rules are ignored, as are
+// earlier rules that have a schedule (since they do not always apply).
+//
+// For Panorama, pass in the pre-rulebase rules followed by the post-rulebase
+// rules to analyze the full policy pushed to a device.
+func FindShadowedRules(rules []Entry) []Shadow {
+	var ans []Shadow
+
+	for i := range rules {
+		if rules[i].Disabled {
+			continue
+		}
+		for j := 0; j < i; j++ {
+			if rules[j].Disabled || !rules[j].covers(rules[i]) {
+				continue
+			}
+			ans = append(ans, Shadow{
+				Rule:      rules[i].Name,
+				By:        rules[j].Name,
+				Redundant: ruleAction(rules[i]) == ruleAction(rules[j]),
+			})
+			break
+		}
+	}
+
+	return ans
+}
+
+// covers returns true if every packet matched by rule `o2` is also matched by
+// this rule.
+func (o Entry) covers(o2 Entry) bool {
+	if o.Schedule != "" {
+		return false
+	}
+
+	switch o.Type {
+	case "", "universal":
+	default:
+		if o.Type != o2.Type {
+			return false
+		}
+	}
+
+	if len(o.Targets) != 0 || o.NegateTarget {
+		if o.NegateTarget != o2.NegateTarget || !sameTargets(o.Targets, o2.Targets) {
+			return false
+		}
+	}
+
+	if o.NegateSource || o2.NegateSource {
+		if o.NegateSource != o2.NegateSource || !sameSet(o.SourceAddresses, o2.SourceAddresses) {
+			return false
+		}
+	} else if !superset(o.SourceAddresses, o2.SourceAddresses) {
+		return false
+	}
+
+	if o.NegateDestination || o2.NegateDestination {
+		if o.NegateDestination != o2.NegateDestination || !sameSet(o.DestinationAddresses, o2.DestinationAddresses) {
+			return false
+		}
+	} else if !superset(o.DestinationAddresses, o2.DestinationAddresses) {
+		return false
+	}
+
+	return superset(o.SourceZones, o2.SourceZones) &&
+		superset(o.DestinationZones, o2.DestinationZones) &&
+		superset(o.SourceUsers, o2.SourceUsers) &&
+		superset(o.SourceDevices, o2.SourceDevices) &&
+		superset(o.DestinationDevices, o2.DestinationDevices) &&
+		superset(o.HipProfiles, o2.HipProfiles) &&
+		superset(o.Applications, o2.Applications) &&
+		superset(o.Services, o2.Services) &&
+		superset(o.Categories, o2.Categories)
+}
+
+// ruleAction returns the rule's action, taking the default into account.
+func ruleAction(e Entry) string {
+	if e.Action == "" {
+		return "allow"
+	}
+	return e.Action
+}
+
+// superset returns true if `a` matches everything that `b` does.  An empty
+// list is treated as "any".
+func superset(a, b []string) bool {
+	if isAny(a) {
+		return true
+	} else if isAny(b) {
+		return false
+	}
+
+	have := make(map[string]bool, len(a))
+	for _, v := range a {
+		have[v] = true
+	}
+	for _, v := range b {
+		if !have[v] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sameSet(a, b []string) bool {
+	return superset(a, b) && superset(b, a)
+}
+
+func isAny(v []string) bool {
+	for _, x := range v {
+		if x == "any" {
+			return true
+		}
+	}
+
+	return len(v) == 0
+}
+
+func sameTargets(a, b map[string][]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		v2, ok := b[k]
+		if !ok || !sameSet(v, v2) {
+			return false
+		}
+	}
+
+	return true
+}
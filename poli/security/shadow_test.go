@@ -0,0 +1,68 @@
+package security
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindShadowedRules(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		rules    []Entry
+		expected []Shadow
+	}{
+		{"no rules", nil, nil},
+		{"redundant", []Entry{
+			{Name: "web", SourceAddresses: []string{"any"}, DestinationAddresses: []string{"web1", "web2"}, Applications: []string{"web-browsing"}, Action: "allow"},
+			{Name: "web1", SourceAddresses: []string{"10.1.1.0/24"}, DestinationAddresses: []string{"web1"}, Applications: []string{"web-browsing"}, Action: "allow"},
+		}, []Shadow{{Rule: "web1", By: "web", Redundant: true}}},
+		{"conflict", []Entry{
+			{Name: "deny-all", Action: "deny"},
+			{Name: "allow-ssh", Applications: []string{"ssh"}, Action: "allow"},
+		}, []Shadow{{Rule: "allow-ssh", By: "deny-all"}}},
+		{"narrower first", []Entry{
+			{Name: "allow-ssh", Applications: []string{"ssh"}, Action: "allow"},
+			{Name: "deny-all", Action: "deny"},
+		}, nil},
+		{"partial overlap", []Entry{
+			{Name: "a", DestinationAddresses: []string{"web1"}, Services: []string{"service-http"}},
+			{Name: "b", DestinationAddresses: []string{"web1", "web2"}, Services: []string{"service-http"}},
+		}, nil},
+		{"disabled rule ignored", []Entry{
+			{Name: "deny-all", Action: "deny", Disabled: true},
+			{Name: "allow-ssh", Applications: []string{"ssh"}},
+		}, nil},
+		{"scheduled rule ignored", []Entry{
+			{Name: "deny-all", Action: "deny", Schedule: "weekends"},
+			{Name: "allow-ssh", Applications: []string{"ssh"}},
+		}, nil},
+		{"intrazone does not cover universal", []Entry{
+			{Name: "intra", Type: "intrazone"},
+			{Name: "all", Type: "universal", Applications: []string{"ssh"}},
+		}, nil},
+		{"negated source", []Entry{
+			{Name: "a", SourceAddresses: []string{"bad"}, NegateSource: true},
+			{Name: "b", SourceAddresses: []string{"bad"}, NegateSource: true, Applications: []string{"dns"}},
+			{Name: "c", SourceAddresses: []string{"good"}, Applications: []string{"dns"}},
+		}, []Shadow{{Rule: "b", By: "a", Redundant: true}}},
+		{"device limited rule", []Entry{
+			{Name: "a", SourceDevices: []string{"printers"}, Action: "deny"},
+			{Name: "b", SourceDevices: []string{"any"}, Applications: []string{"ssh"}},
+			{Name: "c", DestinationDevices: []string{"cameras"}},
+			{Name: "d", SourceDevices: []string{"printers"}, DestinationDevices: []string{"cameras"}},
+		}, []Shadow{{Rule: "d", By: "a"}}},
+		{"different targets", []Entry{
+			{Name: "a", Targets: map[string][]string{"001": nil}},
+			{Name: "b", Targets: map[string][]string{"002": nil}},
+			{Name: "c", Targets: map[string][]string{"001": nil}},
+		}, []Shadow{{Rule: "c", By: "a", Redundant: true}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if v := FindShadowedRules(tc.rules); !reflect.DeepEqual(v, tc.expected) {
+				t.Errorf("%#v != %#v", v, tc.expected)
+			}
+		})
+	}
+}
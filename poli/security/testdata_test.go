@@ -32,5 +32,13 @@ func getTests() []testCase {
 			},
 			NegateTarget: true,
 		}},
+		{version.Number{10, 0, 0, ""}, "v2 basic rule", "", "", true, Entry{
+			Name: "rule4",
+		}},
+		{version.Number{10, 0, 0, ""}, "v2 rule with devices", "vsys2", util.PreRulebase, true, Entry{
+			Name:               "rule5",
+			SourceDevices:      []string{"printers", "cameras"},
+			DestinationDevices: []string{"any"},
+		}},
 	}
 }
@@ -0,0 +1,19 @@
+package tunnelinspect
+
+// Valid values for Protocols.
+const (
+	ProtocolGre             = "gre"
+	ProtocolNonEncryptedGtp = "non-encrypted-gtp"
+	ProtocolVxlan           = "vxlan"
+)
+
+// Valid values for MaxInspectionLevels.
+const (
+	InspectionLevelOne = "one"
+	InspectionLevelTwo = "two"
+)
+
+const (
+	singular = "tunnel inspection rule"
+	plural   = "tunnel inspection rules"
+)
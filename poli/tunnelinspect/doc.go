@@ -0,0 +1,12 @@
+/*
+Package tunnelinspect is the client.Policies.TunnelInspectionRule namespace.
+
+Tunnel inspection rules match tunneled traffic (GRE, non-encrypted GTP, and
+VXLAN) and inspect the traffic inside the tunnel, optionally placing it in
+separate tunnel security zones.
+
+Only valid for PAN-OS 9.0+.
+
+Normalized object:  Entry
+*/
+package tunnelinspect
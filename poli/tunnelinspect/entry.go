@@ -0,0 +1,260 @@
+package tunnelinspect
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a tunnel
+// inspection rule.
+//
+// Protocols are the tunnel protocols to inspect.
+//
+// MaxInspectionLevels, DropOverMaxLevels, DropStrictHeaderCheckFailed, and
+// DropUnknownProtocol are the inspection options.
+//
+// SecurityOptions enables the tunnel security zones, TunnelSourceZone and
+// TunnelDestinationZone, which are used in place of the outer zones for
+// security policy matching of the tunnel content.
+//
+// Targets is a map where the key is the serial number of the target device and
+// the value is a list of specific vsys on that device.  The list of vsys is
+// nil if all vsys on that device should be included or if the device is a
+// virtual firewall (and thus only has vsys1).
+type Entry struct {
+	Name                        string
+	Description                 string
+	Tags                        []string // ordered
+	SourceZones                 []string // unordered
+	SourceAddresses             []string // unordered
+	NegateSource                bool
+	SourceUsers                 []string // unordered
+	DestinationZones            []string // unordered
+	DestinationAddresses        []string // unordered
+	NegateDestination           bool
+	Applications                []string // unordered
+	Protocols                   []string // unordered
+	MaxInspectionLevels         string
+	DropOverMaxLevels           bool
+	DropStrictHeaderCheckFailed bool
+	DropUnknownProtocol         bool
+	SecurityOptions             bool
+	TunnelSourceZone            string
+	TunnelDestinationZone       string
+	MonitorName                 string
+	MonitorTag                  int
+	LogSetting                  string
+	Disabled                    bool
+	Targets                     map[string][]string
+	NegateTarget                bool
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Description = s.Description
+	o.Tags = s.Tags
+	o.SourceZones = s.SourceZones
+	o.SourceAddresses = s.SourceAddresses
+	o.NegateSource = s.NegateSource
+	o.SourceUsers = s.SourceUsers
+	o.DestinationZones = s.DestinationZones
+	o.DestinationAddresses = s.DestinationAddresses
+	o.NegateDestination = s.NegateDestination
+	o.Applications = s.Applications
+	o.Protocols = s.Protocols
+	o.MaxInspectionLevels = s.MaxInspectionLevels
+	o.DropOverMaxLevels = s.DropOverMaxLevels
+	o.DropStrictHeaderCheckFailed = s.DropStrictHeaderCheckFailed
+	o.DropUnknownProtocol = s.DropUnknownProtocol
+	o.SecurityOptions = s.SecurityOptions
+	o.TunnelSourceZone = s.TunnelSourceZone
+	o.TunnelDestinationZone = s.TunnelDestinationZone
+	o.MonitorName = s.MonitorName
+	o.MonitorTag = s.MonitorTag
+	o.LogSetting = s.LogSetting
+	o.Disabled = s.Disabled
+	o.Targets = s.Targets
+	o.NegateTarget = s.NegateTarget
+}
+
+// MarshalYAML returns this object in a form that YAML encoders can encode.
+func (o Entry) MarshalYAML() (interface{}, error) {
+	return util.MarshalYAML(o)
+}
+
+// UnmarshalYAML decodes YAML into this object.
+func (o *Entry) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return util.UnmarshalYAML(unmarshal, o)
+}
+
+// EntryName returns the name of this tunnel inspection rule.
+func (o Entry) EntryName() string {
+	return o.Name
+}
+
+/** Structs / functions for this namespace. **/
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:                 o.Name,
+		Description:          o.Description,
+		Tags:                 util.MemToStr(o.Tags),
+		SourceZones:          util.MemToStr(o.SourceZones),
+		SourceAddresses:      util.MemToStr(o.SourceAddresses),
+		NegateSource:         util.AsBool(o.NegateSource),
+		SourceUsers:          util.MemToStr(o.SourceUsers),
+		DestinationZones:     util.MemToStr(o.DestinationZones),
+		DestinationAddresses: util.MemToStr(o.DestinationAddresses),
+		NegateDestination:    util.AsBool(o.NegateDestination),
+		Applications:         util.MemToStr(o.Applications),
+		Protocols:            util.MemToStr(o.Protocols),
+		Disabled:             util.AsBool(o.Disabled),
+	}
+
+	if o.Inspection != nil {
+		ans.MaxInspectionLevels = o.Inspection.MaxInspectionLevels
+		ans.DropOverMaxLevels = util.AsBool(o.Inspection.DropOverMaxLevels)
+		ans.DropStrictHeaderCheckFailed = util.AsBool(o.Inspection.DropStrictHeaderCheckFailed)
+		ans.DropUnknownProtocol = util.AsBool(o.Inspection.DropUnknownProtocol)
+	}
+
+	if o.Security != nil {
+		ans.SecurityOptions = util.AsBool(o.Security.Enabled)
+		ans.TunnelSourceZone = o.Security.TunnelSourceZone
+		ans.TunnelDestinationZone = o.Security.TunnelDestinationZone
+	}
+
+	if o.Monitor != nil {
+		ans.MonitorName = o.Monitor.MonitorName
+		ans.MonitorTag = o.Monitor.MonitorTag
+		ans.LogSetting = o.Monitor.LogSetting
+	}
+
+	if o.TargetInfo != nil {
+		ans.NegateTarget = util.AsBool(o.TargetInfo.NegateTarget)
+		ans.Targets = util.VsysEntToMap(o.TargetInfo.Targets)
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName              xml.Name         `xml:"entry"`
+	Name                 string           `xml:"name,attr"`
+	Description          string           `xml:"description,omitempty"`
+	Tags                 *util.MemberType `xml:"tag"`
+	SourceZones          *util.MemberType `xml:"from"`
+	DestinationZones     *util.MemberType `xml:"to"`
+	SourceAddresses      *util.MemberType `xml:"source"`
+	NegateSource         string           `xml:"negate-source"`
+	SourceUsers          *util.MemberType `xml:"source-user"`
+	DestinationAddresses *util.MemberType `xml:"destination"`
+	NegateDestination    string           `xml:"negate-destination"`
+	Applications         *util.MemberType `xml:"application"`
+	Protocols            *util.MemberType `xml:"inspect"`
+	Inspection           *inspection      `xml:"inspection-options"`
+	Security             *security        `xml:"security-options"`
+	Monitor              *monitor         `xml:"monitor-options"`
+	Disabled             string           `xml:"disabled"`
+	TargetInfo           *targetInfo      `xml:"target"`
+}
+
+type inspection struct {
+	MaxInspectionLevels         string `xml:"max-tunnel-inspection-levels,omitempty"`
+	DropOverMaxLevels           string `xml:"drop-packet-if-over-max-tunnel-inspection-level"`
+	DropStrictHeaderCheckFailed string `xml:"drop-packet-if-tunnel-protocol-fails-strict-header-check"`
+	DropUnknownProtocol         string `xml:"drop-packet-if-unknown-protocol-inside-tunnel"`
+}
+
+type security struct {
+	Enabled               string `xml:"enable-security-options"`
+	TunnelSourceZone      string `xml:"tunnel-source-zone,omitempty"`
+	TunnelDestinationZone string `xml:"tunnel-destination-zone,omitempty"`
+}
+
+type monitor struct {
+	MonitorName string `xml:"monitor-name,omitempty"`
+	MonitorTag  int    `xml:"monitor-tag,omitempty"`
+	LogSetting  string `xml:"log-setting,omitempty"`
+}
+
+type targetInfo struct {
+	Targets      *util.VsysEntryType `xml:"devices"`
+	NegateTarget string              `xml:"negate,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:                 e.Name,
+		Description:          e.Description,
+		Tags:                 util.StrToMem(e.Tags),
+		SourceZones:          util.StrToMem(e.SourceZones),
+		DestinationZones:     util.StrToMem(e.DestinationZones),
+		SourceAddresses:      util.StrToMem(e.SourceAddresses),
+		NegateSource:         util.YesNo(e.NegateSource),
+		SourceUsers:          util.StrToMem(e.SourceUsers),
+		DestinationAddresses: util.StrToMem(e.DestinationAddresses),
+		NegateDestination:    util.YesNo(e.NegateDestination),
+		Applications:         util.StrToMem(e.Applications),
+		Protocols:            util.StrToMem(e.Protocols),
+		Disabled:             util.YesNo(e.Disabled),
+	}
+
+	if e.MaxInspectionLevels != "" || e.DropOverMaxLevels || e.DropStrictHeaderCheckFailed || e.DropUnknownProtocol {
+		ans.Inspection = &inspection{
+			MaxInspectionLevels:         e.MaxInspectionLevels,
+			DropOverMaxLevels:           util.YesNo(e.DropOverMaxLevels),
+			DropStrictHeaderCheckFailed: util.YesNo(e.DropStrictHeaderCheckFailed),
+			DropUnknownProtocol:         util.YesNo(e.DropUnknownProtocol),
+		}
+	}
+
+	if e.SecurityOptions || e.TunnelSourceZone != "" || e.TunnelDestinationZone != "" {
+		ans.Security = &security{
+			Enabled:               util.YesNo(e.SecurityOptions),
+			TunnelSourceZone:      e.TunnelSourceZone,
+			TunnelDestinationZone: e.TunnelDestinationZone,
+		}
+	}
+
+	if e.MonitorName != "" || e.MonitorTag != 0 || e.LogSetting != "" {
+		ans.Monitor = &monitor{
+			MonitorName: e.MonitorName,
+			MonitorTag:  e.MonitorTag,
+			LogSetting:  e.LogSetting,
+		}
+	}
+
+	if e.Targets != nil || e.NegateTarget {
+		ans.TargetInfo = &targetInfo{
+			Targets:      util.MapToVsysEnt(e.Targets),
+			NegateTarget: util.YesNo(e.NegateTarget),
+		}
+	}
+
+	return ans
+}
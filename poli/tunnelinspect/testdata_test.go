@@ -0,0 +1,58 @@
+package tunnelinspect
+
+type testCase struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"basic", Entry{
+			Name:                 "r1",
+			SourceZones:          []string{"untrust"},
+			SourceAddresses:      []string{"any"},
+			SourceUsers:          []string{"any"},
+			DestinationZones:     []string{"trust"},
+			DestinationAddresses: []string{"any"},
+			Applications:         []string{"any"},
+			Protocols:            []string{ProtocolGre, ProtocolVxlan},
+		}},
+		{"inspection options", Entry{
+			Name:                        "r2",
+			Description:                 "gtp",
+			Tags:                        []string{"t1", "t2"},
+			SourceZones:                 []string{"untrust"},
+			SourceAddresses:             []string{"10.1.1.0/24"},
+			NegateSource:                true,
+			DestinationZones:            []string{"trust"},
+			DestinationAddresses:        []string{"10.2.1.0/24"},
+			NegateDestination:           true,
+			Applications:                []string{"gtp"},
+			Protocols:                   []string{ProtocolNonEncryptedGtp},
+			MaxInspectionLevels:         InspectionLevelTwo,
+			DropOverMaxLevels:           true,
+			DropStrictHeaderCheckFailed: true,
+			DropUnknownProtocol:         true,
+			Disabled:                    true,
+		}},
+		{"security and monitor options", Entry{
+			Name:                  "r3",
+			Protocols:             []string{ProtocolGre},
+			SecurityOptions:       true,
+			TunnelSourceZone:      "tunnel-in",
+			TunnelDestinationZone: "tunnel-out",
+			MonitorName:           "mon",
+			MonitorTag:            7,
+			LogSetting:            "default",
+		}},
+		{"targets", Entry{
+			Name:      "r4",
+			Protocols: []string{ProtocolVxlan},
+			Targets: map[string][]string{
+				"fw1": nil,
+				"fw2": {"vsys2"},
+			},
+			NegateTarget: true,
+		}},
+	}
+}
@@ -0,0 +1,123 @@
+package pango
+
+import (
+	"net/url"
+	"strings"
+)
+
+// ApiPermissions is the set of XML API request types that the client's API
+// key is allowed to use, as returned by ProbePermissions.
+type ApiPermissions struct {
+	Config bool
+	Op     bool
+	Commit bool
+	Import bool
+	Export bool
+}
+
+// Missing returns the admin role permissions (such as "xmlapi/commit") that
+// the API key does not have.
+func (o ApiPermissions) Missing() []string {
+	var ans []string
+
+	list := []struct {
+		allowed bool
+		name    string
+	}{
+		{o.Config, "config"},
+		{o.Op, "op"},
+		{o.Commit, "commit"},
+		{o.Import, "import"},
+		{o.Export, "export"},
+	}
+	for _, x := range list {
+		if !x.allowed {
+			ans = append(ans, "xmlapi/"+x.name)
+		}
+	}
+
+	return ans
+}
+
+// ProbePermissions checks which XML API request types the client's API key
+// can use by issuing harmless requests of each type, which is useful as a
+// preflight check before automation makes any changes.
+//
+// The config probe is a GET of the hostname and the op probe is "show
+// clock".  The commit, import, and export probes are intentionally invalid
+// requests: PAN-OS checks the admin role before validating the request, so
+// any error other than a permission error means the request type is
+// allowed.  Note that config write access cannot be probed harmlessly, so
+// Config being true only means that the config can be read.
+//
+// If the client is read only, then commit and import are reported as not
+// allowed without being probed.
+//
+// An error is only returned if a probe fails for a reason other than
+// permissions, such as invalid credentials or a connection problem.
+func (c *Client) ProbePermissions() (ApiPermissions, error) {
+	var ans ApiPermissions
+	var err error
+
+	probes := []struct {
+		ptr  *bool
+		skip bool
+		data url.Values
+	}{
+		{&ans.Config, false, url.Values{
+			"type":   {"config"},
+			"action": {"get"},
+			"xpath":  {"/config/devices/entry[@name='localhost.localdomain']/deviceconfig/system/hostname"},
+		}},
+		{&ans.Op, false, url.Values{
+			"type": {"op"},
+			"cmd":  {"<show><clock></clock></show>"},
+		}},
+		{&ans.Commit, c.ReadOnly, url.Values{
+			"type": {"commit"},
+			"cmd":  {"<pango-permission-probe></pango-permission-probe>"},
+		}},
+		{&ans.Import, c.ReadOnly, url.Values{
+			"type":     {"import"},
+			"category": {"pango-permission-probe"},
+		}},
+		{&ans.Export, false, url.Values{
+			"type":     {"export"},
+			"category": {"pango-permission-probe"},
+		}},
+	}
+
+	for _, p := range probes {
+		if p.skip {
+			continue
+		}
+		c.LogOp("(probe) %s permission", p.data.Get("type"))
+		if *p.ptr, err = c.probe(p.data); err != nil {
+			return ans, err
+		}
+	}
+
+	return ans, nil
+}
+
+// probe sends the given request and returns if the request type is allowed.
+func (c *Client) probe(data url.Values) (bool, error) {
+	if c.Target != "" {
+		data.Set("target", c.Target)
+	}
+
+	_, err := c.Communicate(data, nil)
+	switch e := err.(type) {
+	case nil:
+		return true, nil
+	case PermissionError:
+		return false, nil
+	case PanosError:
+		if e.Code == 403 && strings.Contains(strings.ToLower(e.Msg), "credential") {
+			return false, err
+		}
+		return true, nil
+	}
+
+	return false, err
+}
@@ -0,0 +1,71 @@
+package pango
+
+import (
+	"reflect"
+	"testing"
+)
+
+const (
+	probeOk      = `<response status="success"><result>ok</result></response>`
+	probeDenied  = `<response status="error" code="403"><result><msg>Type [%s] not authorized for user role.</msg></result></response>`
+	probeInvalid = `<response status="error" code="17"><msg><line>invalid command</line></msg></response>`
+)
+
+func TestProbePermissions(t *testing.T) {
+	c := &Client{rb: [][]byte{
+		[]byte(probeOk),
+		[]byte(probeOk),
+		[]byte(probeDenied),
+		[]byte(probeInvalid),
+		[]byte(probeDenied),
+	}}
+	c.Initialize()
+
+	ans, err := c.ProbePermissions()
+	if err != nil {
+		t.Fatalf("Error probing: %s", err)
+	}
+
+	expected := ApiPermissions{Config: true, Op: true, Import: true}
+	if !reflect.DeepEqual(ans, expected) {
+		t.Errorf("Got %#v", ans)
+	}
+	if missing := ans.Missing(); !reflect.DeepEqual(missing, []string{"xmlapi/commit", "xmlapi/export"}) {
+		t.Errorf("Missing is %v", missing)
+	}
+
+	types := make([]string, 0, len(c.rp))
+	for _, v := range c.rp {
+		types = append(types, v.Get("type"))
+	}
+	if !reflect.DeepEqual(types, []string{"config", "op", "commit", "import", "export"}) {
+		t.Errorf("Probed %v", types)
+	}
+}
+
+func TestProbePermissionsReadOnly(t *testing.T) {
+	c := &Client{ReadOnly: true, rb: [][]byte{[]byte(probeOk), []byte(probeOk), []byte(probeOk)}}
+	c.Initialize()
+
+	ans, err := c.ProbePermissions()
+	if err != nil {
+		t.Fatalf("Error probing: %s", err)
+	}
+	if ans.Commit || ans.Import || !ans.Config || !ans.Op || !ans.Export {
+		t.Errorf("Got %#v", ans)
+	}
+	if len(c.rp) != 3 {
+		t.Errorf("Sent %d requests, not 3", len(c.rp))
+	}
+}
+
+func TestProbePermissionsInvalidCredentials(t *testing.T) {
+	c := &Client{rb: [][]byte{
+		[]byte(`<response status="error" code="403"><result><msg>Invalid Credential</msg></result></response>`),
+	}}
+	c.Initialize()
+
+	if _, err := c.ProbePermissions(); err == nil {
+		t.Errorf("Expected an error")
+	}
+}
@@ -0,0 +1,61 @@
+package pango
+
+import (
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/commit"
+)
+
+// CommitAll performs a Panorama commit-all (push to devices), then waits for
+// the push to finish using WaitForCommit.
+//
+// The per-device results of the push are in the returned CommitResult.  If
+// there was nothing to push, then the CommitResult is empty.
+func (c *Panorama) CommitAll(cmd commit.PanoramaCommitAll, sleep time.Duration, fn CommitProgressFunc) (CommitResult, error) {
+	if err := cmd.Validate(); err != nil {
+		return CommitResult{}, err
+	}
+
+	c.LogAction("(commit-all) %s %q", cmd.Type, cmd.Name)
+	return c.CommitAndWait(cmd, "", nil, sleep, fn)
+}
+
+// PushDeviceGroup pushes the given device group (and optionally its
+// templates) to the given devices, returning the job ID of the commit-all.
+//
+// If no devices are given, the device group is pushed to all of its devices.
+func (c *Panorama) PushDeviceGroup(name string, includeTemplate bool, devices ...string) (uint, error) {
+	cmd := commit.PanoramaCommitAll{
+		Type:            commit.TypeDeviceGroup,
+		Name:            name,
+		IncludeTemplate: includeTemplate,
+		Devices:         devices,
+	}
+	if err := cmd.Validate(); err != nil {
+		return 0, err
+	}
+
+	id, _, err := c.Commit(cmd, "", nil)
+	return id, err
+}
+
+// PushTemplate pushes the given template (or template stack, if `stack` is
+// true) to the given devices, returning the job ID of the commit-all.
+//
+// If no devices are given, the template is pushed to all of its devices.
+func (c *Panorama) PushTemplate(name string, stack bool, devices ...string) (uint, error) {
+	cmd := commit.PanoramaCommitAll{
+		Type:    commit.TypeTemplate,
+		Name:    name,
+		Devices: devices,
+	}
+	if stack {
+		cmd.Type = commit.TypeTemplateStack
+	}
+	if err := cmd.Validate(); err != nil {
+		return 0, err
+	}
+
+	id, _, err := c.Commit(cmd, "", nil)
+	return id, err
+}
@@ -0,0 +1,53 @@
+package pango
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/commit"
+)
+
+func TestPanoramaCommitAll(t *testing.T) {
+	c := &Panorama{Client: Client{rb: [][]byte{
+		[]byte(`<response status="success"><result><job>12</job></result></response>`),
+		[]byte(`<response status="success"><result><job><status>FIN</status><result>OK</result><progress>100</progress><devices><entry><serial-no>0001</serial-no><devicename>fw1</devicename><status>commit succeeded</status><result>OK</result><progress>100</progress></entry></devices></job></result></response>`),
+	}}}
+	c.Client.Initialize()
+
+	cmd := commit.PanoramaCommitAll{
+		Type:               commit.TypeDeviceGroup,
+		Name:               "dg1",
+		Devices:            []string{"0001"},
+		MergeWithCandidate: true,
+	}
+	ans, err := c.CommitAll(cmd, 0, nil)
+	if err != nil {
+		t.Fatalf("Error in commit-all: %s", err)
+	}
+
+	rp := c.rp[0]
+	if rp.Get("type") != "commit" || rp.Get("action") != "all" {
+		t.Errorf("Type / action is %q / %q", rp.Get("type"), rp.Get("action"))
+	}
+	if !strings.Contains(rp.Get("cmd"), "<merge-with-candidate-cfg>yes</merge-with-candidate-cfg>") {
+		t.Errorf("Cmd is %s", rp.Get("cmd"))
+	}
+	if ans.Id != 12 || len(ans.Devices) != 1 || ans.Devices[0].Name != "fw1" {
+		t.Errorf("Result: %#v", ans)
+	}
+}
+
+func TestPanoramaPushInvalid(t *testing.T) {
+	c := &Panorama{Client: Client{}}
+	c.Client.Initialize()
+
+	if _, err := c.PushDeviceGroup("", false); err == nil {
+		t.Errorf("No error for missing device group")
+	}
+	if _, err := c.CommitAll(commit.PanoramaCommitAll{Name: "dg1"}, 0, nil); err == nil {
+		t.Errorf("No error for missing type")
+	}
+	if len(c.rp) != 0 {
+		t.Errorf("Sent %d requests", len(c.rp))
+	}
+}
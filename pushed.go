@@ -0,0 +1,75 @@
+package pango
+
+import (
+	"encoding/xml"
+	"strings"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// PushedSharedPolicyConfig retrieves the shared policy and objects that
+// Panorama has pushed to this PAN-OS device.
+func (c *Client) PushedSharedPolicyConfig() ([]byte, error) {
+	type req struct {
+		XMLName xml.Name `xml:"show"`
+		Cmd     string   `xml:"config>pushed-shared-policy"`
+	}
+
+	c.LogOp("(op) retrieving the pushed shared policy")
+	b, err := c.Op(req{}, "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return util.StripPanosPackaging(b, ""), nil
+}
+
+// PushedSharedPolicy retrieves the given xpath from the shared policy that
+// Panorama has pushed to this firewall.
+//
+// This has the same signature as Get and Show, so it can be used anywhere a
+// util.Retriever is expected.  The xpath given should be the usual firewall
+// xpath, which is mapped to its location in the pushed shared policy:
+//
+//      * /config/shared/... maps to /policy/panorama/...
+//      * /config/devices/entry[@name='localhost.localdomain']/vsys/... maps
+//        to /policy/vsys/...
+//
+// As with EffectiveRunning, the xpath is resolved client side and the
+// response returned is formatted like the response from a SHOW.
+//
+// The extras param is ignored.
+//
+// If the xpath does not match anything, then a PanosError with code 7 is
+// returned.
+func (c *Client) PushedSharedPolicy(path, extras, ans interface{}) ([]byte, error) {
+	xp := pushedXpath(util.AsXpath(path))
+	c.logXpath(xp)
+
+	b, err := c.PushedSharedPolicyConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return selectConfig(b, xp, ans)
+}
+
+/** Internal functions for the pushed shared policy **/
+
+var pushedPrefixes = []struct {
+	from string
+	to   string
+}{
+	{"/config/shared/", "/policy/panorama/"},
+	{"/config/devices/entry[@name='localhost.localdomain']/vsys/", "/policy/vsys/"},
+}
+
+func pushedXpath(xp string) string {
+	for _, p := range pushedPrefixes {
+		if strings.HasPrefix(xp, p.from) {
+			return p.to + strings.TrimPrefix(xp, p.from)
+		}
+	}
+
+	return xp
+}
@@ -0,0 +1,60 @@
+package pango
+
+import (
+	"strings"
+	"testing"
+)
+
+const pushedPolicy = `<response status="success"><result><policy>
+<panorama><address><entry name="pano-shared"><fqdn>example.com</fqdn></entry></address></panorama>
+<vsys><entry name="vsys1">
+<address><entry name="pano-dg"><ip-netmask>10.3.3.3</ip-netmask></entry></address>
+<pre-rulebase><security><rules><entry name="pre1"><action>allow</action></entry></rules></security></pre-rulebase>
+</entry></vsys>
+</policy></result></response>`
+
+func TestPushedSharedPolicy(t *testing.T) {
+	type entry struct {
+		Name string `xml:"name,attr"`
+	}
+	type container struct {
+		Entries []entry `xml:"result>entry"`
+	}
+
+	testCases := []struct {
+		path string
+		name string
+	}{
+		{"/config/shared/address/entry", "pano-shared"},
+		{"/config/devices/entry[@name='localhost.localdomain']/vsys/entry[@name='vsys1']/address/entry", "pano-dg"},
+		{"/config/devices/entry[@name='localhost.localdomain']/vsys/entry[@name='vsys1']/pre-rulebase/security/rules/entry", "pre1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &Client{rb: [][]byte{[]byte(pushedPolicy)}}
+			c.Initialize()
+
+			var ans container
+			if _, err := c.PushedSharedPolicy(tc.path, nil, &ans); err != nil {
+				t.Fatalf("Error in retrieval: %s", err)
+			}
+			if len(ans.Entries) != 1 || ans.Entries[0].Name != tc.name {
+				t.Errorf("Unexpected entries: %#v", ans.Entries)
+			}
+			if s := c.rp[len(c.rp)-1].Get("cmd"); !strings.Contains(s, "<pushed-shared-policy>") {
+				t.Errorf("Cmd is %q", s)
+			}
+		})
+	}
+}
+
+func TestPushedSharedPolicyNotFound(t *testing.T) {
+	c := &Client{rb: [][]byte{[]byte(pushedPolicy)}}
+	c.Initialize()
+
+	_, err := c.PushedSharedPolicy("/config/devices/entry[@name='localhost.localdomain']/vsys/entry[@name='vsys2']/address", nil, nil)
+	if e, ok := err.(PanosError); !ok || !e.ObjectNotFound() {
+		t.Errorf("Error is %#v", err)
+	}
+}
@@ -0,0 +1,237 @@
+package refactor
+
+import (
+	"github.com/PaloAltoNetworks/pango/objs/addrgrp"
+	"github.com/PaloAltoNetworks/pango/objs/srvcgrp"
+	"github.com/PaloAltoNetworks/pango/poli/nat"
+	"github.com/PaloAltoNetworks/pango/poli/security"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Changes are the config changes computed by a refactoring operation.
+type Changes struct {
+	// Entries to be edited, with references already rewritten.
+	AddressGroups []addrgrp.Entry
+	ServiceGroups []srvcgrp.Entry
+	SecurityRules map[string][]security.Entry
+	NatRules      map[string][]nat.Entry
+
+	// Objects to be deleted after the edits are done.
+	DeleteAddressGroups []string
+	DeleteServiceGroups []string
+	DeleteAddresses     []string
+	DeleteServices      []string
+
+	// AddressReplacements and ServiceReplacements map each replaced name to
+	// the name now referenced instead.
+	AddressReplacements map[string]string
+	ServiceReplacements map[string]string
+}
+
+// Empty returns true if there are no changes to apply.
+func (o Changes) Empty() bool {
+	for _, list := range o.SecurityRules {
+		if len(list) > 0 {
+			return false
+		}
+	}
+	for _, list := range o.NatRules {
+		if len(list) > 0 {
+			return false
+		}
+	}
+
+	return len(o.AddressGroups) == 0 && len(o.ServiceGroups) == 0 && len(o.DeleteAddressGroups) == 0 && len(o.DeleteServiceGroups) == 0 && len(o.DeleteAddresses) == 0 && len(o.DeleteServices) == 0
+}
+
+// ReplaceReferences returns the changes needed to make everything in the
+// given config that references a key of addrs or srvcs reference the
+// corresponding value instead.
+//
+// Only entries that actually change are included.  Nothing is deleted.
+func ReplaceReferences(conf Config, addrs, srvcs map[string]string) Changes {
+	ans := Changes{
+		SecurityRules:       make(map[string][]security.Entry),
+		NatRules:            make(map[string][]nat.Entry),
+		AddressReplacements: addrs,
+		ServiceReplacements: srvcs,
+	}
+
+	for _, e := range conf.AddressGroups {
+		if v, ok := replaceList(e.StaticAddresses, addrs); ok {
+			e.StaticAddresses = v
+			ans.AddressGroups = append(ans.AddressGroups, e)
+		}
+	}
+
+	for _, e := range conf.ServiceGroups {
+		if v, ok := replaceList(e.Services, srvcs); ok {
+			e.Services = v
+			ans.ServiceGroups = append(ans.ServiceGroups, e)
+		}
+	}
+
+	for base, rules := range conf.SecurityRules {
+		for _, e := range rules {
+			var c1, c2, c3 bool
+			e.SourceAddresses, c1 = replaceList(e.SourceAddresses, addrs)
+			e.DestinationAddresses, c2 = replaceList(e.DestinationAddresses, addrs)
+			e.Services, c3 = replaceList(e.Services, srvcs)
+			if c1 || c2 || c3 {
+				ans.SecurityRules[base] = append(ans.SecurityRules[base], e)
+			}
+		}
+	}
+
+	for base, rules := range conf.NatRules {
+		for _, e := range rules {
+			c := make([]bool, 8)
+			e.SourceAddresses, c[0] = replaceList(e.SourceAddresses, addrs)
+			e.DestinationAddresses, c[1] = replaceList(e.DestinationAddresses, addrs)
+			e.SatTranslatedAddresses, c[2] = replaceList(e.SatTranslatedAddresses, addrs)
+			e.SatFallbackTranslatedAddresses, c[3] = replaceList(e.SatFallbackTranslatedAddresses, addrs)
+			e.SatStaticTranslatedAddress, c[4] = replaceOne(e.SatStaticTranslatedAddress, addrs)
+			e.DatAddress, c[5] = replaceOne(e.DatAddress, addrs)
+			e.Service, c[6] = replaceOne(e.Service, srvcs)
+			for _, changed := range c {
+				if changed {
+					ans.NatRules[base] = append(ans.NatRules[base], e)
+					break
+				}
+			}
+		}
+	}
+
+	return ans
+}
+
+// Apply applies the changes to the given location.
+//
+// Edits are performed before deletes, so that objects are no longer
+// referenced by the time they are deleted.  Groups are deleted before the
+// objects they may contain.  To apply the changes using
+// multi-config, pass in a pango.BatchWriter as con.
+func (o Changes) Apply(con util.XapiClient, loc Location) error {
+	if loc.IsPanorama() {
+		return o.applyPano(con, loc.DeviceGroup, loc.rulebases())
+	}
+	return o.applyFw(con, loc.Vsys)
+}
+
+func (o Changes) applyFw(con util.XapiClient, vsys string) error {
+	ns := newFwNamespaces(con)
+
+	for _, e := range o.AddressGroups {
+		if err := ns.addrgrp.Edit(vsys, e); err != nil {
+			return err
+		}
+	}
+	for _, e := range o.ServiceGroups {
+		if err := ns.srvcgrp.Edit(vsys, e); err != nil {
+			return err
+		}
+	}
+	for _, e := range o.SecurityRules[util.Rulebase] {
+		if err := ns.security.Edit(vsys, e); err != nil {
+			return err
+		}
+	}
+	for _, e := range o.NatRules[util.Rulebase] {
+		if err := ns.nat.Edit(vsys, e); err != nil {
+			return err
+		}
+	}
+
+	if err := ns.addrgrp.Delete(vsys, toInterfaces(o.DeleteAddressGroups)...); err != nil {
+		return err
+	}
+	if err := ns.srvcgrp.Delete(vsys, toInterfaces(o.DeleteServiceGroups)...); err != nil {
+		return err
+	}
+	if err := ns.addr.Delete(vsys, toInterfaces(o.DeleteAddresses)...); err != nil {
+		return err
+	}
+	return ns.srvc.Delete(vsys, toInterfaces(o.DeleteServices)...)
+}
+
+func (o Changes) applyPano(con util.XapiClient, dg string, bases []string) error {
+	ns := newPanoNamespaces(con)
+
+	for _, e := range o.AddressGroups {
+		if err := ns.addrgrp.Edit(dg, e); err != nil {
+			return err
+		}
+	}
+	for _, e := range o.ServiceGroups {
+		if err := ns.srvcgrp.Edit(dg, e); err != nil {
+			return err
+		}
+	}
+	for _, base := range bases {
+		for _, e := range o.SecurityRules[base] {
+			if err := ns.security.Edit(dg, base, e); err != nil {
+				return err
+			}
+		}
+		for _, e := range o.NatRules[base] {
+			if err := ns.nat.Edit(dg, base, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := ns.addrgrp.Delete(dg, toInterfaces(o.DeleteAddressGroups)...); err != nil {
+		return err
+	}
+	if err := ns.srvcgrp.Delete(dg, toInterfaces(o.DeleteServiceGroups)...); err != nil {
+		return err
+	}
+	if err := ns.addr.Delete(dg, toInterfaces(o.DeleteAddresses)...); err != nil {
+		return err
+	}
+	return ns.srvc.Delete(dg, toInterfaces(o.DeleteServices)...)
+}
+
+// replaceList returns the list with names replaced, and if anything changed.
+// Replacing two names with the same name does not introduce a duplicate.
+func replaceList(list []string, m map[string]string) ([]string, bool) {
+	changed := false
+	for _, v := range list {
+		if _, ok := m[v]; ok {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return list, false
+	}
+
+	seen := make(map[string]bool, len(list))
+	ans := make([]string, 0, len(list))
+	for _, v := range list {
+		if nv, ok := m[v]; ok {
+			v = nv
+		}
+		if !seen[v] {
+			seen[v] = true
+			ans = append(ans, v)
+		}
+	}
+
+	return ans, true
+}
+
+func replaceOne(v string, m map[string]string) (string, bool) {
+	if nv, ok := m[v]; ok {
+		return nv, true
+	}
+	return v, false
+}
+
+func toInterfaces(list []string) []interface{} {
+	ans := make([]interface{}, 0, len(list))
+	for _, v := range list {
+		ans = append(ans, v)
+	}
+	return ans
+}
@@ -0,0 +1,166 @@
+package refactor
+
+import (
+	"github.com/PaloAltoNetworks/pango/objs/addr"
+	"github.com/PaloAltoNetworks/pango/objs/addrgrp"
+	"github.com/PaloAltoNetworks/pango/objs/srvc"
+	"github.com/PaloAltoNetworks/pango/objs/srvcgrp"
+	"github.com/PaloAltoNetworks/pango/poli/nat"
+	"github.com/PaloAltoNetworks/pango/poli/security"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Location is where the config being refactored lives.
+//
+// If DeviceGroup is set, then the location is a Panorama device group (or
+// "shared").  Otherwise, the location is the given firewall vsys, which
+// defaults to "vsys1".
+type Location struct {
+	Vsys        string
+	DeviceGroup string
+}
+
+// IsPanorama returns true if this is a Panorama location.
+func (o Location) IsPanorama() bool {
+	return o.DeviceGroup != ""
+}
+
+func (o Location) rulebases() []string {
+	if o.IsPanorama() {
+		return []string{util.PreRulebase, util.PostRulebase}
+	}
+	return []string{util.Rulebase}
+}
+
+// Config is the config of a single location that refactoring operates on.
+type Config struct {
+	Addresses     []addr.Entry
+	AddressGroups []addrgrp.Entry
+	Services      []srvc.Entry
+	ServiceGroups []srvcgrp.Entry
+
+	// Rules are keyed by rulebase:  util.Rulebase for firewalls,
+	// util.PreRulebase and util.PostRulebase for Panorama.
+	SecurityRules map[string][]security.Entry
+	NatRules      map[string][]nat.Entry
+}
+
+// Load retrieves the config for the given location.
+//
+// The con param is usually a *pango.Firewall or *pango.Panorama.
+func Load(con util.XapiClient, loc Location) (Config, error) {
+	var err error
+	var ans Config
+	ans.SecurityRules = make(map[string][]security.Entry)
+	ans.NatRules = make(map[string][]nat.Entry)
+
+	if loc.IsPanorama() {
+		ns := newPanoNamespaces(con)
+		if ans.Addresses, err = ns.addr.GetAll(loc.DeviceGroup); missing(err) != nil {
+			return ans, err
+		}
+		if ans.AddressGroups, err = ns.addrgrp.GetAll(loc.DeviceGroup); missing(err) != nil {
+			return ans, err
+		}
+		if ans.Services, err = ns.srvc.GetAll(loc.DeviceGroup); missing(err) != nil {
+			return ans, err
+		}
+		if ans.ServiceGroups, err = ns.srvcgrp.GetAll(loc.DeviceGroup); missing(err) != nil {
+			return ans, err
+		}
+		for _, base := range loc.rulebases() {
+			if ans.SecurityRules[base], err = ns.security.GetAll(loc.DeviceGroup, base); missing(err) != nil {
+				return ans, err
+			}
+			if ans.NatRules[base], err = ns.nat.GetAll(loc.DeviceGroup, base); missing(err) != nil {
+				return ans, err
+			}
+		}
+	} else {
+		ns := newFwNamespaces(con)
+		if ans.Addresses, err = ns.addr.GetAll(loc.Vsys); missing(err) != nil {
+			return ans, err
+		}
+		if ans.AddressGroups, err = ns.addrgrp.GetAll(loc.Vsys); missing(err) != nil {
+			return ans, err
+		}
+		if ans.Services, err = ns.srvc.GetAll(loc.Vsys); missing(err) != nil {
+			return ans, err
+		}
+		if ans.ServiceGroups, err = ns.srvcgrp.GetAll(loc.Vsys); missing(err) != nil {
+			return ans, err
+		}
+		if ans.SecurityRules[util.Rulebase], err = ns.security.GetAll(loc.Vsys); missing(err) != nil {
+			return ans, err
+		}
+		if ans.NatRules[util.Rulebase], err = ns.nat.GetAll(loc.Vsys); missing(err) != nil {
+			return ans, err
+		}
+	}
+
+	return ans, nil
+}
+
+// missing returns nil if the error is that the objects do not exist.
+func missing(err error) error {
+	if err != nil && (err.Error() == "No such node" || err.Error() == "Object not found") {
+		return nil
+	}
+	return err
+}
+
+type fwNamespaces struct {
+	addr     *addr.FwAddr
+	addrgrp  *addrgrp.FwAddrGrp
+	srvc     *srvc.FwSrvc
+	srvcgrp  *srvcgrp.FwSrvcGrp
+	security *security.FwSecurity
+	nat      *nat.FwNat
+}
+
+func newFwNamespaces(con util.XapiClient) fwNamespaces {
+	ans := fwNamespaces{
+		addr:     &addr.FwAddr{},
+		addrgrp:  &addrgrp.FwAddrGrp{},
+		srvc:     &srvc.FwSrvc{},
+		srvcgrp:  &srvcgrp.FwSrvcGrp{},
+		security: &security.FwSecurity{},
+		nat:      &nat.FwNat{},
+	}
+	ans.addr.Initialize(con)
+	ans.addrgrp.Initialize(con)
+	ans.srvc.Initialize(con)
+	ans.srvcgrp.Initialize(con)
+	ans.security.Initialize(con)
+	ans.nat.Initialize(con)
+
+	return ans
+}
+
+type panoNamespaces struct {
+	addr     *addr.PanoAddr
+	addrgrp  *addrgrp.PanoAddrGrp
+	srvc     *srvc.PanoSrvc
+	srvcgrp  *srvcgrp.PanoSrvcGrp
+	security *security.PanoSecurity
+	nat      *nat.PanoNat
+}
+
+func newPanoNamespaces(con util.XapiClient) panoNamespaces {
+	ans := panoNamespaces{
+		addr:     &addr.PanoAddr{},
+		addrgrp:  &addrgrp.PanoAddrGrp{},
+		srvc:     &srvc.PanoSrvc{},
+		srvcgrp:  &srvcgrp.PanoSrvcGrp{},
+		security: &security.PanoSecurity{},
+		nat:      &nat.PanoNat{},
+	}
+	ans.addr.Initialize(con)
+	ans.addrgrp.Initialize(con)
+	ans.srvc.Initialize(con)
+	ans.srvcgrp.Initialize(con)
+	ans.security.Initialize(con)
+	ans.nat.Initialize(con)
+
+	return ans
+}
@@ -0,0 +1,82 @@
+package refactor
+
+import (
+	"strings"
+
+	"github.com/PaloAltoNetworks/pango/objs/addr"
+	"github.com/PaloAltoNetworks/pango/objs/srvc"
+)
+
+// Dedup returns the changes needed to remove duplicate address and service
+// objects from the given config.
+//
+// Address objects are duplicates if they refer to the same value.  IP
+// netmasks and ranges are compared by the addresses they cover, so
+// "10.1.1.1", "10.1.1.1/32", and "10.1.1.1-10.1.1.1" are all duplicates.  Service objects
+// are duplicates if they have the same protocol, destination ports, and
+// source ports.
+//
+// For each set of duplicates, the first object in the config is kept and all
+// references to the others are rewritten to reference it.  The others are
+// then deleted.  Only the references within the config are rewritten, so
+// objects in a Panorama device group should not be deduplicated if they are
+// also referenced from descendant device groups.
+func Dedup(conf Config) Changes {
+	addrs := make(map[string]string)
+	for _, names := range duplicateAddresses(conf.Addresses) {
+		for _, name := range names[1:] {
+			addrs[name] = names[0]
+		}
+	}
+
+	srvcs := make(map[string]string)
+	for _, names := range srvc.FindDuplicates(conf.Services) {
+		for _, name := range names[1:] {
+			srvcs[name] = names[0]
+		}
+	}
+
+	ans := ReplaceReferences(conf, addrs, srvcs)
+	for _, e := range conf.Addresses {
+		if _, ok := addrs[e.Name]; ok {
+			ans.DeleteAddresses = append(ans.DeleteAddresses, e.Name)
+		}
+	}
+	for _, e := range conf.Services {
+		if _, ok := srvcs[e.Name]; ok {
+			ans.DeleteServices = append(ans.DeleteServices, e.Name)
+		}
+	}
+
+	return ans
+}
+
+// duplicateAddresses returns groups of address object names that have the
+// same value, in the order the first of each group appears.
+func duplicateAddresses(list []addr.Entry) [][]string {
+	idx := make(map[string]int)
+	var groups [][]string
+
+	for _, e := range list {
+		key := e.Type + " " + strings.ToLower(strings.TrimSpace(e.Value))
+		if first, last, err := e.Range(); err == nil {
+			key = first.String() + "-" + last.String()
+		}
+
+		if i, ok := idx[key]; ok {
+			groups[i] = append(groups[i], e.Name)
+		} else {
+			idx[key] = len(groups)
+			groups = append(groups, []string{e.Name})
+		}
+	}
+
+	ans := make([][]string, 0, len(groups))
+	for _, g := range groups {
+		if len(g) > 1 {
+			ans = append(ans, g)
+		}
+	}
+
+	return ans
+}
@@ -0,0 +1,139 @@
+package refactor
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/objs/addr"
+	"github.com/PaloAltoNetworks/pango/objs/addrgrp"
+	"github.com/PaloAltoNetworks/pango/objs/srvc"
+	"github.com/PaloAltoNetworks/pango/objs/srvcgrp"
+	"github.com/PaloAltoNetworks/pango/poli/nat"
+	"github.com/PaloAltoNetworks/pango/poli/security"
+	"github.com/PaloAltoNetworks/pango/testdata"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+func testConfig() Config {
+	return Config{
+		Addresses: []addr.Entry{
+			{Name: "web1", Type: addr.IpNetmask, Value: "10.1.1.1"},
+			{Name: "db", Type: addr.IpNetmask, Value: "10.1.1.2"},
+			{Name: "web1-copy", Type: addr.IpNetmask, Value: "10.1.1.1/32"},
+			{Name: "site", Type: addr.Fqdn, Value: "Example.com"},
+			{Name: "site-copy", Type: addr.Fqdn, Value: "example.com"},
+		},
+		AddressGroups: []addrgrp.Entry{
+			{Name: "servers", StaticAddresses: []string{"web1", "web1-copy", "db"}},
+			{Name: "dbs", StaticAddresses: []string{"db"}},
+		},
+		Services: []srvc.Entry{
+			{Name: "tcp-8080", Protocol: srvc.ProtocolTcp, DestinationPort: "8080"},
+			{Name: "alt-http", Protocol: srvc.ProtocolTcp, DestinationPort: "8080"},
+		},
+		ServiceGroups: []srvcgrp.Entry{
+			{Name: "web", Services: []string{"alt-http", "service-https"}},
+		},
+		SecurityRules: map[string][]security.Entry{
+			util.Rulebase: {
+				{Name: "r1", SourceAddresses: []string{"any"}, DestinationAddresses: []string{"site-copy"}, Services: []string{"application-default"}},
+				{Name: "r2", SourceAddresses: []string{"any"}, DestinationAddresses: []string{"db"}, Services: []string{"tcp-8080"}},
+			},
+		},
+		NatRules: map[string][]nat.Entry{
+			util.Rulebase: {
+				{Name: "n1", DatAddress: "web1-copy", Service: "alt-http"},
+			},
+		},
+	}
+}
+
+func TestDedup(t *testing.T) {
+	ans := Dedup(testConfig())
+
+	if !reflect.DeepEqual(ans.AddressReplacements, map[string]string{"web1-copy": "web1", "site-copy": "site"}) {
+		t.Errorf("Address replacements: %#v", ans.AddressReplacements)
+	}
+	if !reflect.DeepEqual(ans.ServiceReplacements, map[string]string{"alt-http": "tcp-8080"}) {
+		t.Errorf("Service replacements: %#v", ans.ServiceReplacements)
+	}
+	if !reflect.DeepEqual(ans.DeleteAddresses, []string{"web1-copy", "site-copy"}) {
+		t.Errorf("Delete addresses: %#v", ans.DeleteAddresses)
+	}
+	if !reflect.DeepEqual(ans.DeleteServices, []string{"alt-http"}) {
+		t.Errorf("Delete services: %#v", ans.DeleteServices)
+	}
+
+	if len(ans.AddressGroups) != 1 || !reflect.DeepEqual(ans.AddressGroups[0].StaticAddresses, []string{"web1", "db"}) {
+		t.Errorf("Address groups: %#v", ans.AddressGroups)
+	}
+	if len(ans.ServiceGroups) != 1 || !reflect.DeepEqual(ans.ServiceGroups[0].Services, []string{"tcp-8080", "service-https"}) {
+		t.Errorf("Service groups: %#v", ans.ServiceGroups)
+	}
+	if rules := ans.SecurityRules[util.Rulebase]; len(rules) != 1 || rules[0].Name != "r1" || rules[0].DestinationAddresses[0] != "site" {
+		t.Errorf("Security rules: %#v", rules)
+	}
+	if rules := ans.NatRules[util.Rulebase]; len(rules) != 1 || rules[0].DatAddress != "web1" || rules[0].Service != "tcp-8080" {
+		t.Errorf("NAT rules: %#v", rules)
+	}
+}
+
+func TestDedupNothing(t *testing.T) {
+	conf := testConfig()
+	conf.Addresses = conf.Addresses[:2]
+	conf.Services = conf.Services[:1]
+
+	if ans := Dedup(conf); !ans.Empty() {
+		t.Errorf("Expected no changes: %#v", ans)
+	}
+}
+
+func TestApplyFirewall(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp("")
+
+	changes := Dedup(testConfig())
+	if err := changes.Apply(mc, Location{Vsys: "vsys2"}); err != nil {
+		t.Fatalf("Error in apply: %s", err)
+	}
+
+	// The last write is deleting the duplicate service.
+	if mc.Function != "delete" || !strings.Contains(mc.Path, "vsys2") || !strings.HasSuffix(mc.Path, "/service/entry[@name='alt-http']") {
+		t.Errorf("Last write was %s %s", mc.Function, mc.Path)
+	}
+	if mc.Called != 6 {
+		t.Errorf("Expected 6 writes, got %d", mc.Called)
+	}
+}
+
+func TestLoadFirewall(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp(`<entry name="a1"><ip-netmask>10.1.1.1</ip-netmask></entry>`)
+	mc.AddResp(`<entry name="g1"><static><member>a1</member></static></entry>`)
+	mc.AddResp(`<entry name="s1"><protocol><tcp><port>80</port></tcp></protocol></entry>`)
+	mc.AddResp(`<entry name="sg1"><members><member>s1</member></members></entry>`)
+	mc.AddResp(`<rules><entry name="r1"><action>allow</action></entry></rules>`)
+	mc.AddResp(`<rules><entry name="n1"></entry></rules>`)
+
+	conf, err := Load(mc, Location{})
+	if err != nil {
+		t.Fatalf("Error loading: %s", err)
+	}
+
+	if len(conf.Addresses) != 1 || conf.Addresses[0].Value != "10.1.1.1" {
+		t.Errorf("Addresses: %#v", conf.Addresses)
+	}
+	if len(conf.AddressGroups) != 1 || conf.AddressGroups[0].StaticAddresses[0] != "a1" {
+		t.Errorf("Address groups: %#v", conf.AddressGroups)
+	}
+	if len(conf.Services) != 1 || conf.Services[0].DestinationPort != "80" {
+		t.Errorf("Services: %#v", conf.Services)
+	}
+	if len(conf.ServiceGroups) != 1 || conf.ServiceGroups[0].Services[0] != "s1" {
+		t.Errorf("Service groups: %#v", conf.ServiceGroups)
+	}
+	if len(conf.SecurityRules[util.Rulebase]) != 1 || len(conf.NatRules[util.Rulebase]) != 1 {
+		t.Errorf("Rules: %#v / %#v", conf.SecurityRules, conf.NatRules)
+	}
+}
@@ -0,0 +1,24 @@
+/*
+Package refactor contains bulk configuration refactoring operations, such as
+deduplicating objects or moving them between locations, and rewriting the
+references to them.
+
+Refactoring is done in three steps:  load the relevant config, compute the
+changes, then apply them.  Computing changes is done entirely client side, so
+the changes can be reviewed before being applied.  Applying the changes with
+a pango.BatchWriter sends them to PAN-OS in multi-config requests:
+
+	loc := refactor.Location{Vsys: "vsys1"}
+	conf, err := refactor.Load(fw, loc)
+	if err != nil {
+		return err
+	}
+
+	changes := refactor.Dedup(conf)
+	bw := fw.NewBatchWriter(500, 0, true)
+	if err = changes.Apply(bw, loc); err != nil {
+		return err
+	}
+	return bw.Close()
+*/
+package refactor
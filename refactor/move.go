@@ -0,0 +1,338 @@
+package refactor
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/PaloAltoNetworks/pango/objs/addr"
+	"github.com/PaloAltoNetworks/pango/objs/addrgrp"
+	"github.com/PaloAltoNetworks/pango/objs/srvc"
+	"github.com/PaloAltoNetworks/pango/objs/srvcgrp"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// MoveRequest describes objects to move from one location to another.
+type MoveRequest struct {
+	From Location
+	To   Location
+
+	// Names of address objects / address groups and service objects /
+	// service groups to move.
+	Addresses []string
+	Services  []string
+
+	// Dependents is true if members of the groups being moved should also be
+	// moved, if they are in the From location.
+	Dependents bool
+
+	// NewNames optionally renames objects as they are moved, with
+	// references in the From location rewritten to match.
+	NewNames map[string]string
+}
+
+// Move is a planned move of objects between locations.
+type Move struct {
+	From Location
+	To   Location
+
+	// Objects to create in the To location.
+	Addresses     []addr.Entry
+	AddressGroups []addrgrp.Entry
+	Services      []srvc.Entry
+	ServiceGroups []srvcgrp.Entry
+
+	// Changes to make in the From location:  reference rewrites (if any
+	// objects are renamed) and deleting the original objects.
+	Changes Changes
+}
+
+// PlanMove plans moving objects out of the given config, which should be the
+// config of req.From.
+//
+// The objects being moved must not be left referenced from req.From unless
+// req.To is "shared", since objects in other device groups or vsys are not
+// visible from req.From.
+func PlanMove(conf Config, req MoveRequest) (Move, error) {
+	ans := Move{From: req.From, To: req.To}
+
+	addrs := make(map[string]addr.Entry, len(conf.Addresses))
+	for _, e := range conf.Addresses {
+		addrs[e.Name] = e
+	}
+	addrGrps := make(map[string]addrgrp.Entry, len(conf.AddressGroups))
+	for _, e := range conf.AddressGroups {
+		addrGrps[e.Name] = e
+	}
+	srvcs := make(map[string]srvc.Entry, len(conf.Services))
+	for _, e := range conf.Services {
+		srvcs[e.Name] = e
+	}
+	srvcGrps := make(map[string]srvcgrp.Entry, len(conf.ServiceGroups))
+	for _, e := range conf.ServiceGroups {
+		srvcGrps[e.Name] = e
+	}
+
+	// Determine everything being moved.
+	movingAddrs := make(map[string]bool)
+	var addAddr func(string) error
+	addAddr = func(name string) error {
+		if movingAddrs[name] {
+			return nil
+		}
+		if _, ok := addrs[name]; ok {
+			movingAddrs[name] = true
+			return nil
+		}
+		g, ok := addrGrps[name]
+		if !ok {
+			return fmt.Errorf("address object or group %q not found", name)
+		}
+		movingAddrs[name] = true
+		for _, m := range g.StaticAddresses {
+			_, isAddr := addrs[m]
+			_, isGrp := addrGrps[m]
+			if !isAddr && !isGrp {
+				continue
+			}
+			if !req.Dependents {
+				if !movingAddrs[m] && !inList(m, req.Addresses) {
+					return fmt.Errorf("address group %q member %q is not being moved", name, m)
+				}
+				continue
+			}
+			if err := addAddr(m); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, name := range req.Addresses {
+		if err := addAddr(name); err != nil {
+			return ans, err
+		}
+	}
+
+	movingSrvcs := make(map[string]bool)
+	var addSrvc func(string) error
+	addSrvc = func(name string) error {
+		if movingSrvcs[name] {
+			return nil
+		}
+		if _, ok := srvcs[name]; ok {
+			movingSrvcs[name] = true
+			return nil
+		}
+		g, ok := srvcGrps[name]
+		if !ok {
+			return fmt.Errorf("service or service group %q not found", name)
+		}
+		movingSrvcs[name] = true
+		for _, m := range g.Services {
+			_, isSrvc := srvcs[m]
+			_, isGrp := srvcGrps[m]
+			if !isSrvc && !isGrp {
+				continue
+			}
+			if !req.Dependents {
+				if !movingSrvcs[m] && !inList(m, req.Services) {
+					return fmt.Errorf("service group %q member %q is not being moved", name, m)
+				}
+				continue
+			}
+			if err := addSrvc(m); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, name := range req.Services {
+		if err := addSrvc(name); err != nil {
+			return ans, err
+		}
+	}
+
+	// Everything that stays behind.
+	var rest Config
+	rest.SecurityRules = conf.SecurityRules
+	rest.NatRules = conf.NatRules
+	for _, e := range conf.AddressGroups {
+		if !movingAddrs[e.Name] {
+			rest.AddressGroups = append(rest.AddressGroups, e)
+		}
+	}
+	for _, e := range conf.ServiceGroups {
+		if !movingSrvcs[e.Name] {
+			rest.ServiceGroups = append(rest.ServiceGroups, e)
+		}
+	}
+
+	// Moved objects can only still be referenced if they stay visible.
+	if !req.To.isShared() {
+		if refs := references(rest, movingAddrs, movingSrvcs); len(refs) > 0 {
+			return ans, fmt.Errorf("objects are still referenced by: %s", strings.Join(refs, ", "))
+		}
+	}
+
+	// Build the objects to create, renaming as needed.
+	renamedAddrs := make(map[string]string)
+	renamedSrvcs := make(map[string]string)
+	for oldName, newName := range req.NewNames {
+		if movingAddrs[oldName] {
+			renamedAddrs[oldName] = newName
+		} else if movingSrvcs[oldName] {
+			renamedSrvcs[oldName] = newName
+		} else {
+			return ans, fmt.Errorf("%q is renamed but is not being moved", oldName)
+		}
+	}
+
+	for _, e := range conf.Addresses {
+		if movingAddrs[e.Name] {
+			ans.Changes.DeleteAddresses = append(ans.Changes.DeleteAddresses, e.Name)
+			e.Name, _ = replaceOne(e.Name, renamedAddrs)
+			ans.Addresses = append(ans.Addresses, e)
+		}
+	}
+	for _, e := range conf.AddressGroups {
+		if movingAddrs[e.Name] {
+			ans.Changes.DeleteAddressGroups = append(ans.Changes.DeleteAddressGroups, e.Name)
+			e.Name, _ = replaceOne(e.Name, renamedAddrs)
+			e.StaticAddresses, _ = replaceList(e.StaticAddresses, renamedAddrs)
+			ans.AddressGroups = append(ans.AddressGroups, e)
+		}
+	}
+	for _, e := range conf.Services {
+		if movingSrvcs[e.Name] {
+			ans.Changes.DeleteServices = append(ans.Changes.DeleteServices, e.Name)
+			e.Name, _ = replaceOne(e.Name, renamedSrvcs)
+			ans.Services = append(ans.Services, e)
+		}
+	}
+	for _, e := range conf.ServiceGroups {
+		if movingSrvcs[e.Name] {
+			ans.Changes.DeleteServiceGroups = append(ans.Changes.DeleteServiceGroups, e.Name)
+			e.Name, _ = replaceOne(e.Name, renamedSrvcs)
+			e.Services, _ = replaceList(e.Services, renamedSrvcs)
+			ans.ServiceGroups = append(ans.ServiceGroups, e)
+		}
+	}
+
+	// Rewrite references left behind, then delete the originals.
+	ch := ReplaceReferences(rest, renamedAddrs, renamedSrvcs)
+	ch.DeleteAddressGroups = ans.Changes.DeleteAddressGroups
+	ch.DeleteServiceGroups = ans.Changes.DeleteServiceGroups
+	ch.DeleteAddresses = ans.Changes.DeleteAddresses
+	ch.DeleteServices = ans.Changes.DeleteServices
+	ans.Changes = ch
+
+	return ans, nil
+}
+
+// Apply performs the move:  objects are created in the To location, then the
+// Changes are applied to the From location.
+//
+// To apply the move using multi-config, pass in a pango.BatchWriter as con.
+func (o Move) Apply(con util.XapiClient) error {
+	if o.To.IsPanorama() {
+		ns := newPanoNamespaces(con)
+		dg := o.To.DeviceGroup
+		if err := ns.addr.Set(dg, o.Addresses...); err != nil {
+			return err
+		}
+		if err := ns.srvc.Set(dg, o.Services...); err != nil {
+			return err
+		}
+		if err := ns.addrgrp.Set(dg, o.AddressGroups...); err != nil {
+			return err
+		}
+		if err := ns.srvcgrp.Set(dg, o.ServiceGroups...); err != nil {
+			return err
+		}
+	} else {
+		ns := newFwNamespaces(con)
+		vsys := o.To.Vsys
+		if err := ns.addr.Set(vsys, o.Addresses...); err != nil {
+			return err
+		}
+		if err := ns.srvc.Set(vsys, o.Services...); err != nil {
+			return err
+		}
+		if err := ns.addrgrp.Set(vsys, o.AddressGroups...); err != nil {
+			return err
+		}
+		if err := ns.srvcgrp.Set(vsys, o.ServiceGroups...); err != nil {
+			return err
+		}
+	}
+
+	return o.Changes.Apply(con, o.From)
+}
+
+func (o Location) isShared() bool {
+	return o.DeviceGroup == "shared" || (o.DeviceGroup == "" && o.Vsys == "shared")
+}
+
+// references returns descriptions of the entries in conf that reference any
+// of the given addresses or services.
+func references(conf Config, addrs, srvcs map[string]bool) []string {
+	var ans []string
+
+	refs := func(list []string, m map[string]bool) bool {
+		for _, v := range list {
+			if m[v] {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, e := range conf.AddressGroups {
+		if refs(e.StaticAddresses, addrs) {
+			ans = append(ans, fmt.Sprintf("address group %q", e.Name))
+		}
+	}
+	for _, e := range conf.ServiceGroups {
+		if refs(e.Services, srvcs) {
+			ans = append(ans, fmt.Sprintf("service group %q", e.Name))
+		}
+	}
+	for _, base := range sortedKeys(conf.SecurityRules) {
+		for _, e := range conf.SecurityRules[base] {
+			if refs(e.SourceAddresses, addrs) || refs(e.DestinationAddresses, addrs) || refs(e.Services, srvcs) {
+				ans = append(ans, fmt.Sprintf("security rule %q", e.Name))
+			}
+		}
+	}
+	for _, base := range sortedKeys(conf.NatRules) {
+		for _, e := range conf.NatRules[base] {
+			if refs(e.SourceAddresses, addrs) || refs(e.DestinationAddresses, addrs) ||
+				refs(e.SatTranslatedAddresses, addrs) || refs(e.SatFallbackTranslatedAddresses, addrs) ||
+				addrs[e.SatStaticTranslatedAddress] || addrs[e.DatAddress] || srvcs[e.Service] {
+				ans = append(ans, fmt.Sprintf("NAT rule %q", e.Name))
+			}
+		}
+	}
+
+	return ans
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	ans := make([]string, 0, len(m))
+	for k := range m {
+		ans = append(ans, k)
+	}
+	sort.Strings(ans)
+
+	return ans
+}
+
+func inList(v string, list []string) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+
+	return false
+}
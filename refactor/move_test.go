@@ -0,0 +1,108 @@
+package refactor
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/objs/addr"
+	"github.com/PaloAltoNetworks/pango/objs/addrgrp"
+	"github.com/PaloAltoNetworks/pango/poli/security"
+	"github.com/PaloAltoNetworks/pango/testdata"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+func moveConfig() Config {
+	return Config{
+		Addresses: []addr.Entry{
+			{Name: "web1", Type: addr.IpNetmask, Value: "10.1.1.1"},
+			{Name: "web2", Type: addr.IpNetmask, Value: "10.1.1.2"},
+			{Name: "db", Type: addr.IpNetmask, Value: "10.1.1.3"},
+		},
+		AddressGroups: []addrgrp.Entry{
+			{Name: "web", StaticAddresses: []string{"web1", "web2", "shared-web"}},
+		},
+		SecurityRules: map[string][]security.Entry{
+			util.PreRulebase: {
+				{Name: "to-web", DestinationAddresses: []string{"web"}},
+			},
+		},
+	}
+}
+
+func TestPlanMoveToShared(t *testing.T) {
+	req := MoveRequest{
+		From:       Location{DeviceGroup: "dg1"},
+		To:         Location{DeviceGroup: "shared"},
+		Addresses:  []string{"web"},
+		Dependents: true,
+		NewNames:   map[string]string{"web": "web-servers"},
+	}
+
+	mv, err := PlanMove(moveConfig(), req)
+	if err != nil {
+		t.Fatalf("Error planning move: %s", err)
+	}
+
+	if len(mv.Addresses) != 2 || len(mv.AddressGroups) != 1 {
+		t.Fatalf("Moving %#v / %#v", mv.Addresses, mv.AddressGroups)
+	}
+	if mv.AddressGroups[0].Name != "web-servers" {
+		t.Errorf("Group was not renamed: %#v", mv.AddressGroups[0])
+	}
+	if !reflect.DeepEqual(mv.Changes.DeleteAddressGroups, []string{"web"}) || !reflect.DeepEqual(mv.Changes.DeleteAddresses, []string{"web1", "web2"}) {
+		t.Errorf("Deleting %#v / %#v", mv.Changes.DeleteAddressGroups, mv.Changes.DeleteAddresses)
+	}
+	rules := mv.Changes.SecurityRules[util.PreRulebase]
+	if len(rules) != 1 || !reflect.DeepEqual(rules[0].DestinationAddresses, []string{"web-servers"}) {
+		t.Errorf("Rules: %#v", rules)
+	}
+
+	mc := &testdata.MockClient{}
+	mc.AddResp("")
+	if err = mv.Apply(mc); err != nil {
+		t.Fatalf("Error applying move: %s", err)
+	}
+	if mc.Function != "delete" || !strings.Contains(mc.Path, "entry[@name='dg1']/address/entry[@name='web1' or @name='web2']") {
+		t.Errorf("Last write was %s %s", mc.Function, mc.Path)
+	}
+}
+
+func TestPlanMoveErrors(t *testing.T) {
+	testCases := []struct {
+		desc string
+		req  MoveRequest
+	}{
+		{"not found", MoveRequest{To: Location{Vsys: "shared"}, Addresses: []string{"missing"}}},
+		{"members left behind", MoveRequest{To: Location{Vsys: "shared"}, Addresses: []string{"web"}}},
+		{"still referenced", MoveRequest{To: Location{Vsys: "vsys2"}, Addresses: []string{"web"}, Dependents: true}},
+		{"rename not moved", MoveRequest{To: Location{Vsys: "shared"}, Addresses: []string{"db"}, NewNames: map[string]string{"web1": "x"}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if _, err := PlanMove(moveConfig(), tc.req); err == nil {
+				t.Errorf("Expected an error")
+			}
+		})
+	}
+}
+
+func TestPlanMoveToVsys(t *testing.T) {
+	req := MoveRequest{
+		From:      Location{Vsys: "vsys1"},
+		To:        Location{Vsys: "vsys2"},
+		Addresses: []string{"db"},
+	}
+
+	mv, err := PlanMove(moveConfig(), req)
+	if err != nil {
+		t.Fatalf("Error planning move: %s", err)
+	}
+	if len(mv.Addresses) != 1 || mv.Addresses[0].Name != "db" || !reflect.DeepEqual(mv.Changes.DeleteAddresses, []string{"db"}) {
+		t.Errorf("Move: %#v", mv)
+	}
+	if len(mv.Changes.SecurityRules) != 0 {
+		t.Errorf("Unexpected rule changes: %#v", mv.Changes.SecurityRules)
+	}
+}
@@ -0,0 +1,171 @@
+package pango
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Valid values for report periods.
+const (
+	ReportPeriodLast15Minutes     = "last-15-minutes"
+	ReportPeriodLastHour          = "last-hour"
+	ReportPeriodLast12Hours       = "last-12-hrs"
+	ReportPeriodLast24Hours       = "last-24-hrs"
+	ReportPeriodLastCalendarDay   = "last-calendar-day"
+	ReportPeriodLast7Days         = "last-7-days"
+	ReportPeriodLast7CalendarDays = "last-7-calendar-days"
+	ReportPeriodLastCalendarWeek  = "last-calendar-week"
+	ReportPeriodLast30Days        = "last-30-days"
+)
+
+// DynamicReport runs an ad-hoc ("custom-dynamic-report") report.
+//
+// The cmd param is the report definition, which is the contents of a custom
+// report's config (the "type", "period", "query", "topn", etc elements).  It
+// can be either a string or a struct that can be marshalled into XML, in
+// which case the outermost element is removed.
+//
+// The vsys param is the vsys to run the report in, if any.
+//
+// The ans param should be a pointer to a struct to unmarshal the response
+// into or nil.
+func (c *Client) DynamicReport(cmd interface{}, vsys string, ans interface{}) ([]byte, error) {
+	var def string
+
+	switch v := cmd.(type) {
+	case string:
+		def = v
+	default:
+		b, err := xml.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		s := string(b)
+		start, end := strings.Index(s, ">"), strings.LastIndex(s, "</")
+		if start < 0 || end < start {
+			return nil, fmt.Errorf("report definition has no contents: %s", s)
+		}
+		def = s[start+1 : end]
+	}
+
+	data := url.Values{}
+	data.Set("type", "report")
+	data.Set("reporttype", "dynamic")
+	data.Set("reportname", "custom-dynamic-report")
+	data.Set("cmd", def)
+	if vsys != "" {
+		data.Set("vsys", vsys)
+	}
+	if c.Target != "" {
+		data.Set("target", c.Target)
+	}
+
+	return c.Communicate(data, ans)
+}
+
+// SaasAppUsage is the usage of one SaaS application, as returned by
+// SaasAppUsageReport.
+//
+// Risk is the application's risk level (1 - 5), Sanctioned is if the
+// application has been tagged as sanctioned, and Users is the number of
+// unique users of the application.
+type SaasAppUsage struct {
+	Application string
+	Category    string
+	Subcategory string
+	Risk        int
+	Sanctioned  bool
+	Sessions    uint64
+	Bytes       uint64
+	Users       uint64
+}
+
+// SaasAppUsageReport returns the usage of SaaS applications over the given
+// period (one of the ReportPeriod constants), sorted by bytes.
+//
+// The vsys param is the vsys to run the report in, if any.  The limit param
+// is the maximum number of applications to return; 0 returns up to 500.
+//
+// This is the same data as the SaaS Application Usage report in the GUI, and
+// is useful for finding unsanctioned SaaS applications (shadow IT).
+func (c *Client) SaasAppUsageReport(vsys, period string, limit int) ([]SaasAppUsage, error) {
+	if limit <= 0 {
+		limit = 500
+	}
+
+	req := saasReportReq{
+		Summary: reportSummary{
+			SortBy:      "bytes",
+			AggregateBy: []string{"app", "category-of-app", "subcategory-of-app", "risk-of-app", "sanctioned-state-of-app"},
+			Values:      []string{"sessions", "bytes", "nunique-of-users"},
+		},
+		TopN:   limit,
+		TopM:   10,
+		Period: period,
+		Query:  "(is-saas-of-app eq yes)",
+	}
+
+	var resp saasReportResp
+
+	c.LogOp("(report) saas application usage for %s", period)
+	if _, err := c.DynamicReport(req, vsys, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.normalize(), nil
+}
+
+type saasReportReq struct {
+	XMLName xml.Name      `xml:"report"`
+	Summary reportSummary `xml:"type>trsum"`
+	TopN    int           `xml:"topn"`
+	TopM    int           `xml:"topm"`
+	Period  string        `xml:"period"`
+	Query   string        `xml:"query"`
+}
+
+type reportSummary struct {
+	SortBy      string   `xml:"sortby"`
+	AggregateBy []string `xml:"aggregate-by>member"`
+	Values      []string `xml:"values>member"`
+}
+
+type saasReportResp struct {
+	XMLName xml.Name          `xml:"response"`
+	Entries []saasReportEntry `xml:"result>report>entry"`
+}
+
+type saasReportEntry struct {
+	Application string `xml:"app"`
+	Category    string `xml:"category-of-app"`
+	Subcategory string `xml:"subcategory-of-app"`
+	Risk        int    `xml:"risk-of-app"`
+	Sanctioned  string `xml:"sanctioned-state-of-app"`
+	Sessions    uint64 `xml:"sessions"`
+	Bytes       uint64 `xml:"bytes"`
+	Users       uint64 `xml:"nunique-of-users"`
+}
+
+func (o saasReportResp) normalize() []SaasAppUsage {
+	if len(o.Entries) == 0 {
+		return nil
+	}
+
+	ans := make([]SaasAppUsage, 0, len(o.Entries))
+	for _, x := range o.Entries {
+		ans = append(ans, SaasAppUsage{
+			Application: x.Application,
+			Category:    x.Category,
+			Subcategory: x.Subcategory,
+			Risk:        x.Risk,
+			Sanctioned:  x.Sanctioned == "yes",
+			Sessions:    x.Sessions,
+			Bytes:       x.Bytes,
+			Users:       x.Users,
+		})
+	}
+
+	return ans
+}
@@ -0,0 +1,53 @@
+package pango
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSaasAppUsageReport(t *testing.T) {
+	resp := `<response status="success"><result><report reportname="custom-dynamic-report" logtype="trsum">
+<entry><app>box-base</app><category-of-app>general-internet</category-of-app><subcategory-of-app>file-sharing</subcategory-of-app><risk-of-app>4</risk-of-app><sanctioned-state-of-app>yes</sanctioned-state-of-app><sessions>120</sessions><bytes>52428800</bytes><nunique-of-users>12</nunique-of-users></entry>
+<entry><app>dropbox-base</app><category-of-app>general-internet</category-of-app><subcategory-of-app>file-sharing</subcategory-of-app><risk-of-app>4</risk-of-app><sanctioned-state-of-app>no</sanctioned-state-of-app><sessions>8</sessions><bytes>1024</bytes><nunique-of-users>1</nunique-of-users></entry>
+</report></result></response>`
+
+	c := &Client{rb: [][]byte{[]byte(resp)}}
+	c.Initialize()
+
+	ans, err := c.SaasAppUsageReport("vsys2", ReportPeriodLast7Days, 0)
+	if err != nil {
+		t.Fatalf("Error running report: %s", err)
+	}
+
+	expected := []SaasAppUsage{
+		{Application: "box-base", Category: "general-internet", Subcategory: "file-sharing", Risk: 4, Sanctioned: true, Sessions: 120, Bytes: 52428800, Users: 12},
+		{Application: "dropbox-base", Category: "general-internet", Subcategory: "file-sharing", Risk: 4, Sessions: 8, Bytes: 1024, Users: 1},
+	}
+	if !reflect.DeepEqual(ans, expected) {
+		t.Errorf("Got %#v", ans)
+	}
+
+	req := c.rp[0]
+	if req.Get("type") != "report" || req.Get("reporttype") != "dynamic" || req.Get("reportname") != "custom-dynamic-report" {
+		t.Errorf("Request is %v", req)
+	}
+	if req.Get("vsys") != "vsys2" {
+		t.Errorf("Vsys is %q", req.Get("vsys"))
+	}
+	cmd := "<type><trsum><sortby>bytes</sortby><aggregate-by><member>app</member><member>category-of-app</member><member>subcategory-of-app</member><member>risk-of-app</member><member>sanctioned-state-of-app</member></aggregate-by><values><member>sessions</member><member>bytes</member><member>nunique-of-users</member></values></trsum></type><topn>500</topn><topm>10</topm><period>last-7-days</period><query>(is-saas-of-app eq yes)</query>"
+	if req.Get("cmd") != cmd {
+		t.Errorf("Cmd is %s", req.Get("cmd"))
+	}
+}
+
+func TestDynamicReportString(t *testing.T) {
+	c := &Client{rb: [][]byte{[]byte(`<response status="success"><result><report/></result></response>`)}}
+	c.Initialize()
+
+	if _, err := c.DynamicReport("<type><appstat/></type>", "", nil); err != nil {
+		t.Fatalf("Error running report: %s", err)
+	}
+	if c.rp[0].Get("cmd") != "<type><appstat/></type>" {
+		t.Errorf("Cmd is %s", c.rp[0].Get("cmd"))
+	}
+}
@@ -0,0 +1,108 @@
+package pango
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ScheduledCommit is a commit that has been scheduled to run at a later time
+// using ScheduleCommit().
+type ScheduledCommit struct {
+	At time.Time
+
+	c      *Client
+	cmd    interface{}
+	action string
+	sleep  time.Duration
+
+	mu       sync.Mutex
+	timer    *time.Timer
+	done     chan struct{}
+	canceled bool
+	id       uint
+	err      error
+}
+
+// ScheduleCommit schedules a commit to be performed at the given time, such
+// as the start of a maintenance window.  Once the commit is performed, the
+// resulting job is tracked until it finishes.
+//
+// The cmd and action params are the same as Commit(), so this can be used for
+// firewall commits as well as Panorama commits and commit-all pushes.  The
+// sleep param is an optional sleep duration to wait between polling for job
+// completion.
+//
+// The PAN-OS XML API does not offer a scheduled commit, so scheduling is done
+// client side and this process must still be running at the given time.  Any
+// config changes made with the client while the commit is being performed
+// are subject to the client's DrainMode.
+func (c *Client) ScheduleCommit(at time.Time, cmd interface{}, action string, sleep time.Duration) *ScheduledCommit {
+	s := &ScheduledCommit{
+		At:     at,
+		c:      c,
+		cmd:    cmd,
+		action: action,
+		sleep:  sleep,
+		done:   make(chan struct{}),
+	}
+
+	c.LogOp("(op) scheduling commit for %s", at.Format(time.RFC3339))
+	s.timer = time.AfterFunc(time.Until(at), s.run)
+
+	return s
+}
+
+// Cancel cancels the scheduled commit.  This returns false if the commit has
+// already started.
+func (s *ScheduledCommit) Cancel() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.timer.Stop() {
+		return false
+	}
+
+	s.canceled = true
+	s.err = fmt.Errorf("scheduled commit canceled")
+	close(s.done)
+
+	return true
+}
+
+// Done returns a channel that is closed once the commit job finishes or the
+// scheduled commit is canceled.
+func (s *ScheduledCommit) Done() <-chan struct{} {
+	return s.done
+}
+
+// Wait blocks until the commit job finishes, then returns the job ID and the
+// result of the commit.
+func (s *ScheduledCommit) Wait() (uint, error) {
+	<-s.done
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.id, s.err
+}
+
+func (s *ScheduledCommit) run() {
+	s.mu.Lock()
+	if s.canceled {
+		s.mu.Unlock()
+		return
+	}
+	s.mu.Unlock()
+
+	s.c.LogOp("(op) performing scheduled commit")
+	id, _, err := s.c.Commit(s.cmd, s.action, nil)
+	if err == nil && id != 0 {
+		err = s.c.WaitForJob(id, s.sleep, nil)
+	}
+
+	s.mu.Lock()
+	s.id, s.err = id, err
+	s.mu.Unlock()
+	close(s.done)
+}
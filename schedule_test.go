@@ -0,0 +1,58 @@
+package pango
+
+import (
+	"testing"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/commit"
+)
+
+func TestScheduleCommit(t *testing.T) {
+	c := &Client{rb: [][]byte{
+		[]byte(`<response status="success"><result><job>7</job></result></response>`),
+		[]byte(`<response status="success"><result><job><result>OK</result><progress>100</progress></job></result></response>`),
+	}}
+	c.Initialize()
+
+	start := time.Now()
+	s := c.ScheduleCommit(start.Add(20*time.Millisecond), commit.FirewallCommit{}, "", 0)
+
+	id, err := s.Wait()
+	if err != nil {
+		t.Fatalf("Error in scheduled commit: %s", err)
+	}
+	if id != 7 {
+		t.Errorf("Job ID is %d", id)
+	}
+	if time.Since(start) < 20*time.Millisecond {
+		t.Errorf("Commit ran early")
+	}
+	if s.Cancel() {
+		t.Errorf("Cancel succeeded after commit")
+	}
+}
+
+func TestScheduleCommitCancel(t *testing.T) {
+	c := &Client{rb: [][]byte{
+		[]byte(`<response status="success"><result><job>7</job></result></response>`),
+	}}
+	c.Initialize()
+
+	s := c.ScheduleCommit(time.Now().Add(time.Hour), commit.FirewallCommit{}, "", 0)
+	if !s.Cancel() {
+		t.Fatalf("Failed to cancel")
+	}
+
+	select {
+	case <-s.Done():
+	default:
+		t.Errorf("Done not closed after cancel")
+	}
+
+	if _, err := s.Wait(); err == nil {
+		t.Errorf("Expected an error from a canceled commit")
+	}
+	if len(c.rp) != 0 {
+		t.Errorf("Canceled commit sent %d requests", len(c.rp))
+	}
+}
@@ -0,0 +1,17 @@
+/*
+Package schema generates JSON Schemas for the Entry types of pango's
+namespaces.
+
+External tools, such as validation webhooks or UIs, can use these schemas to
+validate desired-state documents before they are decoded into Entry structs
+and given to pango.  The schemas describe documents that use the snake_case
+keys that the Entry types' YAML support produces:
+
+	if err := schema.WriteDir("schemas"); err != nil {
+		return err
+	}
+
+Schemas can also be generated one at a time using ForNamespace(), or for any
+struct using Generate().
+*/
+package schema
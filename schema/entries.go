@@ -0,0 +1,237 @@
+package schema
+
+import (
+	"github.com/PaloAltoNetworks/pango/dev/certificate"
+	"github.com/PaloAltoNetworks/pango/dev/profile/email"
+	emailserver "github.com/PaloAltoNetworks/pango/dev/profile/email/server"
+	"github.com/PaloAltoNetworks/pango/dev/profile/http"
+	"github.com/PaloAltoNetworks/pango/dev/profile/http/header"
+	"github.com/PaloAltoNetworks/pango/dev/profile/http/param"
+	httpserver "github.com/PaloAltoNetworks/pango/dev/profile/http/server"
+	"github.com/PaloAltoNetworks/pango/dev/profile/mfa"
+	"github.com/PaloAltoNetworks/pango/dev/profile/snmp"
+	"github.com/PaloAltoNetworks/pango/dev/profile/snmp/v2c"
+	"github.com/PaloAltoNetworks/pango/dev/profile/snmp/v3"
+	"github.com/PaloAltoNetworks/pango/dev/profile/ssltls"
+	"github.com/PaloAltoNetworks/pango/dev/profile/syslog"
+	syslogserver "github.com/PaloAltoNetworks/pango/dev/profile/syslog/server"
+	"github.com/PaloAltoNetworks/pango/dev/profile/tacacs"
+	"github.com/PaloAltoNetworks/pango/dev/ssh"
+	"github.com/PaloAltoNetworks/pango/dev/vminfo"
+	"github.com/PaloAltoNetworks/pango/netw/dhcp"
+	gpagent "github.com/PaloAltoNetworks/pango/netw/globalprotect/agent"
+	gpclient "github.com/PaloAltoNetworks/pango/netw/globalprotect/client"
+	gpclientlessapp "github.com/PaloAltoNetworks/pango/netw/globalprotect/clientless/app"
+	gpclientlessappgroup "github.com/PaloAltoNetworks/pango/netw/globalprotect/clientless/appgroup"
+	"github.com/PaloAltoNetworks/pango/netw/ikegw"
+	"github.com/PaloAltoNetworks/pango/netw/imports"
+	interfaceaggregate "github.com/PaloAltoNetworks/pango/netw/interface/aggregate"
+	"github.com/PaloAltoNetworks/pango/netw/interface/arp"
+	"github.com/PaloAltoNetworks/pango/netw/interface/eth"
+	"github.com/PaloAltoNetworks/pango/netw/interface/loopback"
+	"github.com/PaloAltoNetworks/pango/netw/interface/subinterface/layer2"
+	"github.com/PaloAltoNetworks/pango/netw/interface/subinterface/layer3"
+	"github.com/PaloAltoNetworks/pango/netw/interface/tunnel"
+	interfacevlan "github.com/PaloAltoNetworks/pango/netw/interface/vlan"
+	"github.com/PaloAltoNetworks/pango/netw/ipsectunnel"
+	proxyidipv4 "github.com/PaloAltoNetworks/pango/netw/ipsectunnel/proxyid/ipv4"
+	"github.com/PaloAltoNetworks/pango/netw/profile/bfd"
+	"github.com/PaloAltoNetworks/pango/netw/profile/ike"
+	"github.com/PaloAltoNetworks/pango/netw/profile/ipsec"
+	lldpprof "github.com/PaloAltoNetworks/pango/netw/profile/lldp"
+	"github.com/PaloAltoNetworks/pango/netw/profile/mngtprof"
+	"github.com/PaloAltoNetworks/pango/netw/profile/monitor"
+	qosprof "github.com/PaloAltoNetworks/pango/netw/profile/qos"
+	sdwanprof "github.com/PaloAltoNetworks/pango/netw/profile/sdwan"
+	qosiface "github.com/PaloAltoNetworks/pango/netw/qos/iface"
+	redistipv4 "github.com/PaloAltoNetworks/pango/netw/routing/profile/redist/ipv4"
+	bgpaggregate "github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/aggregate"
+	aggadvertise "github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/aggregate/filter/advertise"
+	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/aggregate/filter/suppress"
+	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/conadv"
+	conadvadvertise "github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/conadv/filter/advertise"
+	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/conadv/filter/nonexist"
+	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/exp"
+	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/imp"
+	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/peer"
+	peergroup "github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/peer/group"
+	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/profile/auth"
+	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/profile/dampening"
+	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/redist"
+	mcifgroup "github.com/PaloAltoNetworks/pango/netw/routing/protocol/multicast/ifgroup"
+	mcrp "github.com/PaloAltoNetworks/pango/netw/routing/protocol/multicast/rp"
+	mcssm "github.com/PaloAltoNetworks/pango/netw/routing/protocol/multicast/ssm"
+	ripexp "github.com/PaloAltoNetworks/pango/netw/routing/protocol/rip/exp"
+	ripiface "github.com/PaloAltoNetworks/pango/netw/routing/protocol/rip/iface"
+	ripauth "github.com/PaloAltoNetworks/pango/netw/routing/protocol/rip/profile/auth"
+	staticipv4 "github.com/PaloAltoNetworks/pango/netw/routing/route/static/ipv4"
+	"github.com/PaloAltoNetworks/pango/netw/routing/router"
+	"github.com/PaloAltoNetworks/pango/netw/tunnel/gre"
+	netwvlan "github.com/PaloAltoNetworks/pango/netw/vlan"
+	"github.com/PaloAltoNetworks/pango/netw/zone"
+	"github.com/PaloAltoNetworks/pango/objs/addr"
+	"github.com/PaloAltoNetworks/pango/objs/addrgrp"
+	"github.com/PaloAltoNetworks/pango/objs/app"
+	appgroup "github.com/PaloAltoNetworks/pango/objs/app/group"
+	"github.com/PaloAltoNetworks/pango/objs/app/signature"
+	"github.com/PaloAltoNetworks/pango/objs/app/signature/andcond"
+	"github.com/PaloAltoNetworks/pango/objs/app/signature/orcond"
+	"github.com/PaloAltoNetworks/pango/objs/custom/urlcat"
+	objsdevice "github.com/PaloAltoNetworks/pango/objs/device"
+	"github.com/PaloAltoNetworks/pango/objs/edl"
+	decprof "github.com/PaloAltoNetworks/pango/objs/profile/decryption"
+	dosprof "github.com/PaloAltoNetworks/pango/objs/profile/dos"
+	"github.com/PaloAltoNetworks/pango/objs/profile/logfwd"
+	"github.com/PaloAltoNetworks/pango/objs/profile/logfwd/matchlist"
+	"github.com/PaloAltoNetworks/pango/objs/profile/logfwd/matchlist/action"
+	"github.com/PaloAltoNetworks/pango/objs/profile/sdwan/pathquality"
+	"github.com/PaloAltoNetworks/pango/objs/profile/sdwan/traffic"
+	"github.com/PaloAltoNetworks/pango/objs/srvc"
+	"github.com/PaloAltoNetworks/pango/objs/srvcgrp"
+	"github.com/PaloAltoNetworks/pango/objs/tags"
+	"github.com/PaloAltoNetworks/pango/pnrm/collector"
+	collectorgroup "github.com/PaloAltoNetworks/pango/pnrm/collector/group"
+	"github.com/PaloAltoNetworks/pango/pnrm/dg"
+	awsmonitoring "github.com/PaloAltoNetworks/pango/pnrm/plugins/aws/monitoring"
+	azuremonitoring "github.com/PaloAltoNetworks/pango/pnrm/plugins/azure/monitoring"
+	"github.com/PaloAltoNetworks/pango/pnrm/plugins/gcp/account"
+	gkecluster "github.com/PaloAltoNetworks/pango/pnrm/plugins/gcp/gke/cluster"
+	clustergroup "github.com/PaloAltoNetworks/pango/pnrm/plugins/gcp/gke/cluster/group"
+	"github.com/PaloAltoNetworks/pango/pnrm/plugins/kubernetes/bundle"
+	kubernetescluster "github.com/PaloAltoNetworks/pango/pnrm/plugins/kubernetes/cluster"
+	"github.com/PaloAltoNetworks/pango/pnrm/plugins/sdwan/device"
+	"github.com/PaloAltoNetworks/pango/pnrm/plugins/sdwan/vpncluster"
+	"github.com/PaloAltoNetworks/pango/pnrm/template"
+	"github.com/PaloAltoNetworks/pango/pnrm/template/stack"
+	"github.com/PaloAltoNetworks/pango/pnrm/template/variable"
+	"github.com/PaloAltoNetworks/pango/poli/appoverride"
+	"github.com/PaloAltoNetworks/pango/poli/authentication"
+	polidecryption "github.com/PaloAltoNetworks/pango/poli/decryption"
+	polidos "github.com/PaloAltoNetworks/pango/poli/dos"
+	"github.com/PaloAltoNetworks/pango/poli/nat"
+	"github.com/PaloAltoNetworks/pango/poli/pbf"
+	poliqos "github.com/PaloAltoNetworks/pango/poli/qos"
+	"github.com/PaloAltoNetworks/pango/poli/sdwan"
+	"github.com/PaloAltoNetworks/pango/poli/security"
+	"github.com/PaloAltoNetworks/pango/poli/security/defaultrule"
+	"github.com/PaloAltoNetworks/pango/poli/tunnelinspect"
+)
+
+// entries is every namespace Entry type, keyed by package path relative to
+// the pango module.
+var entries = map[string]interface{}{
+	"dev/certificate":                        certificate.Entry{},
+	"dev/profile/email":                      email.Entry{},
+	"dev/profile/email/server":               emailserver.Entry{},
+	"dev/profile/http":                       http.Entry{},
+	"dev/profile/http/header":                header.Entry{},
+	"dev/profile/http/param":                 param.Entry{},
+	"dev/profile/http/server":                httpserver.Entry{},
+	"dev/profile/mfa":                        mfa.Entry{},
+	"dev/profile/snmp":                       snmp.Entry{},
+	"dev/profile/snmp/v2c":                   v2c.Entry{},
+	"dev/profile/snmp/v3":                    v3.Entry{},
+	"dev/profile/ssltls":                     ssltls.Entry{},
+	"dev/profile/syslog":                     syslog.Entry{},
+	"dev/profile/syslog/server":              syslogserver.Entry{},
+	"dev/profile/tacacs":                     tacacs.Entry{},
+	"dev/ssh":                                ssh.Entry{},
+	"dev/vminfo":                             vminfo.Entry{},
+	"netw/dhcp":                              dhcp.Entry{},
+	"netw/globalprotect/agent":               gpagent.Entry{},
+	"netw/globalprotect/client":              gpclient.Entry{},
+	"netw/globalprotect/clientless/app":      gpclientlessapp.Entry{},
+	"netw/globalprotect/clientless/appgroup": gpclientlessappgroup.Entry{},
+	"netw/ikegw":                             ikegw.Entry{},
+	"netw/imports":                           imports.Entry{},
+	"netw/interface/aggregate":               interfaceaggregate.Entry{},
+	"netw/interface/arp":                     arp.Entry{},
+	"netw/interface/eth":                     eth.Entry{},
+	"netw/interface/loopback":                loopback.Entry{},
+	"netw/interface/subinterface/layer2":     layer2.Entry{},
+	"netw/interface/subinterface/layer3":     layer3.Entry{},
+	"netw/interface/tunnel":                  tunnel.Entry{},
+	"netw/interface/vlan":                    interfacevlan.Entry{},
+	"netw/ipsectunnel":                       ipsectunnel.Entry{},
+	"netw/ipsectunnel/proxyid/ipv4":          proxyidipv4.Entry{},
+	"netw/profile/bfd":                       bfd.Entry{},
+	"netw/profile/ike":                       ike.Entry{},
+	"netw/profile/ipsec":                     ipsec.Entry{},
+	"netw/profile/lldp":                      lldpprof.Entry{},
+	"netw/profile/mngtprof":                  mngtprof.Entry{},
+	"netw/profile/monitor":                   monitor.Entry{},
+	"netw/profile/qos":                       qosprof.Entry{},
+	"netw/profile/sdwan":                     sdwanprof.Entry{},
+	"netw/qos/iface":                         qosiface.Entry{},
+	"netw/routing/profile/redist/ipv4":       redistipv4.Entry{},
+	"netw/routing/protocol/bgp/aggregate":    bgpaggregate.Entry{},
+	"netw/routing/protocol/bgp/aggregate/filter/advertise": aggadvertise.Entry{},
+	"netw/routing/protocol/bgp/aggregate/filter/suppress":  suppress.Entry{},
+	"netw/routing/protocol/bgp/conadv":                     conadv.Entry{},
+	"netw/routing/protocol/bgp/conadv/filter/advertise":    conadvadvertise.Entry{},
+	"netw/routing/protocol/bgp/conadv/filter/nonexist":     nonexist.Entry{},
+	"netw/routing/protocol/bgp/exp":                        exp.Entry{},
+	"netw/routing/protocol/bgp/imp":                        imp.Entry{},
+	"netw/routing/protocol/bgp/peer":                       peer.Entry{},
+	"netw/routing/protocol/bgp/peer/group":                 peergroup.Entry{},
+	"netw/routing/protocol/bgp/profile/auth":               auth.Entry{},
+	"netw/routing/protocol/bgp/profile/dampening":          dampening.Entry{},
+	"netw/routing/protocol/bgp/redist":                     redist.Entry{},
+	"netw/routing/protocol/multicast/ifgroup":              mcifgroup.Entry{},
+	"netw/routing/protocol/multicast/rp":                   mcrp.Entry{},
+	"netw/routing/protocol/multicast/ssm":                  mcssm.Entry{},
+	"netw/routing/protocol/rip/exp":                        ripexp.Entry{},
+	"netw/routing/protocol/rip/iface":                      ripiface.Entry{},
+	"netw/routing/protocol/rip/profile/auth":               ripauth.Entry{},
+	"netw/routing/route/static/ipv4":                       staticipv4.Entry{},
+	"netw/routing/router":                                  router.Entry{},
+	"netw/tunnel/gre":                                      gre.Entry{},
+	"netw/vlan":                                            netwvlan.Entry{},
+	"netw/zone":                                            zone.Entry{},
+	"objs/addr":                                            addr.Entry{},
+	"objs/addrgrp":                                         addrgrp.Entry{},
+	"objs/app":                                             app.Entry{},
+	"objs/app/group":                                       appgroup.Entry{},
+	"objs/app/signature":                                   signature.Entry{},
+	"objs/app/signature/andcond":                           andcond.Entry{},
+	"objs/app/signature/orcond":                            orcond.Entry{},
+	"objs/custom/urlcat":                                   urlcat.Entry{},
+	"objs/device":                                          objsdevice.Entry{},
+	"objs/edl":                                             edl.Entry{},
+	"objs/profile/decryption":                              decprof.Entry{},
+	"objs/profile/dos":                                     dosprof.Entry{},
+	"objs/profile/logfwd":                                  logfwd.Entry{},
+	"objs/profile/logfwd/matchlist":                        matchlist.Entry{},
+	"objs/profile/logfwd/matchlist/action":                 action.Entry{},
+	"objs/profile/sdwan/pathquality":                       pathquality.Entry{},
+	"objs/profile/sdwan/traffic":                           traffic.Entry{},
+	"objs/srvc":                                            srvc.Entry{},
+	"objs/srvcgrp":                                         srvcgrp.Entry{},
+	"objs/tags":                                            tags.Entry{},
+	"pnrm/collector":                                       collector.Entry{},
+	"pnrm/collector/group":                                 collectorgroup.Entry{},
+	"pnrm/dg":                                              dg.Entry{},
+	"pnrm/plugins/aws/monitoring":                          awsmonitoring.Entry{},
+	"pnrm/plugins/azure/monitoring":                        azuremonitoring.Entry{},
+	"pnrm/plugins/gcp/account":                             account.Entry{},
+	"pnrm/plugins/gcp/gke/cluster":                         gkecluster.Entry{},
+	"pnrm/plugins/gcp/gke/cluster/group":                   clustergroup.Entry{},
+	"pnrm/plugins/kubernetes/bundle":                       bundle.Entry{},
+	"pnrm/plugins/kubernetes/cluster":                      kubernetescluster.Entry{},
+	"pnrm/plugins/sdwan/device":                            device.Entry{},
+	"pnrm/plugins/sdwan/vpncluster":                        vpncluster.Entry{},
+	"pnrm/template":                                        template.Entry{},
+	"pnrm/template/stack":                                  stack.Entry{},
+	"pnrm/template/variable":                               variable.Entry{},
+	"poli/appoverride":                                     appoverride.Entry{},
+	"poli/authentication":                                  authentication.Entry{},
+	"poli/decryption":                                      polidecryption.Entry{},
+	"poli/dos":                                             polidos.Entry{},
+	"poli/nat":                                             nat.Entry{},
+	"poli/pbf":                                             pbf.Entry{},
+	"poli/qos":                                             poliqos.Entry{},
+	"poli/sdwan":                                           sdwan.Entry{},
+	"poli/security":                                        security.Entry{},
+	"poli/security/defaultrule":                            defaultrule.Entry{},
+	"poli/tunnelinspect":                                   tunnelinspect.Entry{},
+}
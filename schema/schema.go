@@ -0,0 +1,178 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Draft is the JSON Schema draft that generated schemas conform to.
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is a JSON Schema.
+//
+// Only the parts of JSON Schema needed to describe Entry types are present.
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Id                   string             `json:"$id,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties interface{}        `json:"additionalProperties,omitempty"`
+}
+
+// Generate returns the JSON Schema for the given struct.
+//
+// Property names are the snake_case versions of the struct's field names,
+// matching the keys used by the YAML support in the util package.  The
+// "name" property is required if the struct has a Name field.
+func Generate(title string, v interface{}) (*Schema, error) {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected a struct, not %T", v)
+	}
+
+	ans, err := generate(t, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ans.Schema = Draft
+	ans.Title = title
+	if _, ok := t.FieldByName("Name"); ok {
+		ans.Required = []string{"name"}
+	}
+
+	return ans, nil
+}
+
+// Names returns the package path of every namespace with an Entry type,
+// relative to the pango module (such as "objs/addr").
+func Names() []string {
+	ans := make([]string, 0, len(entries))
+	for name := range entries {
+		ans = append(ans, name)
+	}
+	sort.Strings(ans)
+
+	return ans
+}
+
+// ForNamespace returns the JSON Schema of the Entry type of the given
+// namespace, which should be one of the values returned from Names().
+func ForNamespace(name string) (*Schema, error) {
+	v, ok := entries[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown namespace: %q", name)
+	}
+
+	ans, err := Generate(name, v)
+	if err != nil {
+		return nil, err
+	}
+	ans.Id = FileName(name)
+
+	return ans, nil
+}
+
+// FileName returns the file name used by WriteDir for the schema of the given
+// namespace, such as "objs.addr.schema.json".
+func FileName(name string) string {
+	return strings.ReplaceAll(name, "/", ".") + ".schema.json"
+}
+
+// WriteDir writes the JSON Schema of every namespace's Entry type into the
+// given directory, creating it if it does not already exist.
+func WriteDir(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	for _, name := range Names() {
+		s, err := ForNamespace(name)
+		if err != nil {
+			return err
+		}
+
+		b, err := json.MarshalIndent(s, "", "    ")
+		if err != nil {
+			return err
+		}
+
+		if err = os.WriteFile(filepath.Join(dir, FileName(name)), append(b, '\n'), 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/** Internal functions **/
+
+func generate(t reflect.Type, seen []reflect.Type) (*Schema, error) {
+	for _, x := range seen {
+		if x == t {
+			return nil, fmt.Errorf("recursive type: %s", t)
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return generate(t.Elem(), seen)
+	case reflect.String:
+		return &Schema{Type: "string"}, nil
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}, nil
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}, nil
+	case reflect.Slice, reflect.Array:
+		items, err := generate(t.Elem(), seen)
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "array", Items: items}, nil
+	case reflect.Map:
+		values, err := generate(t.Elem(), seen)
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "object", AdditionalProperties: values}, nil
+	case reflect.Interface:
+		return &Schema{}, nil
+	case reflect.Struct:
+		ans := &Schema{
+			Type:                 "object",
+			Properties:           make(map[string]*Schema),
+			AdditionalProperties: false,
+		}
+		seen = append(seen, t)
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" {
+				continue
+			}
+			prop, err := generate(f.Type, seen)
+			if err != nil {
+				return nil, err
+			}
+			ans.Properties[util.SnakeCase(f.Name)] = prop
+		}
+		return ans, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type: %s", t)
+}
@@ -0,0 +1,122 @@
+package schema
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestForNamespace(t *testing.T) {
+	s, err := ForNamespace("objs/addr")
+	if err != nil {
+		t.Fatalf("Error generating schema: %s", err)
+	}
+
+	if s.Schema != Draft || s.Id != "objs.addr.schema.json" || s.Type != "object" {
+		t.Errorf("Unexpected schema header: %#v", s)
+	}
+	if len(s.Required) != 1 || s.Required[0] != "name" {
+		t.Errorf("Required is %v", s.Required)
+	}
+	if s.AdditionalProperties != false {
+		t.Errorf("Additional properties are allowed")
+	}
+	if p := s.Properties["tags"]; p == nil || p.Type != "array" || p.Items.Type != "string" {
+		t.Errorf("Tags property is %#v", p)
+	}
+	if p := s.Properties["description"]; p == nil || p.Type != "string" {
+		t.Errorf("Description property is %#v", p)
+	}
+
+	if _, err = ForNamespace("bogus"); err == nil {
+		t.Errorf("No error for unknown namespace")
+	}
+}
+
+func TestGenerateNested(t *testing.T) {
+	type spec struct {
+		Weight int
+	}
+	type entry struct {
+		SourceZones []string
+		Spec        *spec
+		Labels      map[string]bool
+	}
+
+	s, err := Generate("test", entry{})
+	if err != nil {
+		t.Fatalf("Error generating schema: %s", err)
+	}
+
+	if s.Required != nil {
+		t.Errorf("Required is %v", s.Required)
+	}
+	if p := s.Properties["spec"]; p == nil || p.Properties["weight"].Type != "integer" {
+		t.Errorf("Spec property is %#v", p)
+	}
+	if p := s.Properties["labels"]; p == nil || p.AdditionalProperties.(*Schema).Type != "boolean" {
+		t.Errorf("Labels property is %#v", p)
+	}
+	if _, ok := s.Properties["source_zones"]; !ok {
+		t.Errorf("No source_zones property")
+	}
+
+	if _, err = Generate("test", "string"); err == nil {
+		t.Errorf("No error for non-struct")
+	}
+}
+
+func TestWriteDir(t *testing.T) {
+	dir := t.TempDir()
+	if err := WriteDir(dir); err != nil {
+		t.Fatalf("Error writing schemas: %s", err)
+	}
+
+	b, err := os.ReadFile(filepath.Join(dir, "poli.security.schema.json"))
+	if err != nil {
+		t.Fatalf("Error reading schema: %s", err)
+	}
+	var s map[string]interface{}
+	if err = json.Unmarshal(b, &s); err != nil {
+		t.Fatalf("Invalid JSON: %s", err)
+	}
+	if s["$schema"] != Draft {
+		t.Errorf("$schema is %v", s["$schema"])
+	}
+}
+
+// TestAllEntriesPresent verifies that every Entry type in the module is
+// registered.
+func TestAllEntriesPresent(t *testing.T) {
+	re := regexp.MustCompile(`(?m)^type Entry struct`)
+	err := filepath.Walk("..", func(fp string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		} else if info.IsDir() || !strings.HasSuffix(fp, ".go") || strings.HasSuffix(fp, "_test.go") {
+			return nil
+		}
+
+		b, err := os.ReadFile(fp)
+		if err != nil {
+			return err
+		} else if !re.Match(b) {
+			return nil
+		}
+
+		name := filepath.ToSlash(filepath.Dir(fp))
+		name = strings.TrimPrefix(name, "../")
+		if name == "util" {
+			return nil
+		}
+		if _, ok := entries[name]; !ok {
+			t.Errorf("Entry of %q is not registered", name)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error walking module: %s", err)
+	}
+}
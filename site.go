@@ -0,0 +1,227 @@
+package pango
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/commit"
+	"github.com/PaloAltoNetworks/pango/netw"
+	"github.com/PaloAltoNetworks/pango/netw/ikegw"
+	"github.com/PaloAltoNetworks/pango/netw/interface/eth"
+	"github.com/PaloAltoNetworks/pango/netw/interface/tunnel"
+	"github.com/PaloAltoNetworks/pango/netw/ipsectunnel"
+	"github.com/PaloAltoNetworks/pango/netw/routing/route/static/ipv4"
+	"github.com/PaloAltoNetworks/pango/netw/routing/router"
+	"github.com/PaloAltoNetworks/pango/netw/zone"
+	"github.com/PaloAltoNetworks/pango/poli"
+	"github.com/PaloAltoNetworks/pango/poli/nat"
+	"github.com/PaloAltoNetworks/pango/poli/security"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Site describes the config of a branch site, to be applied to a firewall
+// all at once with ApplySite().
+//
+// StaticRoutes are placed in the VirtualRouter, so if StaticRoutes are
+// specified, then VirtualRouter must be as well.
+type Site struct {
+	Vsys               string
+	EthernetInterfaces []eth.Entry
+	TunnelInterfaces   []tunnel.Entry
+	Zones              []zone.Entry
+	VirtualRouter      *router.Entry
+	StaticRoutes       []ipv4.Entry
+	IkeGateways        []ikegw.Entry
+	IpsecTunnels       []ipsectunnel.Entry
+	NatRules           []nat.Entry
+	SecurityRules      []security.Entry
+}
+
+// ApplySite configures everything in the given site, then commits.
+//
+// All config is sent in dependency order (interfaces, zones, the virtual
+// router and its static routes, IKE gateways, IPSec tunnels, NAT rules, then
+// security rules) as a single strict transactional multi-config request, so
+// if any part of the config is rejected, none of it is applied.  The config
+// is staged in its own Batch, so any multi-config that was prepared on the
+// client is left as-is.
+//
+// If the commit fails, then each xpath that the site config was applied to is
+// restored to what it was in the candidate config beforehand.  Changes made
+// elsewhere in the candidate config are left alone.  A commit that succeeds
+// with warnings is not a failure:  the config is left in place, and the
+// WarningError is returned.
+//
+// The sleep param is an optional sleep duration to wait between polling for
+// commit completion.
+//
+// The job ID of the commit is returned, which is 0 if no commit was needed.
+func (c *Firewall) ApplySite(s Site, cmd commit.FirewallCommit, sleep time.Duration) (uint, error) {
+	var err error
+
+	if len(s.StaticRoutes) > 0 && s.VirtualRouter == nil {
+		return 0, fmt.Errorf("static routes require a virtual router")
+	}
+
+	c.LogAction("(site) applying site config")
+	b := c.NewBatch()
+	network := &netw.FwNetw{}
+	network.Initialize(b)
+	policies := &poli.FwPoli{}
+	policies.Initialize(b)
+
+	steps := []func() error{
+		func() error { return network.EthernetInterface.Set(s.Vsys, s.EthernetInterfaces...) },
+		func() error { return network.TunnelInterface.Set(s.Vsys, s.TunnelInterfaces...) },
+		func() error { return network.Zone.Set(s.Vsys, s.Zones...) },
+		func() error {
+			if s.VirtualRouter == nil {
+				return nil
+			}
+			return network.VirtualRouter.Set(s.Vsys, *s.VirtualRouter)
+		},
+		func() error {
+			if s.VirtualRouter == nil {
+				return nil
+			}
+			return network.StaticRoute.Set(s.VirtualRouter.Name, s.StaticRoutes...)
+		},
+		func() error { return network.IkeGateway.Set(s.IkeGateways...) },
+		func() error { return network.IpsecTunnel.Set(s.IpsecTunnels...) },
+		func() error { return policies.Nat.Set(s.Vsys, s.NatRules...) },
+		func() error { return policies.Security.Set(s.Vsys, s.SecurityRules...) },
+	}
+	for _, step := range steps {
+		if err = step(); err != nil {
+			b.Discard()
+			return 0, err
+		}
+	}
+
+	if b.Pending() == 0 {
+		return 0, nil
+	}
+
+	undo, err := c.siteUndo(b.Requests())
+	if err != nil {
+		b.Discard()
+		return 0, err
+	}
+
+	if _, err = b.Apply(); err != nil {
+		return 0, fmt.Errorf("Site config failed: %s", err)
+	}
+
+	// Warnings do not mean that the commit failed, so the config is only
+	// restored for other errors.
+	c.LogAction("(site) committing site config")
+	id, _, err := c.Commit(cmd, "", nil)
+	if _, ok := err.(WarningError); (err == nil || ok) && id != 0 {
+		if e2 := c.WaitForJob(id, sleep, nil); e2 != nil {
+			err = e2
+		}
+	}
+	if _, ok := err.(WarningError); err != nil && !ok {
+		c.LogAction("(site) restoring %d xpaths", len(undo))
+		if _, e2 := c.sendBatch(undo, true); e2 != nil {
+			return id, fmt.Errorf("%s (restore failed: %s)", err, e2)
+		}
+		return id, err
+	}
+
+	return id, err
+}
+
+// siteUndo returns the requests that restore each xpath the given requests
+// change to its current state in the candidate config.
+//
+// The requests are returned in reverse order, so that an xpath nested inside
+// of another one is restored first.  Removing an import from all vsys is
+// restored by restoring the imports of each vsys that currently has any.
+func (c *Firewall) siteUndo(reqs []MultiConfigureRequest) ([]MultiConfigureRequest, error) {
+	seen := make(map[string]bool, len(reqs))
+	ans := make([]MultiConfigureRequest, 0, len(reqs))
+
+	for i := len(reqs) - 1; i >= 0; i-- {
+		xps := []string{reqs[i].Xpath}
+		existingOnly := false
+		if idx := strings.Index(xps[0], "/vsys/entry/"); idx != -1 {
+			xp := xps[0]
+			prefix := strings.Split(strings.TrimPrefix(xp[:idx], "/"), "/")
+			list, err := c.EntryListUsing(c.Get, append(prefix, "vsys"))
+			if err != nil {
+				return nil, err
+			}
+			suffix := xp[idx+len("/vsys/entry") : parentXpathEnd(xp)]
+			xps = make([]string, 0, len(list))
+			for _, vsys := range list {
+				xps = append(xps, fmt.Sprintf("%s/vsys/%s%s", xp[:idx], util.AsEntryXpath([]string{vsys}), suffix))
+			}
+			existingOnly = true
+		}
+
+		for _, xp := range xps {
+			if seen[xp] {
+				continue
+			}
+			seen[xp] = true
+
+			node, err := c.siteSnapshot(xp)
+			if err != nil {
+				return nil, err
+			} else if node != nil {
+				ans = append(ans, newMultiConfigureRequest("edit", xp, node))
+			} else if !existingOnly {
+				ans = append(ans, newMultiConfigureRequest("delete", xp, nil))
+			}
+		}
+	}
+
+	return ans, nil
+}
+
+// siteSnapshot returns the config at the given xpath in the candidate config,
+// or nil if there is none.
+func (c *Firewall) siteSnapshot(xp string) (*configNode, error) {
+	b, err := c.Get(xp, nil, nil)
+	if err != nil {
+		if e2, ok := err.(PanosError); ok && e2.ObjectNotFound() {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	prev := util.StripPanosPackaging(b, "")
+	if len(prev) == 0 {
+		return nil, nil
+	}
+
+	var node configNode
+	if err = xml.Unmarshal([]byte(util.CleanRawXml(string(prev))), &node); err != nil {
+		return nil, err
+	}
+
+	return &node, nil
+}
+
+// parentXpathEnd returns the index of the start of the last segment of the
+// given xpath, ignoring slashes in predicates.
+func parentXpathEnd(xp string) int {
+	var depth, ans int
+	for i := range xp {
+		switch xp[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case '/':
+			if depth == 0 {
+				ans = i
+			}
+		}
+	}
+
+	return ans
+}
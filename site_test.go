@@ -0,0 +1,177 @@
+package pango
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/commit"
+	"github.com/PaloAltoNetworks/pango/netw/interface/eth"
+	"github.com/PaloAltoNetworks/pango/netw/routing/route/static/ipv4"
+	"github.com/PaloAltoNetworks/pango/netw/zone"
+	"github.com/PaloAltoNetworks/pango/poli/security"
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+const (
+	commitJobResp  = `<response status="success"><result><job>5</job></result></response>`
+	jobOkResp      = `<response status="success"><result><job><progress>100</progress><result>OK</result></job></result></response>`
+	jobFailResp    = `<response status="success"><result><job><progress>100</progress><result>FAIL</result></job></result></response>`
+	emptyGetResp   = `<response status="success"><result total-count="0" count="0"/></response>`
+	siteZoneName   = "untrust"
+	siteIfaceName  = "ethernet1/1"
+	siteRuleName   = "allow out"
+	siteCommitDesc = "site"
+)
+
+func testSite() Site {
+	return Site{
+		Vsys: "vsys1",
+		EthernetInterfaces: []eth.Entry{
+			{Name: siteIfaceName, Mode: "layer3"},
+		},
+		Zones: []zone.Entry{
+			{Name: siteZoneName, Mode: zone.ModeL3, Interfaces: []string{siteIfaceName}},
+		},
+		SecurityRules: []security.Entry{
+			{Name: siteRuleName, Action: "allow"},
+		},
+	}
+}
+
+// siteReadResps are the responses to the reads done before the site config
+// is applied: the list of vsys and the interface imports of each (where the
+// interface is not imported), then the security rules, zones, vsys1
+// interface imports, and the ethernet interfaces.
+var siteReadResps = []string{
+	vsysListResp,
+	emptyGetResp,
+	emptyGetResp,
+	`<response status="success"><result total-count="1" count="1"><rules><entry name="old"/></rules></result></response>`,
+	emptyGetResp,
+	emptyGetResp,
+	emptyGetResp,
+}
+
+func siteFirewall(t *testing.T, resps ...string) *Firewall {
+	fw := &Firewall{Client: Client{}}
+	for _, r := range append(siteReadResps, resps...) {
+		fw.rb = append(fw.rb, []byte(r))
+	}
+	if err := fw.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+	fw.Version = version.Number{9, 0, 0, ""}
+
+	return fw
+}
+
+func TestApplySite(t *testing.T) {
+	fw := siteFirewall(t, okMultiConfigResp, commitJobResp, jobOkResp)
+	fw.PrepareMultiConfigure(0)
+	if _, err := fw.Set("/config/shared/address", nil, nil, nil); err != nil {
+		t.Fatalf("Error queueing set: %s", err)
+	}
+
+	id, err := fw.ApplySite(testSite(), commit.FirewallCommit{Description: siteCommitDesc}, 0)
+	if err != nil {
+		t.Fatalf("Error applying site: %s", err)
+	} else if id != 5 {
+		t.Errorf("Job id is %d, not 5", id)
+	} else if reqs := fw.queuedMultiConfigure(); len(reqs) != 1 || reqs[0].Xpath != "/config/shared/address" {
+		t.Errorf("Prepared multi-configure was changed: %#v", reqs)
+	}
+
+	n := len(siteReadResps)
+	if len(fw.rp) != n+3 {
+		t.Fatalf("Sent %d requests, not %d", len(fw.rp), n+3)
+	}
+	body := fw.rp[n].Get("element")
+	zi := strings.Index(body, siteZoneName)
+	ii := strings.Index(body, siteIfaceName)
+	ri := strings.Index(body, siteRuleName)
+	if ii == -1 || zi == -1 || ri == -1 {
+		t.Errorf("Missing config in multi-config:\n%s", body)
+	} else if !(ii < zi && zi < ri) {
+		t.Errorf("Config not in dependency order:\n%s", body)
+	} else if strings.Contains(body, "/config/shared/address") {
+		t.Errorf("Prepared multi-configure was sent with the site:\n%s", body)
+	} else if strings.Contains(body, "/vsys/entry/import") {
+		t.Errorf("Unimport sent for an interface that is not imported:\n%s", body)
+	}
+	if fw.rp[n].Get("strict-transactional") != "yes" {
+		t.Errorf("Multi-config is not strict transactional")
+	}
+}
+
+func TestApplySiteRestoresOnCommitFailure(t *testing.T) {
+	fw := siteFirewall(t, okMultiConfigResp, commitJobResp, jobFailResp, okMultiConfigResp)
+
+	if _, err := fw.ApplySite(testSite(), commit.FirewallCommit{}, 0); err == nil {
+		t.Fatalf("Expected an error on failed commit")
+	}
+
+	n := len(siteReadResps)
+	if len(fw.rp) != n+4 {
+		t.Fatalf("Sent %d requests, not %d", len(fw.rp), n+4)
+	}
+	last := fw.rp[n+3]
+	if last.Get("action") != "multi-config" {
+		t.Fatalf("Last request was not a restore: %#v", last)
+	}
+	body := last.Get("element")
+	if !strings.Contains(body, `/rulebase/security/rules"><rules><entry name="old"></entry></rules></edit>`) {
+		t.Errorf("Security rules not restored:\n%s", body)
+	}
+	if !strings.Contains(body, `/zone"></delete>`) {
+		t.Errorf("Zones not restored:\n%s", body)
+	}
+	if strings.Contains(body, "running-config") {
+		t.Errorf("Restore reverted to the running config:\n%s", body)
+	}
+}
+
+func TestApplySiteCommitWarnings(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		commit string
+		job    string
+	}{
+		{"commit", `<response status="success"><msg><line>Warning: app dependency</line></msg><result><job>5</job></result></response>`, jobOkResp},
+		{"job", commitJobResp, `<response status="success"><result><job><progress>100</progress><result>OK</result><warnings><line>app dependency warning</line></warnings></job></result></response>`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			fw := siteFirewall(t, okMultiConfigResp, tc.commit, tc.job, okMultiConfigResp)
+			fw.WarningsAsErrors = true
+
+			id, err := fw.ApplySite(testSite(), commit.FirewallCommit{}, 0)
+			if _, ok := err.(WarningError); !ok {
+				t.Errorf("Expected a WarningError, not %v", err)
+			}
+			if id != 5 {
+				t.Errorf("Job id is %d, not 5", id)
+			}
+
+			n := len(siteReadResps)
+			if len(fw.rp) != n+3 {
+				t.Errorf("Sent %d requests, not %d; config was restored", len(fw.rp), n+3)
+			}
+		})
+	}
+}
+
+func TestApplySiteStaticRoutesNeedRouter(t *testing.T) {
+	fw := &Firewall{Client: Client{
+		rb: [][]byte{[]byte(okMultiConfigResp)},
+	}}
+	if err := fw.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	s := testSite()
+	s.StaticRoutes = make([]ipv4.Entry, 1)
+	if _, err := fw.ApplySite(s, commit.FirewallCommit{}, 0); err == nil {
+		t.Errorf("Expected an error for static routes without a virtual router")
+	}
+}
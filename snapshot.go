@@ -0,0 +1,123 @@
+package pango
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/url"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Valid values for LoadConfigPartial's mode.
+const (
+	LoadPartialMerge   = "merge"
+	LoadPartialReplace = "replace"
+	LoadPartialAppend  = "append"
+)
+
+// SavedConfig retrieves the named config file that was previously saved to
+// the PAN-OS device.
+//
+// The saved config is only read, so the candidate config is left untouched.
+func (c *Client) SavedConfig(name string) ([]byte, error) {
+	type req struct {
+		XMLName xml.Name `xml:"show"`
+		Name    string   `xml:"config>saved"`
+	}
+
+	c.LogOp("(op) retrieving saved config %q", name)
+	b, err := c.Op(req{Name: name}, "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return util.StripPanosPackaging(b, ""), nil
+}
+
+// ExportSavedConfig exports the named config file that was previously saved
+// to the PAN-OS device.
+//
+// This is an alternative to SavedConfig for API users that have export
+// permissions but not op permissions.
+func (c *Client) ExportSavedConfig(name string) ([]byte, error) {
+	data := url.Values{}
+	data.Set("type", "export")
+	data.Set("category", "configuration")
+	data.Set("from", name)
+
+	c.LogOp("(export) saved config %q", name)
+	return c.Communicate(data, nil)
+}
+
+// SavedConfigChanges returns what would change in the candidate config if
+// the named saved config were loaded, so a rollback target can be checked
+// before reverting to it.
+//
+// If export is true, the saved config is retrieved using ExportSavedConfig,
+// otherwise SavedConfig is used.
+func (c *Client) SavedConfigChanges(name string, export bool) ([]ConfigChange, error) {
+	var saved []byte
+	var err error
+
+	if export {
+		saved, err = c.ExportSavedConfig(name)
+	} else {
+		saved, err = c.SavedConfig(name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	candidate, err := c.CandidateConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return DiffConfig(candidate, saved)
+}
+
+// LoadConfigPartial loads the config at fromXpath in the named saved config
+// into the candidate config at toXpath, leaving the rest of the candidate
+// config untouched.
+//
+// The name param can also be "running-config.xml" to restore a subtree from
+// the running config.
+//
+// The mode param should be one of the LoadPartial constants.  If toXpath is
+// empty, then fromXpath is used.
+//
+// The candidate config is not committed.  As this changes the candidate
+// config, it is subject to the client's ReadOnly and DrainMode settings.
+func (c *Client) LoadConfigPartial(name, fromXpath, toXpath, mode string) error {
+	switch mode {
+	case LoadPartialMerge, LoadPartialReplace, LoadPartialAppend:
+	default:
+		return fmt.Errorf("invalid load config partial mode: %q", mode)
+	}
+
+	if toXpath == "" {
+		toXpath = fromXpath
+	}
+
+	if c.ReadOnly {
+		return ReadOnlyError{"load"}
+	}
+
+	done, err := c.drainBegin("load")
+	if err != nil {
+		return err
+	}
+	defer done()
+
+	type req struct {
+		XMLName   xml.Name `xml:"load"`
+		From      string   `xml:"config>partial>from"`
+		FromXpath string   `xml:"config>partial>from-xpath"`
+		ToXpath   string   `xml:"config>partial>to-xpath"`
+		Mode      string   `xml:"config>partial>mode"`
+	}
+
+	c.LogAction("(load) partial config %q: %s -> %s (%s)", name, fromXpath, toXpath, mode)
+	_, err = c.Op(req{From: name, FromXpath: fromXpath, ToXpath: toXpath, Mode: mode}, "", nil, nil)
+	return err
+}
@@ -0,0 +1,126 @@
+package pango
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSavedConfigChanges(t *testing.T) {
+	c := &Client{rb: [][]byte{
+		[]byte(`<response status="success"><result><config><shared><address><entry name="a1"><ip-netmask>10.1.1.1</ip-netmask></entry></address></shared></config></result></response>`),
+		[]byte(`<response status="success"><result><config><shared><address><entry name="a1"><ip-netmask>10.1.1.2</ip-netmask></entry><entry name="a2"/></address></shared></config></result></response>`),
+	}}
+	c.Initialize()
+
+	ans, err := c.SavedConfigChanges("before.xml", false)
+	if err != nil {
+		t.Fatalf("Error in diff: %s", err)
+	}
+
+	if cmd := c.rp[0].Get("cmd"); cmd != "<show><config><saved>before.xml</saved></config></show>" {
+		t.Errorf("Saved cmd is %s", cmd)
+	}
+	if cmd := c.rp[1].Get("cmd"); cmd != "<show><config><candidate></candidate></config></show>" {
+		t.Errorf("Candidate cmd is %s", cmd)
+	}
+
+	expected := []ConfigChange{
+		{
+			Xpath:  "/config/shared/address/entry[@name='a1']/ip-netmask",
+			Action: ConfigChanged,
+			Old:    "10.1.1.2",
+			New:    "10.1.1.1",
+		},
+		{
+			Xpath:  "/config/shared/address/entry[@name='a2']",
+			Action: ConfigDeleted,
+		},
+	}
+	if !reflect.DeepEqual(ans, expected) {
+		t.Errorf("%#v != %#v", ans, expected)
+	}
+}
+
+func TestSavedConfigChangesExport(t *testing.T) {
+	c := &Client{rb: [][]byte{
+		[]byte(`<config version="10.1.0"><shared><address><entry name="a1"><fqdn>example.com</fqdn></entry></address></shared></config>`),
+		[]byte(`<response status="success"><result><config><shared><address><entry name="a1"><fqdn>example.com</fqdn></entry></address></shared></config></result></response>`),
+	}}
+	c.Initialize()
+
+	ans, err := c.SavedConfigChanges("before.xml", true)
+	if err != nil {
+		t.Fatalf("Error in diff: %s", err)
+	}
+
+	if len(ans) != 0 {
+		t.Errorf("Expected no changes, got %#v", ans)
+	}
+
+	v := c.rp[0]
+	if v.Get("type") != "export" || v.Get("category") != "configuration" || v.Get("from") != "before.xml" {
+		t.Errorf("Export params: %v", v)
+	}
+}
+
+func TestLoadConfigPartial(t *testing.T) {
+	c := &Client{rb: [][]byte{
+		[]byte(`<response status="success"><result>Config loaded from before.xml</result></response>`),
+	}}
+	c.Initialize()
+
+	if err := c.LoadConfigPartial("before.xml", "/config/shared/address", "", LoadPartialReplace); err != nil {
+		t.Fatalf("Error in load: %s", err)
+	}
+
+	expected := "<load><config><partial><from>before.xml</from><from-xpath>/config/shared/address</from-xpath><to-xpath>/config/shared/address</to-xpath><mode>replace</mode></partial></config></load>"
+	if cmd := c.rp[0].Get("cmd"); cmd != expected {
+		t.Errorf("cmd is %s", cmd)
+	}
+}
+
+func TestLoadConfigPartialInvalidMode(t *testing.T) {
+	c := &Client{}
+	c.Initialize()
+
+	if err := c.LoadConfigPartial("before.xml", "/config/shared", "", "overwrite"); err == nil {
+		t.Errorf("No error for invalid mode")
+	}
+	if len(c.rp) != 0 {
+		t.Errorf("Sent %d requests", len(c.rp))
+	}
+}
+
+func TestLoadConfigPartialReadOnly(t *testing.T) {
+	c := &Client{ReadOnly: true}
+	c.Initialize()
+
+	err := c.LoadConfigPartial("before.xml", "/config/shared", "", LoadPartialMerge)
+	if _, ok := err.(ReadOnlyError); !ok {
+		t.Errorf("Expected ReadOnlyError, got %v", err)
+	}
+	if len(c.rp) != 0 {
+		t.Errorf("Sent %d requests", len(c.rp))
+	}
+}
+
+func TestLoadConfigPartialDrain(t *testing.T) {
+	c := &Client{DrainMode: DrainError, rb: [][]byte{
+		[]byte(`<response status="success"><result><job>5</job></result></response>`),
+	}}
+	c.Initialize()
+
+	if _, _, err := c.Commit("<commit></commit>", "", nil); err != nil {
+		t.Fatalf("Error in commit: %s", err)
+	}
+
+	err := c.LoadConfigPartial("before.xml", "/config/shared", "", LoadPartialMerge)
+	if e, ok := err.(CommitInProgressError); !ok {
+		t.Errorf("Expected CommitInProgressError, got %v", err)
+	} else if e.JobId != 5 || e.Action != "load" {
+		t.Errorf("Got %#v", e)
+	}
+	if len(c.rp) != 1 {
+		t.Errorf("Sent %d requests", len(c.rp))
+	}
+}
@@ -0,0 +1,83 @@
+package pango
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// communicateStream sends the given data to PAN-OS, decoding the response
+// into ans as it is read.
+//
+// Error responses are small, so if the response is an error, then it is
+// buffered and handled by endCommunication() as usual, and the raw response
+// is returned.
+func (c *Client) communicateStream(data url.Values, ans interface{}) ([]byte, error) {
+	rc, err := c.postStream(data)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	br := bufio.NewReader(rc)
+	if isErrorResponse(br) {
+		body, err := ioutil.ReadAll(br)
+		if err != nil {
+			return nil, err
+		}
+		return c.endCommunication(body, ans)
+	}
+
+	if c.Logging&LogReceive == LogReceive {
+		log.Printf("Response = (streamed)")
+	}
+
+	if err = xml.NewDecoder(br).Decode(ans); err != nil {
+		return nil, fmt.Errorf("Error unmarshaling into provided interface: %s", err)
+	}
+
+	return nil, nil
+}
+
+// postStream is post(), but returns a reader of the response.
+func (c *Client) postStream(data url.Values) (io.ReadCloser, error) {
+	if len(c.rb) != 0 {
+		body, err := c.post(data)
+		if err != nil {
+			return nil, err
+		}
+		return ioutil.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	req, err := http.NewRequest("POST", c.api_url, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return c.doStream(req)
+}
+
+// isErrorResponse peeks at the start of the given response, returning true
+// if the response's status is not success.
+func isErrorResponse(br *bufio.Reader) bool {
+	head, _ := br.Peek(512)
+
+	idx := bytes.Index(head, []byte("<response"))
+	if idx < 0 {
+		return true
+	}
+	head = head[idx:]
+	if end := bytes.IndexByte(head, '>'); end >= 0 {
+		head = head[:end]
+	}
+
+	return !bytes.Contains(head, []byte(`status="success"`)) && !bytes.Contains(head, []byte(`status='success'`))
+}
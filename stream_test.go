@@ -0,0 +1,77 @@
+package pango
+
+import (
+	"testing"
+)
+
+func TestStreamResponses(t *testing.T) {
+	c := &Client{StreamResponses: true, rb: [][]byte{
+		[]byte(`<response status="success"><result><entry name="one"/><entry name="two"/></result></response>`),
+	}}
+	c.Initialize()
+
+	var ans struct {
+		Entries []struct {
+			Name string `xml:"name,attr"`
+		} `xml:"result>entry"`
+	}
+
+	body, err := c.Get("/config/shared/address", nil, &ans)
+	if err != nil {
+		t.Fatalf("Error in get: %s", err)
+	}
+	if body != nil {
+		t.Errorf("Streamed response returned a body: %s", body)
+	}
+	if len(ans.Entries) != 2 || ans.Entries[1].Name != "two" {
+		t.Errorf("Got %#v", ans)
+	}
+}
+
+func TestStreamResponsesError(t *testing.T) {
+	c := &Client{StreamResponses: true, rb: [][]byte{
+		[]byte(`<response status="error" code="7"><msg><line>Object doesn't exist</line></msg></response>`),
+	}}
+	c.Initialize()
+
+	var ans struct{}
+	body, err := c.Get("/config/shared/address", nil, &ans)
+	if err == nil {
+		t.Fatalf("Expected an error")
+	}
+	if e, ok := err.(PanosError); !ok || !e.ObjectNotFound() {
+		t.Errorf("Expected an object not found PanosError, got %#v", err)
+	}
+	if body == nil {
+		t.Errorf("Error response did not return the body")
+	}
+}
+
+func TestStreamResponsesWithoutAnswer(t *testing.T) {
+	raw := `<response status="success"><result>hi</result></response>`
+	c := &Client{StreamResponses: true, rb: [][]byte{[]byte(raw)}}
+	c.Initialize()
+
+	body, err := c.Get("/config/shared/address", nil, nil)
+	if err != nil {
+		t.Fatalf("Error in get: %s", err)
+	} else if string(body) != raw {
+		t.Errorf("Body is %q", body)
+	}
+}
+
+func TestStreamResponsesWaitForJob(t *testing.T) {
+	c := &Client{StreamResponses: true, rb: [][]byte{
+		[]byte(`<response status="success"><result><job><id>7</id><result>OK</result><progress>100</progress></job></result></response>`),
+	}}
+	c.Initialize()
+
+	var ans struct {
+		Id uint `xml:"result>job>id"`
+	}
+	if err := c.WaitForJob(7, 0, &ans); err != nil {
+		t.Fatalf("Error waiting for job: %s", err)
+	} else if ans.Id != 7 {
+		t.Errorf("Job id is %d", ans.Id)
+	}
+}
@@ -0,0 +1,65 @@
+package pango
+
+import (
+	"github.com/PaloAltoNetworks/pango/netw/interface/subinterface/layer3"
+	"github.com/PaloAltoNetworks/pango/netw/routing/router"
+	"github.com/PaloAltoNetworks/pango/netw/zone"
+)
+
+// SetVlanSubinterfaces creates / updates the layer3 subinterfaces of the given
+// VLAN range, along with their vsys, zone, and virtual router assignment, in
+// a single strictly transactional multi-config request (PAN-OS 9.0+).
+//
+// The zone and virtual router are optional and must already exist.  Either
+// every subinterface is configured, or none of them are.
+//
+// The names of the subinterfaces are returned.
+func (c *Firewall) SetVlanSubinterfaces(r layer3.VlanRange, vsys, zoneName, vr string) ([]string, error) {
+	iType, err := r.InterfaceType()
+	if err != nil {
+		return nil, err
+	}
+	list, err := r.Entries()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(list))
+	for _, e := range list {
+		names = append(names, e.Name)
+	}
+
+	b := c.NewBatch()
+
+	subs := &layer3.FwLayer3{}
+	subs.Initialize(b)
+	if err = subs.Set(iType, r.Parent, vsys, list...); err != nil {
+		return nil, err
+	}
+
+	if zoneName != "" {
+		zones := &zone.FwZone{}
+		zones.Initialize(b)
+		for _, name := range names {
+			if err = zones.SetInterface(vsys, zoneName, zone.ModeL3, name); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if vr != "" {
+		routers := &router.FwRouter{}
+		routers.Initialize(b)
+		for _, name := range names {
+			if err = routers.SetInterface(vr, name); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if _, err = b.Apply(); err != nil {
+		return nil, err
+	}
+
+	return names, nil
+}
@@ -0,0 +1,69 @@
+package pango
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/netw/interface/subinterface/layer3"
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+func TestSetVlanSubinterfaces(t *testing.T) {
+	c := &Firewall{Client: Client{rb: [][]byte{
+		[]byte(vsysListResp),
+		[]byte(emptyGetResp),
+		[]byte(emptyGetResp),
+		[]byte(okMultiConfigResp),
+	}}}
+	c.Client.Initialize()
+	c.Version = version.Number{10, 1, 0, ""}
+
+	r := layer3.VlanRange{
+		Parent:    "ethernet1/3",
+		First:     100,
+		Last:      199,
+		StaticIps: []string{"10.1.{vlan}.1/24"},
+	}
+
+	names, err := c.SetVlanSubinterfaces(r, "vsys1", "inside", "default")
+	if err != nil {
+		t.Fatalf("Error in set: %s", err)
+	}
+	if len(names) != 100 || names[0] != "ethernet1/3.100" {
+		t.Errorf("Names: %v", names)
+	}
+
+	if len(c.rp) != 4 {
+		t.Fatalf("Sent %d requests, not 4", len(c.rp))
+	}
+	elm := c.rp[3].Get("element")
+	if !strings.Contains(elm, `<entry name="10.1.199.1/24">`) {
+		t.Errorf("Static IP missing: %s", elm)
+	}
+	for _, x := range []string{
+		"/zone/entry[@name=&#39;inside&#39;]/network/layer3",
+		"/virtual-router/entry[@name=&#39;default&#39;]/interface",
+		"/import/network",
+	} {
+		if !strings.Contains(elm, x) {
+			t.Errorf("Element missing %q", x)
+		}
+	}
+	if strings.Contains(elm, "/vsys/entry/import") {
+		t.Errorf("Unimport staged for subinterfaces that are not imported")
+	}
+}
+
+func TestSetVlanSubinterfacesInvalid(t *testing.T) {
+	c := &Firewall{Client: Client{}}
+	c.Client.Initialize()
+	c.Version = version.Number{10, 1, 0, ""}
+
+	r := layer3.VlanRange{Parent: "ethernet1/3", First: 100, Last: 5000}
+	if _, err := c.SetVlanSubinterfaces(r, "vsys1", "", ""); err == nil {
+		t.Errorf("No error for invalid range")
+	}
+	if len(c.rp) != 0 {
+		t.Errorf("Sent %d requests", len(c.rp))
+	}
+}
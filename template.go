@@ -0,0 +1,207 @@
+package pango
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/PaloAltoNetworks/pango/commit"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// TemplateOverride is a template value pushed from Panorama that has a
+// different value configured locally on the firewall.
+//
+// Xpath is the location of the value.  TemplateValue is the value that
+// Panorama pushed, while LocalValue is the value configured on the firewall.
+// For member lists, the values are the sorted members joined with ", ".
+type TemplateOverride struct {
+	Xpath         string
+	TemplateValue string
+	LocalValue    string
+}
+
+// PushedTemplateConfig retrieves the template config that Panorama has pushed
+// to this firewall.
+func (c *Firewall) PushedTemplateConfig() ([]byte, error) {
+	type req struct {
+		XMLName xml.Name `xml:"show"`
+		Cmd     string   `xml:"config>pushed-template"`
+	}
+
+	c.LogOp("(op) retrieving the pushed template config")
+	b, err := c.Op(req{}, "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return util.StripPanosPackaging(b, ""), nil
+}
+
+// TemplateOverrides returns the template values pushed from Panorama that are
+// overridden by local config on this firewall.
+//
+// The pushed template config is compared against the local running config,
+// and every template leaf value that is also configured locally with a
+// different value is returned, sorted by xpath.
+//
+// Use Panorama.ForceTemplateValues to remediate the drift.
+func (c *Firewall) TemplateOverrides() ([]TemplateOverride, error) {
+	tmpl, err := c.PushedTemplateConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	local, err := c.RunningConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return CompareTemplateConfig(tmpl, local)
+}
+
+// CompareTemplateConfig compares the given pushed template config against the
+// given local config, returning the template values that are overridden.
+//
+// Both configs are expected to be XML documents containing a <config> element.
+func CompareTemplateConfig(tmpl, local []byte) ([]TemplateOverride, error) {
+	tv, _, err := configLeaves(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("template config: %s", err)
+	}
+
+	lv, _, err := configLeaves(local)
+	if err != nil {
+		return nil, fmt.Errorf("local config: %s", err)
+	}
+
+	ans := make([]TemplateOverride, 0)
+	for path, val := range tv {
+		if lval, ok := lv[path]; ok && lval != val {
+			ans = append(ans, TemplateOverride{
+				Xpath:         path,
+				TemplateValue: val,
+				LocalValue:    lval,
+			})
+		}
+	}
+
+	sort.Slice(ans, func(i, j int) bool {
+		return ans[i].Xpath < ans[j].Xpath
+	})
+
+	return ans, nil
+}
+
+// ForceTemplateValues pushes the given template (or template stack, if
+// `stack` is true) to the given devices, overwriting any locally overridden
+// values on those firewalls.
+//
+// If no devices are given, the template is pushed to all of its devices.
+//
+// The job ID of the commit-all is returned.
+func (c *Panorama) ForceTemplateValues(name string, stack bool, devices ...string) (uint, error) {
+	cmd := commit.PanoramaCommitAll{
+		Type:                commit.TypeTemplate,
+		Name:                name,
+		Description:         "force template values",
+		ForceTemplateValues: true,
+		Devices:             devices,
+	}
+	if stack {
+		cmd.Type = commit.TypeTemplateStack
+	}
+
+	id, _, err := c.Commit(cmd, "", nil)
+	return id, err
+}
+
+/** Internal functions for template overrides **/
+
+// configLeaves returns the leaf values of the <config> element in the given
+// XML document, keyed by xpath, along with the order of the entries in each
+// rulebase, keyed by the xpath of the rules.
+func configLeaves(b []byte) (map[string]string, map[string][]string, error) {
+	var root configNode
+	if err := xml.Unmarshal(b, &root); err != nil {
+		return nil, nil, err
+	}
+
+	cfg := root.find("config")
+	if cfg == nil {
+		return nil, nil, fmt.Errorf("no config element found")
+	}
+
+	ans := make(map[string]string)
+	order := make(map[string][]string)
+	cfg.leaves("", ans, order)
+	return ans, order, nil
+}
+
+// find returns the first node with the given tag, searching depth first.
+func (n *configNode) find(tag string) *configNode {
+	if n.XMLName.Local == tag {
+		return n
+	}
+
+	for _, x := range n.Nodes {
+		if ans := x.find(tag); ans != nil {
+			return ans
+		}
+	}
+
+	return nil
+}
+
+// leaves adds the leaf values at and beneath this node to ans, keyed by
+// xpath.  Member lists are treated as a single leaf, and entries without any
+// config are leaves with an empty value.
+//
+// As the order of rules matters, the entry names of each rulebase are added
+// to order (if given), keyed by the xpath of the rules.
+func (n *configNode) leaves(prefix string, ans map[string]string, order map[string][]string) {
+	path := prefix + "/" + n.XMLName.Local
+	if name := n.name(); name != "" {
+		path = fmt.Sprintf("%s[@name=%s]", path, util.XpathLiteral(name))
+	}
+
+	if len(n.Nodes) == 0 {
+		ans[path] = strings.TrimSpace(n.Text)
+		return
+	}
+
+	if n.isMemberList() {
+		list := make([]string, 0, len(n.Nodes))
+		for _, x := range n.Nodes {
+			list = append(list, strings.TrimSpace(x.Text))
+		}
+		sort.Strings(list)
+		ans[path] = strings.Join(list, ", ")
+		return
+	}
+
+	if order != nil && n.XMLName.Local == "rules" {
+		list := make([]string, 0, len(n.Nodes))
+		for _, x := range n.Nodes {
+			if x.XMLName.Local == "entry" {
+				list = append(list, x.name())
+			}
+		}
+		order[path] = list
+	}
+
+	for _, x := range n.Nodes {
+		x.leaves(path, ans, order)
+	}
+}
+
+func (n *configNode) isMemberList() bool {
+	for _, x := range n.Nodes {
+		if x.XMLName.Local != "member" || len(x.Nodes) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,91 @@
+package pango
+
+import (
+	"strings"
+	"testing"
+)
+
+const templatePushed = `<response status="success"><result><template name="t1"><config version="10.1.0">
+<devices><entry name="localhost.localdomain"><deviceconfig><system>
+<hostname>tmpl-host</hostname>
+<timezone>UTC</timezone>
+<dns-setting><servers><primary>10.0.0.1</primary></servers></dns-setting>
+<permitted-ip><entry name="10.1.0.0/16"/></permitted-ip>
+<ntp-servers><primary-ntp-server><ntp-server-address>ntp1</ntp-server-address></primary-ntp-server></ntp-servers>
+</system></deviceconfig>
+<network><interface><ethernet><entry name="ethernet1/1"><layer3><ip><entry name="10.2.0.1/24"/></ip></layer3><comment>tmpl</comment></entry></ethernet></interface></network>
+<vsys><entry name="vsys1"><zone><entry name="trust"><network><layer3><member>ethernet1/1</member><member>ethernet1/2</member></layer3></network></entry></zone></entry></vsys>
+</entry></devices></config></template></result></response>`
+
+const templateLocal = `<response status="success"><result><config version="10.1.0">
+<devices><entry name="localhost.localdomain"><deviceconfig><system>
+<hostname>local-host</hostname>
+<timezone>UTC</timezone>
+<dns-setting><servers><primary>10.0.0.1</primary></servers></dns-setting>
+</system></deviceconfig>
+<network><interface><ethernet><entry name="ethernet1/1"><comment>local</comment></entry></ethernet></interface></network>
+<vsys><entry name="vsys1"><zone><entry name="trust"><network><layer3><member>ethernet1/2</member><member>ethernet1/1</member><member>ethernet1/3</member></layer3></network></entry></zone></entry></vsys>
+</entry></devices></config></result></response>`
+
+func TestTemplateOverrides(t *testing.T) {
+	c := &Firewall{Client: Client{rb: [][]byte{
+		[]byte(templatePushed),
+		[]byte(templateLocal),
+	}}}
+	c.Client.Initialize()
+
+	list, err := c.TemplateOverrides()
+	if err != nil {
+		t.Fatalf("Error getting overrides: %s", err)
+	}
+
+	dev := "/config/devices/entry[@name='localhost.localdomain']"
+	expected := []TemplateOverride{
+		{dev + "/deviceconfig/system/hostname", "tmpl-host", "local-host"},
+		{dev + "/network/interface/ethernet/entry[@name='ethernet1/1']/comment", "tmpl", "local"},
+		{dev + "/vsys/entry[@name='vsys1']/zone/entry[@name='trust']/network/layer3", "ethernet1/1, ethernet1/2", "ethernet1/1, ethernet1/2, ethernet1/3"},
+	}
+
+	if len(list) != len(expected) {
+		t.Fatalf("Got %d overrides, not %d: %#v", len(list), len(expected), list)
+	}
+	for i := range expected {
+		if list[i] != expected[i] {
+			t.Errorf("%d: expected %#v, got %#v", i, expected[i], list[i])
+		}
+	}
+
+	if s := c.rp[len(c.rp)-1].Get("cmd"); !strings.Contains(s, "<running>") {
+		t.Errorf("Last cmd is %q", s)
+	}
+}
+
+func TestCompareTemplateConfigNoConfig(t *testing.T) {
+	if _, err := CompareTemplateConfig([]byte(`<result/>`), []byte(`<config/>`)); err == nil {
+		t.Errorf("No error for missing config element")
+	}
+}
+
+func TestForceTemplateValues(t *testing.T) {
+	c := &Panorama{Client: Client{rb: [][]byte{
+		[]byte(`<response status="success"><result><job>7</job></result></response>`),
+	}}}
+	c.Client.Initialize()
+
+	id, err := c.ForceTemplateValues("stack1", true, "0123")
+	if err != nil {
+		t.Fatalf("Error forcing template values: %s", err)
+	} else if id != 7 {
+		t.Errorf("Job ID is %d", id)
+	}
+
+	if v := c.rp[len(c.rp)-1].Get("action"); v != "all" {
+		t.Errorf("Action is %q", v)
+	}
+	s := c.rp[len(c.rp)-1].Get("cmd")
+	for _, want := range []string{"<template-stack>", "<name>stack1</name>", "<force-template-values>yes</force-template-values>", "<member>0123</member>"} {
+		if !strings.Contains(s, want) {
+			t.Errorf("Cmd %q does not contain %q", s, want)
+		}
+	}
+}
@@ -22,6 +22,10 @@ type MockClient struct {
 	Plugin        []map[string]string
 	PasswordHash  string
 	UnimportError error
+	ImportError   error
+	SkipUnchanged bool
+	Stream        bool
+	ImportedInto  map[string]string
 
 	// Variables saved from the mock client's invocation.
 	Function      string
@@ -66,6 +70,22 @@ func (c *MockClient) Show(path interface{}, extras interface{}, ans interface{})
 	return c.finalize(ans)
 }
 
+func (c *MockClient) EffectiveRunning(path interface{}, extras interface{}, ans interface{}) ([]byte, error) {
+	c.Function = "effective-running"
+	c.Path = util.AsXpath(path)
+	c.Extras = extras
+
+	return c.finalize(ans)
+}
+
+func (c *MockClient) PushedSharedPolicy(path interface{}, extras interface{}, ans interface{}) ([]byte, error) {
+	c.Function = "pushed-shared-policy"
+	c.Path = util.AsXpath(path)
+	c.Extras = extras
+
+	return c.finalize(ans)
+}
+
 func (c *MockClient) Get(path interface{}, extras interface{}, ans interface{}) ([]byte, error) {
 	c.Function = "get"
 	c.Path = util.AsXpath(path)
@@ -143,6 +163,10 @@ func (c *MockClient) finalize(resp interface{}) ([]byte, error) {
 		return nil, err
 	}
 
+	if c.Stream && ans.Error == nil {
+		return nil, nil
+	}
+
 	return ans.Raw, ans.Error
 }
 
@@ -166,7 +190,7 @@ func (c *MockClient) VsysImport(ns, tmpl, ts, vsys string, names []string) error
 	c.Vsys = vsys
 	c.Imports = names
 
-	return nil
+	return c.ImportError
 }
 
 func (c *MockClient) VsysUnimport(ns, tmpl, ts string, names []string) error {
@@ -177,6 +201,25 @@ func (c *MockClient) VsysUnimport(ns, tmpl, ts string, names []string) error {
 	return c.UnimportError
 }
 
+func (c *MockClient) IsImported(ns, tmpl, ts, vsys, name string) (bool, error) {
+	return c.ImportedInto[name] == vsys, nil
+}
+
+func (c *MockClient) ImportedVsys(ns, tmpl, ts string, names []string) (map[string]string, error) {
+	ans := make(map[string]string)
+	for _, name := range names {
+		if vsys := c.ImportedInto[name]; vsys != "" {
+			ans[name] = vsys
+		}
+	}
+
+	return ans, nil
+}
+
+func (c *MockClient) SkipUnchangedWrites() bool { return c.SkipUnchanged }
+
+func (c *MockClient) StreamsResponses() bool { return c.Stream }
+
 func (c *MockClient) WaitForJob(a uint, d time.Duration, resp interface{}) error {
 	_, err := c.finalize(resp)
 	return err
@@ -0,0 +1,190 @@
+package pango
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+// Batch stages config changes, then applies them all at once in a single,
+// strictly transactional multi-config request (PAN-OS 9.0+).
+//
+// Like a BatchWriter, a Batch satisfies util.XapiClient, so namespaces can be
+// initialized with it instead of the client.  Set, edit, delete, move, rename,
+// and vsys import requests are staged until Apply() is called, while reads are
+// passed through to the client (so staged changes are not visible to reads):
+//
+//	b := fw.NewBatch()
+//	addrs := &addr.FwAddr{}
+//	addrs.Initialize(b)
+//	rules := &security.FwSecurity{}
+//	rules.Initialize(b)
+//
+//	if err := addrs.Set("vsys1", a1, a2, a3); err != nil {
+//	    return err
+//	}
+//	if err := rules.Edit("vsys1", rule); err != nil {
+//	    return err
+//	}
+//	if _, err := b.Apply(); err != nil {
+//	    return err
+//	}
+//
+// Either every staged change is applied, or none of them are.  Operations that
+// cannot be staged (such as commits) return an error.
+//
+// A Batch is safe for concurrent use, including alongside other API calls
+// made with the same client.  Batch and BatchWriter stage their requests the
+// same way, and differ only in when the requests are sent.
+type Batch struct {
+	*Client
+
+	q mcQueue
+}
+
+// NewBatch returns an empty Batch that is applied using this client.
+func (c *Client) NewBatch() *Batch {
+	return &Batch{Client: c}
+}
+
+// Set stages a "set" type command.
+func (b *Batch) Set(path, element, extras, ans interface{}) ([]byte, error) {
+	return nil, b.add(newMultiConfigureRequest("set", path, element))
+}
+
+// Edit stages an "edit" type command.
+func (b *Batch) Edit(path, element, extras, ans interface{}) ([]byte, error) {
+	return nil, b.add(newMultiConfigureRequest("edit", path, element))
+}
+
+// Delete stages a "delete" type command.
+func (b *Batch) Delete(path, extras, ans interface{}) ([]byte, error) {
+	return nil, b.add(newMultiConfigureRequest("delete", path, nil))
+}
+
+// Move stages a "move" type command.
+func (b *Batch) Move(path interface{}, where, dst string, extras, ans interface{}) ([]byte, error) {
+	r := newMultiConfigureRequest("move", path, nil)
+	r.Where = where
+	r.Dst = dst
+
+	return nil, b.add(r)
+}
+
+// Rename stages a "rename" type command.
+func (b *Batch) Rename(path interface{}, newname string, extras, ans interface{}) ([]byte, error) {
+	r := newMultiConfigureRequest("rename", path, nil)
+	r.NewName = newname
+
+	return nil, b.add(r)
+}
+
+// VsysImport stages importing the given names into a vsys.
+func (b *Batch) VsysImport(loc, tmpl, ts, vsys string, names []string) error {
+	path := b.xpathImport(tmpl, ts, vsys)
+	if len(names) == 0 || vsys == "" {
+		return nil
+	} else if len(names) == 1 {
+		path = append(path, loc)
+	}
+
+	obj := util.BulkElement{XMLName: xml.Name{Local: loc}}
+	for i := range names {
+		obj.Data = append(obj.Data, vis{xml.Name{Local: "member"}, names[i]})
+	}
+
+	_, err := b.Set(path, obj.Config(), nil, nil)
+	return err
+}
+
+// VsysUnimport stages removing the given names from all vsys.
+//
+// Deleting a name that is not imported anywhere would fail the whole batch,
+// so the current imports are read first, and only the names that are
+// currently imported are staged.
+func (b *Batch) VsysUnimport(loc, tmpl, ts string, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	imported, err := b.ImportedVsys(loc, tmpl, ts, names)
+	if err != nil {
+		return err
+	}
+
+	list := make([]string, 0, len(imported))
+	for _, name := range names {
+		if _, ok := imported[name]; ok {
+			list = append(list, name)
+		}
+	}
+	if len(list) == 0 {
+		return nil
+	}
+
+	path := make([]string, 0, 14)
+	path = append(path, b.xpathImport(tmpl, ts, "")...)
+	path = append(path, loc, util.AsMemberXpath(list))
+
+	_, err = b.Delete(path, nil, nil)
+	return err
+}
+
+// PositionFirstEntity returns an error, as positioning depends on the current
+// config and cannot be staged.
+func (b *Batch) PositionFirstEntity(mvt int, rel, ent string, path, elms []string) error {
+	return fmt.Errorf("positioning cannot be part of a batch")
+}
+
+// Commit returns an error, as the batch must be applied before committing.
+func (b *Batch) Commit(cmd interface{}, action string, extras interface{}) (uint, []byte, error) {
+	return 0, nil, fmt.Errorf("commit cannot be part of a batch; apply the batch first")
+}
+
+// Pending returns the number of staged requests.
+func (b *Batch) Pending() int {
+	return b.q.pending()
+}
+
+// Requests returns a copy of the staged requests.
+func (b *Batch) Requests() []MultiConfigureRequest {
+	return b.q.requests()
+}
+
+// Discard removes all staged requests without applying them.
+func (b *Batch) Discard() {
+	b.q.take()
+}
+
+// Apply sends all staged requests to PAN-OS in a single strictly
+// transactional multi-config request.
+//
+// The staged requests are cleared whether or not they were applied.  If
+// PAN-OS rejects the batch, then none of the changes are applied, and the
+// response is returned along with an error; the Id of each result is the
+// one-based index of its request.
+func (b *Batch) Apply() (MultiConfigureResponse, error) {
+	reqs := b.q.take()
+	if len(reqs) == 0 {
+		return MultiConfigureResponse{}, nil
+	} else if !b.Versioning().Gte(version.Number{9, 0, 0, ""}) {
+		return MultiConfigureResponse{}, fmt.Errorf("multi-config requires PAN-OS 9.0+")
+	}
+
+	b.LogAction("(batch) applying %d requests", len(reqs))
+	return b.Client.sendBatch(reqs, true)
+}
+
+/** Internal functions for the Batch struct **/
+
+func (b *Batch) add(r MultiConfigureRequest) error {
+	if b.ReadOnly {
+		return ReadOnlyError{r.XMLName.Local}
+	}
+
+	b.logXpath(r.Xpath)
+	b.q.add(r)
+	return nil
+}
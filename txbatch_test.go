@@ -0,0 +1,129 @@
+package pango
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/objs/addr"
+	"github.com/PaloAltoNetworks/pango/util"
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+var _ util.XapiClient = &Batch{}
+
+func TestBatchApply(t *testing.T) {
+	c := &Client{rb: [][]byte{[]byte(okMultiConfigResp)}}
+	c.Initialize()
+	c.Version = version.Number{10, 1, 0, ""}
+	b := c.NewBatch()
+
+	ns := &addr.FwAddr{}
+	ns.Initialize(b)
+
+	if err := ns.Set("", addr.Entry{Name: "a1", Value: "10.1.1.1", Type: addr.IpNetmask}, addr.Entry{Name: "a2", Value: "10.1.1.2", Type: addr.IpNetmask}); err != nil {
+		t.Fatalf("Error in set: %s", err)
+	}
+	if err := ns.Delete("", "a3"); err != nil {
+		t.Fatalf("Error in delete: %s", err)
+	}
+	if _, err := b.Rename("/config/shared/address/entry[@name='a4']", "a5", nil, nil); err != nil {
+		t.Fatalf("Error in rename: %s", err)
+	}
+
+	if len(c.rp) != 0 {
+		t.Fatalf("Sent %d requests before apply", len(c.rp))
+	} else if b.Pending() != 3 {
+		t.Fatalf("Pending is %d, not 3", b.Pending())
+	}
+
+	if _, err := b.Apply(); err != nil {
+		t.Fatalf("Error in apply: %s", err)
+	}
+	if len(c.rp) != 1 {
+		t.Fatalf("Sent %d requests, not 1", len(c.rp))
+	}
+	rp := c.rp[0]
+	if rp.Get("action") != "multi-config" {
+		t.Errorf("Action is %q", rp.Get("action"))
+	}
+	if rp.Get("strict-transactional") != "yes" {
+		t.Errorf("Strict transactional is %q", rp.Get("strict-transactional"))
+	}
+	elm := rp.Get("element")
+	if !strings.Contains(elm, `<rename id="3"`) || !strings.Contains(elm, `newname="a5"`) {
+		t.Errorf("Rename not in element: %s", elm)
+	}
+	if b.Pending() != 0 {
+		t.Errorf("Pending is %d after apply", b.Pending())
+	}
+}
+
+func TestBatchApplyOldVersion(t *testing.T) {
+	c := &Client{}
+	c.Initialize()
+	c.Version = version.Number{8, 1, 0, ""}
+	b := c.NewBatch()
+
+	if _, err := b.Delete("/config/shared/address/entry[@name='a1']", nil, nil); err != nil {
+		t.Fatalf("Error in delete: %s", err)
+	}
+	if _, err := b.Apply(); err == nil {
+		t.Errorf("No error for PAN-OS 8.1")
+	}
+	if len(c.rp) != 0 {
+		t.Errorf("Sent %d requests", len(c.rp))
+	}
+}
+
+func TestBatchCommitNotStaged(t *testing.T) {
+	c := &Client{}
+	c.Initialize()
+	b := c.NewBatch()
+
+	if _, _, err := b.Commit("<commit/>", "", nil); err == nil {
+		t.Errorf("No error from commit")
+	}
+}
+
+const vsysListResp = `<response status="success"><result total-count="2" count="2"><entry name="vsys1"/><entry name="vsys2"/></result></response>`
+
+func TestBatchVsysUnimportOnlyImported(t *testing.T) {
+	c := &Client{rb: [][]byte{
+		[]byte(vsysListResp),
+		[]byte(`<response status="success"><result total-count="1" count="1"><member>ethernet1/1</member></result></response>`),
+		[]byte(`<response status="success"><result total-count="0" count="0"/></response>`),
+	}}
+	c.Initialize()
+	b := c.NewBatch()
+
+	if err := b.VsysUnimport(util.InterfaceImport, "", "", []string{"ethernet1/1", "ethernet1/2"}); err != nil {
+		t.Fatalf("Error in unimport: %s", err)
+	}
+
+	reqs := b.Requests()
+	if len(reqs) != 1 {
+		t.Fatalf("Staged %d requests, not 1", len(reqs))
+	}
+	if reqs[0].XMLName.Local != "delete" {
+		t.Errorf("Staged a %q", reqs[0].XMLName.Local)
+	}
+	if !strings.Contains(reqs[0].Xpath, "ethernet1/1") || strings.Contains(reqs[0].Xpath, "ethernet1/2") {
+		t.Errorf("Xpath is %s", reqs[0].Xpath)
+	}
+}
+
+func TestBatchVsysUnimportNotImported(t *testing.T) {
+	c := &Client{rb: [][]byte{
+		[]byte(vsysListResp),
+		[]byte(`<response status="success"><result total-count="0" count="0"/></response>`),
+	}}
+	c.Initialize()
+	b := c.NewBatch()
+
+	if err := b.VsysUnimport(util.InterfaceImport, "", "", []string{"ethernet1/1"}); err != nil {
+		t.Fatalf("Error in unimport: %s", err)
+	}
+	if b.Pending() != 0 {
+		t.Errorf("Pending is %d, not 0", b.Pending())
+	}
+}
@@ -2,6 +2,9 @@ package util
 
 import (
 	"encoding/xml"
+	"errors"
+	"sort"
+	"strings"
 )
 
 // BulkElement is a generic bulk container for bulk operations.
@@ -17,3 +20,99 @@ func (o BulkElement) Config() interface{} {
 	}
 	return o
 }
+
+// Names returns the name attribute of each entry in Data.  Entries without
+// a name have an empty string as their name.
+func (o BulkElement) Names() []string {
+	ans := make([]string, len(o.Data))
+	for i := range o.Data {
+		ans[i] = entryName(o.Data[i])
+	}
+
+	return ans
+}
+
+// EntryErrors maps the messages of the given error to the entries of this
+// bulk element that they refer to.  PAN-OS reports problems with a bulk SET as
+// message lines such as "a1 -> ip-netmask 'x' is not a valid IP", so each
+// line is attributed to the first entry name found in its path.
+//
+// Messages that cannot be attributed to an entry are mapped to the empty
+// string.  If err is nil, then nil is returned.
+func (o BulkElement) EntryErrors(err error) map[string][]string {
+	if err == nil {
+		return nil
+	}
+
+	var msgs []string
+	var ml MessageLister
+	if errors.As(err, &ml) {
+		msgs = ml.Messages()
+	} else {
+		msgs = []string{err.Error()}
+	}
+
+	names := o.Names()
+	sort.Slice(names, func(i, j int) bool {
+		return len(names[i]) > len(names[j])
+	})
+
+	ans := make(map[string][]string)
+	for _, msg := range msgs {
+		name := matchEntryName(strings.TrimSpace(msg), names)
+		ans[name] = append(ans[name], strings.TrimSpace(msg))
+	}
+
+	return ans
+}
+
+// MessageLister is an error that is made up of multiple messages, such as a
+// PAN-OS error response with multiple lines.
+type MessageLister interface {
+	Messages() []string
+}
+
+/** Internal functions **/
+
+// entryName returns the name attribute of the given version specific object.
+func entryName(v interface{}) string {
+	b, err := xml.Marshal(v)
+	if err != nil {
+		return ""
+	}
+
+	d := xml.NewDecoder(strings.NewReader(string(b)))
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return ""
+		}
+		if se, ok := tok.(xml.StartElement); ok {
+			for _, attr := range se.Attr {
+				if attr.Name.Local == "name" {
+					return attr.Value
+				}
+			}
+			return ""
+		}
+	}
+}
+
+// matchEntryName returns the first of the given names that is referenced in
+// the path of the given message.  The names should be sorted longest first
+// so that names that are prefixes of other names are handled properly.
+func matchEntryName(msg string, names []string) string {
+	for _, seg := range strings.Split(msg, "->") {
+		seg = strings.Trim(strings.TrimSpace(seg), `'"`)
+		for _, name := range names {
+			if name == "" {
+				continue
+			}
+			if seg == name || strings.HasPrefix(seg, name+" ") || strings.HasPrefix(seg, name+"'") {
+				return name
+			}
+		}
+	}
+
+	return ""
+}
@@ -28,7 +28,14 @@ const (
 )
 
 // Valid values to use for any function expecting a pango query type `qt`.
+//
+// Get retrieves from the candidate config, Show retrieves from the running
+// config, EffectiveRunning retrieves from the running config merged with any
+// config pushed from Panorama, and PushedSharedPolicy retrieves only the
+// policy and objects pushed from Panorama.
 const (
-	Get  = "get"
-	Show = "show"
+	Get                = "get"
+	Show               = "show"
+	EffectiveRunning   = "effective-running"
+	PushedSharedPolicy = "pushed-shared-policy"
 )
@@ -0,0 +1,49 @@
+package util
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// IdSeparator separates the parts of an ID built with BuildId.
+const IdSeparator = ":"
+
+var idEscaper = strings.NewReplacer("%", "%25", IdSeparator, "%3A")
+
+// BuildId returns a composite ID made up of the given parts, such as the
+// template, template stack, vsys, and name of an object.
+//
+// The parts are joined with IdSeparator.  Any separator or "%" inside of a
+// part is percent encoded, so names containing colons (such as IPv6
+// addresses) survive a round trip through ParseId.  IDs made from parts that
+// contain neither are just the parts joined by colons:
+//
+//	util.BuildId("tmpl1", "", "vsys1", "web") == "tmpl1::vsys1:web"
+func BuildId(parts ...string) string {
+	list := make([]string, 0, len(parts))
+	for _, p := range parts {
+		list = append(list, idEscaper.Replace(p))
+	}
+
+	return strings.Join(list, IdSeparator)
+}
+
+// ParseId parses the given composite ID made by BuildId, verifying that it
+// has the given number of parts.
+func ParseId(id string, n int) ([]string, error) {
+	list := strings.Split(id, IdSeparator)
+	if len(list) != n {
+		return nil, fmt.Errorf("expected %d parts in ID %q, not %d", n, id, len(list))
+	}
+
+	for i := range list {
+		p, err := url.PathUnescape(list[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid ID %q: %s", id, err)
+		}
+		list[i] = p
+	}
+
+	return list, nil
+}
@@ -0,0 +1,41 @@
+package util
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIdRoundTrip(t *testing.T) {
+	testCases := []struct {
+		parts []string
+		id    string
+	}{
+		{[]string{"vsys1", "web"}, "vsys1:web"},
+		{[]string{"tmpl1", "", "vsys1", "web"}, "tmpl1::vsys1:web"},
+		{[]string{"shared", "fd00::1"}, "shared:fd00%3A%3A1"},
+		{[]string{"dg", "100%"}, "dg:100%25"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.id, func(t *testing.T) {
+			if id := BuildId(tc.parts...); id != tc.id {
+				t.Errorf("Built %q", id)
+			}
+			parts, err := ParseId(tc.id, len(tc.parts))
+			if err != nil {
+				t.Fatalf("Error parsing: %s", err)
+			} else if !reflect.DeepEqual(parts, tc.parts) {
+				t.Errorf("Parsed %#v", parts)
+			}
+		})
+	}
+}
+
+func TestParseIdErrors(t *testing.T) {
+	if _, err := ParseId("vsys1:web", 3); err == nil {
+		t.Errorf("No error for wrong number of parts")
+	}
+	if _, err := ParseId("vsys1:w%zzb", 2); err == nil {
+		t.Errorf("No error for invalid escape")
+	}
+}
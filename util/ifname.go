@@ -0,0 +1,117 @@
+package util
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// Interface types returned by ParseInterfaceName.
+const (
+	EthernetInterface          = "ethernet"
+	AggregateEthernetInterface = "aggregate-ethernet"
+	TunnelInterface            = "tunnel"
+	VlanInterface              = "vlan"
+	LoopbackInterface          = "loopback"
+)
+
+// MaxInterfaceUnit is the largest subinterface / logical interface unit number.
+const MaxInterfaceUnit = 9999
+
+// InterfaceName is a parsed interface name.
+//
+// Parent is the name of the physical or logical interface that units are
+// created beneath (such as "ethernet1/3", "ae2", or "tunnel").  Unit is the
+// subinterface / logical interface number, or 0 if there is none.
+type InterfaceName struct {
+	Type   string
+	Parent string
+	Unit   int
+}
+
+// String returns the interface name.
+func (o InterfaceName) String() string {
+	if o.Unit == 0 {
+		return o.Parent
+	}
+
+	return fmt.Sprintf("%s.%d", o.Parent, o.Unit)
+}
+
+var (
+	ethernetNameRe = regexp.MustCompile(`^ethernet([1-9][0-9]*)/([1-9][0-9]*)(?:\.([1-9][0-9]*))?$`)
+	aeNameRe       = regexp.MustCompile(`^ae([1-9][0-9]*)(?:\.([1-9][0-9]*))?$`)
+	logicalNameRe  = regexp.MustCompile(`^(tunnel|vlan|loopback)(?:\.([1-9][0-9]*))?$`)
+)
+
+// ParseInterfaceName parses and validates the given interface name, such as
+// "ethernet1/1.100", "ae1", "tunnel.5", "vlan.10", or "loopback.3".
+//
+// Unit numbers must be between 1 and MaxInterfaceUnit.
+func ParseInterfaceName(name string) (InterfaceName, error) {
+	var ans InterfaceName
+	var unit string
+
+	if m := ethernetNameRe.FindStringSubmatch(name); m != nil {
+		ans.Type = EthernetInterface
+		ans.Parent = fmt.Sprintf("ethernet%s/%s", m[1], m[2])
+		unit = m[3]
+	} else if m = aeNameRe.FindStringSubmatch(name); m != nil {
+		ans.Type = AggregateEthernetInterface
+		ans.Parent = "ae" + m[1]
+		unit = m[2]
+	} else if m = logicalNameRe.FindStringSubmatch(name); m != nil {
+		ans.Type = m[1]
+		ans.Parent = m[1]
+		unit = m[2]
+	} else {
+		return InterfaceName{}, fmt.Errorf("invalid interface name: %q", name)
+	}
+
+	if unit != "" {
+		n, err := strconv.Atoi(unit)
+		if err != nil || n > MaxInterfaceUnit {
+			return InterfaceName{}, fmt.Errorf("%s: unit must be between 1 and %d", name, MaxInterfaceUnit)
+		}
+		ans.Unit = n
+	}
+
+	return ans, nil
+}
+
+// ValidateInterfaceName returns an error if the given interface name is
+// invalid.  See ParseInterfaceName for the accepted names.
+func ValidateInterfaceName(name string) error {
+	_, err := ParseInterfaceName(name)
+	return err
+}
+
+// NextInterfaceName returns the name of the lowest numbered unit of the given
+// parent interface that is not present in the existing interface names.
+//
+// For example, if the existing names are "ethernet1/3.1" and
+// "ethernet1/3.3", then the next name for "ethernet1/3" is "ethernet1/3.2".
+func NextInterfaceName(parent string, existing []string) (string, error) {
+	p, err := ParseInterfaceName(parent)
+	if err != nil {
+		return "", err
+	} else if p.Unit != 0 {
+		return "", fmt.Errorf("%s: parent interface has a unit number", parent)
+	}
+
+	used := make(map[int]bool)
+	for _, name := range existing {
+		if x, err := ParseInterfaceName(name); err == nil && x.Parent == p.Parent {
+			used[x.Unit] = true
+		}
+	}
+
+	for i := 1; i <= MaxInterfaceUnit; i++ {
+		if !used[i] {
+			p.Unit = i
+			return p.String(), nil
+		}
+	}
+
+	return "", fmt.Errorf("%s: no free unit numbers", parent)
+}
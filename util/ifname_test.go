@@ -0,0 +1,78 @@
+package util
+
+import (
+	"testing"
+)
+
+func TestParseInterfaceName(t *testing.T) {
+	testCases := []struct {
+		name string
+		ok   bool
+		typ  string
+		par  string
+		unit int
+	}{
+		{"ethernet1/1", true, EthernetInterface, "ethernet1/1", 0},
+		{"ethernet1/1.100", true, EthernetInterface, "ethernet1/1", 100},
+		{"ae1", true, AggregateEthernetInterface, "ae1", 0},
+		{"ae2.5", true, AggregateEthernetInterface, "ae2", 5},
+		{"tunnel", true, TunnelInterface, "tunnel", 0},
+		{"tunnel.5", true, TunnelInterface, "tunnel", 5},
+		{"vlan.10", true, VlanInterface, "vlan", 10},
+		{"loopback.3", true, LoopbackInterface, "loopback", 3},
+		{"ethernet1/1.0", false, "", "", 0},
+		{"ethernet1/1.10000", false, "", "", 0},
+		{"ethernet0/1", false, "", "", 0},
+		{"ethernet1", false, "", "", 0},
+		{"ae0", false, "", "", 0},
+		{"tunnel.05", false, "", "", 0},
+		{"loopback3", false, "", "", 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v, err := ParseInterfaceName(tc.name)
+			if !tc.ok {
+				if err == nil {
+					t.Errorf("No error, got %#v", v)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Error: %s", err)
+			}
+			if v.Type != tc.typ || v.Parent != tc.par || v.Unit != tc.unit {
+				t.Errorf("Parsed %#v", v)
+			}
+			if v.String() != tc.name {
+				t.Errorf("String is %q", v.String())
+			}
+		})
+	}
+}
+
+func TestNextInterfaceName(t *testing.T) {
+	existing := []string{"ethernet1/3", "ethernet1/3.1", "ethernet1/3.3", "ethernet1/4.2", "tunnel.1"}
+
+	testCases := []struct {
+		parent string
+		next   string
+	}{
+		{"ethernet1/3", "ethernet1/3.2"},
+		{"ethernet1/4", "ethernet1/4.1"},
+		{"tunnel", "tunnel.2"},
+		{"ae1", "ae1.1"},
+	}
+
+	for _, tc := range testCases {
+		if next, err := NextInterfaceName(tc.parent, existing); err != nil {
+			t.Errorf("%s: error: %s", tc.parent, err)
+		} else if next != tc.next {
+			t.Errorf("%s: next is %q, not %q", tc.parent, next, tc.next)
+		}
+	}
+
+	if _, err := NextInterfaceName("ethernet1/3.1", existing); err == nil {
+		t.Errorf("No error for parent with a unit")
+	}
+}
@@ -31,6 +31,60 @@ func MemToStr(e *MemberType) []string {
 	return ans
 }
 
+// StrBuffer hands out string slices carved from larger shared allocations.
+// It is meant for normalizing many entries at once, where allocating a
+// separate slice for every member list otherwise dominates.
+//
+// Each slice returned has its capacity capped to its length, so appending to
+// one never overwrites another.  Note that retaining any one slice keeps its
+// whole shared allocation alive.  The zero value is ready to use.
+type StrBuffer struct {
+	buf []string
+}
+
+// MemToStr is MemToStr, but using this buffer.
+func (b *StrBuffer) MemToStr(e *MemberType) []string {
+	if e == nil {
+		return nil
+	}
+
+	ans := b.take(len(e.Members))
+	for i := range e.Members {
+		ans[i] = e.Members[i].Value
+	}
+
+	return ans
+}
+
+// EntToStr is EntToStr, but using this buffer.
+func (b *StrBuffer) EntToStr(e *EntryType) []string {
+	if e == nil {
+		return nil
+	}
+
+	ans := b.take(len(e.Entries))
+	for i := range e.Entries {
+		ans[i] = e.Entries[i].Value
+	}
+
+	return ans
+}
+
+const strBufferSize = 1024
+
+func (b *StrBuffer) take(n int) []string {
+	if n > strBufferSize {
+		return make([]string, n)
+	} else if n > len(b.buf) || b.buf == nil {
+		b.buf = make([]string, strBufferSize)
+	}
+
+	ans := b.buf[:n:n]
+	b.buf = b.buf[n:]
+
+	return ans
+}
+
 // StrToMem converts a list of strings into a MemberType pointer.
 func StrToMem(e []string) *MemberType {
 	if e == nil {
@@ -0,0 +1,136 @@
+package util
+
+import (
+	"net/netip"
+	"sort"
+)
+
+// PrefixRange returns the first and last addresses in the given prefix.
+func PrefixRange(p netip.Prefix) (netip.Addr, netip.Addr) {
+	p = p.Masked()
+	first := p.Addr()
+
+	b := first.As16()
+	hostBits := first.BitLen() - p.Bits()
+	for i := 15; i >= 0 && hostBits > 0; i-- {
+		if hostBits >= 8 {
+			b[i] = 0xff
+			hostBits -= 8
+		} else {
+			b[i] |= byte(1<<hostBits) - 1
+			hostBits = 0
+		}
+	}
+
+	last := netip.AddrFrom16(b)
+	if first.Is4() {
+		last = last.Unmap()
+	}
+
+	return first, last
+}
+
+// RangeToPrefixes returns the smallest list of prefixes that exactly covers
+// the inclusive range of addresses from start to end.
+//
+// Nil is returned if start and end are different address families or if
+// start is after end.
+func RangeToPrefixes(start, end netip.Addr) []netip.Prefix {
+	if start.BitLen() != end.BitLen() || end.Less(start) {
+		return nil
+	}
+
+	var ans []netip.Prefix
+	for {
+		var p netip.Prefix
+		var last netip.Addr
+		for bits := 0; bits <= start.BitLen(); bits++ {
+			p = netip.PrefixFrom(start, bits)
+			if p.Masked().Addr() != start {
+				continue
+			}
+			if _, last = PrefixRange(p); !end.Less(last) {
+				break
+			}
+		}
+		ans = append(ans, p)
+
+		if last == end {
+			return ans
+		}
+		start = last.Next()
+	}
+}
+
+// MergePrefixes returns the smallest list of prefixes that covers exactly the
+// same addresses as the given prefixes, sorted with IPv4 before IPv6.
+func MergePrefixes(list []netip.Prefix) []netip.Prefix {
+	var ans []netip.Prefix
+	for _, r := range mergeRanges(list) {
+		ans = append(ans, RangeToPrefixes(r[0], r[1])...)
+	}
+
+	return ans
+}
+
+// ComplementPrefixes returns the smallest list of prefixes that covers every
+// address not covered by the given prefixes, sorted with IPv4 before IPv6.
+//
+// Both the IPv4 and IPv6 address spaces are considered, so the complement of
+// an empty list is 0.0.0.0/0 and ::/0.
+func ComplementPrefixes(list []netip.Prefix) []netip.Prefix {
+	var ans []netip.Prefix
+	ranges := mergeRanges(list)
+
+	for _, family := range []netip.Prefix{netip.MustParsePrefix("0.0.0.0/0"), netip.MustParsePrefix("::/0")} {
+		next, max := PrefixRange(family)
+		done := false
+		for _, r := range ranges {
+			if r[0].BitLen() != next.BitLen() {
+				continue
+			}
+			if next.Less(r[0]) {
+				ans = append(ans, RangeToPrefixes(next, r[0].Prev())...)
+			}
+			if r[1] == max {
+				done = true
+				break
+			}
+			next = r[1].Next()
+		}
+		if !done {
+			ans = append(ans, RangeToPrefixes(next, max)...)
+		}
+	}
+
+	return ans
+}
+
+// mergeRanges converts the prefixes to sorted, non-overlapping, non-adjacent
+// inclusive address ranges.
+func mergeRanges(list []netip.Prefix) [][2]netip.Addr {
+	ranges := make([][2]netip.Addr, 0, len(list))
+	for _, p := range list {
+		first, last := PrefixRange(p)
+		ranges = append(ranges, [2]netip.Addr{first, last})
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i][0].Less(ranges[j][0])
+	})
+
+	ans := make([][2]netip.Addr, 0, len(ranges))
+	for _, r := range ranges {
+		if n := len(ans); n > 0 {
+			prev := &ans[n-1]
+			if prev[1].BitLen() == r[0].BitLen() && (!prev[1].Less(r[0]) || prev[1].Next() == r[0]) {
+				if prev[1].Less(r[1]) {
+					prev[1] = r[1]
+				}
+				continue
+			}
+		}
+		ans = append(ans, r)
+	}
+
+	return ans
+}
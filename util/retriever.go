@@ -1,5 +1,32 @@
 package util
 
+import (
+	"fmt"
+)
+
 // Retriever is a type that is intended to act as a stand-in for using
 // either the Get or Show pango Client functions.
 type Retriever func(interface{}, interface{}, interface{}) ([]byte, error)
+
+// RetrieverFor returns the Retriever for the given pango query type, which
+// should be one of Get, Show, EffectiveRunning, or PushedSharedPolicy.
+func RetrieverFor(con XapiClient, qt string) (Retriever, error) {
+	switch qt {
+	case Get:
+		return con.Get, nil
+	case Show:
+		return con.Show, nil
+	case EffectiveRunning:
+		if v, ok := con.(EffectiveRunningClient); ok {
+			return v.EffectiveRunning, nil
+		}
+		return nil, fmt.Errorf("client does not support query type: %s", qt)
+	case PushedSharedPolicy:
+		if v, ok := con.(PushedSharedPolicyClient); ok {
+			return v.PushedSharedPolicy, nil
+		}
+		return nil, fmt.Errorf("client does not support query type: %s", qt)
+	}
+
+	return nil, fmt.Errorf("invalid query type: %s", qt)
+}
@@ -0,0 +1,55 @@
+package util
+
+import (
+	"fmt"
+)
+
+// Valid values for BulkSetError.Phase.
+const (
+	PhaseSet      = "set"
+	PhaseUnimport = "unimport"
+	PhaseImport   = "import"
+)
+
+// BulkSetError is returned when a Set function that performs multiple
+// API calls (such as creating interfaces and then importing them into a
+// vsys) fails partway through.
+//
+// Phase is the step that failed.  Created is the list of objects whose
+// config was successfully sent to PAN-OS before the failure, while Failed is
+// the list of objects the failing step was acting upon.  Err is the
+// underlying error.
+//
+// If the failure could be attributed to specific objects, then EntryErrors
+// maps those object names to the PAN-OS error messages about them.  Messages
+// that could not be attributed to an object are mapped to the empty string.
+//
+// If the Set function supports rollback, then RolledBack is true if the
+// objects were successfully restored to their previous config, otherwise
+// RollbackErr is the error encountered while trying to do so.
+type BulkSetError struct {
+	Phase       string
+	Created     []string
+	Failed      []string
+	Err         error
+	EntryErrors map[string][]string
+	RolledBack  bool
+	RollbackErr error
+}
+
+// Error returns the error message.
+func (e BulkSetError) Error() string {
+	msg := fmt.Sprintf("%s failed for %v: %s", e.Phase, e.Failed, e.Err)
+	if e.RolledBack {
+		return msg + " (rolled back)"
+	} else if e.RollbackErr != nil {
+		return fmt.Sprintf("%s (rollback failed: %s)", msg, e.RollbackErr)
+	}
+
+	return msg
+}
+
+// Unwrap returns the underlying error.
+func (e BulkSetError) Unwrap() error {
+	return e.Err
+}
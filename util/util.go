@@ -127,6 +127,43 @@ func AsMemberXpath(vals []string) string {
 	return buf.String()
 }
 
+// AsTaggedEntryXpath returns an entry xpath segment that matches all entries
+// that have the given administrative tag.
+func AsTaggedEntryXpath(tag string) string {
+	return fmt.Sprintf("entry[tag/member=%s]", XpathLiteral(tag))
+}
+
+// XpathLiteral returns the given string as an xpath string literal.
+//
+// XPath 1.0 has no escape sequences, so a string containing both single and
+// double quotes is built up with concat().
+func XpathLiteral(v string) string {
+	if !strings.Contains(v, "'") {
+		return "'" + v + "'"
+	} else if !strings.Contains(v, `"`) {
+		return `"` + v + `"`
+	}
+
+	parts := strings.Split(v, "'")
+	args := make([]string, 0, 2*len(parts)-1)
+	for i, p := range parts {
+		if i > 0 {
+			args = append(args, `"'"`)
+		}
+		if p != "" {
+			args = append(args, "'"+p+"'")
+		}
+	}
+
+	return "concat(" + strings.Join(args, ", ") + ")"
+}
+
+// AsEntryXpathPage returns an entry xpath segment that matches up to limit
+// entries, starting at the given zero-based offset.
+func AsEntryXpathPage(offset, limit int) string {
+	return fmt.Sprintf("entry[position() > %d and position() <= %d]", offset, offset+limit)
+}
+
 // TemplateXpathPrefix returns the template xpath prefix of the given template name.
 func TemplateXpathPrefix(tmpl, ts string) []string {
 	if tmpl != "" {
@@ -198,9 +235,16 @@ func StripPanosPackaging(input []byte, tag string) []byte {
 	index = bytes.Index(input, gt)
 	ans := input[index+1:]
 	index = bytes.LastIndex(ans, lt)
+	if index < 0 {
+		return nil
+	}
 	ans = ans[:index]
 
-	// Remove result.
+	// Remove result.  An empty result is self closing, such as when a page
+	// of entries is requested past the last entry.
+	if bytes.HasSuffix(bytes.TrimSpace(ans), []byte("/>")) && bytes.Count(ans, lt) == 1 {
+		return nil
+	}
 	index = bytes.Index(ans, gt)
 	ans = ans[index+1:]
 	index = bytes.LastIndex(ans, lt)
@@ -1,7 +1,11 @@
 package util
 
 import (
+	"encoding/xml"
 	"fmt"
+	"net/netip"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -77,6 +81,48 @@ func BenchmarkMemToStr(b *testing.B) {
 	}
 }
 
+func BenchmarkStrBufferMemToStr(b *testing.B) {
+	m := &MemberType{[]Member{
+		{Value: "one"},
+		{Value: "two"},
+		{Value: "three"},
+		{Value: "four"},
+		{Value: "five"},
+	}}
+	var buf StrBuffer
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = buf.MemToStr(m)
+	}
+}
+
+func TestStrBuffer(t *testing.T) {
+	var buf StrBuffer
+	m := &MemberType{[]Member{{Value: "one"}, {Value: "two"}}}
+
+	if v := buf.MemToStr(&MemberType{}); v == nil || len(v) != 0 {
+		t.Errorf("Expected an empty, non-nil slice, got %#v", v)
+	}
+
+	a := buf.MemToStr(m)
+	b := buf.MemToStr(m)
+	if !reflect.DeepEqual(a, []string{"one", "two"}) || !reflect.DeepEqual(b, a) {
+		t.Fatalf("Got %#v and %#v", a, b)
+	}
+
+	// Appending to one slice must not clobber the next.
+	a = append(a, "three")
+	if b[0] != "one" {
+		t.Errorf("Append overwrote the next slice: %#v", b)
+	}
+
+	if buf.MemToStr(nil) != nil || buf.EntToStr(nil) != nil {
+		t.Errorf("Expected nil for nil input")
+	}
+}
+
 func BenchmarkStrToEnt(b *testing.B) {
 	v := []string{"one", "two", "three", "four", "five"}
 	b.ResetTimer()
@@ -184,9 +230,203 @@ func TestAsMemberXpath(t *testing.T) {
 	}
 }
 
+func TestAsTaggedEntryXpath(t *testing.T) {
+	testCases := []struct {
+		v string
+		r string
+	}{
+		{"web", "entry[tag/member='web']"},
+		{"bob's", `entry[tag/member="bob's"]`},
+		{`a\b`, `entry[tag/member='a\b']`},
+		{`bob's "web"`, `entry[tag/member=concat('bob', "'", 's "web"')]`},
+		{`'x"`, `entry[tag/member=concat("'", 'x"')]`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.r, func(t *testing.T) {
+			if v := AsTaggedEntryXpath(tc.v); v != tc.r {
+				t.Errorf("%s != %s", v, tc.r)
+			}
+		})
+	}
+}
+
 func TestCleanRawXml(t *testing.T) {
 	v := `<foo admin="admin" dirtyId="2" time="1234/05/06 07:08:09">hi</foo>`
 	if CleanRawXml(v) != "<foo>hi</foo>" {
 		t.Fail()
 	}
 }
+
+func TestTemplateVariables(t *testing.T) {
+	vals := []string{"$wan-ip", "10.1.1.1/24", "$", "$lan-ip", "$wan-ip"}
+	expected := []string{"$wan-ip", "$lan-ip"}
+
+	if v := TemplateVariables(vals...); !reflect.DeepEqual(v, expected) {
+		t.Errorf("%#v != %#v", v, expected)
+	}
+}
+
+func TestValidateIps(t *testing.T) {
+	if err := ValidateIps([]string{"10.1.1.1", "10.2.2.1/24", "$wan-ip", "fd00::1/64", "web-server", "10.1.1.0-net"}); err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+
+	for _, v := range []string{"10.1.1.300", "10.1.1.1/33", "fd00::1::2"} {
+		if err := ValidateIps([]string{"10.1.1.1", v}); err == nil {
+			t.Errorf("Expected an error for %q", v)
+		}
+	}
+}
+
+type testEntryXml struct {
+	XMLName xml.Name `xml:"entry"`
+	Name    string   `xml:"name,attr"`
+}
+
+type testMessages []string
+
+func (e testMessages) Error() string      { return strings.Join(e, " | ") }
+func (e testMessages) Messages() []string { return e }
+
+func TestBulkElementEntryErrors(t *testing.T) {
+	elm := BulkElement{Data: []interface{}{
+		testEntryXml{Name: "a1"},
+		testEntryXml{Name: "a10"},
+		testEntryXml{Name: "a2"},
+	}}
+	err := testMessages{
+		" address -> a10 -> ip-netmask 'bogus' is not a valid IP",
+		"'a2' is not a valid reference",
+		"address is invalid",
+	}
+	expected := map[string][]string{
+		"a10": {"address -> a10 -> ip-netmask 'bogus' is not a valid IP"},
+		"a2":  {"'a2' is not a valid reference"},
+		"":    {"address is invalid"},
+	}
+
+	if v := elm.EntryErrors(err); !reflect.DeepEqual(v, expected) {
+		t.Errorf("%#v != %#v", v, expected)
+	}
+	if elm.EntryErrors(nil) != nil {
+		t.Errorf("Expected nil for a nil error")
+	}
+}
+
+func TestPrefixRange(t *testing.T) {
+	testCases := []struct {
+		p     string
+		first string
+		last  string
+	}{
+		{"10.1.1.0/24", "10.1.1.0", "10.1.1.255"},
+		{"10.1.1.5/30", "10.1.1.4", "10.1.1.7"},
+		{"10.1.1.1/32", "10.1.1.1", "10.1.1.1"},
+		{"0.0.0.0/0", "0.0.0.0", "255.255.255.255"},
+		{"fd00::/120", "fd00::", "fd00::ff"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.p, func(t *testing.T) {
+			first, last := PrefixRange(netip.MustParsePrefix(tc.p))
+			if first.String() != tc.first || last.String() != tc.last {
+				t.Errorf("Got %s - %s", first, last)
+			}
+		})
+	}
+}
+
+func TestRangeToPrefixes(t *testing.T) {
+	testCases := []struct {
+		start string
+		end   string
+		r     []string
+	}{
+		{"10.1.1.0", "10.1.1.255", []string{"10.1.1.0/24"}},
+		{"10.1.1.1", "10.1.1.1", []string{"10.1.1.1/32"}},
+		{"10.1.1.1", "10.1.1.10", []string{"10.1.1.1/32", "10.1.1.2/31", "10.1.1.4/30", "10.1.1.8/31", "10.1.1.10/32"}},
+		{"0.0.0.0", "255.255.255.255", []string{"0.0.0.0/0"}},
+		{"fd00::", "fd00::1:ffff", []string{"fd00::/111"}},
+		{"10.1.1.10", "10.1.1.1", nil},
+		{"10.1.1.1", "fd00::1", nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.start+"-"+tc.end, func(t *testing.T) {
+			ans := RangeToPrefixes(netip.MustParseAddr(tc.start), netip.MustParseAddr(tc.end))
+			var v []string
+			for _, p := range ans {
+				v = append(v, p.String())
+			}
+			if !reflect.DeepEqual(v, tc.r) {
+				t.Errorf("%#v != %#v", v, tc.r)
+			}
+		})
+	}
+}
+
+func TestMergePrefixes(t *testing.T) {
+	testCases := []struct {
+		desc string
+		v    []string
+		r    []string
+	}{
+		{"empty", nil, nil},
+		{"adjacent", []string{"10.1.1.0/25", "10.1.1.128/25"}, []string{"10.1.1.0/24"}},
+		{"contained", []string{"10.1.1.0/24", "10.1.1.5/32", "fd00::/64"}, []string{"10.1.1.0/24", "fd00::/64"}},
+		{"disjoint", []string{"10.2.2.0/24", "10.1.1.0/24"}, []string{"10.1.1.0/24", "10.2.2.0/24"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if v := prefixStrings(MergePrefixes(parsePrefixes(tc.v))); !reflect.DeepEqual(v, tc.r) {
+				t.Errorf("%#v != %#v", v, tc.r)
+			}
+		})
+	}
+}
+
+func TestComplementPrefixes(t *testing.T) {
+	testCases := []struct {
+		desc string
+		v    []string
+		r    []string
+	}{
+		{"empty", nil, []string{"0.0.0.0/0", "::/0"}},
+		{"everything", []string{"0.0.0.0/0", "::/0"}, nil},
+		{"upper half", []string{"128.0.0.0/1"}, []string{"0.0.0.0/1", "::/0"}},
+		{"lower half v6", []string{"0.0.0.0/0", "::/1"}, []string{"8000::/1"}},
+		{"middle", []string{"64.0.0.0/2", "128.0.0.0/2", "::/0"}, []string{"0.0.0.0/2", "192.0.0.0/2"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if v := prefixStrings(ComplementPrefixes(parsePrefixes(tc.v))); !reflect.DeepEqual(v, tc.r) {
+				t.Errorf("%#v != %#v", v, tc.r)
+			}
+		})
+	}
+}
+
+func parsePrefixes(v []string) []netip.Prefix {
+	var ans []netip.Prefix
+	for _, s := range v {
+		ans = append(ans, netip.MustParsePrefix(s))
+	}
+	return ans
+}
+
+func prefixStrings(v []netip.Prefix) []string {
+	var ans []string
+	for _, p := range v {
+		ans = append(ans, p.String())
+	}
+	return ans
+}
+
+func TestRetrieverForInvalid(t *testing.T) {
+	if _, err := RetrieverFor(nil, "bogus"); err == nil {
+		t.Errorf("No error for invalid query type")
+	}
+}
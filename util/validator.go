@@ -0,0 +1,7 @@
+package util
+
+// Validator is an interface for commits that can check themselves before
+// being sent.
+type Validator interface {
+	Validate() error
+}
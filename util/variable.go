@@ -0,0 +1,63 @@
+package util
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// IsTemplateVariable returns true if the given value is a reference to a
+// Panorama template variable (such as "$wan-ip") instead of a literal value.
+func IsTemplateVariable(v string) bool {
+	return len(v) > 1 && strings.HasPrefix(v, "$")
+}
+
+// TemplateVariables returns the unique template variables referenced in the
+// given values, in the order they were first encountered.
+func TemplateVariables(vals ...string) []string {
+	var ans []string
+	seen := make(map[string]bool)
+
+	for _, v := range vals {
+		if IsTemplateVariable(v) && !seen[v] {
+			seen[v] = true
+			ans = append(ans, v)
+		}
+	}
+
+	return ans
+}
+
+// ValidateIps checks that the given values that look like IP addresses are
+// valid IP addresses or CIDR formatted IP addresses.
+//
+// Other values are skipped:  template variables, as their value is only known
+// at the time the template is pushed to the firewall, and address object
+// names, as PAN-OS accepts those in place of an IP address.
+func ValidateIps(vals []string) error {
+	for _, v := range vals {
+		if IsTemplateVariable(v) || !looksLikeIp(v) {
+			continue
+		}
+
+		if net.ParseIP(v) != nil {
+			continue
+		}
+
+		if _, _, err := net.ParseCIDR(v); err != nil {
+			return fmt.Errorf("Invalid IP address: %q", v)
+		}
+	}
+
+	return nil
+}
+
+// looksLikeIp returns true if the given value is made up of only the
+// characters of an IP address or CIDR, and so is not an address object name.
+func looksLikeIp(v string) bool {
+	if strings.Contains(v, ":") {
+		return strings.Trim(v, "0123456789abcdefABCDEF:./") == ""
+	}
+
+	return strings.Contains(v, ".") && strings.Trim(v, "0123456789./") == ""
+}
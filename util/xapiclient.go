@@ -1,6 +1,8 @@
 package util
 
 import (
+	"fmt"
+	"sort"
 	"time"
 
 	"github.com/PaloAltoNetworks/pango/version"
@@ -31,3 +33,113 @@ type XapiClient interface {
 	Commit(interface{}, string, interface{}) (uint, []byte, error)
 	PositionFirstEntity(int, string, string, []string, []string) error
 }
+
+// The following are optional interfaces that an XapiClient may implement.
+// Namespaces check for them with a type assertion, so an XapiClient that does
+// not implement them still works, just without the related functionality.
+
+// EffectiveRunningClient is an XapiClient that can retrieve config from the
+// effective running config.
+type EffectiveRunningClient interface {
+	EffectiveRunning(interface{}, interface{}, interface{}) ([]byte, error)
+}
+
+// PushedSharedPolicyClient is an XapiClient that can retrieve config from the
+// shared policy pushed from Panorama.
+type PushedSharedPolicyClient interface {
+	PushedSharedPolicy(interface{}, interface{}, interface{}) ([]byte, error)
+}
+
+// ImportChecker is an XapiClient that can check if an object is imported
+// into a vsys.
+type ImportChecker interface {
+	IsImported(string, string, string, string, string) (bool, error)
+}
+
+// ImportLocator is an XapiClient that can look up which vsys objects are
+// imported into.
+type ImportLocator interface {
+	ImportedVsys(string, string, string, []string) (map[string]string, error)
+}
+
+// UnchangedWriteSkipper is an XapiClient that can be configured to skip
+// writing config that has not changed.
+type UnchangedWriteSkipper interface {
+	SkipUnchangedWrites() bool
+}
+
+// SkipUnchangedWrites returns true if the client is configured to skip
+// writing config that has not changed.
+func SkipUnchangedWrites(con XapiClient) bool {
+	if v, ok := con.(UnchangedWriteSkipper); ok {
+		return v.SkipUnchangedWrites()
+	}
+
+	return false
+}
+
+// ResponseStreamer is an XapiClient that can be configured to decode
+// responses directly into the given answer struct instead of returning the
+// raw response.
+type ResponseStreamer interface {
+	StreamsResponses() bool
+}
+
+// StreamsResponses returns true if the client decodes responses directly into
+// the given answer struct, in which case the raw response is not returned.
+func StreamsResponses(con XapiClient) bool {
+	if v, ok := con.(ResponseStreamer); ok {
+		return v.StreamsResponses()
+	}
+
+	return false
+}
+
+// IsImported returns true if the given object is imported into the vsys.
+//
+// If the client cannot check imports, then false is returned, so that the
+// import is performed.
+func IsImported(con XapiClient, loc, tmpl, ts, vsys, name string) (bool, error) {
+	if v, ok := con.(ImportChecker); ok {
+		return v.IsImported(loc, tmpl, ts, vsys, name)
+	}
+
+	return false, nil
+}
+
+// ImportedVsys returns the vsys that each of the given objects is imported
+// into.  Objects that are not imported into a vsys are omitted.
+//
+// If the client cannot look up imports, then an error is returned.
+func ImportedVsys(con XapiClient, loc, tmpl, ts string, names []string) (map[string]string, error) {
+	if v, ok := con.(ImportLocator); ok {
+		return v.ImportedVsys(loc, tmpl, ts, names)
+	}
+
+	return nil, fmt.Errorf("client does not support looking up vsys imports")
+}
+
+// RestoreImports imports each object back into the vsys it was imported
+// into, as returned by ImportedVsys().
+func RestoreImports(con XapiClient, loc, tmpl, ts string, prev map[string]string) error {
+	byVsys := make(map[string][]string)
+	for name, vsys := range prev {
+		byVsys[vsys] = append(byVsys[vsys], name)
+	}
+
+	list := make([]string, 0, len(byVsys))
+	for vsys := range byVsys {
+		list = append(list, vsys)
+	}
+	sort.Strings(list)
+
+	for _, vsys := range list {
+		names := byVsys[vsys]
+		sort.Strings(names)
+		if err := con.VsysImport(loc, tmpl, ts, vsys, names); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
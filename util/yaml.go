@@ -0,0 +1,255 @@
+package util
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+/*
+MarshalYAML returns a representation of the given struct that YAML encoders
+can encode, for use in MarshalYAML functions.
+
+The keys are the snake_case versions of the struct's field names, and fields
+that have their zero value are omitted.  So an address object Entry looks
+like this:
+
+	name: web-server
+	value: 10.1.1.1
+	type: ip-netmask
+	tags:
+	  - web
+
+This function does not depend on any particular YAML package.  The returned
+value is suitable for both gopkg.in/yaml.v2 and gopkg.in/yaml.v3.
+*/
+func MarshalYAML(in interface{}) (interface{}, error) {
+	return yamlValue(reflect.ValueOf(in)), nil
+}
+
+/*
+UnmarshalYAML decodes YAML into the given struct pointer, for use in
+UnmarshalYAML functions.
+
+The unmarshal param is the function given to UnmarshalYAML by the YAML
+package (this is the gopkg.in/yaml.v2 style, which gopkg.in/yaml.v3 also
+supports).
+
+Keys are matched to struct fields ignoring case, underscores, and dashes, so
+"source_zones", "source-zones", and "SourceZones" are all decoded into the
+SourceZones field.  Scalars are converted to the type of the field, so a
+port given as the number 443 is decoded into a string field as "443".
+Unknown keys are an error.
+*/
+func UnmarshalYAML(unmarshal func(interface{}) error, out interface{}) error {
+	var data map[string]interface{}
+	if err := unmarshal(&data); err != nil {
+		return err
+	}
+
+	v := reflect.ValueOf(out)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("expected a struct pointer, not %T", out)
+	}
+
+	return setYamlValue(v.Elem(), data, "")
+}
+
+// SnakeCase returns the snake_case version of the given Go identifier.
+func SnakeCase(s string) string {
+	r := []rune(s)
+	var b strings.Builder
+	for i, ch := range r {
+		if unicode.IsUpper(ch) {
+			if i > 0 && (unicode.IsLower(r[i-1]) || unicode.IsDigit(r[i-1]) ||
+				(i+1 < len(r) && unicode.IsLower(r[i+1]) && unicode.IsUpper(r[i-1]))) {
+				b.WriteByte('_')
+			}
+			ch = unicode.ToLower(ch)
+		}
+		b.WriteRune(ch)
+	}
+
+	return b.String()
+}
+
+/** Internal functions for YAML support **/
+
+func yamlValue(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return yamlValue(v.Elem())
+	case reflect.Struct:
+		ans := make(map[string]interface{})
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" || v.Field(i).IsZero() {
+				continue
+			}
+			ans[SnakeCase(f.Name)] = yamlValue(v.Field(i))
+		}
+		return ans
+	case reflect.Slice, reflect.Array:
+		ans := make([]interface{}, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			ans = append(ans, yamlValue(v.Index(i)))
+		}
+		return ans
+	case reflect.Map:
+		ans := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			ans[fmt.Sprint(iter.Key().Interface())] = yamlValue(iter.Value())
+		}
+		return ans
+	}
+
+	return v.Interface()
+}
+
+func yamlKey(s string) string {
+	s = strings.ReplaceAll(s, "_", "")
+	s = strings.ReplaceAll(s, "-", "")
+	return strings.ToLower(s)
+}
+
+func setYamlValue(v reflect.Value, data interface{}, path string) error {
+	if data == nil {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		nv := reflect.New(v.Type().Elem())
+		if err := setYamlValue(nv.Elem(), data, path); err != nil {
+			return err
+		}
+		v.Set(nv)
+		return nil
+	case reflect.Struct:
+		m, err := yamlMap(data, path)
+		if err != nil {
+			return err
+		}
+		fields := make(map[string]int)
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).PkgPath == "" {
+				fields[yamlKey(t.Field(i).Name)] = i
+			}
+		}
+		for k, x := range m {
+			i, ok := fields[yamlKey(k)]
+			if !ok {
+				return fmt.Errorf("%s: unknown key %q", yamlPath(path), k)
+			}
+			if err = setYamlValue(v.Field(i), x, path+"."+k); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Slice:
+		list, ok := data.([]interface{})
+		if !ok {
+			// Allow a single value in place of a list.
+			list = []interface{}{data}
+		}
+		sv := reflect.MakeSlice(v.Type(), len(list), len(list))
+		for i := range list {
+			if err := setYamlValue(sv.Index(i), list[i], fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		v.Set(sv)
+		return nil
+	case reflect.Map:
+		m, err := yamlMap(data, path)
+		if err != nil {
+			return err
+		}
+		mv := reflect.MakeMapWithSize(v.Type(), len(m))
+		for k, x := range m {
+			kv := reflect.New(v.Type().Key()).Elem()
+			if err = setYamlValue(kv, k, path); err != nil {
+				return err
+			}
+			ev := reflect.New(v.Type().Elem()).Elem()
+			if err = setYamlValue(ev, x, path+"."+k); err != nil {
+				return err
+			}
+			mv.SetMapIndex(kv, ev)
+		}
+		v.Set(mv)
+		return nil
+	case reflect.Interface:
+		v.Set(reflect.ValueOf(data))
+		return nil
+	}
+
+	// Scalars.
+	s := fmt.Sprint(data)
+	var err error
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		var b bool
+		if b, err = strconv.ParseBool(s); err == nil {
+			v.SetBool(b)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var n int64
+		if n, err = strconv.ParseInt(s, 10, v.Type().Bits()); err == nil {
+			v.SetInt(n)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var n uint64
+		if n, err = strconv.ParseUint(s, 10, v.Type().Bits()); err == nil {
+			v.SetUint(n)
+		}
+	case reflect.Float32, reflect.Float64:
+		var n float64
+		if n, err = strconv.ParseFloat(s, v.Type().Bits()); err == nil {
+			v.SetFloat(n)
+		}
+	default:
+		return fmt.Errorf("%s: unsupported type %s", yamlPath(path), v.Type())
+	}
+	if err != nil {
+		return fmt.Errorf("%s: invalid %s %q", yamlPath(path), v.Kind(), s)
+	}
+
+	return nil
+}
+
+// yamlMap converts the given decoded YAML mapping into a map with string keys,
+// since gopkg.in/yaml.v2 decodes nested mappings with interface{} keys.
+func yamlMap(data interface{}, path string) (map[string]interface{}, error) {
+	switch m := data.(type) {
+	case map[string]interface{}:
+		return m, nil
+	case map[interface{}]interface{}:
+		ans := make(map[string]interface{}, len(m))
+		for k, x := range m {
+			ans[fmt.Sprint(k)] = x
+		}
+		return ans, nil
+	}
+
+	return nil, fmt.Errorf("%s: expected a mapping, not %T", yamlPath(path), data)
+}
+
+func yamlPath(path string) string {
+	if path == "" {
+		return "(root)"
+	}
+
+	return strings.TrimPrefix(path, ".")
+}
@@ -0,0 +1,135 @@
+package util
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type yamlSpec struct {
+	Enabled bool
+	Weight  int
+}
+
+type yamlEntry struct {
+	Name        string
+	SourceZones []string
+	Port        string
+	Spec        *yamlSpec
+	Labels      map[string]string
+	hidden      string
+}
+
+// yamlUnmarshaler mimics the unmarshal func given by a YAML package.
+func yamlUnmarshaler(data interface{}) func(interface{}) error {
+	return func(out interface{}) error {
+		b, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(b, out)
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	testCases := map[string]string{
+		"Name":          "name",
+		"SourceZones":   "source_zones",
+		"URLCategories": "url_categories",
+		"Ipv6Enabled":   "ipv6_enabled",
+		"HttpOcsp":      "http_ocsp",
+	}
+
+	for in, out := range testCases {
+		if s := SnakeCase(in); s != out {
+			t.Errorf("%s: expected %q, got %q", in, out, s)
+		}
+	}
+}
+
+func TestMarshalYAML(t *testing.T) {
+	e := yamlEntry{
+		Name:        "one",
+		SourceZones: []string{"trust"},
+		Spec:        &yamlSpec{Weight: 5},
+		hidden:      "x",
+	}
+
+	v, err := MarshalYAML(e)
+	if err != nil {
+		t.Fatalf("Error marshaling: %s", err)
+	}
+
+	expected := map[string]interface{}{
+		"name":         "one",
+		"source_zones": []interface{}{"trust"},
+		"spec":         map[string]interface{}{"weight": 5},
+	}
+	if !reflect.DeepEqual(v, expected) {
+		t.Errorf("Expected %#v, got %#v", expected, v)
+	}
+}
+
+func TestUnmarshalYAML(t *testing.T) {
+	data := map[string]interface{}{
+		"name":         "one",
+		"source-zones": "trust",
+		"port":         443,
+		"spec":         map[string]interface{}{"enabled": true, "weight": "5"},
+		"labels":       map[string]interface{}{"env": "prod"},
+	}
+
+	var e yamlEntry
+	if err := UnmarshalYAML(yamlUnmarshaler(data), &e); err != nil {
+		t.Fatalf("Error unmarshaling: %s", err)
+	}
+
+	expected := yamlEntry{
+		Name:        "one",
+		SourceZones: []string{"trust"},
+		Port:        "443",
+		Spec:        &yamlSpec{Enabled: true, Weight: 5},
+		Labels:      map[string]string{"env": "prod"},
+	}
+	if !reflect.DeepEqual(e, expected) {
+		t.Errorf("Expected %#v, got %#v", expected, e)
+	}
+}
+
+func TestUnmarshalYAMLInterfaceKeys(t *testing.T) {
+	data := map[string]interface{}{
+		"spec": map[interface{}]interface{}{"weight": 3},
+	}
+	unmarshal := func(out interface{}) error {
+		*(out.(*map[string]interface{})) = data
+		return nil
+	}
+
+	var e yamlEntry
+	if err := UnmarshalYAML(unmarshal, &e); err != nil {
+		t.Fatalf("Error unmarshaling: %s", err)
+	} else if e.Spec == nil || e.Spec.Weight != 3 {
+		t.Errorf("Spec is %#v", e.Spec)
+	}
+}
+
+func TestUnmarshalYAMLErrors(t *testing.T) {
+	testCases := []struct {
+		desc string
+		data map[string]interface{}
+	}{
+		{"unknown key", map[string]interface{}{"bogus": 1}},
+		{"hidden field", map[string]interface{}{"hidden": "x"}},
+		{"invalid int", map[string]interface{}{"spec": map[string]interface{}{"weight": "heavy"}}},
+		{"not a mapping", map[string]interface{}{"spec": "x"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var e yamlEntry
+			if err := UnmarshalYAML(yamlUnmarshaler(tc.data), &e); err == nil {
+				t.Errorf("No error")
+			}
+		})
+	}
+}
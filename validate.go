@@ -0,0 +1,208 @@
+package pango
+
+import (
+	"encoding/xml"
+	"strings"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Valid values for ValidationFinding.Severity.
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// ValidationFinding is a single error or warning from a config validation.
+//
+// The Xpath is the config location as reported by PAN-OS, with each element
+// separated by a "/".  PAN-OS reports entry names as path elements, so a rule
+// named "r1" is given as ".../rules/r1" instead of ".../rules/entry[@name='r1']".
+// The Xpath is empty if PAN-OS did not give a location for the finding.
+type ValidationFinding struct {
+	Severity string
+	Xpath    string
+	Message  string
+}
+
+// ValidationResult is the result of a config validation job.
+type ValidationResult struct {
+	CommitResult
+	Findings []ValidationFinding
+}
+
+// Valid returns if the config passed validation.
+func (o ValidationResult) Valid() bool {
+	return o.Result == "OK"
+}
+
+// Errors returns the findings that are errors.
+func (o ValidationResult) Errors() []ValidationFinding {
+	return o.filter(SeverityError)
+}
+
+// Warnings returns the findings that are warnings.
+func (o ValidationResult) Warnings() []ValidationFinding {
+	return o.filter(SeverityWarning)
+}
+
+func (o ValidationResult) filter(severity string) []ValidationFinding {
+	var ans []ValidationFinding
+	for _, f := range o.Findings {
+		if f.Severity == severity {
+			ans = append(ans, f)
+		}
+	}
+
+	return ans
+}
+
+// ValidationError is returned by ValidateAndWait if the config failed
+// validation.
+type ValidationError struct {
+	Findings []ValidationFinding
+}
+
+// Error returns the error message.
+func (e ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Findings))
+	for _, f := range e.Findings {
+		if f.Severity != SeverityError {
+			continue
+		}
+		if f.Xpath != "" {
+			msgs = append(msgs, f.Xpath+": "+f.Message)
+		} else {
+			msgs = append(msgs, f.Message)
+		}
+	}
+
+	if len(msgs) == 0 {
+		return "config validation failed"
+	}
+	return "config validation failed: " + strings.Join(msgs, " | ")
+}
+
+// ValidateAndWait performs a full commit validation of the candidate config,
+// then waits for the validation job to finish.
+//
+// The sleep and fn params are the same as for WaitForCommit.
+//
+// The job details and warnings are parsed into findings.  If validation
+// failed, a ValidationError is returned along with the result.
+func (c *Client) ValidateAndWait(sleep time.Duration, fn CommitProgressFunc) (ValidationResult, error) {
+	c.LogOp("(op) validating config")
+	type op_req struct {
+		XMLName xml.Name `xml:"validate"`
+		Cmd     string   `xml:"full"`
+	}
+
+	job_ans := util.JobResponse{}
+	if _, err := c.Op(op_req{}, "", nil, &job_ans); err != nil {
+		return ValidationResult{}, err
+	}
+
+	cr, err := c.WaitForCommit(job_ans.Id, sleep, fn)
+	ans := ValidationResult{
+		CommitResult: cr,
+		Findings:     parseFindings(cr),
+	}
+
+	if cr.Result == "FAIL" {
+		return ans, ValidationError{Findings: ans.Findings}
+	}
+
+	return ans, err
+}
+
+/** Internal functions **/
+
+// parseFindings pulls the validation findings out of the job details and
+// warnings.
+//
+// Details lines are findings if they give a config location (elements are
+// separated by " -> ") or start with "Error" / "Warning".  Headers such as
+// "Validation Error:" are skipped.
+func parseFindings(cr CommitResult) []ValidationFinding {
+	var ans []ValidationFinding
+
+	for _, line := range cr.Details {
+		f, ok := parseFinding(line)
+		if !ok {
+			continue
+		}
+		if f.Severity == "" {
+			if cr.Result == "FAIL" {
+				f.Severity = SeverityError
+			} else {
+				f.Severity = SeverityWarning
+			}
+		}
+		ans = append(ans, f)
+	}
+
+	for _, line := range cr.Warnings {
+		if f, ok := parseFinding(line); ok {
+			f.Severity = SeverityWarning
+			ans = append(ans, f)
+		} else if line = strings.TrimSpace(line); line != "" {
+			ans = append(ans, ValidationFinding{
+				Severity: SeverityWarning,
+				Message:  line,
+			})
+		}
+	}
+
+	return ans
+}
+
+// parseFinding parses a single line, returning false if the line is not a
+// finding.
+func parseFinding(line string) (ValidationFinding, bool) {
+	var ans ValidationFinding
+
+	line = strings.TrimSpace(line)
+	lower := strings.ToLower(line)
+	for _, p := range []struct {
+		prefix   string
+		severity string
+	}{
+		{"warning:", SeverityWarning},
+		{"warning", SeverityWarning},
+		{"error:", SeverityError},
+	} {
+		if strings.HasPrefix(lower, p.prefix) {
+			ans.Severity = p.severity
+			line = strings.TrimSpace(line[len(p.prefix):])
+			break
+		}
+	}
+
+	if strings.HasSuffix(line, ":") && !strings.Contains(line, " -> ") {
+		// Section header, such as "Validation Error:".
+		return ans, false
+	}
+
+	parts := strings.Split(line, " -> ")
+	if len(parts) == 1 {
+		ans.Message = line
+		return ans, ans.Severity != "" && line != ""
+	}
+
+	// The last part is the final path element, followed by the message.
+	last := parts[len(parts)-1]
+	if i := strings.IndexAny(last, " \t"); i > 0 {
+		parts[len(parts)-1] = last[:i]
+		ans.Message = strings.TrimSpace(last[i:])
+	} else {
+		ans.Message = last
+	}
+
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+	ans.Xpath = strings.Join(parts, "/")
+
+	return ans, true
+}
@@ -0,0 +1,65 @@
+package pango
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateAndWaitFailure(t *testing.T) {
+	c := &Client{rb: [][]byte{
+		[]byte(`<response status="success"><result><job>9</job></result></response>`),
+		[]byte(`<response status="success"><result><job><status>FIN</status><result>FAIL</result><progress>100</progress><details><line><![CDATA[ Validation Error:]]></line><line><![CDATA[ vsys -> vsys1 -> rulebase -> security -> rules -> r1 -> from 'bad' is not a valid reference]]></line><line><![CDATA[ vsys -> vsys1 -> rulebase -> security -> rules -> r1 is invalid]]></line></details></job></result></response>`),
+	}}
+	c.Initialize()
+
+	ans, err := c.ValidateAndWait(0, nil)
+	if _, ok := err.(ValidationError); !ok {
+		t.Fatalf("Expected ValidationError, got %v", err)
+	}
+
+	if ans.Valid() {
+		t.Errorf("Result is valid")
+	}
+	if cmd := c.rp[0].Get("cmd"); cmd != "<validate><full></full></validate>" {
+		t.Errorf("cmd is %s", cmd)
+	}
+
+	expected := []ValidationFinding{
+		{SeverityError, "vsys/vsys1/rulebase/security/rules/r1/from", "'bad' is not a valid reference"},
+		{SeverityError, "vsys/vsys1/rulebase/security/rules/r1", "is invalid"},
+	}
+	if !reflect.DeepEqual(ans.Findings, expected) {
+		t.Errorf("%#v != %#v", ans.Findings, expected)
+	}
+	if len(ans.Errors()) != 2 || len(ans.Warnings()) != 0 {
+		t.Errorf("Errors: %d, warnings: %d", len(ans.Errors()), len(ans.Warnings()))
+	}
+}
+
+func TestValidateAndWaitWarnings(t *testing.T) {
+	c := &Client{rb: [][]byte{
+		[]byte(`<response status="success"><result><job>9</job></result></response>`),
+		[]byte(`<response status="success"><result><job><status>FIN</status><result>OK</result><progress>100</progress><warnings><line>Warning: No valid antivirus content package exists</line></warnings><details><line>Configuration is valid</line><line>Warning: vsys -> vsys1 -> rulebase -> security -> rules -> r2 -> application 'web-browsing' requires 'ssl'</line></details></job></result></response>`),
+	}}
+	c.Initialize()
+
+	ans, err := c.ValidateAndWait(0, nil)
+	if err != nil {
+		t.Fatalf("Error in validate: %s", err)
+	}
+
+	if !ans.Valid() {
+		t.Errorf("Result is not valid")
+	}
+
+	expected := []ValidationFinding{
+		{SeverityWarning, "vsys/vsys1/rulebase/security/rules/r2/application", "'web-browsing' requires 'ssl'"},
+		{SeverityWarning, "", "No valid antivirus content package exists"},
+	}
+	if !reflect.DeepEqual(ans.Findings, expected) {
+		t.Errorf("%#v != %#v", ans.Findings, expected)
+	}
+	if ans.Errors() != nil {
+		t.Errorf("Errors: %#v", ans.Errors())
+	}
+}
@@ -0,0 +1,147 @@
+package pango
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+)
+
+// WarningError is returned instead of a nil error when the client has
+// WarningsAsErrors set and PAN-OS reports success with warnings.
+//
+// The request itself was successful, so the config change, commit, etc. has
+// still taken place.
+type WarningError struct {
+	Warnings []string
+}
+
+// Error returns the error message.
+func (e WarningError) Error() string {
+	return "PAN-OS returned warnings: " + strings.Join(e.Warnings, " | ")
+}
+
+// Messages returns each warning.
+func (e WarningError) Messages() []string {
+	return e.Warnings
+}
+
+/** Internal functions **/
+
+// panosWarnings collects the various places PAN-OS puts warnings in a
+// successful response.
+type panosWarnings struct {
+	XMLName    xml.Name `xml:"response"`
+	Warnings   []string `xml:"warnings>line"`
+	Result     []string `xml:"result>warnings>line"`
+	Job        []string `xml:"result>job>warnings>line"`
+	Msg        []string `xml:"msg>line"`
+	ResultMsg  []string `xml:"result>msg>line"`
+	JobDetails []string `xml:"result>job>details>line"`
+}
+
+// warningMarkers are the strings that a response must contain in order to
+// have any warnings.  "arning" matches both "warning" and "Warning".
+var warningMarkers = [][]byte{
+	[]byte("arning"),
+	[]byte("ARNING"),
+	[]byte("is not a valid reference"),
+}
+
+// hasWarningMarker returns if the given response body may have warnings.
+func hasWarningMarker(body []byte) bool {
+	for _, m := range warningMarkers {
+		if bytes.Contains(body, m) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// lines returns the warnings found.
+//
+// Everything in a warnings section is a warning, while message and job
+// details lines are only treated as warnings if they look like one.
+func (o panosWarnings) lines() []string {
+	ans := make([]string, 0)
+
+	for _, list := range [][]string{o.Warnings, o.Result, o.Job} {
+		for _, v := range list {
+			if v = strings.TrimSpace(v); v != "" {
+				ans = append(ans, v)
+			}
+		}
+	}
+
+	for _, list := range [][]string{o.Msg, o.ResultMsg, o.JobDetails} {
+		for _, v := range list {
+			v = strings.TrimSpace(v)
+			lv := strings.ToLower(v)
+			if strings.HasPrefix(lv, "warning") || strings.Contains(lv, "is not a valid reference") {
+				ans = append(ans, v)
+			}
+		}
+	}
+
+	return ans
+}
+
+// Warnings returns the warnings from the most recent API call made by this
+// client.
+//
+// When the client is shared between goroutines, the most recent call may not
+// be the caller's own.  Set WarningsAsErrors to get the warnings for each
+// call from the WarningError returned instead.
+func (c *Client) Warnings() []string {
+	c.mu().warnings.Lock()
+	defer c.mu().warnings.Unlock()
+
+	if len(c.warnings) == 0 {
+		return nil
+	}
+
+	ans := make([]string, len(c.warnings))
+	copy(ans, c.warnings)
+	return ans
+}
+
+// saveWarnings saves the warnings from the most recent API call.
+func (c *Client) saveWarnings(lines []string) {
+	c.mu().warnings.Lock()
+	c.warnings = lines
+	c.mu().warnings.Unlock()
+}
+
+// checkWarnings saves any warnings present in the response body, returning
+// a WarningError if warnings should be treated as errors.
+func (c *Client) checkWarnings(body []byte) error {
+	// Most responses have no warnings, so skip parsing the body (which may be
+	// a large config) unless it has something that could be one.
+	if !hasWarningMarker(body) {
+		c.saveWarnings(nil)
+		return nil
+	}
+
+	w := panosWarnings{}
+	if err := xml.Unmarshal(body, &w); err != nil {
+		c.saveWarnings(nil)
+		return nil
+	}
+
+	lines := w.lines()
+	if len(lines) == 0 {
+		c.saveWarnings(nil)
+		return nil
+	}
+	c.saveWarnings(lines)
+
+	for _, v := range lines {
+		c.LogAction("(warning) %s", v)
+	}
+
+	if c.WarningsAsErrors {
+		return WarningError{Warnings: lines}
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package pango
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestWarningsSaved(t *testing.T) {
+	c := &Client{rb: [][]byte{
+		[]byte(`<response status="success" code="20"><msg><line>command succeeded</line><line>Warning: rule1 -> to 'z9' is not a valid reference</line></msg></response>`),
+		[]byte(`<response status="success" code="20"><msg>command succeeded</msg></response>`),
+	}}
+	c.Initialize()
+
+	if _, err := c.Set("/config/shared", "<address/>", nil, nil); err != nil {
+		t.Fatalf("Error in set: %s", err)
+	}
+	expected := []string{"Warning: rule1 -> to 'z9' is not a valid reference"}
+	if !reflect.DeepEqual(c.Warnings(), expected) {
+		t.Errorf("Warnings are %#v", c.Warnings())
+	}
+
+	if _, err := c.Set("/config/shared", "<address/>", nil, nil); err != nil {
+		t.Fatalf("Error in set: %s", err)
+	}
+	if c.Warnings() != nil {
+		t.Errorf("Warnings not cleared: %#v", c.Warnings())
+	}
+}
+
+func TestWarningsAsErrors(t *testing.T) {
+	c := &Client{
+		WarningsAsErrors: true,
+		rb: [][]byte{
+			[]byte(`<response status="success"><result><warnings><line>ssl decryption is disabled</line></warnings></result></response>`),
+		},
+	}
+	c.Initialize()
+
+	_, err := c.Op("<show><foo/></show>", "", nil, nil)
+	e, ok := err.(WarningError)
+	if !ok {
+		t.Fatalf("Error is %#v, not WarningError", err)
+	}
+	if !reflect.DeepEqual(e.Warnings, []string{"ssl decryption is disabled"}) {
+		t.Errorf("Warnings are %#v", e.Warnings)
+	}
+}
+
+func TestWaitForJobWarnings(t *testing.T) {
+	c := &Client{
+		WarningsAsErrors: true,
+		rb: [][]byte{
+			[]byte(`<response status="success"><result><job><result>OK</result><progress>100</progress><warnings><line>app dependency warning</line></warnings><details><line>Configuration committed successfully</line></details></job></result></response>`),
+		},
+	}
+	c.Initialize()
+
+	if _, ok := c.WaitForJob(1, 0, nil).(WarningError); !ok {
+		t.Errorf("Expected a WarningError")
+	}
+
+	c.WarningsAsErrors = false
+	if err := c.WaitForJob(1, 0, nil); err != nil {
+		t.Errorf("Unexpected error: %s", err)
+	}
+}
+
+func TestWarningsConcurrent(t *testing.T) {
+	c := &Client{}
+	body := []byte(`<response status="success"><msg><line>Warning: rule1 is not a valid reference</line></msg></response>`)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_ = c.checkWarnings(body)
+				_ = c.Warnings()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(c.Warnings()) != 1 {
+		t.Errorf("Warnings are %#v", c.Warnings())
+	}
+}
+
+func TestHasWarningMarker(t *testing.T) {
+	testCases := []struct {
+		body string
+		ok   bool
+	}{
+		{`<response status="success"><result><config><address/></config></result></response>`, false},
+		{`<response status="success"><result><warnings><line>x</line></warnings></result></response>`, true},
+		{`<response status="success"><msg><line>Warning: x</line></msg></response>`, true},
+		{`<response status="success"><msg><line>WARNING: x</line></msg></response>`, true},
+		{`<response status="success"><msg><line>'z9' is not a valid reference</line></msg></response>`, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.body, func(t *testing.T) {
+			if hasWarningMarker([]byte(tc.body)) != tc.ok {
+				t.Errorf("Expected %t", tc.ok)
+			}
+		})
+	}
+}